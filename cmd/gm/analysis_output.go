@@ -0,0 +1,77 @@
+package main
+
+import (
+	"github.com/yourusername/gitman/internal/usecase"
+)
+
+// analysisOutputVersion is bumped whenever a field of analysisOutput is
+// renamed, removed or changes meaning; adding fields keeps the version.
+const analysisOutputVersion = 1
+
+// analysisOutput is what gm commit --analyze-only --json prints. Its field
+// names are part of the command's interface for tools, so domain types are
+// copied into it rather than marshaled directly.
+type analysisOutput struct {
+	Version      int                 `json:"version"`
+	Branch       string              `json:"branch"`
+	Action       string              `json:"action"`
+	Confidence   float64             `json:"confidence"`
+	Reasoning    string              `json:"reasoning"`
+	Message      *messageOutput      `json:"message"`               // null when no message was suggested
+	BranchName   string              `json:"branch_name,omitempty"` // Suggested branch for create-branch
+	Alternatives []alternativeOutput `json:"alternatives"`
+	Files        []string            `json:"files"` // Changed files that were analyzed
+	Model        string              `json:"model"`
+	TokensUsed   int                 `json:"tokens_used"`
+}
+
+// messageOutput is a suggested commit message.
+type messageOutput struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body,omitempty"`
+	Full    string `json:"full"` // Subject and body as git records them
+}
+
+// alternativeOutput is an action offered besides the recommended one.
+type alternativeOutput struct {
+	Action      string  `json:"action"`
+	BranchName  string  `json:"branch_name,omitempty"`
+	Confidence  float64 `json:"confidence"`
+	Description string  `json:"description"`
+}
+
+// newAnalysisOutput copies the analysis into its JSON form.
+func newAnalysisOutput(resp *usecase.AnalyzeCommitResponse) analysisOutput {
+	decision := resp.Decision
+	output := analysisOutput{
+		Version:      analysisOutputVersion,
+		Action:       decision.Action().String(),
+		Confidence:   decision.Confidence(),
+		Reasoning:    decision.Reasoning(),
+		BranchName:   decision.BranchName(),
+		Alternatives: []alternativeOutput{},
+		Files:        []string{},
+		Model:        resp.Model,
+		TokensUsed:   resp.TokensUsed,
+	}
+	if resp.BranchInfo != nil {
+		output.Branch = resp.BranchInfo.Name()
+	}
+	if msg := decision.SuggestedMessage(); msg != nil {
+		output.Message = &messageOutput{Subject: msg.Title(), Body: msg.Body(), Full: msg.FullMessage()}
+	}
+	for _, alt := range decision.Alternatives() {
+		output.Alternatives = append(output.Alternatives, alternativeOutput{
+			Action:      alt.Action.String(),
+			BranchName:  alt.BranchName,
+			Confidence:  alt.Confidence,
+			Description: alt.Description,
+		})
+	}
+	if resp.Repository != nil {
+		for _, change := range resp.Repository.Changes() {
+			output.Files = append(output.Files, change.Path)
+		}
+	}
+	return output
+}
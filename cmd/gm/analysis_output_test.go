@@ -0,0 +1,52 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/domain"
+	"github.com/yourusername/gitman/internal/usecase"
+)
+
+func TestNewAnalysisOutput(t *testing.T) {
+	decision, err := domain.NewDecision(domain.ActionCreateBranch, 0.8, "Touches the login flow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	decision.SetBranchName("feature/oauth-login")
+	decision.AddAlternative(domain.Alternative{Action: domain.ActionCommitDirect, Confidence: 0.3, Description: "Commit to main"})
+	branch, err := domain.NewBranchInfo("main")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := json.Marshal(newAnalysisOutput(&usecase.AnalyzeCommitResponse{BranchInfo: branch, Decision: decision, Model: "stub"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`"version":1`,
+		`"branch":"main"`,
+		`"action":"create-branch"`,
+		`"message":null`,
+		`"branch_name":"feature/oauth-login"`,
+		`"alternatives":[{"action":"commit-direct","confidence":0.3,"description":"Commit to main"}]`,
+		`"files":[]`,
+		`"tokens_used":0`,
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("JSON = %s, want it to contain %s", out, want)
+		}
+	}
+
+	msg, err := domain.NewCommitMessage("feat: add OAuth login\n\nUses PKCE.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	decision.SetSuggestedMessage(msg)
+	output := newAnalysisOutput(&usecase.AnalyzeCommitResponse{Decision: decision})
+	if output.Message == nil || output.Message.Subject != "feat: add OAuth login" || output.Message.Body != "Uses PKCE." {
+		t.Errorf("Message = %+v, want the suggested subject and body", output.Message)
+	}
+}
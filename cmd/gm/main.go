@@ -2,23 +2,69 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/term"
 	"github.com/spf13/cobra"
 	"github.com/yourusername/gitman/internal/adapter/ai"
 	"github.com/yourusername/gitman/internal/adapter/config"
 	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/adapter/github"
 	"github.com/yourusername/gitman/internal/domain"
 	"github.com/yourusername/gitman/internal/ui"
+	"github.com/yourusername/gitman/internal/usecase"
 )
 
 var (
 	version = "0.1.0"
 	cfgManager *config.Manager
+
+	// noAI bypasses the AI provider entirely (manual commit messages, default merge strategy)
+	noAI bool
+
+	// dryRun previews the git commands of a commit or merge without running them
+	dryRun bool
+
+	// pathScope limits commit analysis and staging to a subtree of the repository
+	pathScope string
+
+	// forceTUI launches the TUI even when stdin/stdout are not terminals
+	forceTUI bool
+
+	// isTerminal reports whether stdin and stdout are attached to a terminal
+	isTerminal = func() bool {
+		return term.IsTerminal(os.Stdin.Fd()) && term.IsTerminal(os.Stdout.Fd())
+	}
+
+	// runTUI runs a full-screen Bubble Tea program and returns its final model
+	runTUI = func(model tea.Model) (tea.Model, error) {
+		return tea.NewProgram(model, tea.WithAltScreen()).Run()
+	}
 )
 
+// errNotTerminal is returned instead of launching the TUI without a terminal,
+// where it would garble piped output or hang waiting for input in CI.
+var errNotTerminal = errors.New("the GitMind dashboard needs an interactive terminal, but stdin/stdout is not a TTY (piped output or CI)\n" +
+	"  Run gm from a terminal, use 'gm commit --no-tui' to commit from a script\n" +
+	"  or 'gm commit --analyze-only --json' to read the analysis,\n" +
+	"  or pass --force-tui to launch the dashboard anyway")
+
+// requireTerminal refuses to start the TUI when not attached to a terminal, unless --force-tui is set
+func requireTerminal() error {
+	if forceTUI || isTerminal() {
+		return nil
+	}
+	return errNotTerminal
+}
+
 func main() {
 	// Initialize config manager
 	var err error
@@ -43,10 +89,18 @@ commit messages and help you make smart branching decisions.`,
 		},
 	}
 
+	rootCmd.PersistentFlags().BoolVar(&noAI, "no-ai", false, "Skip AI analysis and write commit messages manually")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Show the git commands a commit or merge would run without running them")
+	rootCmd.PersistentFlags().StringVar(&pathScope, "path", "", "Only analyze and stage changes under this path (e.g. services/api in a monorepo)")
+	rootCmd.PersistentFlags().BoolVar(&forceTUI, "force-tui", false, "Launch the dashboard even when not attached to a terminal")
+
 	rootCmd.AddCommand(commitCmd())
 	rootCmd.AddCommand(mergeCmd())
 	rootCmd.AddCommand(configCmd())
 	rootCmd.AddCommand(onboardCmd())
+	rootCmd.AddCommand(changelogCmd())
+	rootCmd.AddCommand(reviewCmd())
+	rootCmd.AddCommand(doctorCmd())
 
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
@@ -54,18 +108,60 @@ commit messages and help you make smart branching decisions.`,
 }
 
 func commitCmd() *cobra.Command {
+	var split, noTUI, yes, analyzeOnly, jsonOutput bool
+	var message string
+
 	cmd := &cobra.Command{
 		Use:   "commit",
 		Short: "Analyze changes and create an AI-powered commit",
 		Long: `Analyzes your git changes using AI and helps you create meaningful commits.
 The AI will suggest commit messages and determine whether to commit directly
-or create a new branch based on the nature of your changes.`,
+or create a new branch based on the nature of your changes.
+
+With --split, the AI instead groups the changed files into logical sets and
+proposes a message for each; after you confirm, every group is staged and
+committed on its own, giving an atomic history from a mixed working tree.
+
+With --no-tui, the analysis runs without the dashboard for scripts and CI:
+the recommended action and message are printed, and --yes carries them out.
+-m commits with the given message instead, without calling the AI. A failed
+analysis or commit exits with a non-zero code. Example:
+  gm commit --no-tui --yes -m "chore: regenerate API client"
+
+With --analyze-only, the analysis is printed and nothing is staged or
+committed; add --json for a machine-readable result, e.g. for editor plugins.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if jsonOutput && !analyzeOnly {
+				return fmt.Errorf("--json needs --analyze-only")
+			}
+			if analyzeOnly {
+				return runAnalyzeOnly(jsonOutput)
+			}
+			if !noTUI && (yes || message != "") {
+				return fmt.Errorf("--yes and --message need --no-tui")
+			}
+			if noTUI {
+				return runHeadlessCommit(message, yes)
+			}
+			if split {
+				return runSplitCommit()
+			}
 			// Launch dashboard which handles commit workflow
 			return runDashboard()
 		},
 	}
 
+	cmd.Flags().BoolVar(&split, "split", false, "Split unrelated changes into separate commits, one per logical group")
+	cmd.Flags().BoolVar(&noTUI, "no-tui", false, "Analyze and commit without the dashboard, for scripts and CI")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "With --no-tui, carry out the recommended action instead of only printing it")
+	cmd.Flags().StringVarP(&message, "message", "m", "", "With --no-tui, commit with this message instead of asking the AI")
+	cmd.Flags().BoolVar(&analyzeOnly, "analyze-only", false, "Print the analysis without committing anything")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "With --analyze-only, print the analysis as JSON")
+	cmd.MarkFlagsMutuallyExclusive("split", "no-tui")
+	cmd.MarkFlagsMutuallyExclusive("analyze-only", "split")
+	cmd.MarkFlagsMutuallyExclusive("analyze-only", "yes")
+	cmd.MarkFlagsMutuallyExclusive("analyze-only", "message")
+
 	return cmd
 }
 
@@ -124,168 +220,84 @@ This wizard will guide you through:
 	return cmd
 }
 
-// DEPRECATED: runCommit is no longer used. All commands now launch the unified dashboard/AppModel.
-/* func runCommit(userPrompt string, useConventional bool) error {
-	// Load configuration
-	cfg, err := cfgManager.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	// Check if API key is configured
-	if cfg.APIKey == "" {
-		fmt.Println("GitMind is not configured yet.")
-		fmt.Println("Please run 'gm config' to set up your API key.")
-		return nil
-	}
+func changelogCmd() *cobra.Command {
+	var fromRef, toRef, outputPath, title string
 
-	// Get API key
-	apiKey, err := cfgManager.GetAPIKey(cfg)
-	if err != nil {
-		return fmt.Errorf("invalid API configuration: %w", err)
-	}
-
-	// Get current directory
-	cwd, err := os.Getwd()
-	if err != nil {
-		return fmt.Errorf("failed to get current directory: %w", err)
-	}
-
-	// Initialize dependencies
-	gitOps := git.NewExecOperations()
-
-	// Create AI provider
-	providerConfig := ai.ProviderConfig{
-		Model:   cfg.DefaultModel,
-		Timeout: 30,
-	}
-
-	aiProvider := ai.NewCerebrasProvider(apiKey, providerConfig)
-
-	// Create use cases
-	analyzeUseCase := usecase.NewAnalyzeCommitUseCase(gitOps, aiProvider)
-	executeUseCase := usecase.NewExecuteCommitUseCase(gitOps)
-
-	// Analyze commit
-	ui.PrintInfo("Analyzing changes with AI...")
-	ui.PrintSubtle("Reading file contents (files will not be staged until you confirm)")
-
-	// Use longer timeout for analysis (AI can be slow on free tier)
-	analysisCtx, analysisCancel := context.WithTimeout(context.Background(), 90*time.Second)
-	defer analysisCancel()
-
-	analysisReq := usecase.AnalyzeCommitRequest{
-		RepoPath:               cwd,
-		UserPrompt:             userPrompt,
-		UseConventionalCommits: useConventional || cfg.UseConventionalCommits,
-		APIKey:                 apiKey,
-		ProtectedBranches:      cfg.ProtectedBranches,
-	}
-
-	analysis, err := analyzeUseCase.Execute(analysisCtx, analysisReq)
-	if err != nil {
-		// Check for free tier limit error
-		if freeTierErr, ok := err.(*ai.FreeTierLimitError); ok {
-			ui.PrintWarning(freeTierErr.Message)
-			ui.PrintInfo(fmt.Sprintf("You can upgrade your API key or wait %d seconds before trying again", freeTierErr.RetryAfter))
-			return nil
-		}
-
-		// Special handling for common errors
-		errMsg := err.Error()
-		if errMsg == "no changes to commit" {
-			ui.PrintInfo("Working directory is clean - no changes to commit")
-			ui.PrintSubtle("Make some changes to your files, then run 'gm commit' again")
-			return nil
-		}
-
-		return fmt.Errorf("analysis failed: %w", err)
-	}
-
-	// Show TUI for user decision
-	model := ui.NewCommitViewModel(analysis)
-	p := tea.NewProgram(model)
-
-	finalModel, err := p.Run()
-	if err != nil {
-		return fmt.Errorf("UI error: %w", err)
-	}
-
-	commitModel := finalModel.(ui.CommitViewModel)
-
-	if commitModel.IsCancelled() {
-		ui.PrintInfo("Operation cancelled by user")
-		return nil
-	}
-
-	if !commitModel.IsConfirmed() {
-		ui.PrintWarning("No action selected")
-		return nil
-	}
-
-	// Get selected option
-	option := commitModel.GetSelectedOption()
-	if option == nil {
-		return fmt.Errorf("no option selected")
-	}
-
-	// Handle merge action differently - run merge workflow instead
-	if option.Action == domain.ActionMerge {
-		fmt.Println() // Blank line
-		ui.PrintInfo("Launching merge workflow...")
-
-		// Determine target branch from branch info
-		targetBranch := ""
-		if analysis.BranchInfo != nil && analysis.BranchInfo.Parent() != "" {
-			targetBranch = analysis.BranchInfo.Parent()
-		}
-
-		// Run merge workflow
-		return runMerge("", targetBranch)
+	cmd := &cobra.Command{
+		Use:   "changelog [from] [to]",
+		Short: "Generate a changelog between two tags or branches",
+		Long: `Gathers the commits between two refs (git log <from>..<to>) and groups them
+by conventional-commit type into breaking changes, features, fixes, and other
+changes; commits that aren't conventional go under "Other changes". AI words
+the entries unless --no-ai is set.
+The result is added to the top of CHANGELOG.md, or the file given with --output;
+--output - only prints the markdown to stdout.
+
+The refs can be given as arguments or with --from and --to; <to> defaults to HEAD. Examples:
+  gm changelog v1.0.0 v1.1.0
+  gm changelog --from v1.0.0 --to HEAD --output - > RELEASE_NOTES.md`,
+		Args: cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, to, err := changelogRange(args, fromRef, toRef)
+			if err != nil {
+				return err
+			}
+			return runChangelog(from, to, title, outputPath)
+		},
 	}
 
-	// Execute the commit
-	fmt.Println() // Blank line
-	ui.PrintInfo("Executing selected action...")
+	cmd.Flags().StringVar(&fromRef, "from", "", "Older ref, e.g. the previous release tag")
+	cmd.Flags().StringVar(&toRef, "to", "", "Newer ref (defaults to HEAD)")
+	cmd.Flags().StringVarP(&outputPath, "output", "o", usecase.DefaultChangelogPath, "Changelog file to write, or - to only print it")
+	cmd.Flags().StringVar(&title, "title", "", "Section heading (defaults to <to>)")
 
-	// Create a fresh context for git operations (generous timeout for large repos)
-	execCtx, execCancel := context.WithTimeout(context.Background(), 120*time.Second)
-	defer execCancel()
+	return cmd
+}
 
-	executeReq := usecase.ExecuteCommitRequest{
-		RepoPath:      cwd,
-		Decision:      analysis.Decision,
-		Action:        option.Action,
-		CommitMessage: option.Message,
-		BranchName:    option.BranchName,
-		StageAll:      true, // Always stage all for now
-	}
+func doctorCmd() *cobra.Command {
+	var checkKey bool
 
-	executeResp, err := executeUseCase.Execute(execCtx, executeReq)
-	if err != nil {
-		return fmt.Errorf("commit execution failed: %w", err)
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check that GitMind's dependencies and settings are in order",
+		Long: `Checks git, the GitHub CLI and its login, the config file, the API key,
+clipboard support, and whether the current directory is a git repository,
+then prints a checklist with a hint for anything that needs fixing.
+
+With --check-key the API key is also validated against the provider,
+which needs network access. Exits non-zero if any check fails.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDoctor(checkKey)
+		},
 	}
 
-	// Show success message
-	fmt.Println() // Blank line
-
-	// Special handling for manual review
-	if option.Action == domain.ActionReview {
-		ui.PrintInfo(executeResp.Message)
-		ui.PrintSubtle("Review your changes, then run 'gm commit' again when ready")
-		return nil
-	}
+	cmd.Flags().BoolVar(&checkKey, "check-key", false, "Validate the API key with the provider")
 
-	ui.PrintSuccess(executeResp.Message)
+	return cmd
+}
 
-	if executeResp.BranchCreated != "" {
-		fmt.Printf("  %s %s\n", ui.FormatLabel("Branch:"), ui.FormatValue(executeResp.BranchCreated))
+func reviewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "review [patch]",
+		Short: "Review a patch or diff with AI",
+		Long: `Reads a unified diff or git format-patch file and asks AI to summarize it,
+give a verdict, and list concerns. Reads from stdin when no file (or "-") is given.
+
+Review is read-only: the patch is never applied and the repository is not touched.
+Examples:
+  gm review fix-login.patch
+  git diff main...feature | gm review`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := "-"
+			if len(args) == 1 {
+				path = args[0]
+			}
+			return runReview(path)
+		},
 	}
-	fmt.Printf("  %s %s\n", ui.FormatLabel("Message:"), ui.FormatValue(option.Message.Title()))
-
-	return nil
 }
-*/
 
 // DEPRECATED: runMerge is no longer used. All commands now launch the unified dashboard/AppModel.
 /* func runMerge(sourceBranch, targetBranch string) error {
@@ -409,6 +421,10 @@ This wizard will guide you through:
 */
 
 func runDashboard() error {
+	if err := requireTerminal(); err != nil {
+		return err
+	}
+
 	// Get current directory
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -429,26 +445,98 @@ func runDashboard() error {
 	}
 
 	// Load config
-	cfg, err := cfgManager.Load()
+	cfg, err := cfgManager.LoadForRepo(cwd)
 	if err != nil {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
 
 	// Initialize theme from config
+	applyTheme(cfg)
+	gitOps.SetRenameDetection(cfg.Git.RenameDetection)
+	gitOps.SetCommitSigning(cfg.Git.SignCommits, cfg.Git.SigningKey)
+	if err := applyPathScope(gitOps, cwd); err != nil {
+		return err
+	}
+
+	providerConfig := aiProviderConfig(cfg, 30)
+
+	// Create AI provider unless AI is bypassed
+	var aiProvider ai.Provider
+	if !noAI {
+		aiProvider, err = newAIProvider(cfg, providerConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Create and launch AppModel (unified TUI)
+	model := ui.NewAppModel(gitOps, aiProvider, cfg, cfgManager, cwd, version)
+	if noAI {
+		model.SetAIDisabled(true)
+	} else {
+		model.SetActiveModel(ai.ResolveModel(providerConfig))
+	}
+	model.SetDryRun(dryRun)
+	final, err := runTUI(model)
+	if err != nil {
+		return fmt.Errorf("application error: %w", err)
+	}
+
+	// The alt screen leaves no scrollback, so leave a record of what was done
+	if app, ok := final.(ui.AppModel); ok {
+		if summary := app.SessionSummary(); summary != "" {
+			fmt.Print(summary)
+		}
+	}
+
+	return nil
+}
+
+// applyTheme selects the configured theme with its color overrides
+func applyTheme(cfg *domain.Config) {
 	ui.SetGlobalTheme(cfg.UI.Theme)
+	if err := ui.SetGlobalColorOverrides(cfg.UI.ColorOverrides); err != nil {
+		ui.PrintWarning(fmt.Sprintf("Ignoring ui.color_overrides: %v", err))
+	}
+}
 
-	// Check if API key is configured
-	if cfg.AI.APIKey == "" {
+// applyPathScope limits gitOps to the --path subtree, which must exist under cwd
+func applyPathScope(gitOps *git.ExecOperations, cwd string) error {
+	if pathScope == "" {
+		return nil
+	}
+	if _, err := os.Stat(filepath.Join(cwd, pathScope)); err != nil {
+		return fmt.Errorf("invalid --path %q: %w", pathScope, err)
+	}
+	gitOps.SetPathScope(pathScope)
+	return nil
+}
+
+// newAIProvider validates the configured API key and creates the AI provider
+func newAIProvider(cfg *domain.Config, providerConfig ai.ProviderConfig) (ai.Provider, error) {
+	// Check if API key is configured (local providers need none)
+	if cfg.AI.APIKey == "" && domain.ProviderRequiresAPIKey(cfg.AI.Provider) {
+		// A locked or unreachable keychain isn't the same as no key
+		if err := cfgManager.APIKeyError(); err != nil {
+			ui.PrintWarning(fmt.Sprintf("Could not read the API key: %v", err))
+			ui.PrintInfo("Unlock the keychain, or run 'gm --no-ai' to write commit messages manually")
+			return nil, fmt.Errorf("failed to read the API key: %w", err)
+		}
 		ui.PrintWarning("No API key configured")
-		ui.PrintInfo("Run 'gm config' or 'gm onboard' to set up your Cerebras API key")
-		ui.PrintInfo("You can get a free API key at https://cloud.cerebras.ai")
-		return fmt.Errorf("API key not configured")
+		if cfg.AI.Provider == "openai" {
+			ui.PrintInfo("Run 'gm config' to set up your OpenAI API key")
+			ui.PrintInfo("You can create an API key at https://platform.openai.com/api-keys")
+		} else {
+			ui.PrintInfo("Run 'gm config' or 'gm onboard' to set up your Cerebras API key")
+			ui.PrintInfo("You can get a free API key at https://cloud.cerebras.ai")
+		}
+		ui.PrintInfo("Or run 'gm --no-ai' to write commit messages manually")
+		return nil, fmt.Errorf("API key not configured")
 	}
 
-	// Create AI provider
 	apiKey, err := domain.NewAPIKey(cfg.AI.APIKey, cfg.AI.Provider)
 	if err != nil {
-		return fmt.Errorf("invalid API key: %w", err)
+		return nil, fmt.Errorf("invalid API key: %w", err)
 	}
 	tier, err := domain.ParseAPITier(cfg.AI.APITier)
 	if err != nil {
@@ -456,24 +544,590 @@ func runDashboard() error {
 	}
 	apiKey.SetTier(tier)
 
-	providerConfig := ai.ProviderConfig{
-		Model:   cfg.AI.DefaultModel,
-		Timeout: 30,
+	aiProvider, err := ai.NewFactory().Create(cfg.AI.Provider, apiKey, providerConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AI provider: %w", err)
 	}
-	aiProvider := ai.NewCerebrasProvider(apiKey, providerConfig)
 
-	// Create and launch AppModel (unified TUI)
-	model := ui.NewAppModel(gitOps, aiProvider, cfg, cfgManager, cwd, version)
-	p := tea.NewProgram(model, tea.WithAltScreen())
+	return aiProvider, nil
+}
 
-	_, err = p.Run()
+// aiProviderConfig builds the AI provider settings from cfg, with a request
+// timeout in seconds.
+func aiProviderConfig(cfg *domain.Config, timeout int) ai.ProviderConfig {
+	return ai.ProviderConfig{
+		Model:                  cfg.AI.DefaultModel,
+		Timeout:                timeout,
+		WeakMessagePolicy:      cfg.AI.WeakMessagePolicy,
+		MaxContextCommits:      cfg.AI.MaxContextCommits,
+		MaxMergeContextCommits: cfg.AI.MaxMergeContextCommits,
+	}
+}
+
+// changelogRange resolves the changelog's refs from the positional arguments
+// and the --from/--to flags; a ref can be given either way, not both.
+func changelogRange(args []string, fromFlag, toFlag string) (string, string, error) {
+	refs := []string{fromFlag, toFlag}
+	for i, arg := range args {
+		if refs[i] != "" && refs[i] != arg {
+			return "", "", fmt.Errorf("%s was given both as an argument and with --%s", arg, []string{"from", "to"}[i])
+		}
+		refs[i] = arg
+	}
+
+	if refs[0] == "" {
+		return "", "", fmt.Errorf("a ref to start from is required, e.g. gm changelog --from v1.0.0")
+	}
+	if refs[1] == "" {
+		refs[1] = "HEAD"
+	}
+	return refs[0], refs[1], nil
+}
+
+func runChangelog(fromRef, toRef, title, outputPath string) error {
+	cwd, err := os.Getwd()
 	if err != nil {
-		return fmt.Errorf("application error: %w", err)
+		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
+	gitOps := git.NewExecOperations()
+	ctx := context.Background()
+	isRepo, err := gitOps.IsGitRepo(ctx, cwd)
+	if err != nil || !isRepo {
+		return fmt.Errorf("not in a git repository")
+	}
+
+	cfg, err := cfgManager.LoadForRepo(cwd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	gitOps.SetRenameDetection(cfg.Git.RenameDetection)
+	gitOps.SetCommitSigning(cfg.Git.SignCommits, cfg.Git.SigningKey)
+
+	var aiProvider ai.Provider
+	var apiKey *domain.APIKey
+	if !noAI {
+		aiProvider, err = newAIProvider(cfg, aiProviderConfig(cfg, 60))
+		if err != nil {
+			return err
+		}
+
+		apiKey, err = domain.NewAPIKey(cfg.AI.APIKey, cfg.AI.Provider)
+		if err != nil {
+			return fmt.Errorf("invalid API key: %w", err)
+		}
+	}
+
+	// Printing only the markdown keeps the output fit for piping
+	toStdout := outputPath == usecase.ChangelogStdout
+	if !toStdout {
+		ui.PrintInfo(fmt.Sprintf("Generating changelog for %s..%s", fromRef, toRef))
+	}
+
+	resp, err := usecase.NewGenerateChangelogUseCase(gitOps, aiProvider).Execute(ctx, usecase.GenerateChangelogRequest{
+		RepoPath:   cwd,
+		FromRef:    fromRef,
+		ToRef:      toRef,
+		Title:      title,
+		OutputPath: outputPath,
+		SkipAI:     noAI,
+		APIKey:     apiKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	if toStdout {
+		fmt.Print(resp.Changelog.Markdown())
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Print(resp.Changelog.Markdown())
+	fmt.Println()
+	ui.PrintSuccess(fmt.Sprintf("Changelog written to %s", resp.OutputPath))
+	fmt.Printf("  %s %s\n", ui.FormatLabel("Commits:"), ui.FormatValue(fmt.Sprintf("%d", resp.CommitCount)))
+
 	return nil
 }
 
+func runReview(path string) error {
+	if noAI {
+		return fmt.Errorf("patch review requires AI; run without --no-ai")
+	}
+
+	var patch []byte
+	var err error
+	if path == "-" {
+		patch, err = io.ReadAll(os.Stdin)
+	} else {
+		patch, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read patch: %w", err)
+	}
+
+	cfg, err := cfgManager.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	aiProvider, err := newAIProvider(cfg, aiProviderConfig(cfg, 60))
+	if err != nil {
+		return err
+	}
+
+	apiKey, err := domain.NewAPIKey(cfg.AI.APIKey, cfg.AI.Provider)
+	if err != nil {
+		return fmt.Errorf("invalid API key: %w", err)
+	}
+
+	ui.PrintInfo("Reviewing patch...")
+
+	resp, err := usecase.NewReviewPatchUseCase(aiProvider).Execute(context.Background(), usecase.ReviewPatchRequest{
+		Patch:  string(patch),
+		APIKey: apiKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	review := resp.Review
+	fmt.Println()
+	fmt.Printf("  %s %s\n", ui.FormatLabel("Files:"), ui.FormatValue(fmt.Sprintf("%d", len(review.Files))))
+	fmt.Printf("  %s %s\n", ui.FormatLabel("Verdict:"), ui.FormatValue(string(review.Verdict)))
+	fmt.Println()
+	fmt.Println(review.Summary)
+	for _, section := range []struct {
+		heading string
+		entries []string
+	}{
+		{"Concerns", review.Concerns},
+		{"Suggestions", review.Suggestions},
+	} {
+		if len(section.entries) == 0 {
+			continue
+		}
+		fmt.Println()
+		fmt.Println(ui.FormatLabel(section.heading + ":"))
+		for _, entry := range section.entries {
+			fmt.Printf("  - %s\n", entry)
+		}
+	}
+	fmt.Println()
+	ui.PrintSubtle(fmt.Sprintf("Model: %s • Tokens: %d", resp.Model, resp.TokensUsed))
+
+	return nil
+}
+
+func runDoctor(checkKey bool) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get current directory: %w", err)
+	}
+
+	req := usecase.DiagnoseEnvironmentRequest{
+		WorkDir:    cwd,
+		ConfigPath: cfgManager.ConfigPath(),
+	}
+	_, statErr := os.Stat(req.ConfigPath)
+	req.ConfigExists = statErr == nil
+	req.Config, req.ConfigErr = cfgManager.LoadForRepo(cwd)
+	if req.Config != nil {
+		applyTheme(req.Config)
+	}
+
+	if checkKey && req.Config != nil && req.Config.AI.APIKey != "" {
+		if apiKey, err := domain.NewAPIKey(req.Config.AI.APIKey, req.Config.AI.Provider); err == nil {
+			req.AIProvider, _ = ai.NewFactory().Create(req.Config.AI.Provider, apiKey, aiProviderConfig(req.Config, 15))
+		}
+	}
+
+	resp, err := usecase.NewDiagnoseEnvironmentUseCase(environmentProbe{gitOps: git.NewExecOperations()}).
+		Execute(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	for _, check := range resp.Checks {
+		line := fmt.Sprintf("%s %s", ui.FormatLabel(check.Name+":"), check.Detail)
+		switch check.Status {
+		case domain.CheckPass:
+			ui.PrintSuccess(line)
+		case domain.CheckWarn:
+			ui.PrintWarning(line)
+		default:
+			ui.PrintError(line)
+		}
+		if check.Hint != "" {
+			ui.PrintSubtle("  " + check.Hint)
+		}
+	}
+	fmt.Println()
+
+	if resp.Failed() {
+		return fmt.Errorf("some checks failed")
+	}
+	return nil
+}
+
+// environmentProbe detects the real environment for gm doctor
+type environmentProbe struct {
+	gitOps *git.ExecOperations
+}
+
+func (p environmentProbe) GitVersion(ctx context.Context) (string, error) {
+	return p.gitOps.Version(ctx)
+}
+
+func (p environmentProbe) IsGitRepo(ctx context.Context, path string) (bool, error) {
+	return p.gitOps.IsGitRepo(ctx, path)
+}
+
+func (p environmentProbe) GHInstalled() bool {
+	return github.CheckGHAvailable()
+}
+
+func (p environmentProbe) GHAuthenticated(ctx context.Context) (bool, error) {
+	return github.IsAuthenticated(ctx)
+}
+
+func (p environmentProbe) ClipboardAvailable() bool {
+	return ui.ClipboardAvailable()
+}
+
+// headlessAnalysis is a commit analysis run without the dashboard, with the
+// repository it was run in
+type headlessAnalysis struct {
+	cwd    string
+	cfg    *domain.Config
+	gitOps *git.ExecOperations
+	resp   *usecase.AnalyzeCommitResponse
+}
+
+// analyzeWithoutTUI runs the commit analysis the dashboard would run, in the
+// current directory. With skipAI only the branch and changes are read.
+func analyzeWithoutTUI(ctx context.Context, skipAI bool) (*headlessAnalysis, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current directory: %w", err)
+	}
+
+	gitOps := git.NewExecOperations()
+	isRepo, err := gitOps.IsGitRepo(ctx, cwd)
+	if err != nil || !isRepo {
+		return nil, fmt.Errorf("not in a git repository")
+	}
+
+	cfg, err := cfgManager.LoadForRepo(cwd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	gitOps.SetRenameDetection(cfg.Git.RenameDetection)
+	gitOps.SetCommitSigning(cfg.Git.SignCommits, cfg.Git.SigningKey)
+	if err := applyPathScope(gitOps, cwd); err != nil {
+		return nil, err
+	}
+
+	req := usecase.AnalyzeCommitRequest{
+		RepoPath:               cwd,
+		UseConventionalCommits: cfg.Commits.Convention == "conventional",
+		ProtectedBranches:      cfg.Git.ProtectedBranches,
+		Normalize:              cfg.Commits.Normalize,
+		SkipAI:                 skipAI,
+		Scope:                  cfg.Commits.AnalysisScope,
+		MaxContextCommits:      cfg.AI.MaxContextCommits,
+		GeneratedPaths:         cfg.Commits.GeneratedPaths,
+		RequireScope:           cfg.Commits.RequireScope,
+		Gitmoji:                cfg.Commits.Convention == "gitmoji",
+	}
+
+	var aiProvider ai.Provider
+	if !skipAI {
+		aiProvider, err = newAIProvider(cfg, aiProviderConfig(cfg, 60))
+		if err != nil {
+			return nil, err
+		}
+		req.APIKey, err = domain.NewAPIKey(cfg.AI.APIKey, cfg.AI.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("invalid API key: %w", err)
+		}
+	}
+
+	analysisCtx, analysisCancel := context.WithTimeout(ctx, 90*time.Second)
+	defer analysisCancel()
+	resp, err := usecase.NewAnalyzeCommitUseCase(gitOps, aiProvider).Execute(analysisCtx, req)
+	if err != nil {
+		return nil, fmt.Errorf("analysis failed: %w", err)
+	}
+
+	return &headlessAnalysis{cwd: cwd, cfg: cfg, gitOps: gitOps, resp: resp}, nil
+}
+
+func runHeadlessCommit(message string, yes bool) error {
+	if noAI && message == "" {
+		return fmt.Errorf("without AI there is no message to suggest; pass one with -m")
+	}
+
+	// A given message needs no analysis beyond the branch and its changes
+	ctx := context.Background()
+	analysis, err := analyzeWithoutTUI(ctx, message != "")
+	if err != nil {
+		return err
+	}
+
+	plan, err := headlessCommitPlan(analysis.cfg, analysis.resp.Decision, message)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s %s\n", ui.FormatLabel("Action:"), ui.FormatValue(plan.action.String()))
+	if plan.branch != "" {
+		fmt.Printf("%s %s\n", ui.FormatLabel("Branch:"), ui.FormatValue(plan.branch))
+	}
+	fmt.Printf("%s %s\n", ui.FormatLabel("Message:"), ui.FormatValue(plan.message.Title()))
+	if repo := analysis.resp.Repository; repo != nil && repo.IsSubmodule() {
+		ui.PrintWarning(fmt.Sprintf("Committing inside a submodule of %s; commit the updated submodule there too", repo.Superproject()))
+	}
+	if !yes {
+		ui.PrintInfo("Pass --yes to commit")
+		return nil
+	}
+
+	execCtx, execCancel := context.WithTimeout(ctx, 120*time.Second)
+	defer execCancel()
+	resp, err := usecase.NewExecuteCommitUseCase(analysis.gitOps).Execute(execCtx, usecase.ExecuteCommitRequest{
+		RepoPath:      analysis.cwd,
+		Decision:      analysis.resp.Decision,
+		Action:        plan.action,
+		CommitMessage: plan.message,
+		BranchName:    plan.branch,
+		StageAll:      true,
+		DryRun:        dryRun,
+	})
+	if err != nil {
+		return fmt.Errorf("commit failed: %w", err)
+	}
+
+	for _, command := range resp.PlannedCommands {
+		fmt.Println(command)
+	}
+	ui.PrintSuccess(resp.Message)
+	return nil
+}
+
+func runAnalyzeOnly(jsonOutput bool) error {
+	analysis, err := analyzeWithoutTUI(context.Background(), noAI)
+	if err != nil {
+		return err
+	}
+
+	output := newAnalysisOutput(analysis.resp)
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(output)
+	}
+
+	fmt.Printf("%s %s\n", ui.FormatLabel("Action:"), ui.FormatValue(fmt.Sprintf("%s (%.0f%% confidence)", output.Action, output.Confidence*100)))
+	if output.BranchName != "" {
+		fmt.Printf("%s %s\n", ui.FormatLabel("Branch:"), ui.FormatValue(output.BranchName))
+	}
+	if output.Message != nil {
+		fmt.Printf("%s %s\n", ui.FormatLabel("Message:"), ui.FormatValue(output.Message.Subject))
+	}
+	fmt.Printf("%s %s\n", ui.FormatLabel("Reasoning:"), output.Reasoning)
+	for _, alt := range output.Alternatives {
+		ui.PrintSubtle(fmt.Sprintf("  or %s: %s", alt.Action, alt.Description))
+	}
+	return nil
+}
+
+// headlessPlan is the commit gm commit --no-tui makes
+type headlessPlan struct {
+	action  domain.ActionType
+	message *domain.CommitMessage
+	branch  string // Branch to create for ActionCreateBranch
+}
+
+// headlessCommitPlan picks the commit to make without the dashboard: the
+// decision's action with message, or the suggested message if message is
+// empty. Only committing directly or on a new branch can be done unattended;
+// the message must follow the commit convention and a new branch is named
+// from the message unless the decision named it.
+func headlessCommitPlan(cfg *domain.Config, decision *domain.Decision, message string) (headlessPlan, error) {
+	plan := headlessPlan{action: decision.Action()}
+	if plan.action != domain.ActionCommitDirect && plan.action != domain.ActionCreateBranch {
+		return plan, fmt.Errorf("the analysis recommends %s (%s); run gm commit without --no-tui, or pass -m to commit anyway",
+			plan.action, decision.Reasoning())
+	}
+
+	if message != "" {
+		msg, err := domain.NewCommitMessage(message)
+		if err != nil {
+			return plan, err
+		}
+		plan.message = msg
+	} else {
+		plan.message = decision.SuggestedMessage()
+	}
+	if plan.message == nil {
+		return plan, fmt.Errorf("no commit message was suggested; pass one with -m")
+	}
+	if err := cfg.ValidateCommitSubject(plan.message.Title()); err != nil {
+		return plan, err
+	}
+
+	if plan.action == domain.ActionCreateBranch {
+		plan.branch = decision.BranchName()
+		if plan.branch == "" {
+			plan.branch = cfg.GenerateBranchName(plan.message.Title())
+		}
+		if err := cfg.ValidateBranchName(plan.branch); err != nil {
+			return plan, fmt.Errorf("cannot name the new branch: %w", err)
+		}
+	}
+
+	return plan, nil
+}
+
+func runSplitCommit() error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get current directory: %w", err)
+	}
+
+	gitOps := git.NewExecOperations()
+	ctx := context.Background()
+	isRepo, err := gitOps.IsGitRepo(ctx, cwd)
+	if err != nil || !isRepo {
+		return fmt.Errorf("not in a git repository")
+	}
+
+	cfg, err := cfgManager.LoadForRepo(cwd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	gitOps.SetRenameDetection(cfg.Git.RenameDetection)
+	gitOps.SetCommitSigning(cfg.Git.SignCommits, cfg.Git.SigningKey)
+	if err := applyPathScope(gitOps, cwd); err != nil {
+		return err
+	}
+
+	// Offer to finish a split that was interrupted partway through; resuming
+	// needs no AI, the plan already has the messages
+	resumeUseCase := usecase.NewSplitCommitUseCase(gitOps, nil)
+	pending, err := resumeUseCase.PendingSplit(ctx, cwd)
+	if err != nil {
+		ui.PrintWarning(fmt.Sprintf("Could not read the interrupted split: %v", err))
+	}
+	if len(pending) > 0 {
+		ui.PrintInfo("A previous split was interrupted. Remaining commits:")
+		printSplitGroups(pending)
+
+		if confirmSplit(fmt.Sprintf("Resume and create %s?", theseCommits(len(pending)))) {
+			return executeSplit(ctx, resumeUseCase, cwd, pending)
+		}
+		if err := resumeUseCase.DiscardPendingSplit(ctx, cwd); err != nil {
+			return err
+		}
+		ui.PrintInfo("Discarded the interrupted split; starting a new one")
+	}
+
+	if noAI {
+		return fmt.Errorf("splitting commits requires AI; run without --no-ai")
+	}
+
+	aiProvider, err := newAIProvider(cfg, aiProviderConfig(cfg, 60))
+	if err != nil {
+		return err
+	}
+
+	apiKey, err := domain.NewAPIKey(cfg.AI.APIKey, cfg.AI.Provider)
+	if err != nil {
+		return fmt.Errorf("invalid API key: %w", err)
+	}
+
+	ui.PrintInfo("Grouping changes into commits...")
+
+	splitUseCase := usecase.NewSplitCommitUseCase(gitOps, aiProvider)
+	suggestion, err := splitUseCase.Suggest(ctx, usecase.SuggestSplitRequest{
+		RepoPath:               cwd,
+		UseConventionalCommits: cfg.Commits.Convention == "conventional",
+		APIKey:                 apiKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	printSplitGroups(suggestion.Groups)
+
+	if !confirmSplit(fmt.Sprintf("Create %s?", theseCommits(len(suggestion.Groups)))) {
+		ui.PrintInfo("Split cancelled; no changes were made")
+		return nil
+	}
+
+	return executeSplit(ctx, splitUseCase, cwd, suggestion.Groups)
+}
+
+// printSplitGroups lists the commits of a split with their files
+func printSplitGroups(groups []domain.CommitGroup) {
+	fmt.Println()
+	for i, group := range groups {
+		fmt.Printf("%s %s\n", ui.FormatLabel(fmt.Sprintf("Commit %d:", i+1)), ui.FormatValue(group.Message.Title()))
+		for _, file := range group.Files {
+			fmt.Printf("    %s\n", file)
+		}
+		if group.Reasoning != "" {
+			ui.PrintSubtle("    " + group.Reasoning)
+		}
+		fmt.Println()
+	}
+}
+
+// confirmSplit asks a yes/no question; anything but y or yes declines.
+// A variable so tests can answer it.
+var confirmSplit = func(question string) bool {
+	fmt.Printf("%s [y/N]: ", question)
+	var answer string
+	_, _ = fmt.Scanln(&answer)
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// executeSplit makes the split's commits and reports each one
+func executeSplit(ctx context.Context, splitUseCase *usecase.SplitCommitUseCase, repoPath string, groups []domain.CommitGroup) error {
+	resp, err := splitUseCase.Execute(ctx, usecase.ExecuteSplitRequest{
+		RepoPath: repoPath,
+		Groups:   groups,
+	})
+	if resp != nil {
+		for i, hash := range resp.CommitHashes {
+			ui.PrintSuccess(fmt.Sprintf("%s %s", hash, groups[i].Message.Title()))
+		}
+	}
+	if err != nil {
+		ui.PrintInfo("Run 'gm commit --split' again to resume the remaining commits")
+		return err
+	}
+
+	if len(resp.CommitHashes) == 1 {
+		ui.PrintSuccess("Created 1 commit")
+	} else {
+		ui.PrintSuccess(fmt.Sprintf("Created %d commits", len(resp.CommitHashes)))
+	}
+	return nil
+}
+
+// theseCommits returns "this commit" or "these 3 commits", for the split prompts
+func theseCommits(n int) string {
+	if n == 1 {
+		return "this commit"
+	}
+	return fmt.Sprintf("these %d commits", n)
+}
+
 func runConfig() error {
 	ui.PrintInfo("GitMind Configuration Wizard")
 	fmt.Println()
@@ -485,7 +1139,7 @@ func runConfig() error {
 	}
 
 	// Initialize theme from config
-	ui.SetGlobalTheme(cfg.UI.Theme)
+	applyTheme(cfg)
 
 	// API Provider
 	fmt.Println("AI Provider:")
@@ -503,7 +1157,7 @@ func runConfig() error {
 	fmt.Println("Cerebras API Key:")
 	fmt.Println("  Get your free API key at: https://cloud.cerebras.ai/")
 	if cfg.AI.APIKey != "" {
-		fmt.Printf("  Current: %s***\n", cfg.AI.APIKey[:min(4, len(cfg.AI.APIKey))])
+		fmt.Printf("  Current: saved in %s\n", cfgManager.SecretStoreName())
 		fmt.Print("  Press Enter to keep current or paste new key: ")
 	} else {
 		fmt.Print("  Paste your API key: ")
@@ -573,6 +1227,10 @@ func runConfig() error {
 }
 
 func runOnboard() error {
+	if err := requireTerminal(); err != nil {
+		return err
+	}
+
 	ui.PrintInfo("Starting GitMind setup wizard...")
 	fmt.Println()
 
@@ -589,10 +1247,11 @@ func runOnboard() error {
 	}
 
 	// Initialize theme from config
-	ui.SetGlobalTheme(cfg.UI.Theme)
+	applyTheme(cfg)
 
 	// Create git operations
 	gitOps := git.NewExecOperations()
+	gitOps.SetCommitSigning(cfg.Git.SignCommits, cfg.Git.SigningKey)
 
 	// Run onboarding wizard
 	return ui.RunOnboarding(gitOps, cfg, cfgManager, cwd, version)
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/gitman/internal/adapter/config"
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+	"github.com/yourusername/gitman/internal/usecase"
+)
+
+// fakeTerminal sets the terminal check and records TUI launches for the duration of the test
+func fakeTerminal(t *testing.T, tty bool) *int {
+	t.Helper()
+
+	launches := 0
+	originalIsTerminal, originalRunTUI, originalForce := isTerminal, runTUI, forceTUI
+	isTerminal = func() bool { return tty }
+	runTUI = func(model tea.Model) (tea.Model, error) {
+		launches++
+		return model, nil
+	}
+	t.Cleanup(func() {
+		isTerminal, runTUI, forceTUI = originalIsTerminal, originalRunTUI, originalForce
+	})
+
+	return &launches
+}
+
+func TestRunDashboard_NonTTYDoesNotLaunchTUI(t *testing.T) {
+	launches := fakeTerminal(t, false)
+
+	err := runDashboard()
+	if !errors.Is(err, errNotTerminal) {
+		t.Fatalf("runDashboard() error = %v, want errNotTerminal", err)
+	}
+	if !strings.Contains(err.Error(), "gm commit --no-tui") {
+		t.Errorf("Expected the error to point to the non-interactive commit, got %q", err)
+	}
+	if *launches != 0 {
+		t.Errorf("TUI launched %d times, want 0", *launches)
+	}
+}
+
+func TestRequireTerminal(t *testing.T) {
+	tests := []struct {
+		name    string
+		tty     bool
+		force   bool
+		wantErr bool
+	}{
+		{"terminal", true, false, false},
+		{"piped", false, false, true},
+		{"piped with --force-tui", false, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeTerminal(t, tt.tty)
+			forceTUI = tt.force
+
+			if err := requireTerminal(); (err != nil) != tt.wantErr {
+				t.Errorf("requireTerminal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChangelogRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		from, to string
+		wantFrom string
+		wantTo   string
+		wantErr  bool
+	}{
+		{"arguments", []string{"v1.0.0", "v1.1.0"}, "", "", "v1.0.0", "v1.1.0", false},
+		{"flags", nil, "v1.0.0", "HEAD~2", "v1.0.0", "HEAD~2", false},
+		{"to defaults to HEAD", nil, "v1.0.0", "", "v1.0.0", "HEAD", false},
+		{"argument and --to", []string{"v1.0.0"}, "", "v1.1.0", "v1.0.0", "v1.1.0", false},
+		{"no from", nil, "", "HEAD", "", "", true},
+		{"conflicting from", []string{"v1.0.0"}, "v0.9.0", "", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, err := changelogRange(tt.args, tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("changelogRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if from != tt.wantFrom || to != tt.wantTo {
+				t.Errorf("changelogRange() = %s..%s, want %s..%s", from, to, tt.wantFrom, tt.wantTo)
+			}
+		})
+	}
+}
+
+func TestHeadlessCommitPlan(t *testing.T) {
+	tests := []struct {
+		name       string
+		action     domain.ActionType
+		suggested  string
+		message    string
+		wantAction domain.ActionType
+		wantTitle  string
+		wantBranch string
+		wantErr    bool
+	}{
+		{"suggested message", domain.ActionCommitDirect, "feat: add login page", "", domain.ActionCommitDirect, "feat: add login page", "", false},
+		{"given message wins", domain.ActionCommitDirect, "feat: add login page", "fix: handle empty password\n\nCloses #4.", domain.ActionCommitDirect, "fix: handle empty password", "", false},
+		{"new branch named from the message", domain.ActionCreateBranch, "", "feat: add login page", domain.ActionCreateBranch, "feat: add login page", "feature/add-login-page", false},
+		{"no message", domain.ActionCommitDirect, "", "", 0, "", "", true},
+		{"message breaks the convention", domain.ActionCommitDirect, "", "added stuff", 0, "", "", true},
+		{"review needs a person", domain.ActionReview, "feat: add login page", "", 0, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision, err := domain.NewDecision(tt.action, 0.9, "test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.suggested != "" {
+				msg, err := domain.NewCommitMessage(tt.suggested)
+				if err != nil {
+					t.Fatal(err)
+				}
+				decision.SetSuggestedMessage(msg)
+			}
+
+			plan, err := headlessCommitPlan(domain.NewDefaultConfig(), decision, tt.message)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("headlessCommitPlan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if plan.action != tt.wantAction || plan.message.Title() != tt.wantTitle || plan.branch != tt.wantBranch {
+				t.Errorf("headlessCommitPlan() = %s %q on %q, want %s %q on %q",
+					plan.action, plan.message.Title(), plan.branch, tt.wantAction, tt.wantTitle, tt.wantBranch)
+			}
+		})
+	}
+}
+
+// TestRunSplitCommit_ResumesInterruptedSplit tests that gm commit --split
+// offers the commits an interrupted split left, and makes them without AI
+func TestRunSplitCommit_ResumesInterruptedSplit(t *testing.T) {
+	repo := t.TempDir()
+	run := func(args ...string) string {
+		t.Helper()
+		out, err := exec.Command("git", append([]string{"-C", repo}, args...)...).CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %s: %v", args, out, err)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "Test")
+	run("commit", "-q", "--allow-empty", "-m", "initial")
+	for _, name := range []string{"api.go", "docs.md"} {
+		if err := os.WriteFile(filepath.Join(repo, name), []byte(name+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The second commit fails on a file that doesn't exist, leaving a plan
+	message := func(title string) *domain.CommitMessage {
+		msg, err := domain.NewCommitMessage(title)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return msg
+	}
+	split := usecase.NewSplitCommitUseCase(git.NewExecOperations(), nil)
+	_, err := split.Execute(context.Background(), usecase.ExecuteSplitRequest{
+		RepoPath: repo,
+		Groups: []domain.CommitGroup{
+			{Files: []string{"api.go"}, Message: message("Add user lookup endpoint")},
+			{Files: []string{"docs.md", "missing.md"}, Message: message("Document the endpoint")},
+		},
+	})
+	if err == nil {
+		t.Fatal("Execute() error = nil, want the second commit to fail")
+	}
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	originalManager, originalConfirm, originalNoAI := cfgManager, confirmSplit, noAI
+	t.Cleanup(func() { cfgManager, confirmSplit, noAI = originalManager, originalConfirm, originalNoAI })
+	if cfgManager, err = config.NewManager(); err != nil {
+		t.Fatal(err)
+	}
+	cfgManager.SetSecretStore(config.NewFileSecretStore(filepath.Join(home, config.SecretsFileName)))
+	var asked string
+	confirmSplit = func(question string) bool {
+		asked = question
+		return true
+	}
+	noAI = true // Resuming must not need the AI
+	t.Chdir(repo)
+
+	if err := runSplitCommit(); err != nil {
+		t.Fatalf("runSplitCommit() error = %v", err)
+	}
+	if asked != "Resume and create this commit?" {
+		t.Errorf("asked %q, want the interrupted split offered", asked)
+	}
+	if subject := run("log", "-1", "--format=%s"); subject != "Document the endpoint" {
+		t.Errorf("last commit = %q, want the resumed commit", subject)
+	}
+	if status := run("status", "--porcelain"); status != "" {
+		t.Errorf("status = %q, want every change committed", status)
+	}
+}
+
+func TestTheseCommits(t *testing.T) {
+	for n, want := range map[int]string{1: "this commit", 3: "these 3 commits"} {
+		if got := theseCommits(n); got != want {
+			t.Errorf("theseCommits(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
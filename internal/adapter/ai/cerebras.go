@@ -1,6 +1,7 @@
 package ai
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -8,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"path"
 	"strings"
 	"time"
 
@@ -21,6 +23,12 @@ const (
 	maxRetries             = 3
 )
 
+func init() {
+	RegisterProvider("cerebras", func(apiKey *domain.APIKey, config ProviderConfig) (Provider, error) {
+		return NewCerebrasProvider(apiKey, config), nil
+	})
+}
+
 // CerebrasProvider implements the Provider interface for Cerebras AI.
 type CerebrasProvider struct {
 	apiKey     *domain.APIKey
@@ -28,6 +36,16 @@ type CerebrasProvider struct {
 	model      string
 	httpClient *http.Client
 	maxRetries int
+
+	weakMessagePolicy string // What to do when the AI's commit message is empty or too short
+
+	maxContextCommits      int // Recent commits included when analyzing a commit
+	maxMergeContextCommits int // Commits listed when writing a merge message
+
+	requireAllProperties bool // Strict schemas must list every property as required (OpenAI)
+
+	// send replaces the chat completions call for providers with another wire format (Ollama)
+	send func(ctx context.Context, reqBody cerebrasRequest) (*cerebrasResponse, error)
 }
 
 // NewCerebrasProvider creates a new Cerebras provider.
@@ -52,6 +70,16 @@ func NewCerebrasProvider(apiKey *domain.APIKey, config ProviderConfig) *Cerebras
 		model = config.Model
 	}
 
+	maxContextCommits := domain.DefaultMaxContextCommits
+	if config.MaxContextCommits > 0 {
+		maxContextCommits = config.MaxContextCommits
+	}
+
+	maxMergeContextCommits := domain.DefaultMaxMergeContextCommits
+	if config.MaxMergeContextCommits > 0 {
+		maxMergeContextCommits = config.MaxMergeContextCommits
+	}
+
 	return &CerebrasProvider{
 		apiKey:  apiKey,
 		baseURL: baseURL,
@@ -59,7 +87,10 @@ func NewCerebrasProvider(apiKey *domain.APIKey, config ProviderConfig) *Cerebras
 		httpClient: &http.Client{
 			Timeout: timeout,
 		},
-		maxRetries: maxRetries,
+		maxRetries:             maxRetries,
+		weakMessagePolicy:      config.WeakMessagePolicy,
+		maxContextCommits:      maxContextCommits,
+		maxMergeContextCommits: maxMergeContextCommits,
 	}
 }
 
@@ -97,7 +128,10 @@ func (c *CerebrasProvider) DetectTier(ctx context.Context) (domain.APITier, erro
 	return domain.TierFree, nil
 }
 
-// Analyze analyzes git changes and returns a decision.
+// Analyze analyzes git changes and returns a decision. With request.Tokens
+// set, the response is streamed and its text sent to Tokens as it arrives;
+// the decision is still parsed from the complete response. Providers with
+// another wire format (Ollama) always answer at once.
 func (c *CerebrasProvider) Analyze(ctx context.Context, request AnalysisRequest) (*AnalysisResponse, error) {
 	if request.Repository == nil {
 		return nil, errors.New("repository cannot be nil")
@@ -111,11 +145,18 @@ func (c *CerebrasProvider) Analyze(ctx context.Context, request AnalysisRequest)
 	// Prepare the request with structured output
 	reqBody := c.buildStructuredRequest(prompt)
 
+	send := c.makeRequestWithRetry
+	if request.Tokens != nil && c.send == nil {
+		send = func(ctx context.Context, reqBody cerebrasRequest, attempt int) (*cerebrasResponse, error) {
+			return c.makeStreamingRequest(ctx, reqBody, request.Tokens)
+		}
+	}
+
 	// Make the API call with retry logic
 	var resp *cerebrasResponse
 	var err error
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
-		resp, err = c.makeRequestWithRetry(ctx, reqBody, attempt)
+		resp, err = send(ctx, reqBody, attempt)
 		if err == nil {
 			break
 		}
@@ -138,7 +179,24 @@ func (c *CerebrasProvider) Analyze(ctx context.Context, request AnalysisRequest)
 	}
 
 	// Parse the structured response
+	tokensUsed := resp.Usage.TotalTokens
 	decision, err := c.parseResponse(resp, request.UseConventionalCommits)
+
+	// An empty or vague commit message shouldn't fail the whole analysis:
+	// ask once more with an explicit reminder, then derive one from the changes
+	if errors.Is(err, errWeakCommitMessage) && c.weakMessagePolicy != domain.WeakMessageFallback {
+		retryResp, retryErr := c.makeRequestWithRetry(ctx, c.buildStructuredRequest(prompt+weakMessageReminder), 0)
+		if retryErr == nil {
+			tokensUsed += retryResp.Usage.TotalTokens
+			if retryDecision, parseErr := c.parseResponse(retryResp, request.UseConventionalCommits); parseErr == nil || errors.Is(parseErr, errWeakCommitMessage) {
+				resp, decision, err = retryResp, retryDecision, parseErr
+			}
+		}
+	}
+	if errors.Is(err, errWeakCommitMessage) {
+		decision.SetSuggestedMessage(fallbackCommitMessage(request.Repository, request.UseConventionalCommits))
+		err = nil
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse AI response: %w", err)
 	}
@@ -147,7 +205,7 @@ func (c *CerebrasProvider) Analyze(ctx context.Context, request AnalysisRequest)
 
 	return &AnalysisResponse{
 		Decision:         decision,
-		TokensUsed:       resp.Usage.TotalTokens,
+		TokensUsed:       tokensUsed,
 		Model:            resp.Model,
 		ProcessingTimeMs: int(processingTime),
 	}, nil
@@ -184,7 +242,15 @@ func (c *CerebrasProvider) buildPrompt(request AnalysisRequest) string {
 		sb.WriteString(fmt.Sprintf("Current branch: %s\n", request.Repository.CurrentBranch()))
 	}
 
-	sb.WriteString(fmt.Sprintf("Changes: %s\n\n", request.Repository.ChangeSummary()))
+	sb.WriteString(fmt.Sprintf("Changes: %s\n", request.Repository.ChangeSummary()))
+
+	// Make the scope explicit so the message matches what is being committed
+	branchScope := request.Scope == domain.AnalysisScopeBranch && request.BranchDiff != "" && request.BranchInfo != nil
+	if branchScope {
+		sb.WriteString(fmt.Sprintf("Analysis scope: the entire branch since %s. The message should summarize the branch's committed changes together with the uncommitted diff.\n\n", request.BranchInfo.Parent()))
+	} else {
+		sb.WriteString("Analysis scope: uncommitted working-tree changes only. Earlier commits are listed for context; describe ONLY the changes in the diff below.\n\n")
+	}
 
 	// Recent commits for context (with scope indicator)
 	if len(request.RecentLog) > 0 {
@@ -195,14 +261,37 @@ func (c *CerebrasProvider) buildPrompt(request AnalysisRequest) string {
 		sb.WriteString(fmt.Sprintf("%s:\n", commitScope))
 
 		for i, log := range request.RecentLog {
-			if i >= 3 {
-				break // Limit to 3 recent commits
+			if i >= c.maxContextCommits {
+				break // Limit to the configured number of recent commits
 			}
 			sb.WriteString(fmt.Sprintf("- %s\n", log))
 		}
 		sb.WriteString("\n")
 	}
 
+	// Submodule bumps: the diff only shows SHAs, so include what changed inside
+	if len(request.SubmoduleUpdates) > 0 {
+		sb.WriteString("Submodule updates (describe these by their contents, not as \"update submodule\"):\n")
+		sb.WriteString(domain.FormatSubmoduleUpdates(request.SubmoduleUpdates))
+		sb.WriteString("\n\n")
+	}
+
+	// The team's commit template encodes their conventions (sections, trailers, ticket refs)
+	if request.CommitTemplate != "" {
+		sb.WriteString("Team commit template (follow its structure; lines starting with # are guidance, not content):\n")
+		sb.WriteString(request.CommitTemplate)
+		sb.WriteString("\n\n")
+	}
+
+	// Lockfile and generated-code churn says little about intent
+	if len(request.GeneratedFiles) > 0 {
+		sb.WriteString("Generated or lock files (incidental; do not describe their line changes, summarize them briefly, e.g. \"update dependencies\", and focus the message on the other changes):\n")
+		for _, file := range request.GeneratedFiles {
+			sb.WriteString(fmt.Sprintf("- %s\n", file))
+		}
+		sb.WriteString("\n")
+	}
+
 	// Diff content (with reduction for free tier)
 	if request.Diff != "" {
 		diff := request.Diff
@@ -217,6 +306,17 @@ func (c *CerebrasProvider) buildPrompt(request AnalysisRequest) string {
 		sb.WriteString("\n\n")
 	}
 
+	if branchScope {
+		branchDiff := request.BranchDiff
+		if request.APIKey.ShouldReduceContext() || request.Repository.IsLargeChangeset() {
+			branchDiff = reduceDiffContext(branchDiff, request.APIKey.MaxTokensPerRequest())
+		}
+
+		sb.WriteString(fmt.Sprintf("Already committed on this branch (git diff %s...HEAD):\n", request.BranchInfo.Parent()))
+		sb.WriteString(branchDiff)
+		sb.WriteString("\n\n")
+	}
+
 	// User context
 	if request.UserPrompt != "" {
 		sb.WriteString(fmt.Sprintf("User context: %s\n\n", request.UserPrompt))
@@ -234,10 +334,16 @@ func (c *CerebrasProvider) buildPrompt(request AnalysisRequest) string {
 	sb.WriteString("Based on these changes, provide:\n")
 	sb.WriteString("1. A professional, software engineering standard commit message.\n")
 	sb.WriteString("   - Subject line: Imperative mood, no period, max 50 chars.\n")
-	sb.WriteString("   - Body: Explain 'what' and 'why', not 'how'. Bullet points for multiple changes.\n")
+	sb.WriteString("   - Body (commit_body): Explain 'what' and 'why', not 'how'. Bullet points (\"- \") for multiple changes; empty for trivial changes.\n")
 	sb.WriteString("   - NO fluff, NO emojis, NO 'updates file', NO 'fixes bug'. Be specific.\n")
 	if request.UseConventionalCommits {
 		sb.WriteString("   - Use conventional commits format (type(scope): description).\n")
+		if request.Gitmoji {
+			sb.WriteString("   - The team uses gitmoji: the type's emoji (e.g. ✨ feat, 🐛 fix) is prefixed automatically, so pick the type carefully and don't add emojis yourself.\n")
+		}
+		if request.ScopeHint != "" {
+			sb.WriteString(fmt.Sprintf("   - The changed files share the directory %q; use it as the scope unless another fits better.\n", request.ScopeHint))
+		}
 	}
 	sb.WriteString("\n")
 	sb.WriteString("2. Your recommendation:\n")
@@ -250,6 +356,7 @@ func (c *CerebrasProvider) buildPrompt(request AnalysisRequest) string {
 	}
 	sb.WriteString("3. Brief reasoning (technical risk assessment)\n")
 	sb.WriteString("4. Alternative approaches\n")
+	sb.WriteString("5. Two alternative phrasings of the commit message (message_candidates), following the same rules\n")
 
 	return sb.String()
 }
@@ -263,7 +370,11 @@ func (c *CerebrasProvider) buildStructuredRequest(prompt string) cerebrasRequest
 		Properties: map[string]property{
 			"commit_message": {
 				Type:        "string",
-				Description: "Clear, concise commit message describing the changes",
+				Description: "Clear, concise commit subject line describing the changes",
+			},
+			"commit_body": {
+				Type:        "string",
+				Description: "Commit body as bullet points explaining what changed and why; empty if the subject says it all",
 			},
 			"action": {
 				Type:        "string",
@@ -295,6 +406,19 @@ func (c *CerebrasProvider) buildStructuredRequest(prompt string) cerebrasRequest
 					AdditionalProperties: &falseBool,
 				},
 			},
+			"message_candidates": {
+				Type:        "array",
+				Description: "Alternative phrasings of commit_message for the user to choose from",
+				Items: &property{
+					Type: "object",
+					Properties: map[string]property{
+						"title": {Type: "string", Description: "Subject line"},
+						"body":  {Type: "string", Description: "Optional body; empty if none"},
+					},
+					Required:             []string{"title"},
+					AdditionalProperties: &falseBool,
+				},
+			},
 		},
 		Required:             []string{"commit_message", "action", "confidence", "reasoning"},
 		AdditionalProperties: &falseBool,
@@ -320,6 +444,13 @@ func (c *CerebrasProvider) buildStructuredRequest(prompt string) cerebrasRequest
 
 // makeRequest makes an API request to Cerebras.
 func (c *CerebrasProvider) makeRequest(ctx context.Context, reqBody cerebrasRequest) (*cerebrasResponse, error) {
+	if c.requireAllProperties && reqBody.ResponseFormat != nil && reqBody.ResponseFormat.JSONSchema != nil {
+		reqBody.ResponseFormat.JSONSchema.Schema = requireAllSchemaProperties(reqBody.ResponseFormat.JSONSchema.Schema)
+	}
+	if c.send != nil {
+		return c.send(ctx, reqBody)
+	}
+
 	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
@@ -356,6 +487,95 @@ func (c *CerebrasProvider) makeRequest(ctx context.Context, reqBody cerebrasRequ
 	return &cerebrasResp, nil
 }
 
+// makeStreamingRequest makes an API request to Cerebras with the response
+// streamed as server-sent events. The content of each event is sent to tokens
+// as it arrives, and the complete response is returned like makeRequest's.
+func (c *CerebrasProvider) makeStreamingRequest(ctx context.Context, reqBody cerebrasRequest, tokens chan<- string) (*cerebrasResponse, error) {
+	if c.requireAllProperties && reqBody.ResponseFormat != nil && reqBody.ResponseFormat.JSONSchema != nil {
+		reqBody.ResponseFormat.JSONSchema.Schema = requireAllSchemaProperties(reqBody.ResponseFormat.JSONSchema.Schema)
+	}
+	reqBody.Stream = true
+
+	jsonBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/chat/completions", bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Authorization", "Bearer "+c.apiKey.Key())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response: %w", err)
+		}
+		return nil, parseErrorResponse(resp.StatusCode, body)
+	}
+
+	return readEventStream(ctx, resp.Body, tokens)
+}
+
+// readEventStream accumulates a streamed chat completion from its
+// "data: {...}" events up to "data: [DONE]", sending each piece of content
+// to tokens.
+func readEventStream(ctx context.Context, body io.Reader, tokens chan<- string) (*cerebrasResponse, error) {
+	result := &cerebrasResponse{}
+	var content strings.Builder
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		data, found := strings.CutPrefix(scanner.Text(), "data:")
+		if !found {
+			continue // Blank separators, comments and event names
+		}
+		data = strings.TrimSpace(data)
+		if data == "[DONE]" {
+			break
+		}
+
+		var chunk streamChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return nil, fmt.Errorf("failed to parse stream event: %w", err)
+		}
+		if chunk.Model != "" {
+			result.Model = chunk.Model
+		}
+		if chunk.Usage != nil {
+			result.Usage = *chunk.Usage
+		}
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			continue
+		}
+
+		piece := chunk.Choices[0].Delta.Content
+		content.WriteString(piece)
+		select {
+		case tokens <- piece:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	result.Choices = []choice{{Message: message{Role: "assistant", Content: content.String()}}}
+	return result, nil
+}
+
 // makeRequestWithRetry makes a request with retry logic.
 func (c *CerebrasProvider) makeRequestWithRetry(ctx context.Context, reqBody cerebrasRequest, attempt int) (*cerebrasResponse, error) {
 	return c.makeRequest(ctx, reqBody)
@@ -375,6 +595,7 @@ func (c *CerebrasProvider) parseResponse(resp *cerebrasResponse, useConventional
 	// Parse JSON response
 	var analysis struct {
 		CommitMessage string  `json:"commit_message"`
+		CommitBody    string  `json:"commit_body,omitempty"`
 		Action        string  `json:"action"`
 		Confidence    float64 `json:"confidence"`
 		Reasoning     string  `json:"reasoning"`
@@ -384,6 +605,10 @@ func (c *CerebrasProvider) parseResponse(resp *cerebrasResponse, useConventional
 			Description string  `json:"description"`
 			Confidence  float64 `json:"confidence"`
 		} `json:"alternatives,omitempty"`
+		MessageCandidates []struct {
+			Title string `json:"title"`
+			Body  string `json:"body,omitempty"`
+		} `json:"message_candidates,omitempty"`
 	}
 
 	if err := json.Unmarshal([]byte(content), &analysis); err != nil {
@@ -399,13 +624,6 @@ func (c *CerebrasProvider) parseResponse(resp *cerebrasResponse, useConventional
 		return nil, err
 	}
 
-	// Create commit message
-	commitMsg, err := domain.NewCommitMessage(analysis.CommitMessage)
-	if err != nil {
-		return nil, fmt.Errorf("invalid commit message from AI: %w", err)
-	}
-	decision.SetSuggestedMessage(commitMsg)
-
 	// Set branch name if applicable
 	if analysis.BranchName != "" {
 		decision.SetBranchName(analysis.BranchName)
@@ -423,9 +641,114 @@ func (c *CerebrasProvider) parseResponse(resp *cerebrasResponse, useConventional
 		}
 	}
 
+	// Alternative phrasings; weak ones are skipped
+	var candidates []*domain.CommitMessage
+	for _, candidate := range analysis.MessageCandidates {
+		if isWeakCommitMessage(candidate.Title) {
+			continue
+		}
+		if msg, err := domain.NewCommitMessage(candidate.Title); err == nil {
+			msg.SetBody(candidate.Body)
+			candidates = append(candidates, msg)
+		}
+	}
+
+	// Create commit message last, so a weak one still returns the rest of the decision.
+	// A weak commit_message gives way to the first good candidate.
+	if isWeakCommitMessage(analysis.CommitMessage) {
+		if len(candidates) == 0 {
+			return decision, errWeakCommitMessage
+		}
+		decision.SetSuggestedMessage(candidates[0])
+		candidates = candidates[1:]
+	} else {
+		commitMsg, err := domain.NewCommitMessage(analysis.CommitMessage)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commit message from AI: %w", err)
+		}
+		if analysis.CommitBody != "" {
+			commitMsg.SetBody(analysis.CommitBody)
+		}
+		decision.SetSuggestedMessage(commitMsg)
+	}
+	for _, candidate := range candidates {
+		decision.AddCandidate(candidate)
+	}
+
 	return decision, nil
 }
 
+// minCommitMessageLength is the shortest commit description (without any
+// conventional commit prefix) accepted from the AI.
+const minCommitMessageLength = 8
+
+// errWeakCommitMessage is returned by parseResponse, along with the rest of
+// the decision, when the commit message is empty or too short to be useful.
+var errWeakCommitMessage = errors.New("commit message from AI is empty or too short")
+
+// weakMessageReminder is appended to the prompt when retrying after a weak message.
+var weakMessageReminder = fmt.Sprintf("\nIMPORTANT: Your previous answer had an empty or too short commit_message. "+
+	"commit_message must specifically describe what changed, in at least %d characters after any type prefix.\n", minCommitMessageLength)
+
+// isWeakCommitMessage reports whether message is empty or too short once any
+// conventional commit prefix is removed.
+func isWeakCommitMessage(message string) bool {
+	title, _, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	if _, description, found := strings.Cut(title, ": "); found {
+		title = description
+	}
+	return len(strings.TrimSpace(title)) < minCommitMessageLength
+}
+
+// fallbackCommitMessage derives a plain commit message from the changed files,
+// for when the AI does not provide a usable one.
+func fallbackCommitMessage(repo *domain.Repository, useConventional bool) *domain.CommitMessage {
+	verbs := map[domain.ChangeStatus]string{
+		domain.StatusAdded:     "Add",
+		domain.StatusUntracked: "Add",
+		domain.StatusDeleted:   "Remove",
+		domain.StatusRenamed:   "Rename",
+	}
+
+	changes := repo.Changes()
+	title := "Update files"
+	switch {
+	case len(changes) == 1:
+		verb, ok := verbs[changes[0].Status]
+		if !ok {
+			verb = "Update"
+		}
+		title = verb + " " + path.Base(changes[0].Path)
+
+	case len(changes) > 1:
+		verb, ok := verbs[changes[0].Status]
+		dir := path.Dir(changes[0].Path)
+		for _, change := range changes[1:] {
+			if v, found := verbs[change.Status]; !found || v != verb {
+				ok = false
+			}
+			for dir != "." && !strings.HasPrefix(change.Path, dir+"/") {
+				dir = path.Dir(dir)
+			}
+		}
+		if !ok {
+			verb = "Update"
+		}
+		title = fmt.Sprintf("%s %d files", verb, len(changes))
+		if dir != "." && dir != "/" {
+			title += " in " + dir
+		}
+	}
+
+	if useConventional {
+		title = "chore: " + strings.ToLower(title[:1]) + title[1:]
+	}
+
+	// The title is never empty, so this cannot fail
+	msg, _ := domain.NewCommitMessage(title)
+	return msg
+}
+
 // GenerateMergeMessage generates a merge commit message and suggests a merge strategy.
 func (c *CerebrasProvider) GenerateMergeMessage(ctx context.Context, request MergeMessageRequest) (*MergeMessageResponse, error) {
 	// Build prompt for merge message generation
@@ -465,8 +788,8 @@ func (c *CerebrasProvider) buildMergePrompt(request MergeMessageRequest) string
 	// List commits
 	sb.WriteString("Commits to merge:\n")
 	maxCommits := len(request.Commits)
-	if maxCommits > 10 {
-		maxCommits = 10 // Limit to avoid token overflow
+	if maxCommits > c.maxMergeContextCommits {
+		maxCommits = c.maxMergeContextCommits // Limit to avoid token overflow
 	}
 	for i := 0; i < maxCommits; i++ {
 		sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, request.Commits[i]))
@@ -569,6 +892,668 @@ func (c *CerebrasProvider) parseMergeResponse(resp *cerebrasResponse) (*MergeMes
 	}, nil
 }
 
+// GeneratePRDescription drafts a pull request title and description from the branch's commits and net diff.
+func (c *CerebrasProvider) GeneratePRDescription(ctx context.Context, request PRDescriptionRequest) (*PRDescriptionResponse, error) {
+	if len(request.Commits) == 0 {
+		return nil, errors.New("no commits to describe")
+	}
+
+	prompt := c.buildPRDescriptionPrompt(request)
+	structuredReq := c.buildPRDescriptionStructuredRequest(prompt)
+
+	resp, err := c.makeRequestWithRetry(ctx, structuredReq, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := parsePRDescriptionResponse(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pull request description: %w", err)
+	}
+
+	description.TokensUsed = resp.Usage.TotalTokens
+	description.Model = resp.Model
+
+	return description, nil
+}
+
+// buildPRDescriptionPrompt builds the prompt for drafting a pull request.
+func (c *CerebrasProvider) buildPRDescriptionPrompt(request PRDescriptionRequest) string {
+	var sb strings.Builder
+
+	sb.WriteString("You are an expert Git workflow assistant. Write the title and description of a pull request for the following branch.\n\n")
+
+	sb.WriteString(fmt.Sprintf("Pull request: %s → %s\n", request.SourceBranch, request.TargetBranch))
+	sb.WriteString(fmt.Sprintf("Commits: %d\n\n", len(request.Commits)))
+
+	sb.WriteString("Commits on the branch (newest first):\n")
+	maxCommits := len(request.Commits)
+	if maxCommits > c.maxMergeContextCommits {
+		maxCommits = c.maxMergeContextCommits // Limit to avoid token overflow
+	}
+	for i := 0; i < maxCommits; i++ {
+		sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, request.Commits[i]))
+	}
+	if len(request.Commits) > maxCommits {
+		sb.WriteString(fmt.Sprintf("... and %d more commits\n", len(request.Commits)-maxCommits))
+	}
+	sb.WriteString("\n")
+
+	// The net diff shows what the branch ends up changing, without the
+	// back-and-forth of individual commits
+	if request.Diff != "" {
+		diff := request.Diff
+		if request.APIKey != nil {
+			diff = reduceDiffContext(diff, request.APIKey.MaxTokensPerRequest())
+		}
+		sb.WriteString(fmt.Sprintf("Net changes (git diff %s...%s):\n", request.TargetBranch, request.SourceBranch))
+		sb.WriteString(diff)
+		sb.WriteString("\n\n")
+	}
+
+	sb.WriteString("Instructions:\n")
+	sb.WriteString("1. Write a title of at most 72 characters saying what the branch changes as a whole, not listing commits\n")
+	sb.WriteString("2. Write a summary of two or three sentences: what the branch changes and why\n")
+	sb.WriteString("3. List the notable changes as short bullets, one change each, without Markdown markers; fold fixups and typo commits into the change they belong to\n")
+	sb.WriteString("4. Mention anything a reviewer should check, such as breaking changes or migrations, only if the commits or changes show it\n")
+	sb.WriteString("5. Do not invent changes, issue numbers, or test results the commits and changes don't mention\n")
+
+	return sb.String()
+}
+
+// buildPRDescriptionStructuredRequest builds a structured request for drafting a pull request.
+func (c *CerebrasProvider) buildPRDescriptionStructuredRequest(prompt string) cerebrasRequest {
+	falseBool := false
+
+	schema := analysisSchema{
+		Type: "object",
+		Properties: map[string]property{
+			"title": {
+				Type:        "string",
+				Description: "One-line pull request title",
+			},
+			"summary": {
+				Type:        "string",
+				Description: "What the branch changes and why",
+			},
+			"bullet_changes": {
+				Type:        "array",
+				Description: "Notable changes, one per bullet",
+				Items:       &property{Type: "string"},
+			},
+		},
+		Required:             []string{"title", "summary", "bullet_changes"},
+		AdditionalProperties: &falseBool,
+	}
+
+	temp := 0.3
+
+	return cerebrasRequest{
+		Model: c.model,
+		Messages: []message{
+			{
+				Role:    "user",
+				Content: prompt,
+			},
+		},
+		ResponseFormat: &responseFormat{
+			Type: "json_schema",
+			JSONSchema: &jsonSchema{
+				Name:   "pull_request_description",
+				Strict: true,
+				Schema: schema,
+			},
+		},
+		MaxCompletionTokens: 1500,
+		Temperature:         &temp,
+	}
+}
+
+// parsePRDescriptionResponse parses the API response into a PRDescriptionResponse.
+func parsePRDescriptionResponse(resp *cerebrasResponse) (*PRDescriptionResponse, error) {
+	if len(resp.Choices) == 0 {
+		return nil, errors.New("no response from AI")
+	}
+
+	var parsed struct {
+		Title         string   `json:"title"`
+		Summary       string   `json:"summary"`
+		BulletChanges []string `json:"bullet_changes"`
+	}
+
+	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON response: %w", err)
+	}
+
+	// Titles are a single line; anything after the first is dropped
+	title := strings.TrimSpace(strings.SplitN(strings.TrimSpace(parsed.Title), "\n", 2)[0])
+	if title == "" {
+		return nil, errors.New("pull request has no title")
+	}
+
+	// Models sometimes keep the Markdown marker despite the instructions
+	var changes []string
+	for _, change := range parsed.BulletChanges {
+		changes = append(changes, strings.TrimLeft(strings.TrimSpace(change), "-*•"))
+	}
+
+	return &PRDescriptionResponse{
+		Title:   title,
+		Summary: strings.TrimSpace(parsed.Summary),
+		Changes: nonEmptyEntries(changes),
+	}, nil
+}
+
+// GenerateChangelog groups the commits between two refs into a release changelog.
+func (c *CerebrasProvider) GenerateChangelog(ctx context.Context, request ChangelogRequest) (*ChangelogResponse, error) {
+	prompt := c.buildChangelogPrompt(request)
+	structuredReq := c.buildChangelogStructuredRequest(prompt)
+
+	resp, err := c.makeRequestWithRetry(ctx, structuredReq, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	changelog, err := parseChangelogResponse(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse changelog response: %w", err)
+	}
+
+	return &ChangelogResponse{
+		Changelog:  changelog,
+		TokensUsed: resp.Usage.TotalTokens,
+		Model:      resp.Model,
+	}, nil
+}
+
+// maxChangelogCommits bounds the commits sent to the model to avoid token overflow.
+const maxChangelogCommits = 200
+
+// buildChangelogPrompt builds the prompt for changelog generation.
+func (c *CerebrasProvider) buildChangelogPrompt(request ChangelogRequest) string {
+	var sb strings.Builder
+
+	sb.WriteString("You are an expert release manager. Write a changelog for the following release.\n\n")
+	sb.WriteString(fmt.Sprintf("Range: %s..%s\n", request.FromRef, request.ToRef))
+	sb.WriteString(fmt.Sprintf("Commits: %d\n\n", len(request.Commits)))
+
+	if request.Grouped != nil {
+		// Commits arrive grouped by their conventional-commit type; the
+		// model words the entries but keeps the grouping
+		sb.WriteString("Commits by conventional-commit type (newest first):\n")
+		listed, total := 0, 0
+		for _, group := range request.Grouped.Groups() {
+			total += len(group.Entries)
+			if len(group.Entries) == 0 || listed >= maxChangelogCommits {
+				continue
+			}
+			sb.WriteString(group.Heading + ":\n")
+			for _, entry := range group.Entries {
+				if listed == maxChangelogCommits {
+					break
+				}
+				sb.WriteString(fmt.Sprintf("- %s\n", entry))
+				listed++
+			}
+		}
+		if total > listed {
+			sb.WriteString(fmt.Sprintf("... and %d more commits\n", total-listed))
+		}
+	} else {
+		sb.WriteString("Commits (newest first):\n")
+		count := len(request.Commits)
+		if count > maxChangelogCommits {
+			count = maxChangelogCommits
+		}
+		for i := 0; i < count; i++ {
+			sb.WriteString(fmt.Sprintf("- %s\n", request.Commits[i]))
+		}
+		if len(request.Commits) > count {
+			sb.WriteString(fmt.Sprintf("... and %d more commits\n", len(request.Commits)-count))
+		}
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("Instructions:\n")
+	if request.Grouped != nil {
+		sb.WriteString("1. Keep each change in the group it is listed under; \"Other changes\" goes in other\n")
+	} else {
+		sb.WriteString("1. Group changes into breaking, features, fixes, and other\n")
+	}
+	sb.WriteString("2. Write each entry as a short, user-facing sentence (no commit hashes, no conventional-commit prefixes)\n")
+	sb.WriteString("3. Merge commits that describe the same change into one entry\n")
+	sb.WriteString("4. Omit noise such as WIP, fixup, typo, and merge commits\n")
+	sb.WriteString("5. Use an empty list for groups with no entries\n")
+
+	return sb.String()
+}
+
+// buildChangelogStructuredRequest builds a structured request for changelog generation.
+func (c *CerebrasProvider) buildChangelogStructuredRequest(prompt string) cerebrasRequest {
+	falseBool := false
+	entries := func(description string) property {
+		return property{
+			Type:        "array",
+			Description: description,
+			Items:       &property{Type: "string"},
+		}
+	}
+
+	schema := analysisSchema{
+		Type: "object",
+		Properties: map[string]property{
+			"breaking": entries("Changes that require action from users"),
+			"features": entries("New functionality"),
+			"fixes":    entries("Bug fixes"),
+			"other":    entries("Other notable changes (refactors, docs, performance)"),
+		},
+		Required:             []string{"breaking", "features", "fixes", "other"},
+		AdditionalProperties: &falseBool,
+	}
+
+	temp := 0.3
+
+	return cerebrasRequest{
+		Model: c.model,
+		Messages: []message{
+			{
+				Role:    "user",
+				Content: prompt,
+			},
+		},
+		ResponseFormat: &responseFormat{
+			Type: "json_schema",
+			JSONSchema: &jsonSchema{
+				Name:   "changelog_generation",
+				Strict: true,
+				Schema: schema,
+			},
+		},
+		MaxCompletionTokens: 2000,
+		Temperature:         &temp,
+	}
+}
+
+// parseChangelogResponse parses the API response into a Changelog.
+func parseChangelogResponse(resp *cerebrasResponse) (*domain.Changelog, error) {
+	if len(resp.Choices) == 0 {
+		return nil, errors.New("no response from AI")
+	}
+
+	var parsed struct {
+		Breaking []string `json:"breaking"`
+		Features []string `json:"features"`
+		Fixes    []string `json:"fixes"`
+		Other    []string `json:"other"`
+	}
+
+	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON response: %w", err)
+	}
+
+	changelog := &domain.Changelog{
+		Breaking: nonEmptyEntries(parsed.Breaking),
+		Features: nonEmptyEntries(parsed.Features),
+		Fixes:    nonEmptyEntries(parsed.Fixes),
+		Other:    nonEmptyEntries(parsed.Other),
+	}
+	if changelog.IsEmpty() {
+		return nil, errors.New("changelog has no entries")
+	}
+
+	return changelog, nil
+}
+
+// nonEmptyEntries trims entries and drops blank ones.
+func nonEmptyEntries(entries []string) []string {
+	var result []string
+	for _, entry := range entries {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// SuggestCommitSplit groups the changed files into logical commits, each with its own message.
+func (c *CerebrasProvider) SuggestCommitSplit(ctx context.Context, request CommitSplitRequest) (*CommitSplitResponse, error) {
+	if request.Repository == nil {
+		return nil, errors.New("repository cannot be nil")
+	}
+
+	prompt := c.buildCommitSplitPrompt(request)
+	structuredReq := c.buildCommitSplitStructuredRequest(prompt)
+
+	resp, err := c.makeRequestWithRetry(ctx, structuredReq, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(request.Repository.Changes()))
+	for _, change := range request.Repository.Changes() {
+		files = append(files, change.Path)
+	}
+
+	groups, err := parseCommitSplitResponse(resp, files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse commit split response: %w", err)
+	}
+
+	return &CommitSplitResponse{
+		Groups:     groups,
+		TokensUsed: resp.Usage.TotalTokens,
+		Model:      resp.Model,
+	}, nil
+}
+
+// buildCommitSplitPrompt builds the prompt for grouping changes into separate commits.
+func (c *CerebrasProvider) buildCommitSplitPrompt(request CommitSplitRequest) string {
+	var sb strings.Builder
+
+	sb.WriteString("You are an expert Git workflow assistant. The working tree mixes unrelated changes. Split them into a sequence of small, atomic commits.\n\n")
+
+	sb.WriteString("Changed files:\n")
+	for _, change := range request.Repository.Changes() {
+		sb.WriteString(fmt.Sprintf("- %s (%s)\n", change.Path, change.Status))
+	}
+	sb.WriteString("\n")
+
+	if request.Diff != "" {
+		diff := request.Diff
+		if request.APIKey.ShouldReduceContext() || request.Repository.IsLargeChangeset() {
+			diff = reduceDiffContext(diff, request.APIKey.MaxTokensPerRequest())
+		}
+		sb.WriteString("Changes (git diff):\n")
+		sb.WriteString(diff)
+		sb.WriteString("\n\n")
+	}
+
+	sb.WriteString("Instructions:\n")
+	sb.WriteString("1. Group files that belong to the same logical change; unrelated changes go in separate groups\n")
+	sb.WriteString("2. Every changed file must appear in exactly one group, using the paths listed above\n")
+	sb.WriteString("3. Order groups so each commit builds on the previous ones (e.g. a library change before its callers)\n")
+	sb.WriteString("4. Write a commit message per group: imperative subject, max 50 chars, no period; body explains what and why\n")
+	if request.UseConventionalCommits {
+		sb.WriteString("5. Use conventional commits format (type(scope): description)\n")
+	}
+
+	return sb.String()
+}
+
+// buildCommitSplitStructuredRequest builds a structured request for commit splitting.
+func (c *CerebrasProvider) buildCommitSplitStructuredRequest(prompt string) cerebrasRequest {
+	falseBool := false
+
+	schema := analysisSchema{
+		Type: "object",
+		Properties: map[string]property{
+			"groups": {
+				Type:        "array",
+				Description: "Commits to make, in order",
+				Items: &property{
+					Type: "object",
+					Properties: map[string]property{
+						"files": {
+							Type:        "array",
+							Description: "Changed file paths in this commit",
+							Items:       &property{Type: "string"},
+						},
+						"commit_message": {
+							Type:        "string",
+							Description: "Commit message for this group",
+						},
+						"reasoning": {
+							Type:        "string",
+							Description: "Why these files belong together",
+						},
+					},
+					Required:             []string{"files", "commit_message", "reasoning"},
+					AdditionalProperties: &falseBool,
+				},
+			},
+		},
+		Required:             []string{"groups"},
+		AdditionalProperties: &falseBool,
+	}
+
+	temp := 0.3
+
+	return cerebrasRequest{
+		Model: c.model,
+		Messages: []message{
+			{
+				Role:    "user",
+				Content: prompt,
+			},
+		},
+		ResponseFormat: &responseFormat{
+			Type: "json_schema",
+			JSONSchema: &jsonSchema{
+				Name:   "commit_split",
+				Strict: true,
+				Schema: schema,
+			},
+		},
+		MaxCompletionTokens: 2000,
+		Temperature:         &temp,
+	}
+}
+
+// parseCommitSplitResponse parses the API response into commit groups. Files
+// the model invented or repeated are dropped, and changed files it left out
+// are added to the last group so that nothing is left uncommitted.
+func parseCommitSplitResponse(resp *cerebrasResponse, files []string) ([]domain.CommitGroup, error) {
+	if len(resp.Choices) == 0 {
+		return nil, errors.New("no response from AI")
+	}
+
+	var parsed struct {
+		Groups []struct {
+			Files         []string `json:"files"`
+			CommitMessage string   `json:"commit_message"`
+			Reasoning     string   `json:"reasoning"`
+		} `json:"groups"`
+	}
+
+	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON response: %w", err)
+	}
+
+	unassigned := make(map[string]bool, len(files))
+	for _, file := range files {
+		unassigned[file] = true
+	}
+
+	var groups []domain.CommitGroup
+	for _, g := range parsed.Groups {
+		var groupFiles []string
+		for _, file := range g.Files {
+			file = strings.TrimSpace(file)
+			if unassigned[file] {
+				groupFiles = append(groupFiles, file)
+				delete(unassigned, file)
+			}
+		}
+		if len(groupFiles) == 0 {
+			continue
+		}
+
+		msg, err := domain.NewCommitMessage(g.CommitMessage)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commit message from AI: %w", err)
+		}
+
+		groups = append(groups, domain.CommitGroup{
+			Files:     groupFiles,
+			Message:   msg,
+			Reasoning: strings.TrimSpace(g.Reasoning),
+		})
+	}
+	if len(groups) == 0 {
+		return nil, errors.New("no commit groups in response")
+	}
+
+	// Keep the input order for files the model missed
+	last := &groups[len(groups)-1]
+	for _, file := range files {
+		if unassigned[file] {
+			last.Files = append(last.Files, file)
+		}
+	}
+
+	return groups, nil
+}
+
+// ReviewPatch summarizes and assesses a standalone patch or diff.
+func (c *CerebrasProvider) ReviewPatch(ctx context.Context, request PatchReviewRequest) (*PatchReviewResponse, error) {
+	if strings.TrimSpace(request.Patch) == "" {
+		return nil, errors.New("patch cannot be empty")
+	}
+
+	prompt := c.buildPatchReviewPrompt(request)
+	structuredReq := c.buildPatchReviewStructuredRequest(prompt)
+
+	resp, err := c.makeRequestWithRetry(ctx, structuredReq, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := parsePatchReviewResponse(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse patch review response: %w", err)
+	}
+
+	return &PatchReviewResponse{
+		Review:     review,
+		TokensUsed: resp.Usage.TotalTokens,
+		Model:      resp.Model,
+	}, nil
+}
+
+// buildPatchReviewPrompt builds the prompt for reviewing a patch.
+func (c *CerebrasProvider) buildPatchReviewPrompt(request PatchReviewRequest) string {
+	var sb strings.Builder
+
+	sb.WriteString("You are an expert code reviewer. Review the following patch, which was sent for review and has not been applied.\n\n")
+
+	if len(request.Files) > 0 {
+		sb.WriteString(fmt.Sprintf("Files touched (%d):\n", len(request.Files)))
+		for _, file := range request.Files {
+			sb.WriteString(fmt.Sprintf("- %s\n", file))
+		}
+		sb.WriteString("\n")
+	}
+
+	patch := request.Patch
+	if request.APIKey != nil {
+		patch = reduceDiffContext(patch, request.APIKey.MaxTokensPerRequest())
+	}
+	sb.WriteString("Patch:\n```diff\n")
+	sb.WriteString(patch)
+	sb.WriteString("\n```\n\n")
+
+	sb.WriteString("Instructions:\n")
+	sb.WriteString("1. Summarize what the patch does in two or three sentences\n")
+	sb.WriteString("2. Choose a verdict: 'looks-good' if it can be applied as is, 'needs-changes' if it should be revised, 'risky' if it may break existing behavior\n")
+	sb.WriteString("3. List concrete concerns (bugs, missing error handling, missing tests, security issues), citing files where possible\n")
+	sb.WriteString("4. List optional suggestions separately from concerns\n")
+	sb.WriteString("5. Use an empty list when there is nothing to report; do not invent problems\n")
+
+	return sb.String()
+}
+
+// buildPatchReviewStructuredRequest builds a structured request for patch review.
+func (c *CerebrasProvider) buildPatchReviewStructuredRequest(prompt string) cerebrasRequest {
+	falseBool := false
+
+	schema := analysisSchema{
+		Type: "object",
+		Properties: map[string]property{
+			"summary": {
+				Type:        "string",
+				Description: "What the patch does",
+			},
+			"verdict": {
+				Type:        "string",
+				Enum:        []string{string(domain.PatchLooksGood), string(domain.PatchNeedsChanges), string(domain.PatchRisky)},
+				Description: "Overall assessment of the patch",
+			},
+			"concerns": {
+				Type:        "array",
+				Description: "Bugs, risks, or missing pieces",
+				Items:       &property{Type: "string"},
+			},
+			"suggestions": {
+				Type:        "array",
+				Description: "Optional improvements",
+				Items:       &property{Type: "string"},
+			},
+		},
+		Required:             []string{"summary", "verdict", "concerns", "suggestions"},
+		AdditionalProperties: &falseBool,
+	}
+
+	temp := 0.3
+
+	return cerebrasRequest{
+		Model: c.model,
+		Messages: []message{
+			{
+				Role:    "user",
+				Content: prompt,
+			},
+		},
+		ResponseFormat: &responseFormat{
+			Type: "json_schema",
+			JSONSchema: &jsonSchema{
+				Name:   "patch_review",
+				Strict: true,
+				Schema: schema,
+			},
+		},
+		MaxCompletionTokens: 2000,
+		Temperature:         &temp,
+	}
+}
+
+// parsePatchReviewResponse parses the API response into a PatchReview.
+func parsePatchReviewResponse(resp *cerebrasResponse) (*domain.PatchReview, error) {
+	if len(resp.Choices) == 0 {
+		return nil, errors.New("no response from AI")
+	}
+
+	var parsed struct {
+		Summary     string   `json:"summary"`
+		Verdict     string   `json:"verdict"`
+		Concerns    []string `json:"concerns"`
+		Suggestions []string `json:"suggestions"`
+	}
+
+	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON response: %w", err)
+	}
+
+	summary := strings.TrimSpace(parsed.Summary)
+	if summary == "" {
+		return nil, errors.New("review has no summary")
+	}
+
+	verdict := domain.PatchVerdict(parsed.Verdict)
+	switch verdict {
+	case domain.PatchLooksGood, domain.PatchNeedsChanges, domain.PatchRisky:
+	default:
+		// Unknown verdicts err on the side of caution
+		verdict = domain.PatchNeedsChanges
+	}
+
+	return &domain.PatchReview{
+		Summary:     summary,
+		Verdict:     verdict,
+		Concerns:    nonEmptyEntries(parsed.Concerns),
+		Suggestions: nonEmptyEntries(parsed.Suggestions),
+	}, nil
+}
+
 // Helper functions
 
 func mapActionType(action string) domain.ActionType {
@@ -702,6 +1687,7 @@ type cerebrasRequest struct {
 	ResponseFormat       *responseFormat `json:"response_format,omitempty"`
 	MaxCompletionTokens  int             `json:"max_completion_tokens,omitempty"`
 	Temperature          *float64        `json:"temperature,omitempty"`
+	Stream               bool            `json:"stream,omitempty"`
 }
 
 type message struct {
@@ -751,6 +1737,15 @@ type choice struct {
 	Message message `json:"message"`
 }
 
+// streamChunk is one server-sent event of a streamed chat completion
+type streamChunk struct {
+	Model   string `json:"model"`
+	Choices []struct {
+		Delta message `json:"delta"`
+	} `json:"choices"`
+	Usage *usage `json:"usage"` // Only on the last chunk
+}
+
 type usage struct {
 	PromptTokens     int `json:"prompt_tokens"`
 	CompletionTokens int `json:"completion_tokens"`
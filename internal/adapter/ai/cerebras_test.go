@@ -0,0 +1,667 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+func TestParseChangelogResponse(t *testing.T) {
+	tests := []struct {
+		name         string
+		content      string
+		wantErr      bool
+		wantFeatures int
+		wantFixes    int
+		wantBreaking int
+	}{
+		{
+			name:         "all groups",
+			content:      `{"breaking":["Drop Go 1.20 support"],"features":["Add changelog generator","Add signed tags"],"fixes":["Fix push after commit"],"other":[]}`,
+			wantFeatures: 2,
+			wantFixes:    1,
+			wantBreaking: 1,
+		},
+		{
+			name:         "blank entries dropped",
+			content:      `{"breaking":[],"features":["  ", "Add tags view"],"fixes":[""],"other":[]}`,
+			wantFeatures: 1,
+		},
+		{
+			name:    "no entries",
+			content: `{"breaking":[],"features":[],"fixes":[],"other":[]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			content: `not json`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &cerebrasResponse{Choices: []choice{{Message: message{Content: tt.content}}}}
+
+			got, err := parseChangelogResponse(resp)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseChangelogResponse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got.Features) != tt.wantFeatures {
+				t.Errorf("Features = %v, want %d entries", got.Features, tt.wantFeatures)
+			}
+			if len(got.Fixes) != tt.wantFixes {
+				t.Errorf("Fixes = %v, want %d entries", got.Fixes, tt.wantFixes)
+			}
+			if len(got.Breaking) != tt.wantBreaking {
+				t.Errorf("Breaking = %v, want %d entries", got.Breaking, tt.wantBreaking)
+			}
+		})
+	}
+}
+
+func TestParseChangelogResponse_Markdown(t *testing.T) {
+	resp := &cerebrasResponse{Choices: []choice{{Message: message{
+		Content: `{"breaking":["Rename config key"],"features":["Add changelog generator"],"fixes":[],"other":[]}`,
+	}}}}
+
+	changelog, err := parseChangelogResponse(resp)
+	if err != nil {
+		t.Fatalf("parseChangelogResponse() error = %v", err)
+	}
+	changelog.Title = "v1.2.0"
+
+	md := changelog.Markdown()
+	for _, want := range []string{"## v1.2.0", "### Breaking Changes", "- Rename config key", "### Features"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("Markdown() missing %q, got:\n%s", want, md)
+		}
+	}
+	if strings.Contains(md, "### Fixes") {
+		t.Errorf("Markdown() should omit empty groups, got:\n%s", md)
+	}
+	// Breaking changes come first
+	if strings.Index(md, "Breaking") > strings.Index(md, "Features") {
+		t.Errorf("Markdown() should list breaking changes before features, got:\n%s", md)
+	}
+}
+
+func TestBuildChangelogPrompt_Grouped(t *testing.T) {
+	apiKey, err := domain.NewAPIKey("test-key", "cerebras")
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+	provider := NewCerebrasProvider(apiKey, ProviderConfig{})
+
+	commits := []string{"feat: add tags view", "Update README"}
+	prompt := provider.buildChangelogPrompt(ChangelogRequest{
+		FromRef: "v1.0.0",
+		ToRef:   "HEAD",
+		Commits: commits,
+		Grouped: domain.GroupCommitsByType(commits),
+	})
+	for _, want := range []string{"Range: v1.0.0..HEAD", "Features:\n- add tags view", "Other changes:\n- Update README", "Keep each change in the group"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("prompt should contain %q, got:\n%s", want, prompt)
+		}
+	}
+	if strings.Contains(prompt, "Breaking Changes:") {
+		t.Errorf("prompt should skip empty groups, got:\n%s", prompt)
+	}
+}
+
+func TestBuildPrompt_StatesAnalysisScope(t *testing.T) {
+	apiKey, err := domain.NewAPIKey("csk-test-key", "cerebras")
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+	branchInfo, err := domain.NewBranchInfo("feature/login")
+	if err != nil {
+		t.Fatalf("NewBranchInfo() error = %v", err)
+	}
+	branchInfo.SetParent("main")
+
+	provider := NewCerebrasProvider(apiKey, ProviderConfig{})
+	request := AnalysisRequest{Repository: repo, BranchInfo: branchInfo, APIKey: apiKey, Diff: "+uncommitted"}
+
+	prompt := provider.buildPrompt(request)
+	if !strings.Contains(prompt, "uncommitted working-tree changes only") {
+		t.Errorf("default prompt should limit scope to uncommitted changes, got:\n%s", prompt)
+	}
+
+	request.Scope = domain.AnalysisScopeBranch
+	request.BranchDiff = "+committed"
+	prompt = provider.buildPrompt(request)
+	if !strings.Contains(prompt, "entire branch since main") || !strings.Contains(prompt, "+committed") {
+		t.Errorf("branch prompt should include the branch range, got:\n%s", prompt)
+	}
+
+	request.CommitTemplate = "[TICKET-###] Summary"
+	prompt = provider.buildPrompt(request)
+	if !strings.Contains(prompt, "Team commit template") || !strings.Contains(prompt, "[TICKET-###] Summary") {
+		t.Errorf("prompt should include the commit template, got:\n%s", prompt)
+	}
+}
+
+func TestBuildPrompt_HonorsMaxContextCommits(t *testing.T) {
+	apiKey, err := domain.NewAPIKey("test-key", "cerebras")
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+
+	var recentLog []string
+	for i := 1; i <= 8; i++ {
+		recentLog = append(recentLog, fmt.Sprintf("commit-%d", i))
+	}
+	request := AnalysisRequest{Repository: repo, APIKey: apiKey, Diff: "+change", RecentLog: recentLog}
+
+	tests := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{"default", 0, domain.DefaultMaxContextCommits},
+		{"configured", 5, 5},
+		{"more than available", 20, 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := NewCerebrasProvider(apiKey, ProviderConfig{MaxContextCommits: tt.limit})
+			prompt := provider.buildPrompt(request)
+
+			if got := strings.Count(prompt, "- commit-"); got != tt.want {
+				t.Errorf("prompt lists %d commits, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildPrompt_GeneratedFilesAreIncidental(t *testing.T) {
+	apiKey, err := domain.NewAPIKey("test-key", "cerebras")
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+	provider := NewCerebrasProvider(apiKey, ProviderConfig{})
+
+	request := AnalysisRequest{Repository: repo, APIKey: apiKey, Diff: "+change"}
+	if prompt := provider.buildPrompt(request); strings.Contains(prompt, "Generated or lock files") {
+		t.Errorf("prompt mentions generated files without any")
+	}
+
+	request.GeneratedFiles = []string{"go.sum"}
+	prompt := provider.buildPrompt(request)
+	if !strings.Contains(prompt, "Generated or lock files (incidental") || !strings.Contains(prompt, "- go.sum\n") {
+		t.Errorf("prompt = %q, want the lockfile listed as incidental", prompt)
+	}
+}
+
+func TestBuildPrompt_ScopeHint(t *testing.T) {
+	apiKey, err := domain.NewAPIKey("test-key", "cerebras")
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+	provider := NewCerebrasProvider(apiKey, ProviderConfig{})
+
+	request := AnalysisRequest{Repository: repo, APIKey: apiKey, Diff: "+change", ScopeHint: "ui"}
+	if prompt := provider.buildPrompt(request); strings.Contains(prompt, `directory "ui"`) {
+		t.Errorf("prompt suggests a scope without conventional commits")
+	}
+
+	request.UseConventionalCommits = true
+	if prompt := provider.buildPrompt(request); !strings.Contains(prompt, `share the directory "ui"`) {
+		t.Errorf("prompt = %q, want the scope hint", prompt)
+	}
+}
+
+func TestBuildMergePrompt_HonorsMaxMergeContextCommits(t *testing.T) {
+	apiKey, err := domain.NewAPIKey("test-key", "cerebras")
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+
+	var commits []string
+	for i := 1; i <= 25; i++ {
+		commits = append(commits, fmt.Sprintf("commit-%d", i))
+	}
+	request := MergeMessageRequest{SourceBranch: "feature/login", TargetBranch: "main", Commits: commits, CommitCount: len(commits)}
+
+	tests := []struct {
+		name     string
+		limit    int
+		want     int
+		wantMore string
+	}{
+		{"default", 0, domain.DefaultMaxMergeContextCommits, "... and 15 more commits"},
+		{"configured", 20, 20, "... and 5 more commits"},
+		{"all commits", 30, 25, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := NewCerebrasProvider(apiKey, ProviderConfig{MaxMergeContextCommits: tt.limit})
+			prompt := provider.buildMergePrompt(request)
+
+			if got := strings.Count(prompt, ". commit-"); got != tt.want {
+				t.Errorf("prompt lists %d commits, want %d", got, tt.want)
+			}
+			if tt.wantMore != "" && !strings.Contains(prompt, tt.wantMore) {
+				t.Errorf("prompt should end the list with %q, got:\n%s", tt.wantMore, prompt)
+			}
+			if tt.wantMore == "" && strings.Contains(prompt, "more commits") {
+				t.Errorf("prompt should list every commit, got:\n%s", prompt)
+			}
+		})
+	}
+}
+
+func TestParseCommitSplitResponse(t *testing.T) {
+	files := []string{"api/user.go", "api/user_test.go", "docs/README.md", "go.mod"}
+
+	tests := []struct {
+		name       string
+		content    string
+		wantErr    bool
+		wantGroups [][]string
+		wantTitles []string
+	}{
+		{
+			name: "groups in order",
+			content: `{"groups":[
+				{"files":["api/user.go","api/user_test.go"],"commit_message":"Add user lookup endpoint","reasoning":"API change with its test"},
+				{"files":["docs/README.md","go.mod"],"commit_message":"Document setup","reasoning":"Docs and module tidy"}]}`,
+			wantGroups: [][]string{{"api/user.go", "api/user_test.go"}, {"docs/README.md", "go.mod"}},
+			wantTitles: []string{"Add user lookup endpoint", "Document setup"},
+		},
+		{
+			name: "unknown and duplicate files dropped, missing files kept",
+			content: `{"groups":[
+				{"files":["api/user.go","api/other.go"],"commit_message":"Add user lookup endpoint","reasoning":""},
+				{"files":["api/user.go","docs/README.md"],"commit_message":"Document setup","reasoning":""}]}`,
+			wantGroups: [][]string{{"api/user.go"}, {"docs/README.md", "api/user_test.go", "go.mod"}},
+			wantTitles: []string{"Add user lookup endpoint", "Document setup"},
+		},
+		{
+			name: "groups left empty are skipped",
+			content: `{"groups":[
+				{"files":["vendor/x.go"],"commit_message":"Vendor","reasoning":""},
+				{"files":["api/user.go","api/user_test.go","docs/README.md","go.mod"],"commit_message":"Add user lookup","reasoning":""}]}`,
+			wantGroups: [][]string{{"api/user.go", "api/user_test.go", "docs/README.md", "go.mod"}},
+			wantTitles: []string{"Add user lookup"},
+		},
+		{
+			name:    "no groups",
+			content: `{"groups":[]}`,
+			wantErr: true,
+		},
+		{
+			name:    "empty message",
+			content: `{"groups":[{"files":["go.mod"],"commit_message":"","reasoning":""}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			content: `{"groups":`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &cerebrasResponse{Choices: []choice{{Message: message{Content: tt.content}}}}
+
+			got, err := parseCommitSplitResponse(resp, files)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCommitSplitResponse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.wantGroups) {
+				t.Fatalf("got %d groups, want %d", len(got), len(tt.wantGroups))
+			}
+			for i, group := range got {
+				if strings.Join(group.Files, ",") != strings.Join(tt.wantGroups[i], ",") {
+					t.Errorf("group %d files = %v, want %v", i, group.Files, tt.wantGroups[i])
+				}
+				if group.Message.Title() != tt.wantTitles[i] {
+					t.Errorf("group %d title = %q, want %q", i, group.Message.Title(), tt.wantTitles[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParsePatchReviewResponse(t *testing.T) {
+	resp := &cerebrasResponse{Choices: []choice{{Message: message{
+		Content: `{"summary":"Adds retry to the login client.","verdict":"surprising","concerns":["No test for the retry limit"," "],"suggestions":[]}`,
+	}}}}
+
+	review, err := parsePatchReviewResponse(resp)
+	if err != nil {
+		t.Fatalf("parsePatchReviewResponse() error = %v", err)
+	}
+	if review.Verdict != domain.PatchNeedsChanges {
+		t.Errorf("Verdict = %q, want unknown verdicts to become %q", review.Verdict, domain.PatchNeedsChanges)
+	}
+	if len(review.Concerns) != 1 || len(review.Suggestions) != 0 {
+		t.Errorf("Concerns = %q, Suggestions = %q, want one concern and no suggestions", review.Concerns, review.Suggestions)
+	}
+
+	empty := &cerebrasResponse{Choices: []choice{{Message: message{
+		Content: `{"summary":"","verdict":"looks-good","concerns":[],"suggestions":[]}`,
+	}}}}
+	if _, err := parsePatchReviewResponse(empty); err == nil {
+		t.Error("parsePatchReviewResponse() expected error for a review without summary")
+	}
+}
+
+func TestParsePRDescriptionResponse(t *testing.T) {
+	resp := &cerebrasResponse{Choices: []choice{{Message: message{
+		Content: `{"title":"  Add OAuth login\nwith refresh tokens","summary":" Adds an OAuth login flow. ","bullet_changes":["- Login page","Session store"," ","-"]}`,
+	}}}}
+
+	description, err := parsePRDescriptionResponse(resp)
+	if err != nil {
+		t.Fatalf("parsePRDescriptionResponse() error = %v", err)
+	}
+	if description.Title != "Add OAuth login" {
+		t.Errorf("Title = %q, want only its first line", description.Title)
+	}
+	if description.Summary != "Adds an OAuth login flow." {
+		t.Errorf("Summary = %q, want it trimmed", description.Summary)
+	}
+	if len(description.Changes) != 2 || description.Changes[0] != "Login page" {
+		t.Errorf("Changes = %q, want the two changes without bullet markers", description.Changes)
+	}
+
+	empty := &cerebrasResponse{Choices: []choice{{Message: message{Content: `{"title":" ","summary":"Adds a login page.","bullet_changes":[]}`}}}}
+	if _, err := parsePRDescriptionResponse(empty); err == nil {
+		t.Error("parsePRDescriptionResponse() expected error for a pull request without title")
+	}
+}
+
+func TestBuildPRDescriptionPrompt(t *testing.T) {
+	apiKey, err := domain.NewAPIKey("test-key", "cerebras")
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+	provider := NewCerebrasProvider(apiKey, ProviderConfig{MaxMergeContextCommits: 2})
+
+	prompt := provider.buildPRDescriptionPrompt(PRDescriptionRequest{
+		SourceBranch: "feature/login",
+		TargetBranch: "main",
+		Commits:      []string{"feat: add login page", "feat: add session store", "chore: bump deps"},
+		Diff:         "diff --git a/login.go b/login.go\n+func Login() {}",
+		APIKey:       apiKey,
+	})
+	for _, want := range []string{"feature/login → main", "1. feat: add login page", "2. feat: add session store", "... and 1 more commits", "Net changes (git diff main...feature/login)", "+func Login() {}"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("prompt should contain %q, got:\n%s", want, prompt)
+		}
+	}
+}
+
+func TestPRDescriptionSchema_ParsesConformingResponse(t *testing.T) {
+	apiKey, err := domain.NewAPIKey("test-key", "cerebras")
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+	provider := NewCerebrasProvider(apiKey, ProviderConfig{})
+
+	req := provider.buildPRDescriptionStructuredRequest("prompt")
+	schema := req.ResponseFormat.JSONSchema.Schema
+
+	// A response carrying exactly the schema's required fields must parse
+	sample := map[string]interface{}{}
+	for _, field := range schema.Required {
+		prop, ok := schema.Properties[field]
+		if !ok {
+			t.Fatalf("required field %q has no property in the schema", field)
+		}
+		switch prop.Type {
+		case "array":
+			sample[field] = []string{"Add login page"}
+		default:
+			sample[field] = "Add OAuth login"
+		}
+	}
+	content, err := json.Marshal(sample)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	description, err := parsePRDescriptionResponse(&cerebrasResponse{Choices: []choice{{Message: message{Content: string(content)}}}})
+	if err != nil {
+		t.Fatalf("parsePRDescriptionResponse() error = %v for %s", err, content)
+	}
+	if description.Title != "Add OAuth login" || description.Summary != "Add OAuth login" || len(description.Changes) != 1 {
+		t.Errorf("parsePRDescriptionResponse() = %+v, want every schema field read back", description)
+	}
+}
+
+// weakMessageServer answers every chat completion with the given commit
+// messages in turn and counts the requests.
+func weakMessageServer(t *testing.T, messages ...string) (*httptest.Server, *int) {
+	t.Helper()
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		msg := messages[len(messages)-1]
+		if calls < len(messages) {
+			msg = messages[calls]
+		}
+		calls++
+
+		content, _ := json.Marshal(map[string]interface{}{
+			"commit_message": msg,
+			"action":         "commit-direct",
+			"confidence":     0.9,
+			"reasoning":      "Small change",
+		})
+		_ = json.NewEncoder(w).Encode(cerebrasResponse{
+			Model:   "test-model",
+			Choices: []choice{{Message: message{Role: "assistant", Content: string(content)}}},
+			Usage:   usage{TotalTokens: 100},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestAnalyze_WeakCommitMessageDoesNotAbort(t *testing.T) {
+	apiKey, err := domain.NewAPIKey("csk-test", "cerebras")
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+	repo.AddChange(domain.FileChange{Path: "internal/auth/login.go", Status: domain.StatusModified})
+	repo.AddChange(domain.FileChange{Path: "internal/auth/session.go", Status: domain.StatusModified})
+
+	tests := []struct {
+		name      string
+		policy    string
+		messages  []string
+		wantCalls int
+		wantTitle string
+	}{
+		{"retry recovers", domain.WeakMessageRetry, []string{"", "Refresh expired login sessions"}, 2, "Refresh expired login sessions"},
+		{"retry then fallback", "", []string{"", "fix"}, 2, "chore: update 2 files in internal/auth"},
+		{"fallback without retry", domain.WeakMessageFallback, []string{"feat: x"}, 1, "chore: update 2 files in internal/auth"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := weakMessageServer(t, tt.messages...)
+			provider := NewCerebrasProvider(apiKey, ProviderConfig{BaseURL: server.URL, WeakMessagePolicy: tt.policy})
+
+			resp, err := provider.Analyze(context.Background(), AnalysisRequest{
+				Repository:             repo,
+				Diff:                   "diff --git a/internal/auth/login.go b/internal/auth/login.go",
+				UseConventionalCommits: true,
+				APIKey:                 apiKey,
+			})
+			if err != nil {
+				t.Fatalf("Analyze() error = %v, want a weak message not to abort", err)
+			}
+
+			if *calls != tt.wantCalls {
+				t.Errorf("requests = %d, want %d", *calls, tt.wantCalls)
+			}
+			if got := resp.Decision.SuggestedMessage().Title(); got != tt.wantTitle {
+				t.Errorf("message = %q, want %q", got, tt.wantTitle)
+			}
+			if resp.TokensUsed != 100*tt.wantCalls {
+				t.Errorf("TokensUsed = %d, want %d", resp.TokensUsed, 100*tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestAnalyze_StreamsTokens(t *testing.T) {
+	content := `{"commit_message":"Refresh expired login sessions","action":"commit-direct","confidence":0.9,"reasoning":"Small change"}`
+	pieces := []string{content[:20], content[20:60], content[60:]}
+
+	var streamed bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req cerebrasRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		streamed = req.Stream
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, piece := range pieces {
+			chunk, _ := json.Marshal(map[string]interface{}{
+				"model":   "test-model",
+				"choices": []map[string]interface{}{{"delta": map[string]string{"content": piece}}},
+			})
+			fmt.Fprintf(w, "data: %s\n\n", chunk)
+		}
+		fmt.Fprint(w, `data: {"model":"test-model","choices":[],"usage":{"total_tokens":120}}`+"\n\n")
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	t.Cleanup(server.Close)
+
+	apiKey, err := domain.NewAPIKey("csk-test", "cerebras")
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+	repo.AddChange(domain.FileChange{Path: "internal/auth/session.go", Status: domain.StatusModified})
+
+	tokens := make(chan string, len(pieces))
+	provider := NewCerebrasProvider(apiKey, ProviderConfig{BaseURL: server.URL})
+	resp, err := provider.Analyze(context.Background(), AnalysisRequest{Repository: repo, APIKey: apiKey, Tokens: tokens})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	close(tokens)
+
+	if !streamed {
+		t.Error("request did not ask for a streamed response")
+	}
+	var received []string
+	for token := range tokens {
+		received = append(received, token)
+	}
+	if strings.Join(received, "") != content || len(received) != len(pieces) {
+		t.Errorf("tokens = %q, want the response in %d pieces", received, len(pieces))
+	}
+	if got := resp.Decision.SuggestedMessage().Title(); got != "Refresh expired login sessions" {
+		t.Errorf("message = %q, want the one parsed from the whole stream", got)
+	}
+	if resp.TokensUsed != 120 || resp.Model != "test-model" {
+		t.Errorf("TokensUsed = %d, Model = %q, want the last chunk's usage and model", resp.TokensUsed, resp.Model)
+	}
+}
+
+func TestParseResponse_CommitBody(t *testing.T) {
+	provider := NewCerebrasProvider(nil, ProviderConfig{})
+	content := `{"commit_message":"Add login page","commit_body":"- Validate passwords\n- Lock after 5 failed tries","action":"commit-direct","confidence":0.9,"reasoning":"ok"}`
+	resp := &cerebrasResponse{Choices: []choice{{Message: message{Content: content}}}}
+
+	decision, err := provider.parseResponse(resp, false)
+	if err != nil {
+		t.Fatalf("parseResponse() error = %v", err)
+	}
+
+	msg := decision.SuggestedMessage()
+	if msg.Title() != "Add login page" {
+		t.Errorf("Title() = %q, want %q", msg.Title(), "Add login page")
+	}
+	if want := "- Validate passwords\n- Lock after 5 failed tries"; msg.Body() != want {
+		t.Errorf("Body() = %q, want %q", msg.Body(), want)
+	}
+}
+
+func TestParseResponse_MessageCandidates(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		wantTitles []string
+	}{
+		{
+			name:       "no candidates keeps the single message",
+			content:    `{"commit_message":"Add login page","action":"commit-direct","confidence":0.9,"reasoning":"ok"}`,
+			wantTitles: []string{"Add login page"},
+		},
+		{
+			name:       "candidates follow the message",
+			content:    `{"commit_message":"Add login page","action":"commit-direct","confidence":0.9,"reasoning":"ok","message_candidates":[{"title":"Introduce the login screen","body":"With remember-me."},{"title":"Add login page"},{"title":"fix"}]}`,
+			wantTitles: []string{"Add login page", "Introduce the login screen"},
+		},
+		{
+			name:       "weak message gives way to a candidate",
+			content:    `{"commit_message":"","action":"commit-direct","confidence":0.9,"reasoning":"ok","message_candidates":[{"title":"Introduce the login screen"}]}`,
+			wantTitles: []string{"Introduce the login screen"},
+		},
+	}
+
+	provider := NewCerebrasProvider(nil, ProviderConfig{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &cerebrasResponse{Choices: []choice{{Message: message{Content: tt.content}}}}
+			decision, err := provider.parseResponse(resp, false)
+			if err != nil {
+				t.Fatalf("parseResponse() error = %v", err)
+			}
+
+			var titles []string
+			for _, candidate := range decision.Candidates() {
+				titles = append(titles, candidate.Title())
+			}
+			if strings.Join(titles, "|") != strings.Join(tt.wantTitles, "|") {
+				t.Errorf("candidates = %v, want %v", titles, tt.wantTitles)
+			}
+		})
+	}
+}
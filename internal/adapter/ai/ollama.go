@@ -0,0 +1,297 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+const (
+	defaultOllamaBaseURL = "http://localhost:11434"
+	defaultOllamaModel   = "llama3.1:8b"
+	minOllamaTimeout     = 2 * time.Minute // Local models can take a while to load
+)
+
+func init() {
+	RegisterProvider("ollama", func(apiKey *domain.APIKey, config ProviderConfig) (Provider, error) {
+		return NewOllamaProvider(apiKey, config), nil
+	})
+}
+
+// errNoJSONObject is returned when a local model's answer contains no JSON object.
+var errNoJSONObject = errors.New("no JSON object in model response")
+
+// OllamaProvider implements the Provider interface for a local Ollama server,
+// for offline and air-gapped use. Prompts and response parsing are shared with
+// CerebrasProvider; since not every Ollama model honors JSON schemas, the schema
+// is described in the prompt and the first JSON object is taken from the answer.
+type OllamaProvider struct {
+	*CerebrasProvider
+}
+
+// NewOllamaProvider creates a new Ollama provider. apiKey may be nil.
+func NewOllamaProvider(apiKey *domain.APIKey, config ProviderConfig) *OllamaProvider {
+	if config.BaseURL == "" {
+		config.BaseURL = defaultOllamaBaseURL
+	}
+	config.BaseURL = strings.TrimSuffix(config.BaseURL, "/")
+	config.Model = ollamaModel(config.Model)
+	if time.Duration(config.Timeout)*time.Second < minOllamaTimeout {
+		config.Timeout = int(minOllamaTimeout / time.Second)
+	}
+
+	provider := &OllamaProvider{CerebrasProvider: NewCerebrasProvider(apiKey, config)}
+	provider.send = provider.chat
+
+	return provider
+}
+
+// ollamaModel maps a configured model name to an Ollama model tag.
+// The hosted llama names become their Ollama equivalents, and models of
+// hosted-only providers fall back to the default.
+func ollamaModel(name string) string {
+	switch {
+	case name == "llama-3.3-70b":
+		return "llama3.3:70b"
+	case name == "llama-3.1-8b", name == "llama3.1-8b":
+		return "llama3.1:8b"
+	case name == "", strings.HasPrefix(name, "gpt-"), strings.HasPrefix(name, "claude-"):
+		return defaultOllamaModel
+	default:
+		return name
+	}
+}
+
+// GetName returns the provider name.
+func (o *OllamaProvider) GetName() string {
+	return "ollama"
+}
+
+// ValidateKey checks that the Ollama server is reachable; there is no key to validate.
+func (o *OllamaProvider) ValidateKey(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", o.baseURL+"/api/tags", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("ollama server not reachable at %s: %w", o.baseURL, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("ollama server not reachable at %s: status code %d", o.baseURL, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// DetectTier reports an unlimited tier: a local server has no rate limits or
+// token quotas, so prompts are not reduced.
+func (o *OllamaProvider) DetectTier(ctx context.Context) (domain.APITier, error) {
+	return domain.TierUnlimited, nil
+}
+
+// Analyze analyzes git changes and returns a decision. When the model's answer
+// cannot be parsed, the decision recommends reviewing the changes by hand.
+func (o *OllamaProvider) Analyze(ctx context.Context, request AnalysisRequest) (*AnalysisResponse, error) {
+	request.APIKey = o.unlimitedKey(ctx, request.APIKey)
+
+	resp, err := o.CerebrasProvider.Analyze(ctx, request)
+	if err == nil || !isUnparseableResponse(err) {
+		return resp, err
+	}
+
+	decision, decisionErr := domain.NewDecision(domain.ActionReview, 0,
+		"The local model's answer could not be parsed; review the changes and edit the suggested message")
+	if decisionErr != nil {
+		return nil, decisionErr
+	}
+	decision.SetSuggestedMessage(fallbackCommitMessage(request.Repository, request.UseConventionalCommits))
+
+	return &AnalysisResponse{Decision: decision, Model: o.model}, nil
+}
+
+// SuggestCommitSplit groups the changed files into logical commits.
+func (o *OllamaProvider) SuggestCommitSplit(ctx context.Context, request CommitSplitRequest) (*CommitSplitResponse, error) {
+	request.APIKey = o.unlimitedKey(ctx, request.APIKey)
+	return o.CerebrasProvider.SuggestCommitSplit(ctx, request)
+}
+
+// unlimitedKey returns apiKey with the tier from DetectTier, so a tier left
+// in the config does not trim prompts for a local model.
+func (o *OllamaProvider) unlimitedKey(ctx context.Context, apiKey *domain.APIKey) *domain.APIKey {
+	var key domain.APIKey
+	if apiKey != nil {
+		key = *apiKey
+	} else if k, err := domain.NewAPIKey("", o.GetName()); err == nil {
+		key = *k
+	}
+
+	tier, _ := o.DetectTier(ctx)
+	key.SetTier(tier)
+	return &key
+}
+
+// chat sends a chat completions request to Ollama's /api/chat and returns the
+// answer in the shape the shared parsers expect.
+func (o *OllamaProvider) chat(ctx context.Context, reqBody cerebrasRequest) (*cerebrasResponse, error) {
+	messages := append([]message(nil), reqBody.Messages...)
+	if format := reqBody.ResponseFormat; format != nil && format.JSONSchema != nil && len(messages) > 0 {
+		schema, err := json.MarshalIndent(format.JSONSchema.Schema, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal schema: %w", err)
+		}
+		last := &messages[len(messages)-1]
+		last.Content += "\n\nRespond with only a JSON object, no other text, matching this JSON schema:\n" + string(schema)
+	}
+
+	chatReq := ollamaRequest{
+		Model:    reqBody.Model,
+		Messages: messages,
+		Stream:   false,
+	}
+	if reqBody.Temperature != nil {
+		chatReq.Options = &ollamaOptions{Temperature: reqBody.Temperature}
+	}
+
+	jsonBody, err := json.Marshal(chatReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", o.baseURL+"/api/chat", bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, parseOllamaError(resp.StatusCode, body)
+	}
+
+	var chatResp ollamaResponse
+	if err := json.Unmarshal(body, &chatResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	content := chatResp.Message.Content
+	if reqBody.ResponseFormat != nil {
+		object, ok := extractJSONObject(content)
+		if !ok {
+			return nil, errNoJSONObject
+		}
+		content = object
+	}
+
+	return &cerebrasResponse{
+		Model:   chatResp.Model,
+		Choices: []choice{{Message: message{Role: "assistant", Content: content}}},
+		Usage: usage{
+			PromptTokens:     chatResp.PromptEvalCount,
+			CompletionTokens: chatResp.EvalCount,
+			TotalTokens:      chatResp.PromptEvalCount + chatResp.EvalCount,
+		},
+	}, nil
+}
+
+// extractJSONObject returns the first balanced {...} block in s that is valid
+// JSON, skipping any prose or code fences the model put around it.
+func extractJSONObject(s string) (string, bool) {
+	for start := strings.Index(s, "{"); start >= 0; {
+		depth := 0
+		inString := false
+		escaped := false
+
+		for i := start; i < len(s); i++ {
+			ch := s[i]
+			switch {
+			case escaped:
+				escaped = false
+			case inString && ch == '\\':
+				escaped = true
+			case ch == '"':
+				inString = !inString
+			case inString:
+			case ch == '{':
+				depth++
+			case ch == '}':
+				depth--
+			}
+
+			if depth == 0 && !inString {
+				if candidate := s[start : i+1]; json.Valid([]byte(candidate)) {
+					return candidate, true
+				}
+				break
+			}
+		}
+
+		next := strings.Index(s[start+1:], "{")
+		if next < 0 {
+			break
+		}
+		start += next + 1
+	}
+
+	return "", false
+}
+
+// isUnparseableResponse reports whether err means the model answered with
+// something other than the JSON it was asked for.
+func isUnparseableResponse(err error) bool {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	return errors.Is(err, errNoJSONObject) || errors.As(err, &syntaxErr) || errors.As(err, &typeErr)
+}
+
+// parseOllamaError turns an Ollama error body ({"error": "..."}) into an error.
+func parseOllamaError(statusCode int, body []byte) error {
+	var errResp struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error != "" {
+		return fmt.Errorf("ollama error (%d): %s", statusCode, errResp.Error)
+	}
+	return fmt.Errorf("ollama error: status code %d", statusCode)
+}
+
+// Type definitions for the Ollama chat API
+
+type ollamaRequest struct {
+	Model    string         `json:"model"`
+	Messages []message      `json:"messages"`
+	Stream   bool           `json:"stream"`
+	Options  *ollamaOptions `json:"options,omitempty"`
+}
+
+type ollamaOptions struct {
+	Temperature *float64 `json:"temperature,omitempty"`
+}
+
+type ollamaResponse struct {
+	Model           string  `json:"model"`
+	Message         message `json:"message"`
+	PromptEvalCount int     `json:"prompt_eval_count"`
+	EvalCount       int     `json:"eval_count"`
+}
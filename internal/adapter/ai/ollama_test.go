@@ -0,0 +1,146 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+func TestExtractJSONObject(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		wantOK  bool
+	}{
+		{"bare object", `{"a":1}`, `{"a":1}`, true},
+		{"prose and code fence", "Sure! Here it is:\n```json\n{\"a\":{\"b\":\"}\"}}\n```", `{"a":{"b":"}"}}`, true},
+		{"skips invalid block", `{not json} then {"a":1}`, `{"a":1}`, true},
+		{"no object", "I cannot help with that.", "", false},
+		{"unterminated", `{"a":1`, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := extractJSONObject(tt.content)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("extractJSONObject() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+// ollamaServer answers /api/chat with content and /api/tags with an empty model list,
+// recording the last chat request.
+func ollamaServer(t *testing.T, content string) (*httptest.Server, *ollamaRequest) {
+	t.Helper()
+	var got ollamaRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tags":
+			_, _ = w.Write([]byte(`{"models":[]}`))
+		case "/api/chat":
+			_ = json.NewDecoder(r.Body).Decode(&got)
+			_ = json.NewEncoder(w).Encode(ollamaResponse{
+				Model:           got.Model,
+				Message:         message{Role: "assistant", Content: content},
+				PromptEvalCount: 80,
+				EvalCount:       20,
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &got
+}
+
+func TestOllamaProvider_Analyze(t *testing.T) {
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+	repo.AddChange(domain.FileChange{Path: "internal/auth/login.go", Status: domain.StatusModified})
+
+	// A free tier left in the config must not trim the diff for a local model
+	apiKey, err := domain.NewAPIKey("", "ollama")
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+	apiKey.SetTier(domain.TierFree)
+	diff := "diff --git a/internal/auth/login.go b/internal/auth/login.go\n" + strings.Repeat("+refresh the session token\n", 2000)
+
+	tests := []struct {
+		name       string
+		content    string
+		wantAction domain.ActionType
+		wantTitle  string
+	}{
+		{
+			name:       "JSON wrapped in prose",
+			content:    "Here is my analysis:\n{\"commit_message\":\"Refresh expired login sessions\",\"action\":\"commit-direct\",\"confidence\":0.8,\"reasoning\":\"Small change\"}\nHope this helps!",
+			wantAction: domain.ActionCommitDirect,
+			wantTitle:  "Refresh expired login sessions",
+		},
+		{
+			name:       "unparseable answer falls back to review",
+			content:    "The changes refresh login sessions.",
+			wantAction: domain.ActionReview,
+			wantTitle:  "Update login.go",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, got := ollamaServer(t, tt.content)
+			provider := NewOllamaProvider(apiKey, ProviderConfig{BaseURL: server.URL, Model: "llama-3.3-70b"})
+
+			resp, err := provider.Analyze(context.Background(), AnalysisRequest{Repository: repo, Diff: diff, APIKey: apiKey})
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+
+			if resp.Decision.Action() != tt.wantAction {
+				t.Errorf("action = %v, want %v", resp.Decision.Action(), tt.wantAction)
+			}
+			if title := resp.Decision.SuggestedMessage().Title(); title != tt.wantTitle {
+				t.Errorf("message = %q, want %q", title, tt.wantTitle)
+			}
+
+			if got.Model != "llama3.3:70b" || got.Stream {
+				t.Errorf("request model = %q, stream = %v, want llama3.3:70b without streaming", got.Model, got.Stream)
+			}
+			prompt := got.Messages[len(got.Messages)-1].Content
+			if !strings.Contains(prompt, "JSON schema") {
+				t.Errorf("prompt does not ask for JSON")
+			}
+			if strings.Count(prompt, "+refresh the session token") != 2000 {
+				t.Errorf("prompt diff was reduced, want the full diff for an unlimited tier")
+			}
+		})
+	}
+}
+
+func TestOllamaProvider_ValidateKeyPingsServer(t *testing.T) {
+	server, _ := ollamaServer(t, "")
+	provider := NewOllamaProvider(nil, ProviderConfig{BaseURL: server.URL})
+
+	if err := provider.ValidateKey(context.Background()); err != nil {
+		t.Errorf("ValidateKey() error = %v, want a reachable server", err)
+	}
+
+	tier, err := provider.DetectTier(context.Background())
+	if err != nil || tier != domain.TierUnlimited {
+		t.Errorf("DetectTier() = %v, %v, want unlimited", tier, err)
+	}
+
+	server.Close()
+	if err := provider.ValidateKey(context.Background()); err == nil {
+		t.Error("ValidateKey() error = nil, want an unreachable server to fail")
+	}
+}
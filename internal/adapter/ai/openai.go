@@ -0,0 +1,132 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+const (
+	defaultOpenAIBaseURL = "https://api.openai.com/v1"
+	defaultOpenAIModel   = "gpt-4o-mini" // Cheapest model with structured outputs
+)
+
+func init() {
+	RegisterProvider("openai", func(apiKey *domain.APIKey, config ProviderConfig) (Provider, error) {
+		return NewOpenAIProvider(apiKey, config), nil
+	})
+}
+
+// OpenAIProvider implements the Provider interface for OpenAI.
+// The chat completions API matches Cerebras, so prompts, JSON schemas and
+// response parsing are shared with CerebrasProvider.
+type OpenAIProvider struct {
+	*CerebrasProvider
+}
+
+// NewOpenAIProvider creates a new OpenAI provider.
+func NewOpenAIProvider(apiKey *domain.APIKey, config ProviderConfig) *OpenAIProvider {
+	if config.BaseURL == "" {
+		config.BaseURL = defaultOpenAIBaseURL
+	}
+	config.Model = openAIModel(config.Model)
+
+	provider := NewCerebrasProvider(apiKey, config)
+	provider.requireAllProperties = true
+
+	return &OpenAIProvider{CerebrasProvider: provider}
+}
+
+// openAIModel maps a configured model name to an OpenAI model.
+// Structured outputs need the gpt-4o family, so plain "gpt-4" is upgraded,
+// and models of other providers (the llama defaults) fall back to gpt-4o-mini.
+func openAIModel(name string) string {
+	switch {
+	case name == "gpt-4":
+		return "gpt-4o"
+	case strings.HasPrefix(name, "gpt-"), strings.HasPrefix(name, "o1"), strings.HasPrefix(name, "o3"):
+		return name
+	default:
+		return defaultOpenAIModel
+	}
+}
+
+// GetName returns the provider name.
+func (o *OpenAIProvider) GetName() string {
+	return "openai"
+}
+
+// ValidateKey checks if the API key is valid by listing models,
+// which costs no tokens.
+func (o *OpenAIProvider) ValidateKey(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", o.baseURL+"/models", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+o.apiKey.Key())
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("API key validation failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API key validation failed: %w", parseErrorResponse(resp.StatusCode, body))
+	}
+
+	return nil
+}
+
+// DetectTier reports the API key tier. OpenAI has no free API tier.
+func (o *OpenAIProvider) DetectTier(ctx context.Context) (domain.APITier, error) {
+	return domain.TierPro, nil
+}
+
+// requireAllSchemaProperties lists every property of every object in the
+// schema as required, as OpenAI's strict structured outputs demand.
+// Optional fields come back as empty values instead of being left out.
+func requireAllSchemaProperties(schema analysisSchema) analysisSchema {
+	schema.Properties = requireAllNestedProperties(schema.Properties)
+	schema.Required = sortedPropertyNames(schema.Properties)
+	return schema
+}
+
+func requireAllNestedProperties(properties map[string]property) map[string]property {
+	if properties == nil {
+		return nil
+	}
+
+	result := make(map[string]property, len(properties))
+	for name, prop := range properties {
+		result[name] = requireAllPropertyFields(prop)
+	}
+	return result
+}
+
+func requireAllPropertyFields(prop property) property {
+	if prop.Properties != nil {
+		prop.Properties = requireAllNestedProperties(prop.Properties)
+		prop.Required = sortedPropertyNames(prop.Properties)
+	}
+	if prop.Items != nil {
+		items := requireAllPropertyFields(*prop.Items)
+		prop.Items = &items
+	}
+	return prop
+}
+
+func sortedPropertyNames(properties map[string]property) []string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,95 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+func TestOpenAIModel(t *testing.T) {
+	tests := []struct {
+		name  string
+		model string
+		want  string
+	}{
+		{"empty uses default", "", "gpt-4o-mini"},
+		{"gpt-4 upgraded for structured outputs", "gpt-4", "gpt-4o"},
+		{"gpt-4o-mini kept", "gpt-4o-mini", "gpt-4o-mini"},
+		{"other provider model replaced", "llama-3.3-70b", "gpt-4o-mini"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := openAIModel(tt.model); got != tt.want {
+				t.Errorf("openAIModel(%q) = %q, want %q", tt.model, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenAIProvider_AnalyzeSendsStrictSchema(t *testing.T) {
+	apiKey, err := domain.NewAPIKey("sk-test", "openai")
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+	repo.AddChange(domain.FileChange{Path: "internal/auth/login.go", Status: domain.StatusModified})
+
+	var got cerebrasRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat/completions" || r.Header.Get("Authorization") != "Bearer sk-test" {
+			t.Errorf("request to %s with auth %q", r.URL.Path, r.Header.Get("Authorization"))
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+
+		content, _ := json.Marshal(map[string]interface{}{
+			"commit_message": "Refresh expired login sessions",
+			"action":         "commit-direct",
+			"confidence":     0.9,
+			"reasoning":      "Small change",
+			"branch_name":    "",
+			"alternatives":   []interface{}{},
+		})
+		_ = json.NewEncoder(w).Encode(cerebrasResponse{
+			Model:   "gpt-4o",
+			Choices: []choice{{Message: message{Role: "assistant", Content: string(content)}}},
+		})
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(apiKey, ProviderConfig{BaseURL: server.URL, Model: "gpt-4"})
+	if provider.GetName() != "openai" {
+		t.Errorf("GetName() = %q, want %q", provider.GetName(), "openai")
+	}
+
+	resp, err := provider.Analyze(context.Background(), AnalysisRequest{
+		Repository: repo,
+		Diff:       "diff --git a/internal/auth/login.go b/internal/auth/login.go",
+		APIKey:     apiKey,
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if title := resp.Decision.SuggestedMessage().Title(); title != "Refresh expired login sessions" {
+		t.Errorf("message = %q, want the AI's message", title)
+	}
+
+	if got.Model != "gpt-4o" {
+		t.Errorf("model = %q, want %q", got.Model, "gpt-4o")
+	}
+	schema := got.ResponseFormat.JSONSchema.Schema
+	if len(schema.Required) != len(schema.Properties) {
+		t.Errorf("required = %v, want every property of %d", schema.Required, len(schema.Properties))
+	}
+	alternatives := schema.Properties["alternatives"].Items
+	if alternatives == nil || len(alternatives.Required) != len(alternatives.Properties) {
+		t.Errorf("alternatives items must require every property")
+	}
+}
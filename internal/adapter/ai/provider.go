@@ -2,6 +2,7 @@ package ai
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/yourusername/gitman/internal/domain"
 )
@@ -14,6 +15,18 @@ type Provider interface {
 	// GenerateMergeMessage generates a merge commit message based on branch commits.
 	GenerateMergeMessage(ctx context.Context, request MergeMessageRequest) (*MergeMessageResponse, error)
 
+	// GeneratePRDescription drafts a pull request title and description from the branch's commits and net diff.
+	GeneratePRDescription(ctx context.Context, request PRDescriptionRequest) (*PRDescriptionResponse, error)
+
+	// GenerateChangelog groups the commits between two refs into a release changelog.
+	GenerateChangelog(ctx context.Context, request ChangelogRequest) (*ChangelogResponse, error)
+
+	// SuggestCommitSplit groups the changed files into logical commits, each with its own message.
+	SuggestCommitSplit(ctx context.Context, request CommitSplitRequest) (*CommitSplitResponse, error)
+
+	// ReviewPatch summarizes and assesses a standalone patch or diff.
+	ReviewPatch(ctx context.Context, request PatchReviewRequest) (*PatchReviewResponse, error)
+
 	// DetectTier attempts to detect the API key tier (free vs pro).
 	DetectTier(ctx context.Context) (domain.APITier, error)
 
@@ -33,9 +46,17 @@ type AnalysisRequest struct {
 	UserPrompt             string             // Optional user-provided context
 	APIKey                 *domain.APIKey     // API key with tier information
 	UseConventionalCommits bool               // Whether to use conventional commit format
+	Gitmoji                bool               // Conventional subjects get their type's gitmoji (added after analysis)
 	MergeOpportunity       bool               // Whether branch is ready for merge
 	MergeTargetBranch      string             // Target branch for merge (if MergeOpportunity is true)
 	MergeCommitCount       int                // Number of commits to be merged
+	SubmoduleUpdates       []domain.SubmoduleUpdate // Submodule pointer changes with their commit subjects
+	Scope                  string             // domain.AnalysisScopeChanges or domain.AnalysisScopeBranch (empty means changes)
+	BranchDiff             string             // Committed changes since the parent branch (branch scope only)
+	CommitTemplate         string             // Content of the repository's commit.template, if configured
+	GeneratedFiles         []string           // Changed lockfiles and generated code, to be treated as incidental
+	ScopeHint              string             // Conventional commit scope suggested by the changed paths (see domain.ScopeFromPaths)
+	Tokens                 chan<- string      // Receives the response text as it streams in; nil asks for the whole response at once
 }
 
 // AnalysisResponse contains the AI's analysis and recommendations.
@@ -64,47 +85,135 @@ type MergeMessageResponse struct {
 	Model             string                // Model used
 }
 
+// PRDescriptionRequest contains the commits a pull request proposes.
+type PRDescriptionRequest struct {
+	SourceBranch string   // Branch the pull request proposes
+	TargetBranch string   // Branch it would be merged into
+	Commits      []string // Commit subjects unique to SourceBranch, newest first
+	Diff         string   // Optional net diff of the branch (git diff TargetBranch...SourceBranch)
+	APIKey       *domain.APIKey
+}
+
+// PRDescriptionResponse contains the AI-drafted pull request.
+type PRDescriptionResponse struct {
+	Title      string   // One-line pull request title
+	Summary    string   // What the branch changes and why, as a paragraph
+	Changes    []string // Notable changes, one per bullet
+	TokensUsed int      // Number of tokens consumed
+	Model      string   // Model used
+}
+
+// ChangelogRequest contains the commits to summarize in a changelog.
+type ChangelogRequest struct {
+	FromRef string   // Older ref (exclusive)
+	ToRef   string   // Newer ref (inclusive)
+	Commits []string          // Commit subjects, newest first
+	Grouped *domain.Changelog // Optional commits grouped by conventional-commit type
+	APIKey  *domain.APIKey
+}
+
+// ChangelogResponse contains the AI-generated changelog.
+type ChangelogResponse struct {
+	Changelog  *domain.Changelog // Grouped entries (Title is left for the caller to set)
+	TokensUsed int               // Number of tokens consumed
+	Model      string            // Model used
+}
+
+// CommitSplitRequest contains the changes to split into separate commits.
+type CommitSplitRequest struct {
+	Repository             *domain.Repository // Changed files come from Repository.Changes()
+	Diff                   string             // Git diff content for the changed files
+	UseConventionalCommits bool               // Whether to use conventional commit format
+	APIKey                 *domain.APIKey
+}
+
+// CommitSplitResponse contains the proposed commits, in the order they should be made.
+type CommitSplitResponse struct {
+	Groups     []domain.CommitGroup // Every changed file appears in exactly one group
+	TokensUsed int                  // Number of tokens consumed
+	Model      string               // Model used
+}
+
+// PatchReviewRequest contains a patch to review outside of any repository.
+type PatchReviewRequest struct {
+	Patch  string   // Unified diff or git format-patch output
+	Files  []string // Files the patch touches
+	APIKey *domain.APIKey
+}
+
+// PatchReviewResponse contains the AI's review of the patch.
+type PatchReviewResponse struct {
+	Review     *domain.PatchReview // Files is left for the caller to set
+	TokensUsed int                 // Number of tokens consumed
+	Model      string              // Model used
+}
+
 // ProviderConfig contains configuration for creating a provider.
 type ProviderConfig struct {
 	APIKey    string
 	BaseURL   string // Optional custom base URL
 	Model     string // Model to use (optional, provider will choose default)
+	RepoModel string // Per-repository model override (takes precedence over Model)
 	Timeout   int    // Request timeout in seconds (default: 30)
 	MaxRetries int   // Maximum number of retries (default: 3)
+
+	// WeakMessagePolicy controls empty or too-short commit messages
+	// (domain.WeakMessageRetry or domain.WeakMessageFallback; empty means retry)
+	WeakMessagePolicy string
+
+	// Context commit limits for commit analysis and merge messages
+	// (0 uses domain.DefaultMaxContextCommits / DefaultMaxMergeContextCommits)
+	MaxContextCommits      int
+	MaxMergeContextCommits int
 }
 
-// Factory creates AI providers.
+// Factory creates AI providers from the global registry (see RegisterProvider),
+// plus any providers registered on the factory itself.
 type Factory struct {
 	providers map[string]func(*domain.APIKey, ProviderConfig) Provider
 }
 
 // NewFactory creates a new provider factory.
 func NewFactory() *Factory {
-	factory := &Factory{
+	return &Factory{
 		providers: make(map[string]func(*domain.APIKey, ProviderConfig) Provider),
 	}
-
-	// Register default providers
-	factory.Register("cerebras", func(apiKey *domain.APIKey, config ProviderConfig) Provider {
-		return NewCerebrasProvider(apiKey, config)
-	})
-
-	return factory
 }
 
-// Register registers a provider constructor.
+// Register registers a provider constructor on this factory only.
+// It takes precedence over a registry provider with the same name.
 func (f *Factory) Register(name string, constructor func(*domain.APIKey, ProviderConfig) Provider) {
 	f.providers[name] = constructor
 }
 
 // Create creates a provider by name.
 func (f *Factory) Create(name string, apiKey *domain.APIKey, config ProviderConfig) (Provider, error) {
-	constructor, ok := f.providers[name]
+	config.Model = ResolveModel(config)
+
+	if constructor, ok := f.providers[name]; ok {
+		return constructor(apiKey, config), nil
+	}
+
+	constructor, ok := lookupProvider(name)
 	if !ok {
 		return nil, &ProviderNotFoundError{ProviderName: name}
 	}
 
-	return constructor(apiKey, config), nil
+	provider, err := constructor(apiKey, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s provider: %w", name, err)
+	}
+
+	return provider, nil
+}
+
+// ResolveModel returns the model a provider should use, preferring the
+// per-repository override over the global default.
+func ResolveModel(config ProviderConfig) string {
+	if config.RepoModel != "" {
+		return config.RepoModel
+	}
+	return config.Model
 }
 
 // ProviderNotFoundError is returned when a provider is not found.
@@ -0,0 +1,115 @@
+package ai
+
+import (
+	"testing"
+
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+func TestFactory_Create_RepoModelOverride(t *testing.T) {
+	tests := []struct {
+		name      string
+		model     string
+		repoModel string
+		wantModel string
+	}{
+		{
+			name:      "global model when no repo override",
+			model:     "llama3.1-8b",
+			repoModel: "",
+			wantModel: "llama3.1-8b",
+		},
+		{
+			name:      "repo model overrides global",
+			model:     "llama3.1-8b",
+			repoModel: "llama-3.3-70b",
+			wantModel: "llama-3.3-70b",
+		},
+		{
+			name:      "repo model used when global empty",
+			model:     "",
+			repoModel: "llama-3.3-70b",
+			wantModel: "llama-3.3-70b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotConfig ProviderConfig
+			factory := NewFactory()
+			factory.Register("fake", func(apiKey *domain.APIKey, config ProviderConfig) Provider {
+				gotConfig = config
+				return nil
+			})
+
+			_, err := factory.Create("fake", nil, ProviderConfig{Model: tt.model, RepoModel: tt.repoModel})
+			if err != nil {
+				t.Fatalf("Create() unexpected error = %v", err)
+			}
+
+			if gotConfig.Model != tt.wantModel {
+				t.Errorf("Create() model = %q, want %q", gotConfig.Model, tt.wantModel)
+			}
+		})
+	}
+}
+
+func TestFactory_Create_UnknownProvider(t *testing.T) {
+	factory := NewFactory()
+
+	_, err := factory.Create("unknown", nil, ProviderConfig{})
+	if err == nil {
+		t.Fatal("Create() expected error for unknown provider, got nil")
+	}
+	if _, ok := err.(*ProviderNotFoundError); !ok {
+		t.Errorf("Create() error type = %T, want *ProviderNotFoundError", err)
+	}
+}
+
+// fakeRegistryProvider is a minimal provider used to exercise the registry.
+type fakeRegistryProvider struct {
+	Provider
+	model string
+}
+
+func (p *fakeRegistryProvider) GetName() string {
+	return "fake-registry"
+}
+
+func TestRegisterProvider_ResolvedByName(t *testing.T) {
+	RegisterProvider("fake-registry", func(apiKey *domain.APIKey, config ProviderConfig) (Provider, error) {
+		return &fakeRegistryProvider{model: config.Model}, nil
+	})
+
+	found := false
+	for _, name := range RegisteredProviders() {
+		if name == "fake-registry" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("RegisteredProviders() = %v, want it to include fake-registry", RegisteredProviders())
+	}
+
+	provider, err := NewFactory().Create("fake-registry", nil, ProviderConfig{Model: "tiny"})
+	if err != nil {
+		t.Fatalf("Create() unexpected error = %v", err)
+	}
+
+	fake, ok := provider.(*fakeRegistryProvider)
+	if !ok {
+		t.Fatalf("Create() provider type = %T, want *fakeRegistryProvider", provider)
+	}
+	if fake.GetName() != "fake-registry" || fake.model != "tiny" {
+		t.Errorf("Create() provider = %+v, want name fake-registry with model tiny", fake)
+	}
+}
+
+func TestRegisteredProviders_IncludesBuiltins(t *testing.T) {
+	for _, name := range RegisteredProviders() {
+		if name == "cerebras" {
+			return
+		}
+	}
+	t.Errorf("RegisteredProviders() = %v, want it to include cerebras", RegisteredProviders())
+}
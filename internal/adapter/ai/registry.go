@@ -0,0 +1,59 @@
+package ai
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// ProviderConstructor creates a provider from an API key and configuration.
+type ProviderConstructor func(apiKey *domain.APIKey, config ProviderConfig) (Provider, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]ProviderConstructor)
+)
+
+// RegisterProvider makes a provider available by name to every Factory.
+// Providers typically call it from an init function. It panics if name is
+// empty, constructor is nil, or the name is already registered.
+func RegisterProvider(name string, constructor ProviderConstructor) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if name == "" {
+		panic("ai: RegisterProvider with empty name")
+	}
+	if constructor == nil {
+		panic("ai: RegisterProvider constructor is nil for " + name)
+	}
+	if _, dup := registry[name]; dup {
+		panic("ai: RegisterProvider called twice for " + name)
+	}
+
+	registry[name] = constructor
+}
+
+// RegisteredProviders returns the names of all registered providers, sorted.
+func RegisteredProviders() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// lookupProvider returns the registered constructor for name.
+func lookupProvider(name string) (ProviderConstructor, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	constructor, ok := registry[name]
+	return constructor, ok
+}
@@ -2,9 +2,11 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 
 	"github.com/yourusername/gitman/internal/domain"
 )
@@ -20,9 +22,29 @@ type LegacyConfig struct {
 	DefaultMergeStrategy   string   `json:"default_merge_strategy"`
 }
 
+// RepoSettingsFileName is the per-repository settings file. The nearest one
+// walking up from the repository overrides the global config file.
+const RepoSettingsFileName = ".gitmind.json"
+
+// apiKeySecret is the AI provider's API key in the secret store
+const apiKeySecret = "ai.api_key"
+
 // Manager handles configuration persistence.
+//
+// Settings are resolved as: repository file (.gitmind.json) > global file
+// (~/.gitman.json) > defaults. Save writes to the global file, unless the
+// config was loaded with LoadForRepo and a repository file exists; then the
+// settings that differ from the global file go to the repository file.
+//
+// The API key is kept in the secret store rather than either file. Loaded
+// configs carry it in AI.APIKey; saving moves it back to the store.
 type Manager struct {
-	configPath string
+	configPath     string
+	repoConfigPath string      // Repository file found by LoadForRepo, if any
+	tomlModel      string      // Model LoadForRepo took from .gitmind.toml, if any
+	savedModel     string      // The model the settings files set, which Save keeps
+	secrets        SecretStore // nil keeps the API key in the config file
+	apiKeyErr      error       // Why the last Load couldn't read the API key from secrets
 }
 
 // NewManager creates a new config manager.
@@ -35,15 +57,31 @@ func NewManager() (*Manager, error) {
 	configPath := filepath.Join(homeDir, ".gitman.json")
 	return &Manager{
 		configPath: configPath,
+		secrets:    NewSecretStore(homeDir),
 	}, nil
 }
 
+// SetSecretStore sets where the API key is kept.
+func (m *Manager) SetSecretStore(secrets SecretStore) {
+	m.secrets = secrets
+}
+
+// SecretStoreName describes where the API key is kept.
+func (m *Manager) SecretStoreName() string {
+	if m.secrets == nil {
+		return m.configPath
+	}
+	return m.secrets.Name()
+}
+
 // Load loads the configuration from disk with automatic migration.
 func (m *Manager) Load() (*domain.Config, error) {
 	// Check if config file exists
 	if _, err := os.Stat(m.configPath); os.IsNotExist(err) {
-		// Return default config
-		return domain.NewDefaultConfig(), nil
+		// Return default config, with a key stored by an earlier setup
+		cfg := domain.NewDefaultConfig()
+		m.loadAPIKey(cfg)
+		return cfg, nil
 	}
 
 	// Read config file
@@ -52,11 +90,13 @@ func (m *Manager) Load() (*domain.Config, error) {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
 
-	// Try parsing as new JSON format first
-	var cfg domain.Config
-	if err := json.Unmarshal(data, &cfg); err == nil {
+	// Try parsing as new JSON format first, over the defaults so settings
+	// added since the file was written keep their default values
+	cfg := domain.NewDefaultConfig()
+	if err := json.Unmarshal(data, cfg); err == nil {
 		// Successfully parsed as new format
-		return &cfg, nil
+		m.loadAPIKey(cfg)
+		return cfg, nil
 	}
 
 	// Try parsing as old key=value format
@@ -84,8 +124,87 @@ func (m *Manager) Load() (*domain.Config, error) {
 	return nil, fmt.Errorf("failed to parse config file (tried both new and old formats)")
 }
 
-// Save saves the configuration to disk in JSON format.
+// LoadForRepo loads the configuration for the repository at repoPath: the
+// global configuration, overridden by the nearest .gitmind.json found walking
+// up from repoPath. Only the keys present in that file are overridden.
+//
+// The older .gitmind.toml, found the same way, can only set ai.default_model.
+// It applies when .gitmind.json doesn't set the model, so each setting has a
+// single repository value, and Save never copies it to another file.
+func (m *Manager) LoadForRepo(repoPath string) (*domain.Config, error) {
+	cfg, err := m.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	m.repoConfigPath = findNearest(repoPath, RepoSettingsFileName)
+	if m.repoConfigPath != "" {
+		data, err := os.ReadFile(m.repoConfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", m.repoConfigPath, err)
+		}
+		if err := json.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", m.repoConfigPath, err)
+		}
+		var settings struct {
+			AI struct {
+				DefaultModel *string `json:"default_model"`
+			} `json:"ai"`
+		}
+		_ = json.Unmarshal(data, &settings) // Parsed above
+		if settings.AI.DefaultModel != nil {
+			m.tomlModel = ""
+			return cfg, nil
+		}
+	}
+
+	repoCfg, err := LoadRepoConfig(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	m.tomlModel, m.savedModel = repoCfg.AI.DefaultModel, cfg.AI.DefaultModel
+	if m.tomlModel != "" {
+		cfg.AI.DefaultModel = m.tomlModel
+	}
+	return cfg, nil
+}
+
+// RepoConfigPath returns the repository settings file LoadForRepo found, or
+// "" if there was none.
+func (m *Manager) RepoConfigPath() string {
+	return m.repoConfigPath
+}
+
+// Save saves the configuration to disk in JSON format: to the repository
+// settings file if LoadForRepo found one, otherwise to the global file.
 func (m *Manager) Save(config *domain.Config) error {
+	// The .gitmind.toml model stays in that file
+	if m.tomlModel != "" && config.AI.DefaultModel == m.tomlModel {
+		withoutTOML := *config
+		withoutTOML.AI.DefaultModel = m.savedModel
+		config = &withoutTOML
+	}
+
+	if m.repoConfigPath != "" {
+		return m.saveRepoSettings(config)
+	}
+	return m.saveGlobal(config)
+}
+
+// saveGlobal writes the whole configuration to the global file, with the API
+// key in the secret store instead
+func (m *Manager) saveGlobal(config *domain.Config) error {
+	// A cleared key is deleted from the store, unless the store couldn't be
+	// read and the key is only missing for that reason
+	if m.secrets != nil && (config.AI.APIKey != "" || m.apiKeyErr == nil) {
+		if err := m.SetAPIKey(config.AI.APIKey); err != nil {
+			return err
+		}
+		withoutKey := *config
+		withoutKey.AI.APIKey = ""
+		config = &withoutKey
+	}
+
 	// Create config directory if it doesn't exist
 	configDir := filepath.Dir(m.configPath)
 	if err := os.MkdirAll(configDir, 0755); err != nil {
@@ -106,13 +225,102 @@ func (m *Manager) Save(config *domain.Config) error {
 	return nil
 }
 
-// GetAPIKey returns the configured API key as a domain object.
+// saveRepoSettings writes to the repository file the settings that differ
+// from the global file, keeping the ones it already overrides. The API key
+// goes to the global file: the repository file may well be committed.
+func (m *Manager) saveRepoSettings(config *domain.Config) error {
+	global, err := m.Load()
+	if err != nil {
+		return err
+	}
+	if config.AI.APIKey != global.AI.APIKey {
+		global.AI.APIKey = config.AI.APIKey
+		if err := m.saveGlobal(global); err != nil {
+			return err
+		}
+	}
+
+	merged, err := toSettingsMap(config)
+	if err != nil {
+		return err
+	}
+	globalSettings, err := toSettingsMap(global)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]any)
+	if data, err := os.ReadFile(m.repoConfigPath); err == nil {
+		_ = json.Unmarshal(data, &existing) // LoadForRepo already parsed it
+	}
+
+	overrides := repoOverrides(existing, merged, globalSettings)
+	if ai, ok := overrides["ai"].(map[string]any); ok {
+		delete(ai, "api_key")
+		if len(ai) == 0 {
+			delete(overrides, "ai")
+		}
+	}
+
+	data, err := json.MarshalIndent(overrides, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal repository settings: %w", err)
+	}
+	if err := os.WriteFile(m.repoConfigPath, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", m.repoConfigPath, err)
+	}
+	return nil
+}
+
+// toSettingsMap returns config as it is written to a file, as nested maps
+func toSettingsMap(config *domain.Config) (map[string]any, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+	settings := make(map[string]any)
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	return settings, nil
+}
+
+// repoOverrides returns the settings of merged a repository file must hold:
+// those it already had in existing, and those that differ from global.
+// Sections are compared key by key.
+func repoOverrides(existing, merged, global map[string]any) map[string]any {
+	overrides := make(map[string]any)
+	for key, value := range merged {
+		if section, ok := value.(map[string]any); ok {
+			existingSection, _ := existing[key].(map[string]any)
+			globalSection, _ := global[key].(map[string]any)
+			if sectionOverrides := repoOverrides(existingSection, section, globalSection); len(sectionOverrides) > 0 {
+				overrides[key] = sectionOverrides
+			}
+			continue
+		}
+		if _, kept := existing[key]; kept || !reflect.DeepEqual(value, global[key]) {
+			overrides[key] = value
+		}
+	}
+	return overrides
+}
+
+// GetAPIKey returns the configured API key as a domain object: the one in
+// config, or else the one in the secret store.
 func (m *Manager) GetAPIKey(config *domain.Config) (*domain.APIKey, error) {
-	if config.AI.APIKey == "" {
+	key := config.AI.APIKey
+	if key == "" && m.secrets != nil {
+		stored, err := m.secrets.Get(apiKeySecret)
+		if err != nil && !errors.Is(err, ErrSecretNotFound) {
+			return nil, fmt.Errorf("failed to read the API key: %w", err)
+		}
+		key = stored
+	}
+	if key == "" && domain.ProviderRequiresAPIKey(config.AI.Provider) {
 		return nil, fmt.Errorf("API key not configured. Run 'gm config' or 'gm onboard' to set up")
 	}
 
-	apiKey, err := domain.NewAPIKey(config.AI.APIKey, config.AI.Provider)
+	apiKey, err := domain.NewAPIKey(key, config.AI.Provider)
 	if err != nil {
 		return nil, err
 	}
@@ -127,6 +335,48 @@ func (m *Manager) GetAPIKey(config *domain.Config) (*domain.APIKey, error) {
 	return apiKey, nil
 }
 
+// SetAPIKey stores the API key in the secret store.
+func (m *Manager) SetAPIKey(key string) error {
+	if m.secrets == nil {
+		return fmt.Errorf("no secret store to keep the API key in")
+	}
+	if key == "" {
+		return m.secrets.Delete(apiKeySecret)
+	}
+	if err := m.secrets.Set(apiKeySecret, key); err != nil {
+		return fmt.Errorf("failed to store API key: %w", err)
+	}
+	return nil
+}
+
+// loadAPIKey fills in the API key from the secret store. A key still in the
+// config file predates the store: it is moved there and blanked in the file.
+// If the store refuses it, the key stays in the file and keeps working.
+func (m *Manager) loadAPIKey(config *domain.Config) {
+	m.apiKeyErr = nil
+	if m.secrets == nil {
+		return
+	}
+	if config.AI.APIKey != "" {
+		_ = m.saveGlobal(config)
+		return
+	}
+	key, err := m.secrets.Get(apiKeySecret)
+	if err != nil {
+		if !errors.Is(err, ErrSecretNotFound) {
+			m.apiKeyErr = err
+		}
+		return
+	}
+	config.AI.APIKey = key
+}
+
+// APIKeyError returns why the last Load couldn't read the API key from the
+// secret store, e.g. a locked keychain, or nil if it was read or never stored.
+func (m *Manager) APIKeyError() error {
+	return m.apiKeyErr
+}
+
 // ConfigPath returns the path to the config file.
 func (m *Manager) ConfigPath() string {
 	return m.configPath
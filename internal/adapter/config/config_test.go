@@ -0,0 +1,241 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeFile writes content to path, failing the test on error
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestManager_LoadForRepo(t *testing.T) {
+	home := t.TempDir()
+	repo := t.TempDir()
+	subdir := filepath.Join(repo, "internal", "api")
+	if err := os.MkdirAll(subdir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	m := &Manager{configPath: filepath.Join(home, ".gitman.json")}
+	writeFile(t, m.configPath, `{"git": {"protected_branches": ["main"], "auto_push": true}, "commits": {"convention": "none"}}`)
+
+	// Without a repository file the global file applies
+	cfg, err := m.LoadForRepo(subdir)
+	if err != nil {
+		t.Fatalf("LoadForRepo() error = %v", err)
+	}
+	if m.RepoConfigPath() != "" || cfg.Commits.Convention != "none" {
+		t.Errorf("LoadForRepo() = convention %q from %q, want the global file's", cfg.Commits.Convention, m.RepoConfigPath())
+	}
+
+	// The repository file, found from a subdirectory, wins for the keys it sets
+	writeFile(t, filepath.Join(repo, RepoSettingsFileName), `{"git": {"protected_branches": ["main", "release"]}, "commits": {"convention": "conventional"}}`)
+	cfg, err = m.LoadForRepo(subdir)
+	if err != nil {
+		t.Fatalf("LoadForRepo() error = %v", err)
+	}
+	if m.RepoConfigPath() != filepath.Join(repo, RepoSettingsFileName) {
+		t.Errorf("RepoConfigPath() = %q, want the file at the repository root", m.RepoConfigPath())
+	}
+	if !reflect.DeepEqual(cfg.Git.ProtectedBranches, []string{"main", "release"}) || cfg.Commits.Convention != "conventional" {
+		t.Errorf("LoadForRepo() = %v / %q, want the repository file's values", cfg.Git.ProtectedBranches, cfg.Commits.Convention)
+	}
+	if !cfg.Git.AutoPush {
+		t.Error("LoadForRepo() AutoPush = false, want the global file's value for a key the repository file doesn't set")
+	}
+	if cfg.Git.MainBranch != "main" {
+		t.Errorf("LoadForRepo() MainBranch = %q, want the default for a key neither file sets", cfg.Git.MainBranch)
+	}
+}
+
+func TestManager_LoadForRepoTOMLModel(t *testing.T) {
+	home := t.TempDir()
+	repo := t.TempDir()
+	m := &Manager{configPath: filepath.Join(home, ".gitman.json")}
+	writeFile(t, m.configPath, `{"ai": {"default_model": "llama-3.3-70b"}}`)
+	writeFile(t, filepath.Join(repo, RepoConfigFileName), "[ai]\ndefault_model = \"llama3.1-8b\"\n")
+
+	// The .gitmind.toml model overrides the global one
+	cfg, err := m.LoadForRepo(repo)
+	if err != nil {
+		t.Fatalf("LoadForRepo() error = %v", err)
+	}
+	if cfg.AI.DefaultModel != "llama3.1-8b" {
+		t.Errorf("LoadForRepo() DefaultModel = %q, want the .gitmind.toml model", cfg.AI.DefaultModel)
+	}
+
+	// Saving other settings leaves the global model alone
+	cfg.Git.AutoPush = true
+	if err := m.Save(cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	global, err := m.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if global.AI.DefaultModel != "llama-3.3-70b" || !global.Git.AutoPush {
+		t.Errorf("global file = model %q, auto-push %v, want its own model and the new setting", global.AI.DefaultModel, global.Git.AutoPush)
+	}
+
+	// A model in .gitmind.json wins over the .gitmind.toml one
+	writeFile(t, filepath.Join(repo, RepoSettingsFileName), `{"ai": {"default_model": "qwen-3-32b"}}`)
+	if cfg, err = m.LoadForRepo(repo); err != nil {
+		t.Fatalf("LoadForRepo() error = %v", err)
+	}
+	if cfg.AI.DefaultModel != "qwen-3-32b" {
+		t.Errorf("LoadForRepo() DefaultModel = %q, want the .gitmind.json model", cfg.AI.DefaultModel)
+	}
+}
+
+func TestManager_SaveWithRepoSettings(t *testing.T) {
+	home := t.TempDir()
+	repo := t.TempDir()
+	m := &Manager{configPath: filepath.Join(home, ".gitman.json")}
+	writeFile(t, m.configPath, `{"git": {"auto_push": true}, "ai": {"api_key": "old-key"}}`)
+	repoFile := filepath.Join(repo, RepoSettingsFileName)
+	writeFile(t, repoFile, `{"commits": {"convention": "conventional"}}`)
+
+	cfg, err := m.LoadForRepo(repo)
+	if err != nil {
+		t.Fatalf("LoadForRepo() error = %v", err)
+	}
+	cfg.Git.ProtectedBranches = []string{"trunk"}
+	cfg.AI.APIKey = "new-key"
+	if err := m.Save(cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	data, err := os.ReadFile(repoFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved map[string]any
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatalf("repository file is not JSON: %v", err)
+	}
+	want := map[string]any{
+		"commits": map[string]any{"convention": "conventional"},
+		"git":     map[string]any{"protected_branches": []any{"trunk"}},
+	}
+	if !reflect.DeepEqual(saved, want) {
+		t.Errorf("repository file = %v, want only its own and the changed settings %v", saved, want)
+	}
+
+	global, err := m.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if global.AI.APIKey != "new-key" || !reflect.DeepEqual(global.Git.ProtectedBranches, []string{"main", "master", "develop"}) {
+		t.Errorf("global file = key %q, branches %v, want the new key and the default branches", global.AI.APIKey, global.Git.ProtectedBranches)
+	}
+}
+
+func TestManager_APIKeyInSecretStore(t *testing.T) {
+	home := t.TempDir()
+	secrets := NewFileSecretStore(filepath.Join(home, SecretsFileName))
+	m := &Manager{configPath: filepath.Join(home, ".gitman.json"), secrets: secrets}
+	writeFile(t, m.configPath, `{"ai": {"provider": "cerebras", "api_key": "csk-plaintext"}}`)
+
+	// The plaintext key moves to the store on first load
+	cfg, err := m.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.AI.APIKey != "csk-plaintext" {
+		t.Errorf("Load() APIKey = %q, want the migrated key", cfg.AI.APIKey)
+	}
+	if stored, err := secrets.Get(apiKeySecret); err != nil || stored != "csk-plaintext" {
+		t.Errorf("secret store holds %q, %v, want the migrated key", stored, err)
+	}
+	data, err := os.ReadFile(m.configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var file struct {
+		AI struct {
+			APIKey string `json:"api_key"`
+		} `json:"ai"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil || file.AI.APIKey != "" {
+		t.Errorf("config file api_key = %q, %v, want it blanked", file.AI.APIKey, err)
+	}
+
+	// Later loads and saves go through the store
+	cfg, err = m.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.AI.APIKey != "csk-plaintext" {
+		t.Fatalf("Load() APIKey = %q, want the key from the store", cfg.AI.APIKey)
+	}
+	cfg.AI.APIKey = "csk-replaced"
+	if err := m.Save(cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if data, _ := os.ReadFile(m.configPath); strings.Contains(string(data), "csk-") {
+		t.Errorf("config file contains the API key:\n%s", data)
+	}
+
+	cfg.AI.APIKey = ""
+	apiKey, err := m.GetAPIKey(cfg)
+	if err != nil {
+		t.Fatalf("GetAPIKey() error = %v", err)
+	}
+	if apiKey.Key() != "csk-replaced" {
+		t.Errorf("GetAPIKey() = %v, want the stored key", apiKey)
+	}
+
+	// Clearing the key deletes it from the store
+	if err := m.Save(cfg); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if _, err := secrets.Get(apiKeySecret); !errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("secret store error = %v after clearing the key, want ErrSecretNotFound", err)
+	}
+	if cfg, err = m.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.AI.APIKey != "" {
+		t.Errorf("Load() APIKey = %q, want the cleared key to stay cleared", cfg.AI.APIKey)
+	}
+}
+
+func TestManager_APIKeyStoreUnreadable(t *testing.T) {
+	home := t.TempDir()
+	file := NewFileSecretStore(filepath.Join(home, SecretsFileName))
+	if err := file.Set(apiKeySecret, "csk-kept"); err != nil {
+		t.Fatal(err)
+	}
+	// The file stands in for a keychain holding the key while it's locked
+	m := &Manager{configPath: filepath.Join(home, ".gitman.json"), secrets: refusingSecretStore{}}
+	writeFile(t, m.configPath, `{"ai": {"provider": "cerebras"}}`)
+
+	cfg, err := m.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if m.APIKeyError() == nil {
+		t.Error("APIKeyError() = nil, want the store's error")
+	}
+	if _, err := m.GetAPIKey(cfg); err == nil || strings.Contains(err.Error(), "not configured") {
+		t.Errorf("GetAPIKey() error = %v, want the store's error rather than a missing key", err)
+	}
+
+	// Saving other settings must not delete the key it couldn't read
+	m.SetSecretStore(file)
+	if err := m.saveGlobal(cfg); err != nil {
+		t.Fatalf("saveGlobal() error = %v", err)
+	}
+	if key, err := file.Get(apiKeySecret); err != nil || key != "csk-kept" {
+		t.Errorf("secret store = %q, %v, want the key kept", key, err)
+	}
+}
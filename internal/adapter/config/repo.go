@@ -0,0 +1,108 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// RepoConfigFileName is the per-repository config file, usually at the repository root.
+const RepoConfigFileName = ".gitmind.toml"
+
+// LoadRepoConfig loads per-repository overrides from the nearest .gitmind.toml
+// in dir or one of its parents, so it is found from any subdirectory of the
+// repository. A missing file is not an error and yields an empty RepoConfig.
+func LoadRepoConfig(dir string) (*domain.RepoConfig, error) {
+	repoCfg := domain.NewRepoConfig()
+
+	path := findNearest(dir, RepoConfigFileName)
+	if path == "" {
+		return repoCfg, nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", RepoConfigFileName, err)
+	}
+	defer file.Close()
+
+	// Minimal TOML subset: [section] headers and key = "value" pairs
+	section := ""
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(stripTOMLComment(scanner.Text()))
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected key = value", RepoConfigFileName, lineNum)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+
+		applyRepoConfigValue(repoCfg, section, key, value)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", RepoConfigFileName, err)
+	}
+
+	return repoCfg, nil
+}
+
+// findNearest returns the file named name in dir or the closest of its
+// parents, or "" if there is none.
+func findNearest(dir, name string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for {
+		path := filepath.Join(dir, name)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// applyRepoConfigValue sets a single known key. Unknown keys are ignored so
+// newer config files keep working with older binaries.
+func applyRepoConfigValue(repoCfg *domain.RepoConfig, section, key, value string) {
+	switch section + "." + key {
+	case "ai.default_model", "ai.defaultModel":
+		repoCfg.AI.DefaultModel = value
+	}
+}
+
+// stripTOMLComment removes a trailing # comment that is not inside quotes.
+func stripTOMLComment(line string) string {
+	inQuotes := false
+	for i, r := range line {
+		switch r {
+		case '"':
+			inQuotes = !inQuotes
+		case '#':
+			if !inQuotes {
+				return line[:i]
+			}
+		}
+	}
+	return line
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadRepoConfig_FromSubdirectory(t *testing.T) {
+	repo := t.TempDir()
+	subdir := filepath.Join(repo, "internal", "api")
+	if err := os.MkdirAll(subdir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(repo, RepoConfigFileName), "[ai]\ndefault_model = \"llama3.1-8b\" # fast enough here\n")
+
+	for _, dir := range []string{repo, subdir} {
+		repoCfg, err := LoadRepoConfig(dir)
+		if err != nil {
+			t.Fatalf("LoadRepoConfig(%s) error = %v", dir, err)
+		}
+		if repoCfg.AI.DefaultModel != "llama3.1-8b" {
+			t.Errorf("LoadRepoConfig(%s) DefaultModel = %q, want the repository root's", dir, repoCfg.AI.DefaultModel)
+		}
+	}
+
+	repoCfg, err := LoadRepoConfig(t.TempDir())
+	if err != nil {
+		t.Fatalf("LoadRepoConfig() without a file error = %v", err)
+	}
+	if repoCfg.AI.DefaultModel != "" {
+		t.Errorf("LoadRepoConfig() without a file DefaultModel = %q, want no overrides", repoCfg.AI.DefaultModel)
+	}
+}
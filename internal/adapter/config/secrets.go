@@ -0,0 +1,229 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/zalando/go-keyring"
+)
+
+// ErrSecretNotFound is returned by SecretStore.Get for a secret never stored.
+var ErrSecretNotFound = errors.New("secret not found")
+
+// SecretStore keeps secrets, like the AI provider's API key, out of the
+// config file.
+type SecretStore interface {
+	// Get returns the secret stored under key, or ErrSecretNotFound.
+	Get(key string) (string, error)
+
+	// Set stores secret under key, replacing any previous value.
+	Set(key, secret string) error
+
+	// Delete removes the secret stored under key. A missing secret is not an error.
+	Delete(key string) error
+
+	// Name describes where secrets are kept, for display.
+	Name() string
+}
+
+// SecretsFileName is the file secrets fall back to when no keychain is available.
+const SecretsFileName = ".gitman-secrets.json"
+
+// keychainService is the service name secrets are filed under in the keychain
+const keychainService = "gitmind"
+
+// NewSecretStore returns the keychain, falling back to a file in dir readable
+// only by the user where the platform has no keychain or it refuses.
+func NewSecretStore(dir string) SecretStore {
+	return &fallbackSecretStore{
+		primary:  NewKeychainStore(keychainService),
+		fallback: NewFileSecretStore(filepath.Join(dir, SecretsFileName)),
+	}
+}
+
+// KeychainStore keeps secrets in the operating system's keychain: the macOS
+// keychain, the Windows Credential Manager, or the Secret Service (GNOME
+// Keyring, KWallet) elsewhere.
+type KeychainStore struct {
+	service string
+}
+
+// NewKeychainStore creates a keychain store filing secrets under service.
+func NewKeychainStore(service string) *KeychainStore {
+	return &KeychainStore{service: service}
+}
+
+// Name describes the keychain.
+func (k *KeychainStore) Name() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "macOS keychain"
+	case "windows":
+		return "Windows Credential Manager"
+	}
+	return "system keyring"
+}
+
+// Get returns the secret stored under key. A keychain that is locked, denies
+// access or can't be reached is an error, not ErrSecretNotFound.
+func (k *KeychainStore) Get(key string) (string, error) {
+	secret, err := keyring.Get(k.service, key)
+	if errors.Is(err, keyring.ErrNotFound) || (err == nil && secret == "") {
+		return "", ErrSecretNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read from the %s: %w", k.Name(), err)
+	}
+	return secret, nil
+}
+
+// Set stores secret under key.
+func (k *KeychainStore) Set(key, secret string) error {
+	if err := keyring.Set(k.service, key, secret); err != nil {
+		return fmt.Errorf("failed to write to the %s: %w", k.Name(), err)
+	}
+	return nil
+}
+
+// Delete removes the secret stored under key.
+func (k *KeychainStore) Delete(key string) error {
+	if err := keyring.Delete(k.service, key); err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("failed to delete from the %s: %w", k.Name(), err)
+	}
+	return nil
+}
+
+// FileSecretStore keeps secrets in a JSON file readable only by the user,
+// for systems without a keychain.
+type FileSecretStore struct {
+	path string
+}
+
+// NewFileSecretStore creates a store keeping secrets in the file at path.
+func NewFileSecretStore(path string) *FileSecretStore {
+	return &FileSecretStore{path: path}
+}
+
+// Name describes the secrets file.
+func (f *FileSecretStore) Name() string {
+	return f.path
+}
+
+// Get returns the secret stored under key.
+func (f *FileSecretStore) Get(key string) (string, error) {
+	secrets, err := f.read()
+	if err != nil {
+		return "", err
+	}
+	secret, ok := secrets[key]
+	if !ok || secret == "" {
+		return "", ErrSecretNotFound
+	}
+	return secret, nil
+}
+
+// Set stores secret under key.
+func (f *FileSecretStore) Set(key, secret string) error {
+	secrets, err := f.read()
+	if err != nil {
+		return err
+	}
+	secrets[key] = secret
+	return f.write(secrets)
+}
+
+// Delete removes the secret stored under key.
+func (f *FileSecretStore) Delete(key string) error {
+	secrets, err := f.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := secrets[key]; !ok {
+		return nil
+	}
+	delete(secrets, key)
+	return f.write(secrets)
+}
+
+// read returns the stored secrets; a missing file holds none
+func (f *FileSecretStore) read() (map[string]string, error) {
+	secrets := make(map[string]string)
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return secrets, nil
+		}
+		return nil, fmt.Errorf("failed to read secrets file: %w", err)
+	}
+	if err := json.Unmarshal(data, &secrets); err != nil {
+		return nil, fmt.Errorf("failed to parse secrets file: %w", err)
+	}
+	return secrets, nil
+}
+
+// write replaces the stored secrets
+func (f *FileSecretStore) write(secrets map[string]string) error {
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return fmt.Errorf("failed to create secrets directory: %w", err)
+	}
+	data, err := json.MarshalIndent(secrets, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal secrets: %w", err)
+	}
+	if err := os.WriteFile(f.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write secrets file: %w", err)
+	}
+	return nil
+}
+
+// fallbackSecretStore prefers the keychain, falling back to the file when the
+// keychain refuses, e.g. over SSH with no desktop session to unlock it.
+type fallbackSecretStore struct {
+	primary  SecretStore
+	fallback SecretStore
+}
+
+// Name describes the keychain.
+func (s *fallbackSecretStore) Name() string {
+	return s.primary.Name()
+}
+
+// Get returns the secret from the keychain, or else from the file. When
+// neither has it, an error reading the keychain is returned rather than
+// ErrSecretNotFound, so a locked keychain isn't mistaken for a missing secret.
+func (s *fallbackSecretStore) Get(key string) (string, error) {
+	secret, err := s.primary.Get(key)
+	if err == nil {
+		return secret, nil
+	}
+	if secret, fileErr := s.fallback.Get(key); !errors.Is(fileErr, ErrSecretNotFound) {
+		return secret, fileErr
+	}
+	return "", err
+}
+
+// Set stores secret in the keychain, removing any copy in the file, or
+// stores it in the file if the keychain refuses.
+func (s *fallbackSecretStore) Set(key, secret string) error {
+	if err := s.primary.Set(key, secret); err != nil {
+		return s.fallback.Set(key, secret)
+	}
+	return s.fallback.Delete(key)
+}
+
+// Delete removes the secret from both. A keychain that can't be reached holds
+// nothing to delete, so only failing to delete a secret it still returns is
+// an error.
+func (s *fallbackSecretStore) Delete(key string) error {
+	err := s.fallback.Delete(key)
+	if primaryErr := s.primary.Delete(key); primaryErr != nil {
+		if _, getErr := s.primary.Get(key); getErr == nil {
+			err = errors.Join(err, primaryErr)
+		}
+	}
+	return err
+}
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zalando/go-keyring"
+)
+
+// refusingSecretStore is a keychain that can't be unlocked
+type refusingSecretStore struct {
+	SecretStore
+}
+
+func (refusingSecretStore) Get(key string) (string, error) {
+	return "", errors.New("keychain locked")
+}
+
+func (refusingSecretStore) Set(key, secret string) error {
+	return errors.New("keychain locked")
+}
+
+func (refusingSecretStore) Delete(key string) error {
+	return errors.New("keychain locked")
+}
+
+func TestFileSecretStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), SecretsFileName)
+	store := NewFileSecretStore(path)
+
+	if _, err := store.Get(apiKeySecret); !errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("Get() before Set() error = %v, want ErrSecretNotFound", err)
+	}
+	if err := store.Set(apiKeySecret, "csk-secret"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if secret, err := store.Get(apiKeySecret); err != nil || secret != "csk-secret" {
+		t.Errorf("Get() = %q, %v, want the stored secret", secret, err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("secrets file not written: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("secrets file mode = %v, want readable only by the user", info.Mode().Perm())
+	}
+
+	if err := store.Delete(apiKeySecret); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := store.Get(apiKeySecret); !errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("Get() after Delete() error = %v, want ErrSecretNotFound", err)
+	}
+}
+
+func TestKeychainStore(t *testing.T) {
+	keyring.MockInit()
+	store := NewKeychainStore(keychainService)
+
+	if _, err := store.Get(apiKeySecret); !errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("Get() before Set() error = %v, want ErrSecretNotFound", err)
+	}
+	if err := store.Set(apiKeySecret, "csk-secret"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if secret, err := store.Get(apiKeySecret); err != nil || secret != "csk-secret" {
+		t.Errorf("Get() = %q, %v, want the stored secret", secret, err)
+	}
+	if err := store.Delete(apiKeySecret); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := store.Delete(apiKeySecret); err != nil {
+		t.Errorf("Delete() of a missing secret error = %v, want nil", err)
+	}
+
+	// A locked keychain is an error, not a missing secret
+	keyring.MockInitWithError(errors.New("keychain locked"))
+	t.Cleanup(keyring.MockInit)
+	if _, err := store.Get(apiKeySecret); err == nil || errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("Get() from a locked keychain error = %v, want the keychain's error", err)
+	}
+}
+
+func TestFallbackSecretStore_KeychainRefuses(t *testing.T) {
+	file := NewFileSecretStore(filepath.Join(t.TempDir(), SecretsFileName))
+	store := &fallbackSecretStore{primary: refusingSecretStore{}, fallback: file}
+
+	if err := store.Set(apiKeySecret, "csk-secret"); err != nil {
+		t.Fatalf("Set() error = %v, want the file to take the secret", err)
+	}
+	if secret, err := store.Get(apiKeySecret); err != nil || secret != "csk-secret" {
+		t.Errorf("Get() = %q, %v, want the secret from the file", secret, err)
+	}
+
+	// With no secret in the file either, the keychain's refusal is reported
+	if err := store.Delete(apiKeySecret); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := store.Get(apiKeySecret); err == nil || errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("Get() error = %v, want the keychain's error rather than ErrSecretNotFound", err)
+	}
+}
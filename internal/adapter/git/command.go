@@ -0,0 +1,35 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// RunShellCommand runs a user-supplied shell command in dir with extra
+// environment variables (KEY=value) appended to the current environment.
+// Combined stdout/stderr is returned so callers can log it.
+func RunShellCommand(ctx context.Context, dir, command string, env []string) (string, error) {
+	if strings.TrimSpace(command) == "" {
+		return "", fmt.Errorf("command cannot be empty")
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env...)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(output), fmt.Errorf("command failed: %w", err)
+	}
+
+	return string(output), nil
+}
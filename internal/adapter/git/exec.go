@@ -8,14 +8,23 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/yourusername/gitman/internal/domain"
 )
 
 // ExecOperations implements Operations using os/exec to call git commands.
 type ExecOperations struct {
-	gitPath string // Path to git executable (defaults to "git")
+	gitPath         string // Path to git executable (defaults to "git")
+	renameDetection string // Rename detection mode for diffs (domain.RenameDetection*)
+	signCommits     bool   // Pass -S to git commit
+	signingKey      string // Key for -S; empty uses git's user.signingkey
+	pathScope       string // Limit status, diffs, and staging to this subtree; empty for the whole repo
 }
 
 // NewExecOperations creates a new ExecOperations instance.
@@ -30,19 +39,179 @@ func (e *ExecOperations) SetGitPath(path string) {
 	e.gitPath = path
 }
 
+// SetRenameDetection sets how GetDiff and diff stats detect renames
+// ("off", "normal", or "aggressive"; empty leaves git's default).
+func (e *ExecOperations) SetRenameDetection(mode string) {
+	e.renameDetection = mode
+}
+
+// SetCommitSigning makes Commit and AmendCommit sign commits (git commit -S),
+// with signingKey if set. When off, git's own commit.gpgsign setting applies.
+func (e *ExecOperations) SetCommitSigning(sign bool, signingKey string) {
+	e.signCommits = sign
+	e.signingKey = signingKey
+}
+
+// SetPathScope limits GetStatus, GetDiff, IsWhitespaceOnlyChange, and staging
+// everything with Add to the subtree at path (relative to the repository path
+// the commands run in). An empty path or "." removes the limit.
+func (e *ExecOperations) SetPathScope(path string) {
+	path = filepath.ToSlash(filepath.Clean(path))
+	if path == "." {
+		path = ""
+	}
+	e.pathScope = path
+}
+
+// PathScope returns the subtree set with SetPathScope, or "" for the whole repo.
+func (e *ExecOperations) PathScope() string {
+	return e.pathScope
+}
+
+// scoped appends the path scope as a pathspec to args, if one is set.
+func (e *ExecOperations) scoped(args ...string) []string {
+	if e.pathScope == "" {
+		return args
+	}
+	return append(args, "--", e.pathScope)
+}
+
+// renameArgs returns the git diff flags for a rename detection mode.
+func renameArgs(mode string) []string {
+	switch mode {
+	case domain.RenameDetectionOff:
+		return []string{"--no-renames"}
+	case domain.RenameDetectionNormal:
+		return []string{"-M"}
+	case domain.RenameDetectionAggressive:
+		// Lower similarity threshold, and look for copies among unmodified files too
+		return []string{"-M30%", "-C", "--find-copies-harder"}
+	default:
+		return nil
+	}
+}
+
 // execGit executes a git command and returns stdout, stderr, and error.
 func (e *ExecOperations) execGit(ctx context.Context, repoPath string, args ...string) (string, string, error) {
+	stdout, stderr, err := e.execGitRaw(ctx, repoPath, args...)
+	return strings.TrimSpace(stdout), strings.TrimSpace(stderr), err
+}
+
+// execGitRaw is execGit without trimming stdout and stderr, for output whose
+// leading whitespace is significant (git status --porcelain).
+func (e *ExecOperations) execGitRaw(ctx context.Context, repoPath string, args ...string) (string, string, error) {
+	return e.execGitEnv(ctx, repoPath, nil, args...)
+}
+
+// execGitEnv is execGitRaw with env added to the inherited environment.
+func (e *ExecOperations) execGitEnv(ctx context.Context, repoPath string, env []string, args ...string) (string, string, error) {
 	cmd := exec.CommandContext(ctx, e.gitPath, args...)
 	if repoPath != "" {
 		cmd.Dir = repoPath
 	}
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
 	err := cmd.Run()
-	return strings.TrimSpace(stdout.String()), strings.TrimSpace(stderr.String()), err
+	return stdout.String(), stderr.String(), err
+}
+
+// execGitNetwork runs a git command that talks to a remote. Git may not
+// prompt for credentials, since the TUI owns the terminal: a remote that needs
+// them fails with a *CredentialPromptError instead of hanging. Cancelling ctx,
+// or its deadline passing, kills git and the error wraps ctx.Err().
+func (e *ExecOperations) execGitNetwork(ctx context.Context, repoPath string, args ...string) (string, string, error) {
+	stdout, stderr, err := e.execGitEnv(ctx, repoPath, []string{"GIT_TERMINAL_PROMPT=0"}, args...)
+	stdout, stderr = strings.TrimSpace(stdout), strings.TrimSpace(stderr)
+	if err == nil {
+		return stdout, stderr, nil
+	}
+
+	// The killed process only reports a signal; say why it was killed
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		if errors.Is(ctxErr, context.DeadlineExceeded) {
+			return stdout, stderr, fmt.Errorf("timed out: %w", ctxErr)
+		}
+		return stdout, stderr, fmt.Errorf("cancelled: %w", ctxErr)
+	}
+	if isCredentialPrompt(stderr) {
+		return stdout, stderr, &CredentialPromptError{Err: err}
+	}
+	return stdout, stderr, err
+}
+
+// credentialPromptMarkers are the messages git prints when it needed to ask
+// for credentials with GIT_TERMINAL_PROMPT=0
+var credentialPromptMarkers = []string{
+	"terminal prompts disabled",
+	"could not read Username",
+	"could not read Password",
+}
+
+// isCredentialPrompt reports whether git's stderr shows it failed because it
+// could not prompt for credentials.
+func isCredentialPrompt(stderr string) bool {
+	for _, marker := range credentialPromptMarkers {
+		if strings.Contains(stderr, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// CredentialPromptError is returned when the remote asked for a username or
+// password, which git can't prompt for inside GitMind. A credential helper (or
+// an SSH remote with a key) supplies them without prompting.
+type CredentialPromptError struct {
+	Err error
+}
+
+func (e *CredentialPromptError) Error() string {
+	return "the remote needs credentials and git cannot prompt for them here; " +
+		"configure a credential helper (git config --global credential.helper <helper>) or use an SSH remote"
+}
+
+func (e *CredentialPromptError) Unwrap() error {
+	return e.Err
+}
+
+// Version returns the installed git version, e.g. "2.43.0".
+func (e *ExecOperations) Version(ctx context.Context) (string, error) {
+	stdout, stderr, err := e.execGit(ctx, "", "--version")
+	if err != nil {
+		return "", fmt.Errorf("git --version failed: %s: %w", stderr, err)
+	}
+
+	version := parseGitVersion(stdout)
+	if version == "" {
+		return "", fmt.Errorf("unexpected git --version output: %q", stdout)
+	}
+	return version, nil
+}
+
+// parseGitVersion extracts the version from git --version output, dropping
+// platform suffixes: "git version 2.39.3 (Apple Git-146)" and
+// "git version 2.43.0.windows.1" both give their leading x.y.z.
+func parseGitVersion(output string) string {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(output), "git version ")
+	if !ok {
+		return ""
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	parts := strings.Split(fields[0], ".")
+	if len(parts) > 3 {
+		parts = parts[:3]
+	}
+	return strings.Join(parts, ".")
 }
 
 // IsGitRepo returns true if the path is a valid git repository.
@@ -132,10 +301,21 @@ func (e *ExecOperations) GetStatus(ctx context.Context, repoPath string) (*domai
 		}
 	}
 
+	// Shallow clones get a warning about limited history (non-fatal)
+	if shallow, err := e.IsShallowRepository(ctx, repoPath); err == nil {
+		repo.SetIsShallow(shallow)
+	}
+
+	// Submodules get a note that their commits are separate from the parent (non-fatal)
+	if superproject, err := e.GetSuperproject(ctx, repoPath); err == nil {
+		repo.SetSuperproject(superproject)
+	}
+
 	// Get status in porcelain format
-	stdout, stderr, err := e.execGit(ctx, repoPath, "status", "--porcelain")
+	// Untrimmed: the first line's status code may start with a space (" M")
+	stdout, stderr, err := e.execGitRaw(ctx, repoPath, e.scoped("status", "--porcelain")...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get status: %s: %w", stderr, err)
+		return nil, fmt.Errorf("failed to get status: %s: %w", strings.TrimSpace(stderr), err)
 	}
 
 	// Parse status output
@@ -172,27 +352,29 @@ func (e *ExecOperations) parseStatus(output string) ([]domain.FileChange, error)
 			continue // Invalid line
 		}
 
-		statusCode := line[:2]
-		filePath := strings.TrimSpace(line[3:])
-
-		change := domain.FileChange{
-			Path: filePath,
+		// XY: X is the index (staged) state, Y the working tree (unstaged) state
+		index, worktree := line[0], line[1]
+		if index == '!' {
+			continue // Ignored file
 		}
+		change := domain.FileChange{Path: strings.TrimSpace(line[3:])}
 
-		// Parse status code
-		switch {
-		case strings.Contains(statusCode, "A"):
-			change.Status = domain.StatusAdded
-		case strings.Contains(statusCode, "M"):
-			change.Status = domain.StatusModified
-		case strings.Contains(statusCode, "D"):
-			change.Status = domain.StatusDeleted
-		case strings.Contains(statusCode, "R"):
-			change.Status = domain.StatusRenamed
-		case strings.Contains(statusCode, "?"):
-			change.Status = domain.StatusUntracked
-		default:
+		if isUnmerged(index, worktree) {
+			// A merge conflict: nothing is staged until it is resolved
 			change.Status = domain.StatusModified
+			change.Unstaged = true
+		} else {
+			change.Staged = index != ' ' && index != '?'
+			change.Unstaged = worktree != ' '
+
+			// What is staged is what a commit records, so the index wins
+			if status, ok := columnStatus(index); ok {
+				change.Status = status
+			} else if status, ok := columnStatus(worktree); ok {
+				change.Status = status
+			} else {
+				change.Status = domain.StatusModified
+			}
 		}
 
 		changes = append(changes, change)
@@ -201,6 +383,31 @@ func (e *ExecOperations) parseStatus(output string) ([]domain.FileChange, error)
 	return changes, nil
 }
 
+// columnStatus maps one column of a porcelain status code to a change
+// status. ok is false for an unchanged column.
+func columnStatus(code byte) (status domain.ChangeStatus, ok bool) {
+	switch code {
+	case 'A', 'C':
+		return domain.StatusAdded, true
+	case 'M', 'T':
+		return domain.StatusModified, true
+	case 'D':
+		return domain.StatusDeleted, true
+	case 'R':
+		return domain.StatusRenamed, true
+	case '?':
+		return domain.StatusUntracked, true
+	}
+	return "", false
+}
+
+// isUnmerged reports whether a porcelain status code is a merge conflict:
+// either side unmerged (U), or both sides added or deleted.
+func isUnmerged(index, worktree byte) bool {
+	return index == 'U' || worktree == 'U' ||
+		(index == 'A' && worktree == 'A') || (index == 'D' && worktree == 'D')
+}
+
 // populateLineStats populates additions/deletions for each file change.
 func (e *ExecOperations) populateLineStats(ctx context.Context, repoPath string, changes []domain.FileChange) error {
 	if len(changes) == 0 {
@@ -224,7 +431,7 @@ func (e *ExecOperations) populateLineStats(ctx context.Context, repoPath string,
 
 	// Apply stats to changes
 	for i := range changes {
-		if stats, ok := allStats[changes[i].Path]; ok {
+		if stats, ok := allStats[renamedPath(changes[i].Path)]; ok {
 			changes[i].Additions = stats.added
 			changes[i].Deletions = stats.deleted
 		} else if changes[i].Status == domain.StatusUntracked {
@@ -239,45 +446,104 @@ func (e *ExecOperations) populateLineStats(ctx context.Context, repoPath string,
 
 // getDiffStats runs git diff --numstat and parses the output.
 func (e *ExecOperations) getDiffStats(ctx context.Context, repoPath string, staged bool) (map[string]struct{ added, deleted int }, error) {
-	args := []string{"diff", "--numstat"}
+	args := append([]string{"diff", "--numstat"}, renameArgs(e.renameDetection)...)
 	if staged {
 		args = append(args, "--cached")
 	}
 
-	stdout, _, err := e.execGit(ctx, repoPath, args...)
+	stdout, _, err := e.execGit(ctx, repoPath, e.scoped(args...)...)
 	if err != nil {
 		return nil, err
 	}
 
 	stats := make(map[string]struct{ added, deleted int })
-	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	for _, stat := range parseNumstat(stdout) {
+		stats[stat.Path] = struct{ added, deleted int }{stat.Additions, stat.Deletions}
+	}
 
-	for _, line := range lines {
+	return stats, nil
+}
+
+// GetMergePreviewStats returns per-file line stats for the changes merging
+// source into target would bring in (git diff --numstat target...source).
+func (e *ExecOperations) GetMergePreviewStats(ctx context.Context, repoPath, target, source string) ([]FileStat, error) {
+	args := append([]string{"diff", "--numstat"}, renameArgs(e.renameDetection)...)
+	args = append(args, target+"..."+source, "--")
+
+	stdout, stderr, err := e.execGit(ctx, repoPath, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merge preview stats: %s: %w", stderr, err)
+	}
+
+	return parseNumstat(stdout), nil
+}
+
+// parseNumstat parses git diff --numstat output. Renamed files are reported
+// under their new path.
+func parseNumstat(output string) []FileStat {
+	var stats []FileStat
+
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
 		if line == "" {
 			continue
 		}
 
-		parts := strings.Fields(line)
+		// Split on tabs only; paths (and rename arrows) may contain spaces
+		parts := strings.SplitN(line, "\t", 3)
 		if len(parts) < 3 {
 			continue
 		}
 
-		added := 0
-		deleted := 0
+		stat := FileStat{Path: renamedPath(parts[2])}
 
 		// Parse added/deleted (can be "-" for binary files)
+		if parts[0] == "-" && parts[1] == "-" {
+			stat.Binary = true
+		}
 		if parts[0] != "-" {
-			_, _ = fmt.Sscanf(parts[0], "%d", &added)
+			_, _ = fmt.Sscanf(parts[0], "%d", &stat.Additions)
 		}
 		if parts[1] != "-" {
-			_, _ = fmt.Sscanf(parts[1], "%d", &deleted)
+			_, _ = fmt.Sscanf(parts[1], "%d", &stat.Deletions)
 		}
 
-		filePath := parts[2]
-		stats[filePath] = struct{ added, deleted int }{added, deleted}
+		stats = append(stats, stat)
 	}
 
-	return stats, nil
+	return stats
+}
+
+// StatusPaths returns the paths a git status entry touches: the destination
+// and the source of a rename ("old -> new"), otherwise just path. Staging or
+// committing both sides keeps a rename from being split in two.
+func StatusPaths(path string) []string {
+	if oldPath, _, found := strings.Cut(path, " -> "); found {
+		return []string{renamedPath(path), oldPath}
+	}
+	return []string{path}
+}
+
+// renamedPath returns the destination path of a rename as written by
+// git status ("old -> new") or git diff --numstat ("old => new" or
+// "dir/{old => new}/file"). Other paths are returned unchanged.
+func renamedPath(path string) string {
+	if _, newPath, found := strings.Cut(path, " -> "); found {
+		return newPath
+	}
+
+	open := strings.Index(path, "{")
+	closing := strings.LastIndex(path, "}")
+	if open >= 0 && closing > open {
+		if _, newPart, found := strings.Cut(path[open+1:closing], " => "); found {
+			// "dir/{ => sub}/file" leaves a doubled slash when one side is empty
+			return strings.ReplaceAll(path[:open]+newPart+path[closing+1:], "//", "/")
+		}
+	}
+
+	if _, newPath, found := strings.Cut(path, " => "); found {
+		return newPath
+	}
+	return path
 }
 
 // countFileLines counts the number of lines in a file (for untracked files).
@@ -303,12 +569,12 @@ func (e *ExecOperations) countFileLines(ctx context.Context, repoPath, filePath
 
 // GetDiff returns the diff for staged/unstaged changes.
 func (e *ExecOperations) GetDiff(ctx context.Context, repoPath string, staged bool) (string, error) {
-	args := []string{"diff"}
+	args := append([]string{"diff"}, renameArgs(e.renameDetection)...)
 	if staged {
 		args = append(args, "--cached")
 	}
 
-	stdout, stderr, err := e.execGit(ctx, repoPath, args...)
+	stdout, stderr, err := e.execGit(ctx, repoPath, e.scoped(args...)...)
 	if err != nil {
 		return "", fmt.Errorf("failed to get diff: %s: %w", stderr, err)
 	}
@@ -316,16 +582,155 @@ func (e *ExecOperations) GetDiff(ctx context.Context, repoPath string, staged bo
 	return stdout, nil
 }
 
+// GetBranchDiff returns the committed changes on head since it diverged from base.
+func (e *ExecOperations) GetBranchDiff(ctx context.Context, repoPath, base, head string) (string, error) {
+	if base == "" || head == "" {
+		return "", errors.New("refs cannot be empty")
+	}
+
+	stdout, stderr, err := e.execGit(ctx, repoPath, "diff", fmt.Sprintf("%s...%s", base, head))
+	if err != nil {
+		return "", fmt.Errorf("failed to get branch diff: %s: %w", stderr, err)
+	}
+
+	return stdout, nil
+}
+
+// GetCommitDiff returns a commit's header, message and diff against its parent.
+func (e *ExecOperations) GetCommitDiff(ctx context.Context, repoPath, hash string) (string, error) {
+	if hash == "" {
+		return "", errors.New("commit hash cannot be empty")
+	}
+
+	args := append([]string{"show", "--patch"}, renameArgs(e.renameDetection)...)
+	stdout, stderr, err := e.execGit(ctx, repoPath, append(args, hash, "--")...)
+	if err != nil {
+		return "", fmt.Errorf("failed to show commit %s: %s: %w", hash, stderr, err)
+	}
+
+	return stdout, nil
+}
+
+// IsWhitespaceOnlyChange reports whether all uncommitted changes (staged and
+// unstaged) are whitespace or line-ending churn. Untracked files always count
+// as real changes.
+func (e *ExecOperations) IsWhitespaceOnlyChange(ctx context.Context, repoPath string) (bool, error) {
+	untracked, stderr, err := e.execGit(ctx, repoPath, e.scoped("ls-files", "--others", "--exclude-standard")...)
+	if err != nil {
+		return false, fmt.Errorf("failed to list untracked files: %s: %w", stderr, err)
+	}
+	if untracked != "" {
+		return false, nil
+	}
+
+	diff, stderr, err := e.execGit(ctx, repoPath, e.scoped("diff", "HEAD")...)
+	if err != nil {
+		return false, fmt.Errorf("failed to get diff: %s: %w", stderr, err)
+	}
+	if diff == "" {
+		return false, nil // No changes at all
+	}
+
+	significant, stderr, err := e.execGit(ctx, repoPath, e.scoped("diff", "HEAD", "--ignore-all-space", "--ignore-cr-at-eol")...)
+	if err != nil {
+		return false, fmt.Errorf("failed to get whitespace-insensitive diff: %s: %w", stderr, err)
+	}
+
+	return significant == "", nil
+}
+
+// GetSubmoduleUpdates returns submodule pointer changes in the working tree and index
+// relative to HEAD, including the subjects of commits the update pulls in.
+func (e *ExecOperations) GetSubmoduleUpdates(ctx context.Context, repoPath string) ([]domain.SubmoduleUpdate, error) {
+	// Only look at submodule paths so regular file diffs aren't generated
+	stdout, _, err := e.execGit(ctx, repoPath, "config", "--file", ".gitmodules", "--get-regexp", `\.path$`)
+	if err != nil {
+		// No .gitmodules (or no submodules configured)
+		return nil, nil
+	}
+
+	var paths []string
+	for _, line := range strings.Split(strings.TrimSpace(stdout), "\n") {
+		if _, path, ok := strings.Cut(line, " "); ok && path != "" {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	args := append([]string{"diff", "HEAD", "--submodule=log", "--"}, paths...)
+	stdout, stderr, err := e.execGit(ctx, repoPath, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get submodule diff: %s: %w", stderr, err)
+	}
+
+	return parseSubmoduleLog(stdout), nil
+}
+
+// submoduleHeaderPattern matches "Submodule <path> <old>..<new>[ (<note>)]:" header lines
+// produced by git diff --submodule=log. Rewinds use "..." between the SHAs.
+var submoduleHeaderPattern = regexp.MustCompile(`^Submodule (.+) ([0-9a-f]+)\.\.\.?([0-9a-f]+)(?: \(([^)]*)\))?:?$`)
+
+// parseSubmoduleLog parses git diff --submodule=log output.
+func parseSubmoduleLog(output string) []domain.SubmoduleUpdate {
+	var updates []domain.SubmoduleUpdate
+	var current *domain.SubmoduleUpdate
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimRight(line, "\r")
+
+		if matches := submoduleHeaderPattern.FindStringSubmatch(line); matches != nil {
+			updates = append(updates, domain.SubmoduleUpdate{
+				Path:   matches[1],
+				OldSHA: nonZeroSHA(matches[2]),
+				NewSHA: nonZeroSHA(matches[3]),
+				Note:   matches[4],
+			})
+			current = &updates[len(updates)-1]
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "> "):
+			current.Commits = append(current.Commits, strings.TrimPrefix(trimmed, "> "))
+		case strings.HasPrefix(trimmed, "< "):
+			current.Reverted = append(current.Reverted, strings.TrimPrefix(trimmed, "< "))
+		default:
+			// Any other line ends the commit list for this submodule
+			current = nil
+		}
+	}
+
+	return updates
+}
+
+// nonZeroSHA returns sha, or "" if it is the all-zero SHA git uses for missing commits.
+func nonZeroSHA(sha string) string {
+	if strings.Trim(sha, "0") == "" {
+		return ""
+	}
+	return sha
+}
+
 // Add stages files for commit.
 func (e *ExecOperations) Add(ctx context.Context, repoPath string, files []string) error {
-	args := []string{"add"}
+	if len(files) > 0 {
+		if files = e.withoutStagedRemovals(ctx, repoPath, files); len(files) == 0 {
+			return nil
+		}
+	}
 
+	args := AddArgs(files)
 	if len(files) == 0 {
-		args = append(args, "-A") // Add all changes
-	} else {
-		args = append(args, files...)
+		// Staging everything stays within the path scope
+		args = e.scoped(args...)
 	}
-
 	_, stderr, err := e.execGit(ctx, repoPath, args...)
 	if err != nil {
 		return fmt.Errorf("failed to add files: %s: %w", stderr, err)
@@ -334,11 +739,41 @@ func (e *ExecOperations) Add(ctx context.Context, repoPath string, files []strin
 	return nil
 }
 
-// Push pushes commits to the remote repository.
-// If branch is empty, pushes the current branch.
-func (e *ExecOperations) Push(ctx context.Context, repoPath, branch string, force bool) error {
-	args := []string{"push"}
+// withoutStagedRemovals drops the files whose removal is already staged, like
+// the source of a staged rename: git add fails on a path that is in neither
+// the working tree nor the index.
+func (e *ExecOperations) withoutStagedRemovals(ctx context.Context, repoPath string, files []string) []string {
+	var missing []string
+	for _, file := range files {
+		if _, err := os.Lstat(filepath.Join(repoPath, file)); os.IsNotExist(err) {
+			missing = append(missing, file)
+		}
+	}
+	if len(missing) == 0 {
+		return files
+	}
 
+	stdout, _, err := e.execGit(ctx, repoPath, append([]string{"diff", "--cached", "--name-only", "--no-renames", "--diff-filter=D", "--"}, missing...)...)
+	if err != nil || stdout == "" {
+		return files
+	}
+	removed := make(map[string]bool)
+	for _, line := range strings.Split(stdout, "\n") {
+		removed[line] = true
+	}
+
+	kept := make([]string, 0, len(files))
+	for _, file := range files {
+		if !removed[file] {
+			kept = append(kept, file)
+		}
+	}
+	return kept
+}
+
+// Push pushes commits to remote, the primary remote if empty.
+// If branch is empty, pushes the current branch.
+func (e *ExecOperations) Push(ctx context.Context, repoPath, remote, branch string, force bool) error {
 	// Get current branch if not specified
 	if branch == "" {
 		currentBranch, err := e.GetCurrentBranch(ctx, repoPath)
@@ -348,48 +783,248 @@ func (e *ExecOperations) Push(ctx context.Context, repoPath, branch string, forc
 		branch = currentBranch
 	}
 
-	// Check if branch has upstream
-	hasUpstream, err := e.HasUpstream(ctx, repoPath, branch)
-	if err != nil {
-		return fmt.Errorf("failed to check upstream: %w", err)
+	if remote == "" {
+		pushRemote, _, err := e.PushRemote(ctx, repoPath, branch)
+		if err != nil {
+			return err
+		}
+		remote = pushRemote
 	}
 
-	// Set upstream if it doesn't exist
-	if !hasUpstream {
-		args = append(args, "--set-upstream", "origin", branch)
+	// Set upstream unless the branch already tracks a branch on remote
+	_, stderr, err := e.execGitNetwork(ctx, repoPath, PushArgs(remote, branch, e.upstreamRemote(ctx, repoPath, branch) != remote, force)...)
+	if err != nil {
+		return fmt.Errorf("failed to push: %s: %w", stderr, err)
 	}
 
-	// Add force flag if requested
-	if force {
-		args = append(args, "--force")
+	return nil
+}
+
+// PushTags pushes every local tag to remote, the primary remote if empty.
+func (e *ExecOperations) PushTags(ctx context.Context, repoPath, remote string) error {
+	if remote == "" {
+		primary, err := e.GetRemoteName(ctx, repoPath)
+		if err != nil {
+			return err
+		}
+		remote = primary
 	}
 
-	_, stderr, err := e.execGit(ctx, repoPath, args...)
+	_, stderr, err := e.execGitNetwork(ctx, repoPath, "push", remote, "--tags")
 	if err != nil {
-		return fmt.Errorf("failed to push: %s: %w", stderr, err)
+		return fmt.Errorf("failed to push tags: %s: %w", stderr, err)
+	}
+	return nil
+}
+
+// PushAllBranches pushes every local branch to remote, the primary remote if
+// empty.
+func (e *ExecOperations) PushAllBranches(ctx context.Context, repoPath, remote string) error {
+	if remote == "" {
+		primary, err := e.GetRemoteName(ctx, repoPath)
+		if err != nil {
+			return err
+		}
+		remote = primary
 	}
 
+	_, stderr, err := e.execGitNetwork(ctx, repoPath, "push", remote, "--all")
+	if err != nil {
+		return fmt.Errorf("failed to push branches: %s: %w", stderr, err)
+	}
 	return nil
 }
 
-// Pull pulls changes from the remote repository.
-func (e *ExecOperations) Pull(ctx context.Context, repoPath string) error {
-	_, stderr, err := e.execGit(ctx, repoPath, "pull")
+// Pull pulls changes from the remote repository. An empty remote pulls the
+// branch's upstream as plain git pull does; a named remote pulls its branch
+// of the same name, unless the branch already tracks a branch there. With
+// rebase, local commits are replayed on top of the remote ones (git pull
+// --rebase); a conflict returns a *RebaseConflictError and leaves the rebase
+// in progress.
+func (e *ExecOperations) Pull(ctx context.Context, repoPath, remote string, rebase bool) error {
+	args := []string{"pull"}
+	if rebase {
+		args = append(args, "--rebase")
+	}
+
+	if remote != "" {
+		branch, err := e.GetCurrentBranch(ctx, repoPath)
+		if err != nil {
+			return fmt.Errorf("failed to get current branch: %w", err)
+		}
+		if e.upstreamRemote(ctx, repoPath, branch) != remote {
+			args = append(args, remote, branch)
+		}
+	}
+
+	stdout, stderr, err := e.execGitNetwork(ctx, repoPath, args...)
 	if err != nil {
+		output := stdout + "\n" + stderr
+		if rebase && strings.Contains(output, "CONFLICT") {
+			return &RebaseConflictError{Files: parseConflictFiles(output)}
+		}
 		return fmt.Errorf("failed to pull: %s: %w", stderr, err)
 	}
 	return nil
 }
 
+// RebaseConflictError is returned when a rebase stops on a conflict. The
+// rebase is left in progress to resolve and continue with ContinueRebase, or
+// to abandon with AbortRebase. Files lists the conflicting paths.
+type RebaseConflictError struct {
+	Files []string
+}
+
+func (e *RebaseConflictError) Error() string {
+	if len(e.Files) == 0 {
+		return "rebase conflict"
+	}
+	return fmt.Sprintf("rebase conflict in %s", strings.Join(e.Files, ", "))
+}
+
 // Fetch fetches updates from the remote repository without merging.
 func (e *ExecOperations) Fetch(ctx context.Context, repoPath string) error {
-	_, stderr, err := e.execGit(ctx, repoPath, "fetch")
+	_, stderr, err := e.execGitNetwork(ctx, repoPath, "fetch")
 	if err != nil {
 		return fmt.Errorf("failed to fetch: %s: %w", stderr, err)
 	}
 	return nil
 }
 
+// IsShallowRepository reports whether the repository is a shallow clone.
+func (e *ExecOperations) IsShallowRepository(ctx context.Context, repoPath string) (bool, error) {
+	stdout, stderr, err := e.execGit(ctx, repoPath, "rev-parse", "--is-shallow-repository")
+	if err != nil {
+		return false, fmt.Errorf("failed to check for shallow clone: %s: %w", stderr, err)
+	}
+
+	if shallow, ok := parseIsShallow(stdout); ok {
+		return shallow, nil
+	}
+
+	// Git before 2.15 echoes the unknown flag back; a shallow clone
+	// records its grafted commits in $GIT_DIR/shallow
+	shallowFile, err := e.GitPath(ctx, repoPath, "shallow")
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(shallowFile)
+	return err == nil, nil
+}
+
+// parseIsShallow parses git rev-parse --is-shallow-repository output.
+// ok is false when the output is neither "true" nor "false".
+func parseIsShallow(output string) (shallow, ok bool) {
+	switch strings.TrimSpace(output) {
+	case "true":
+		return true, true
+	case "false":
+		return false, true
+	}
+	return false, false
+}
+
+// GetSuperproject returns the working tree of the repository repoPath is a
+// submodule of, or "" when it isn't a submodule.
+func (e *ExecOperations) GetSuperproject(ctx context.Context, repoPath string) (string, error) {
+	stdout, stderr, err := e.execGit(ctx, repoPath, "rev-parse", "--show-superproject-working-tree")
+	if err != nil {
+		return "", fmt.Errorf("failed to check for a superproject: %s: %w", stderr, err)
+	}
+	return stdout, nil
+}
+
+// Unshallow fetches the full history of a shallow clone.
+func (e *ExecOperations) Unshallow(ctx context.Context, repoPath string) error {
+	_, stderr, err := e.execGitNetwork(ctx, repoPath, "fetch", "--unshallow")
+	if err != nil {
+		return fmt.Errorf("failed to fetch full history: %s: %w", stderr, err)
+	}
+	return nil
+}
+
+// CountObjects reports how the object database is stored.
+func (e *ExecOperations) CountObjects(ctx context.Context, repoPath string) (*ObjectStats, error) {
+	stdout, stderr, err := e.execGit(ctx, repoPath, "count-objects", "-vH")
+	if err != nil {
+		return nil, fmt.Errorf("failed to count objects: %s: %w", stderr, err)
+	}
+	return parseCountObjects(stdout), nil
+}
+
+// parseCountObjects parses git count-objects -vH output, one "key: value"
+// line per statistic. Unknown keys are ignored.
+func parseCountObjects(output string) *ObjectStats {
+	stats := &ObjectStats{}
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		count, _ := strconv.Atoi(value)
+		switch strings.TrimSpace(key) {
+		case "count":
+			stats.Loose = count
+		case "size":
+			stats.LooseSize = value
+		case "in-pack":
+			stats.Packed = count
+		case "packs":
+			stats.Packs = count
+		case "size-pack":
+			stats.PackSize = value
+		case "prune-packable":
+			stats.PrunePackable = count
+		case "garbage":
+			stats.Garbage = count
+		case "size-garbage":
+			stats.GarbageSize = value
+		}
+	}
+	return stats
+}
+
+// Loose object and pack counts above which git gc --auto repacks, matching
+// git's gc.auto and gc.autoPackLimit defaults.
+const (
+	maintenanceLooseObjects = 6700
+	maintenancePacks        = 50
+)
+
+// MaintenanceReason explains why the repository would benefit from
+// maintenance, or returns "" when it doesn't need any.
+func (s *ObjectStats) MaintenanceReason() string {
+	var reasons []string
+	if s.Loose >= maintenanceLooseObjects {
+		reasons = append(reasons, fmt.Sprintf("%d loose objects", s.Loose))
+	}
+	if s.Packs >= maintenancePacks {
+		reasons = append(reasons, fmt.Sprintf("%d packs", s.Packs))
+	}
+	if s.PrunePackable > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d duplicated objects", s.PrunePackable))
+	}
+	if s.Garbage > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d garbage files", s.Garbage))
+	}
+	return strings.Join(reasons, ", ")
+}
+
+// RunMaintenance repacks objects and prunes garbage. git maintenance run
+// performs the tasks enabled in config, gc by default; git before 2.29 has no
+// maintenance command, so gc runs directly.
+func (e *ExecOperations) RunMaintenance(ctx context.Context, repoPath string) error {
+	_, stderr, err := e.execGit(ctx, repoPath, "maintenance", "run")
+	if err != nil && strings.Contains(stderr, "is not a git command") {
+		_, stderr, err = e.execGit(ctx, repoPath, "gc")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to run maintenance: %s: %w", stderr, err)
+	}
+	return nil
+}
+
 // HasUpstream checks if the specified branch has an upstream tracking branch.
 // If branch is empty, checks the current branch.
 func (e *ExecOperations) HasUpstream(ctx context.Context, repoPath, branch string) (bool, error) {
@@ -411,7 +1046,30 @@ func (e *ExecOperations) HasUpstream(ctx context.Context, repoPath, branch strin
 	return stdout != "", nil
 }
 
-// GetUnpushedCommits returns the number of commits that haven't been pushed to the remote.
+// upstreamRemote returns the remote that branch's upstream is on, or "" if
+// it has no upstream.
+func (e *ExecOperations) upstreamRemote(ctx context.Context, repoPath, branch string) string {
+	stdout, _, err := e.execGit(ctx, repoPath, "config", "--get", "branch."+branch+".remote")
+	if err != nil {
+		return ""
+	}
+	return stdout
+}
+
+// PushRemote returns the remote branch tracks, or the primary remote if it
+// tracks none. A branch tracking another local branch (".") tracks no remote.
+func (e *ExecOperations) PushRemote(ctx context.Context, repoPath, branch string) (string, bool, error) {
+	if remote := e.upstreamRemote(ctx, repoPath, branch); remote != "" && remote != "." {
+		return remote, true, nil
+	}
+	primary, err := e.GetRemoteName(ctx, repoPath)
+	if err != nil {
+		return "", false, err
+	}
+	return primary, false, nil
+}
+
+// GetUnpushedCommits returns the number of commits that haven't been pushed to the remote.
 // If branch is empty, uses the current branch.
 func (e *ExecOperations) GetUnpushedCommits(ctx context.Context, repoPath, branch string) (int, error) {
 	if branch == "" {
@@ -521,27 +1179,135 @@ func (e *ExecOperations) Commit(ctx context.Context, repoPath string, message st
 		}
 	}
 
-	args := []string{"commit", "-m", message}
-
-	_, stderr, err := e.execGit(ctx, repoPath, args...)
+	_, stderr, err := e.execGit(ctx, repoPath, e.CommitArgs(message, files, false)...)
 	if err != nil {
 		// Check if the error is because there's nothing to commit
 		if strings.Contains(stderr, "nothing to commit") {
 			return errors.New("no changes to commit")
 		}
+		if isMissingSigningKey(stderr) {
+			return &SigningKeyError{Detail: stderr}
+		}
 		return fmt.Errorf("failed to commit: %s: %w", stderr, err)
 	}
 
 	return nil
 }
 
+// CommitArgs returns the package CommitArgs with -S added when commit signing
+// is enabled.
+func (e *ExecOperations) CommitArgs(message string, files []string, amend bool) []string {
+	args := CommitArgs(message, files, amend)
+	if !e.signCommits {
+		return args
+	}
+	return append([]string{args[0], "-S" + e.signingKey}, args[1:]...)
+}
+
+// SigningKeyError is returned when git cannot sign a commit because no usable
+// signing key is configured. Detail holds git's error output.
+type SigningKeyError struct {
+	Detail string
+}
+
+func (e *SigningKeyError) Error() string {
+	return "commit signing failed: no usable signing key is configured"
+}
+
+// isMissingSigningKey reports whether git commit stderr shows that signing
+// failed for want of a key, with either gpg or ssh (gpg.format).
+func isMissingSigningKey(stderr string) bool {
+	for _, marker := range []string{
+		"No secret key",            // gpg: the configured or default key is not in the keyring
+		"secret key not available", // older gpg
+		"needs to be configured",   // ssh: neither user.signingkey nor gpg.ssh.defaultKeyCommand is set
+	} {
+		if strings.Contains(stderr, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// commitMessageArgs passes the subject line and the body of message as
+// separate -m arguments, so git records the body as its own paragraph.
+func commitMessageArgs(message string) []string {
+	subject, body, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	args := []string{"-m", strings.TrimSpace(subject)}
+	if body = strings.TrimSpace(body); body != "" {
+		args = append(args, "-m", body)
+	}
+	return args
+}
+
+// AmendCommit replaces the last commit with one that also includes the staged changes.
+func (e *ExecOperations) AmendCommit(ctx context.Context, repoPath string, message string, files []string) error {
+	if message == "" {
+		return errors.New("commit message cannot be empty")
+	}
+
+	// Stage files if specified
+	if len(files) > 0 {
+		if err := e.Add(ctx, repoPath, files); err != nil {
+			return err
+		}
+	}
+
+	_, stderr, err := e.execGit(ctx, repoPath, e.CommitArgs(message, files, true)...)
+	if err != nil {
+		if strings.Contains(stderr, "nothing to amend") {
+			return errors.New("no previous commit to amend")
+		}
+		if isMissingSigningKey(stderr) {
+			return &SigningKeyError{Detail: stderr}
+		}
+		return fmt.Errorf("failed to amend commit: %s: %w", stderr, err)
+	}
+
+	return nil
+}
+
+// SnapshotIndex records the current index as a tree object and returns its hash.
+func (e *ExecOperations) SnapshotIndex(ctx context.Context, repoPath string) (string, error) {
+	stdout, stderr, err := e.execGit(ctx, repoPath, "write-tree")
+	if err != nil {
+		return "", fmt.Errorf("failed to snapshot index: %s: %w", stderr, err)
+	}
+
+	return stdout, nil
+}
+
+// RestoreIndex replaces the index with a snapshot from SnapshotIndex.
+func (e *ExecOperations) RestoreIndex(ctx context.Context, repoPath, tree string) error {
+	if tree == "" {
+		return errors.New("index snapshot cannot be empty")
+	}
+
+	_, stderr, err := e.execGit(ctx, repoPath, "read-tree", tree)
+	if err != nil {
+		return fmt.Errorf("failed to restore index: %s: %w", stderr, err)
+	}
+
+	return nil
+}
+
 // CreateBranch creates a new branch with the given name.
 func (e *ExecOperations) CreateBranch(ctx context.Context, repoPath, branchName string) error {
+	return e.CreateBranchAt(ctx, repoPath, branchName, "")
+}
+
+// CreateBranchAt creates a new branch pointing at startPoint. An empty
+// startPoint branches from HEAD.
+func (e *ExecOperations) CreateBranchAt(ctx context.Context, repoPath, branchName, startPoint string) error {
 	if branchName == "" {
 		return errors.New("branch name cannot be empty")
 	}
 
-	_, stderr, err := e.execGit(ctx, repoPath, "branch", branchName)
+	args := []string{"branch", branchName}
+	if startPoint != "" {
+		args = append(args, startPoint)
+	}
+	_, stderr, err := e.execGit(ctx, repoPath, args...)
 	if err != nil {
 		if strings.Contains(stderr, "already exists") {
 			return fmt.Errorf("branch '%s' already exists", branchName)
@@ -606,6 +1372,133 @@ func (e *ExecOperations) GetRemoteName(ctx context.Context, repoPath string) (st
 	return remotes[0], nil
 }
 
+// ListRemotes returns the names of all configured remotes.
+func (e *ExecOperations) ListRemotes(ctx context.Context, repoPath string) ([]string, error) {
+	stdout, stderr, err := e.execGit(ctx, repoPath, "remote")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remotes: %s: %w", stderr, err)
+	}
+
+	var remotes []string
+	for _, line := range strings.Split(stdout, "\n") {
+		if remote := strings.TrimSpace(line); remote != "" {
+			remotes = append(remotes, remote)
+		}
+	}
+
+	return remotes, nil
+}
+
+// ListRemotesWithURLs returns all configured remotes with their fetch URLs.
+func (e *ExecOperations) ListRemotesWithURLs(ctx context.Context, repoPath string) ([]Remote, error) {
+	stdout, stderr, err := e.execGit(ctx, repoPath, "remote", "-v")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remotes: %s: %w", stderr, err)
+	}
+	return parseRemotes(stdout), nil
+}
+
+// parseRemotes parses git remote -v output, where each remote is listed
+// with its fetch and push URLs: "origin\tgit@host:repo.git (fetch)".
+func parseRemotes(output string) []Remote {
+	var remotes []Remote
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || fields[2] != "(fetch)" {
+			continue
+		}
+		remotes = append(remotes, Remote{Name: fields[0], URL: fields[1]})
+	}
+	return remotes
+}
+
+// AddRemote adds a remote called name at url.
+func (e *ExecOperations) AddRemote(ctx context.Context, repoPath, name, url string) error {
+	if err := e.checkRemoteNameFree(ctx, repoPath, name); err != nil {
+		return err
+	}
+	if strings.TrimSpace(url) == "" {
+		return errors.New("remote URL cannot be empty")
+	}
+
+	_, stderr, err := e.execGit(ctx, repoPath, "remote", "add", name, url)
+	if err != nil {
+		return fmt.Errorf("failed to add remote: %s: %w", stderr, err)
+	}
+	return nil
+}
+
+// RemoveRemote removes a remote along with its remote-tracking branches.
+func (e *ExecOperations) RemoveRemote(ctx context.Context, repoPath, name string) error {
+	if name == "" {
+		return errors.New("remote name cannot be empty")
+	}
+
+	_, stderr, err := e.execGit(ctx, repoPath, "remote", "remove", name)
+	if err != nil {
+		return fmt.Errorf("failed to remove remote: %s: %w", stderr, err)
+	}
+	return nil
+}
+
+// RenameRemote renames a remote and its remote-tracking branches.
+func (e *ExecOperations) RenameRemote(ctx context.Context, repoPath, oldName, newName string) error {
+	if oldName == "" {
+		return errors.New("remote name cannot be empty")
+	}
+	if err := e.checkRemoteNameFree(ctx, repoPath, newName); err != nil {
+		return err
+	}
+
+	_, stderr, err := e.execGit(ctx, repoPath, "remote", "rename", oldName, newName)
+	if err != nil {
+		return fmt.Errorf("failed to rename remote: %s: %w", stderr, err)
+	}
+	return nil
+}
+
+// checkRemoteNameFree rejects an empty or already used remote name, so the
+// user gets a clear message instead of git's.
+func (e *ExecOperations) checkRemoteNameFree(ctx context.Context, repoPath, name string) error {
+	if name == "" {
+		return errors.New("remote name cannot be empty")
+	}
+	if strings.ContainsAny(name, " \t") {
+		return fmt.Errorf("remote name %q cannot contain spaces", name)
+	}
+
+	remotes, err := e.ListRemotes(ctx, repoPath)
+	if err != nil {
+		return err
+	}
+	for _, remote := range remotes {
+		if remote == name {
+			return fmt.Errorf("a remote named %q already exists", name)
+		}
+	}
+	return nil
+}
+
+// ListRemoteBranches returns remote-tracking branches as "<remote>/<branch>".
+func (e *ExecOperations) ListRemoteBranches(ctx context.Context, repoPath string) ([]string, error) {
+	stdout, stderr, err := e.execGit(ctx, repoPath, "for-each-ref", "--format=%(refname:short)", "refs/remotes")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remote branches: %s: %w", stderr, err)
+	}
+
+	var branches []string
+	for _, line := range strings.Split(stdout, "\n") {
+		ref := strings.TrimSpace(line)
+		// Skip symbolic HEAD refs ("origin/HEAD" or bare "origin")
+		if ref == "" || !strings.Contains(ref, "/") || strings.HasSuffix(ref, "/HEAD") {
+			continue
+		}
+		branches = append(branches, ref)
+	}
+
+	return branches, nil
+}
+
 // GetRemoteSyncStatus returns commits ahead/behind relative to remote tracking branch.
 func (e *ExecOperations) GetRemoteSyncStatus(ctx context.Context, repoPath, branch string) (ahead, behind int, err error) {
 	if branch == "" {
@@ -643,29 +1536,8 @@ func (e *ExecOperations) GetRemoteSyncStatus(ctx context.Context, repoPath, bran
 		}
 	}
 
-	// Get ahead/behind counts
-	stdout, stderr, err := e.execGit(ctx, repoPath, "rev-list", "--left-right", "--count", branch+"..."+remoteBranch)
-	if err != nil {
-		return 0, 0, fmt.Errorf("failed to get divergence: %s: %w", stderr, err)
-	}
-
-	// Parse output: "ahead\tbehind"
-	parts := strings.Split(stdout, "\t")
-	if len(parts) != 2 {
-		return 0, 0, fmt.Errorf("unexpected git output format: %s", stdout)
-	}
-
-	_, err = fmt.Sscanf(parts[0], "%d", &ahead)
-	if err != nil {
-		return 0, 0, fmt.Errorf("failed to parse ahead count: %w", err)
-	}
-
-	_, err = fmt.Sscanf(parts[1], "%d", &behind)
-	if err != nil {
-		return 0, 0, fmt.Errorf("failed to parse behind count: %w", err)
-	}
-
-	return ahead, behind, nil
+	// Counted exactly like GetDivergence so sync status and branch comparisons agree
+	return e.GetDivergence(ctx, repoPath, branch, remoteBranch)
 }
 
 // GetLog returns recent commit history.
@@ -718,6 +1590,114 @@ func parseLog(output string) []CommitInfo {
 	return commits
 }
 
+// GetReflog returns the most recent count reflog entries for HEAD.
+func (e *ExecOperations) GetReflog(ctx context.Context, repoPath string, count int) ([]ReflogEntry, error) {
+	if count <= 0 {
+		count = 50
+	}
+
+	// %gd is the selector, HEAD@{<date>} with --date; %gs is "<action>: <message>"
+	args := []string{"reflog", fmt.Sprintf("-%d", count), "--date=iso", "--format=%H%x09%gd%x09%gs"}
+	stdout, stderr, err := e.execGit(ctx, repoPath, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reflog: %s: %w", stderr, err)
+	}
+
+	return parseReflog(stdout), nil
+}
+
+// RefsFingerprint returns HEAD's commit followed by every ref with its commit,
+// the checked-out branch marked with "*".
+func (e *ExecOperations) RefsFingerprint(ctx context.Context, repoPath string) (string, error) {
+	// Fails quietly in a repository without commits, which has no HEAD yet
+	head, _, _ := e.execGit(ctx, repoPath, "rev-parse", "-q", "--verify", "HEAD")
+
+	refs, stderr, err := e.execGit(ctx, repoPath, "for-each-ref", "--format=%(HEAD) %(objectname) %(refname)")
+	if err != nil {
+		return "", fmt.Errorf("failed to list refs: %s: %w", stderr, err)
+	}
+	return head + "\n" + refs, nil
+}
+
+// parseReflog parses reflog lines of "<hash>\t<selector>\t<subject>".
+func parseReflog(output string) []ReflogEntry {
+	var entries []ReflogEntry
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) < 3 {
+			continue
+		}
+
+		entry := ReflogEntry{Hash: fields[0]}
+		if start := strings.Index(fields[1], "@{"); start >= 0 {
+			entry.Date = strings.TrimSuffix(fields[1][start+2:], "}")
+		}
+		action, message, found := strings.Cut(fields[2], ": ")
+		if !found {
+			action, message = fields[2], ""
+		}
+		entry.Action = action
+		entry.Message = message
+
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// Blame returns who last changed each line of filePath.
+func (e *ExecOperations) Blame(ctx context.Context, repoPath, filePath string) ([]BlameLine, error) {
+	stdout, stderr, err := e.execGit(ctx, repoPath, "blame", "--line-porcelain", "--", filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to blame %s: %s: %w", filePath, stderr, err)
+	}
+
+	return parseBlamePorcelain(stdout), nil
+}
+
+// parseBlamePorcelain parses git blame --line-porcelain output. Each line of
+// the file is a "<hash> <orig-line> <final-line> [<count>]" header, then
+// "<key> <value>" lines about the commit, then the content prefixed by a tab.
+func parseBlamePorcelain(output string) []BlameLine {
+	var lines []BlameLine
+	var current BlameLine
+	inLine := false
+	for _, raw := range strings.Split(output, "\n") {
+		if content, ok := strings.CutPrefix(raw, "\t"); ok {
+			if inLine {
+				current.Content = content
+				lines = append(lines, current)
+			}
+			inLine = false
+			continue
+		}
+
+		key, value, _ := strings.Cut(raw, " ")
+		if !inLine {
+			fields := strings.Fields(raw)
+			if len(fields) < 3 || len(fields[0]) < 40 {
+				continue
+			}
+			finalLine, err := strconv.Atoi(fields[2])
+			if err != nil {
+				continue
+			}
+			current = BlameLine{Hash: fields[0], Line: finalLine}
+			inLine = true
+			continue
+		}
+
+		switch key {
+		case "author":
+			current.Author = value
+		case "author-time":
+			if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+				current.Time = time.Unix(seconds, 0)
+			}
+		}
+	}
+	return lines
+}
+
 // min returns the minimum of two integers.
 func min(a, b int) int {
 	if a < b {
@@ -744,10 +1724,22 @@ func (e *ExecOperations) GetBranchInfo(ctx context.Context, repoPath string, pro
 	branchType := domain.DetectBranchType(branchName, protectedBranches)
 	branchInfo.SetType(branchType)
 
-	// Get parent branch from git config
+	// Get parent branch from git config. A parent that no longer resolves,
+	// e.g. because it was deleted, is reported as lost rather than used
 	parent, err := e.GetParentBranch(ctx, repoPath, branchName)
-	if err == nil && parent != "" {
-		branchInfo.SetParent(parent)
+	if err != nil {
+		return nil, err
+	}
+	if parent != "" && !e.resolvesToCommit(ctx, repoPath, parent) {
+		branchInfo.SetLostParent(parent)
+		parent = ""
+	}
+	branchInfo.SetParent(parent)
+
+	// A branch without commits yet (e.g. a new orphan branch) has nothing to
+	// count; its counts stay zero
+	if !e.resolvesToCommit(ctx, repoPath, "HEAD") {
+		return branchInfo, nil
 	}
 
 	// Get upstream tracking branch
@@ -757,23 +1749,31 @@ func (e *ExecOperations) GetBranchInfo(ctx context.Context, repoPath string, pro
 
 		// Get divergence from upstream
 		ahead, behind, err := e.GetDivergence(ctx, repoPath, branchName, upstream)
-		if err == nil {
-			branchInfo.SetAheadBy(ahead)
-			branchInfo.SetBehindBy(behind)
+		if err != nil {
+			return nil, err
 		}
+		branchInfo.SetAheadBy(ahead)
+		branchInfo.SetBehindBy(behind)
 	}
 
 	// Get commit count relative to parent
 	if parent != "" {
 		commits, err := e.GetBranchCommits(ctx, repoPath, branchName, parent)
-		if err == nil {
-			branchInfo.SetCommitCount(len(commits))
+		if err != nil {
+			return nil, err
 		}
+		branchInfo.SetCommitCount(len(commits))
 	}
 
 	return branchInfo, nil
 }
 
+// resolvesToCommit returns true if ref names an existing commit.
+func (e *ExecOperations) resolvesToCommit(ctx context.Context, repoPath, ref string) bool {
+	_, _, err := e.execGit(ctx, repoPath, "rev-parse", "-q", "--verify", ref+"^{commit}")
+	return err == nil
+}
+
 // getUpstreamBranch returns the upstream tracking branch.
 func (e *ExecOperations) getUpstreamBranch(ctx context.Context, repoPath, branch string) (string, error) {
 	stdout, _, err := e.execGit(ctx, repoPath, "rev-parse", "--abbrev-ref", branch+"@{upstream}")
@@ -820,78 +1820,259 @@ func (e *ExecOperations) GetBranchCommits(ctx context.Context, repoPath, branch,
 	return parseLog(stdout), nil
 }
 
-// ListBranches returns all local and optionally remote branches.
-func (e *ExecOperations) ListBranches(ctx context.Context, repoPath string, includeRemote bool) ([]string, error) {
-	args := []string{"branch", "--list"}
-	if includeRemote {
-		args = append(args, "-a")
+// GetCommitsBetween returns non-merge commits reachable from b but not from a.
+func (e *ExecOperations) GetCommitsBetween(ctx context.Context, repoPath, a, b string) ([]CommitInfo, error) {
+	if a == "" || b == "" {
+		return nil, errors.New("refs cannot be empty")
 	}
 
-	stdout, stderr, err := e.execGit(ctx, repoPath, args...)
+	format := "--pretty=format:%H%n%an%n%aI%n%s%n---END---"
+	revRange := fmt.Sprintf("%s..%s", a, b)
+
+	stdout, stderr, err := e.execGit(ctx, repoPath, "log", "--no-merges", revRange, format)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list branches: %s: %w", stderr, err)
+		if strings.Contains(stderr, "unknown revision") || strings.Contains(stderr, "bad revision") {
+			return nil, fmt.Errorf("unknown ref in range %s", revRange)
+		}
+		return nil, fmt.Errorf("failed to get commits between %s and %s: %s: %w", a, b, stderr, err)
 	}
 
-	lines := strings.Split(strings.TrimSpace(stdout), "\n")
-	branches := make([]string, 0, len(lines))
-
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" {
-			continue
-		}
+	return parseLog(stdout), nil
+}
 
-		// Remove * marker for current branch
-		line = strings.TrimPrefix(line, "* ")
+// graphFieldSep separates fields in the commit graph format. Lines without it
+// are graph connector lines between commits.
+const graphFieldSep = "\x1f"
 
-		// Remove remotes/ prefix if present
-		line = strings.TrimPrefix(line, "remotes/")
+// defaultGraphLimit bounds the commit graph when no limit is given.
+const defaultGraphLimit = 100
 
-		branches = append(branches, line)
+// GetCommitGraph returns up to limit commits reachable from refs as drawn by
+// git log --graph, with the branches, tags, and HEAD decorating each commit.
+// If refs is empty, commits from all refs are included (--all).
+func (e *ExecOperations) GetCommitGraph(ctx context.Context, repoPath string, refs []string, limit int) (*domain.CommitGraph, error) {
+	stdout, stderr, err := e.execGit(ctx, repoPath, commitGraphArgs(refs, limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit graph: %s: %w", stderr, err)
 	}
 
-	return branches, nil
+	return parseCommitGraph(stdout), nil
 }
 
-// GetDivergence returns how many commits ahead/behind branch1 is compared to branch2.
-func (e *ExecOperations) GetDivergence(ctx context.Context, repoPath, branch1, branch2 string) (ahead, behind int, err error) {
-	if branch1 == "" || branch2 == "" {
-		return 0, 0, errors.New("branch names cannot be empty")
+// GetCommitMessage returns the full message (subject and body) of a commit.
+func (e *ExecOperations) GetCommitMessage(ctx context.Context, repoPath, hash string) (string, error) {
+	if hash == "" {
+		return "", errors.New("commit hash cannot be empty")
 	}
 
-	// Use git rev-list --left-right --count to get divergence
-	revRange := fmt.Sprintf("%s...%s", branch2, branch1)
-	stdout, stderr, gitErr := e.execGit(ctx, repoPath, "rev-list", "--left-right", "--count", revRange)
-	if gitErr != nil {
-		return 0, 0, fmt.Errorf("failed to get divergence: %s: %w", stderr, gitErr)
+	stdout, stderr, err := e.execGit(ctx, repoPath, "log", "-1", "--format=%B", hash, "--")
+	if err != nil {
+		return "", fmt.Errorf("failed to get commit message: %s: %w", stderr, err)
 	}
 
-	// Output format: "<behind>\t<ahead>"
-	parts := strings.Fields(strings.TrimSpace(stdout))
-	if len(parts) != 2 {
-		return 0, 0, fmt.Errorf("unexpected output format: %s", stdout)
+	return stdout, nil
+}
+
+// commitGraphArgs builds the git log arguments for GetCommitGraph. Refs are
+// deduplicated and passed before "--" so they are never read as paths.
+func commitGraphArgs(refs []string, limit int) []string {
+	if limit <= 0 {
+		limit = defaultGraphLimit
 	}
 
-	_, _ = fmt.Sscanf(parts[0], "%d", &behind)
-	_, _ = fmt.Sscanf(parts[1], "%d", &ahead)
+	// --decorate=full keeps refs/heads/ and refs/remotes/ prefixes so local
+	// branches with slashes are not mistaken for remote-tracking ones
+	format := "--format=" + strings.Join([]string{"", "%H", "%h", "%P", "%an", "%ad", "%D", "%s"}, graphFieldSep)
+	args := []string{"log", "--graph", "--decorate=full", "--date=short", fmt.Sprintf("-%d", limit), format}
 
-	return ahead, behind, nil
-}
+	seen := make(map[string]bool)
+	var revs []string
+	for _, ref := range refs {
+		ref = strings.TrimSpace(ref)
+		if ref == "" || seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		revs = append(revs, ref)
+	}
 
-// GetParentBranch returns the parent branch for the given branch from git config.
-func (e *ExecOperations) GetParentBranch(ctx context.Context, repoPath, branch string) (string, error) {
-	if branch == "" {
-		return "", errors.New("branch name cannot be empty")
+	if len(revs) == 0 {
+		return append(args, "--all")
 	}
+	args = append(args, revs...)
+	return append(args, "--")
+}
 
-	configKey := fmt.Sprintf("branch.%s.parent", branch)
-	stdout, _, err := e.execGit(ctx, repoPath, "config", "--get", configKey)
-	if err != nil {
-		// Config key not found is not an error, just means no parent set
-		return "", nil
+// parseCommitGraph parses the output of GetCommitGraph's git log command.
+func parseCommitGraph(output string) *domain.CommitGraph {
+	graph := &domain.CommitGraph{}
+
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Split(line, graphFieldSep)
+		if len(fields) < 8 {
+			continue // Connector line ("|\", "|/") or blank
+		}
+
+		node := domain.CommitNode{
+			GraphLine: strings.TrimRight(fields[0], " "),
+			Hash:      fields[1],
+			ShortHash: fields[2],
+			Parents:   strings.Fields(fields[3]),
+			Author:    fields[4],
+			Date:      fields[5],
+			// The subject may itself contain the separator
+			Message: strings.Join(fields[7:], graphFieldSep),
+		}
+		applyRefDecorations(&node, fields[6])
+
+		graph.Nodes = append(graph.Nodes, node)
 	}
 
-	return strings.TrimSpace(stdout), nil
+	return graph
+}
+
+// applyRefDecorations fills the ref fields of node from a %D decoration such as
+// "HEAD -> refs/heads/main, refs/remotes/origin/main, tag: refs/tags/v1.0".
+// Short (non-full) decorations are accepted too; unprefixed names are then
+// assumed to be local branches.
+func applyRefDecorations(node *domain.CommitNode, decoration string) {
+	for _, ref := range strings.Split(decoration, ", ") {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			continue
+		}
+
+		switch {
+		case ref == "HEAD":
+			node.IsHead = true
+			node.DetachedHead = true
+
+		case strings.HasPrefix(ref, "HEAD -> "):
+			node.IsHead = true
+			branch := strings.TrimPrefix(strings.TrimPrefix(ref, "HEAD -> "), "refs/heads/")
+			node.HeadBranch = branch
+			node.LocalBranches = append(node.LocalBranches, branch)
+
+		case strings.HasPrefix(ref, "tag: "):
+			node.Tags = append(node.Tags, strings.TrimPrefix(strings.TrimPrefix(ref, "tag: "), "refs/tags/"))
+
+		case strings.HasPrefix(ref, "refs/tags/"):
+			node.Tags = append(node.Tags, strings.TrimPrefix(ref, "refs/tags/"))
+
+		case strings.HasPrefix(ref, "refs/remotes/"):
+			remote := strings.TrimPrefix(ref, "refs/remotes/")
+			// The remote's default-branch pointer (origin/HEAD) is not a branch
+			if strings.HasSuffix(remote, "/HEAD") {
+				continue
+			}
+			node.RemoteBranches = append(node.RemoteBranches, remote)
+
+		case strings.HasPrefix(ref, "refs/heads/"):
+			node.LocalBranches = append(node.LocalBranches, strings.TrimPrefix(ref, "refs/heads/"))
+
+		case strings.HasPrefix(ref, "refs/"):
+			// Other namespaces (refs/stash, refs/notes, ...) are not shown
+
+		default:
+			node.LocalBranches = append(node.LocalBranches, ref)
+		}
+	}
+}
+
+// ListBranches returns all local and optionally remote branches.
+func (e *ExecOperations) ListBranches(ctx context.Context, repoPath string, includeRemote bool) ([]string, error) {
+	args := []string{"branch", "--list"}
+	if includeRemote {
+		args = append(args, "-a")
+	}
+
+	stdout, stderr, err := e.execGit(ctx, repoPath, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %s: %w", stderr, err)
+	}
+
+	return parseBranchList(stdout), nil
+}
+
+// parseBranchList extracts branch names from `git branch --list [-a]` output.
+// It drops the current-branch (*) and other-worktree (+) markers and skips
+// pseudo-entries such as "(HEAD detached at abc123)" and "origin/HEAD -> origin/main".
+func parseBranchList(output string) []string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	branches := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		// Remove * marker for current branch, + for branches checked out in other worktrees
+		line = strings.TrimPrefix(line, "* ")
+		line = strings.TrimPrefix(line, "+ ")
+
+		// Detached HEAD or an in-progress rebase/bisect, not a branch
+		if strings.HasPrefix(line, "(") {
+			continue
+		}
+
+		// Symbolic refs like the remote's default branch pointer
+		if strings.Contains(line, " -> ") {
+			continue
+		}
+
+		// Remove remotes/ prefix if present
+		line = strings.TrimPrefix(line, "remotes/")
+
+		branches = append(branches, line)
+	}
+
+	return branches
+}
+
+// GetDivergence returns how many commits ahead/behind branch1 is compared to branch2.
+func (e *ExecOperations) GetDivergence(ctx context.Context, repoPath, branch1, branch2 string) (ahead, behind int, err error) {
+	if branch1 == "" || branch2 == "" {
+		return 0, 0, errors.New("branch names cannot be empty")
+	}
+
+	// --left-right counts commits only reachable from the left side first, so
+	// with branch1 on the left the output is "<ahead>\t<behind>"
+	revRange := fmt.Sprintf("%s...%s", branch1, branch2)
+	stdout, stderr, gitErr := e.execGit(ctx, repoPath, "rev-list", "--left-right", "--count", revRange)
+	if gitErr != nil {
+		return 0, 0, fmt.Errorf("failed to get divergence: %s: %w", stderr, gitErr)
+	}
+
+	parts := strings.Fields(stdout)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("unexpected output format: %s", stdout)
+	}
+
+	if _, err := fmt.Sscanf(parts[0], "%d", &ahead); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse ahead count: %w", err)
+	}
+	if _, err := fmt.Sscanf(parts[1], "%d", &behind); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse behind count: %w", err)
+	}
+
+	return ahead, behind, nil
+}
+
+// GetParentBranch returns the parent branch for the given branch from git config.
+func (e *ExecOperations) GetParentBranch(ctx context.Context, repoPath, branch string) (string, error) {
+	if branch == "" {
+		return "", errors.New("branch name cannot be empty")
+	}
+
+	configKey := fmt.Sprintf("branch.%s.parent", branch)
+	stdout, _, err := e.execGit(ctx, repoPath, "config", "--get", configKey)
+	if err != nil {
+		// Config key not found is not an error, just means no parent set
+		return "", nil
+	}
+
+	return strings.TrimSpace(stdout), nil
 }
 
 // SetParentBranch sets the parent branch for the given branch in git config.
@@ -909,51 +2090,89 @@ func (e *ExecOperations) SetParentBranch(ctx context.Context, repoPath, branch,
 	return nil
 }
 
+// GetMergeTarget returns the merge target remembered for branch in git config
+// (branch.<name>.mergeTarget), or "" if none was chosen.
+func (e *ExecOperations) GetMergeTarget(ctx context.Context, repoPath, branch string) (string, error) {
+	if branch == "" {
+		return "", errors.New("branch name cannot be empty")
+	}
+
+	configKey := fmt.Sprintf("branch.%s.mergeTarget", branch)
+	stdout, _, err := e.execGit(ctx, repoPath, "config", "--get", configKey)
+	if err != nil {
+		// Config key not found: no target remembered
+		return "", nil
+	}
+
+	return strings.TrimSpace(stdout), nil
+}
+
+// SetMergeTarget remembers target as the default merge target for branch in
+// git config. Unlike the parent, it only decides where merges go.
+func (e *ExecOperations) SetMergeTarget(ctx context.Context, repoPath, branch, target string) error {
+	if branch == "" || target == "" {
+		return errors.New("branch and target names cannot be empty")
+	}
+
+	configKey := fmt.Sprintf("branch.%s.mergeTarget", branch)
+	_, stderr, err := e.execGit(ctx, repoPath, "config", configKey, target)
+	if err != nil {
+		return fmt.Errorf("failed to set merge target: %s: %w", stderr, err)
+	}
+
+	return nil
+}
+
+// GetBaseBranch returns the base branch set for the repository in git config
+// (gitmind.baseBranch), or "" if none was set.
+func (e *ExecOperations) GetBaseBranch(ctx context.Context, repoPath string) (string, error) {
+	stdout, _, err := e.execGit(ctx, repoPath, "config", "--get", "gitmind.baseBranch")
+	if err != nil {
+		// Config key not found: no base set
+		return "", nil
+	}
+
+	return strings.TrimSpace(stdout), nil
+}
+
+// SetBaseBranch sets the base branch for the repository in git config.
+func (e *ExecOperations) SetBaseBranch(ctx context.Context, repoPath, base string) error {
+	if base == "" {
+		return errors.New("base branch name cannot be empty")
+	}
+
+	_, stderr, err := e.execGit(ctx, repoPath, "config", "gitmind.baseBranch", base)
+	if err != nil {
+		return fmt.Errorf("failed to set base branch: %s: %w", stderr, err)
+	}
+
+	return nil
+}
+
 // Merge merges sourceBranch into the current branch using the specified strategy.
 func (e *ExecOperations) Merge(ctx context.Context, repoPath, sourceBranch, strategy, message string) error {
 	if sourceBranch == "" {
 		return errors.New("source branch cannot be empty")
 	}
 
-	args := []string{"merge"}
-
-	// Apply strategy
-	switch strategy {
-	case "squash":
-		args = append(args, "--squash")
-	case "fast-forward":
-		args = append(args, "--ff-only")
-	case "regular":
-		args = append(args, "--no-ff")
-	case "rebase":
-		// Rebase is different from merge, handle separately
+	// Rebase is different from merge, handle separately
+	if strategy == "rebase" {
 		return e.rebaseBranch(ctx, repoPath, sourceBranch)
-	default:
-		// Default merge (allows fast-forward if possible)
-	}
-
-	// Add message if provided (and not squash, as squash requires separate commit)
-	if message != "" && strategy != "squash" {
-		args = append(args, "-m", message)
 	}
 
-	// Add source branch
-	args = append(args, sourceBranch)
-
-	_, stderr, err := e.execGit(ctx, repoPath, args...)
+	stdout, stderr, err := e.execGit(ctx, repoPath, MergeArgs(sourceBranch, strategy, message)...)
 	if err != nil {
-		if strings.Contains(stderr, "CONFLICT") {
-			return fmt.Errorf("merge conflict: %s", stderr)
+		// git reports the conflicting files on stdout
+		output := stdout + "\n" + stderr
+		if strings.Contains(output, "CONFLICT") {
+			return &MergeConflictError{Source: sourceBranch, Files: parseConflictFiles(output)}
 		}
 		return fmt.Errorf("merge failed: %s: %w", stderr, err)
 	}
 
 	// For squash merge, we need to commit separately
 	if strategy == "squash" {
-		if message == "" {
-			message = fmt.Sprintf("Merge branch '%s' (squashed)", sourceBranch)
-		}
-		if err := e.Commit(ctx, repoPath, message, nil); err != nil {
+		if err := e.Commit(ctx, repoPath, SquashMessage(sourceBranch, message), nil); err != nil {
 			return fmt.Errorf("failed to commit squashed merge: %w", err)
 		}
 	}
@@ -961,24 +2180,258 @@ func (e *ExecOperations) Merge(ctx context.Context, repoPath, sourceBranch, stra
 	return nil
 }
 
+// MergeConflictError is returned when merging Source conflicts. The merge is
+// left in progress so the conflicts can be resolved and the merge continued
+// with ContinueMerge, or abandoned with AbortMerge.
+type MergeConflictError struct {
+	Source string
+	Files  []string
+}
+
+func (e *MergeConflictError) Error() string {
+	if len(e.Files) == 0 {
+		return fmt.Sprintf("merge conflict: merging %s conflicts", e.Source)
+	}
+	return fmt.Sprintf("merge conflict in %s", strings.Join(e.Files, ", "))
+}
+
+// GetConflictedFiles lists the files with unresolved conflicts in an
+// in-progress merge.
+func (e *ExecOperations) GetConflictedFiles(ctx context.Context, repoPath string) ([]string, error) {
+	stdout, stderr, err := e.execGit(ctx, repoPath, "diff", "--name-only", "--diff-filter=U")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conflicted files: %s: %w", stderr, err)
+	}
+
+	var files []string
+	for _, line := range strings.Split(stdout, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
+
+// ContinueMerge concludes a merge whose conflicts have been resolved and
+// staged. An empty message keeps the one git prepared for the merge.
+func (e *ExecOperations) ContinueMerge(ctx context.Context, repoPath, message string) error {
+	args := []string{"commit", "--no-edit"}
+	if message != "" {
+		args = []string{"commit", "-m", message}
+	}
+	_, stderr, err := e.execGit(ctx, repoPath, args...)
+	if err != nil {
+		return fmt.Errorf("failed to continue merge: %s: %w", stderr, err)
+	}
+	return nil
+}
+
+// RevertCommit undoes a commit with git revert. With noCommit the reverting
+// changes are staged instead of committed. A revert that conflicts returns a
+// *RevertConflictError and leaves the revert in progress to resolve or abort.
+func (e *ExecOperations) RevertCommit(ctx context.Context, repoPath, hash string, noCommit bool) error {
+	if hash == "" {
+		return errors.New("commit hash cannot be empty")
+	}
+
+	stdout, stderr, err := e.execGit(ctx, repoPath, revertArgs(hash, noCommit)...)
+	if err != nil {
+		// git reports the conflicting files on stdout, the failure on stderr
+		output := stdout + "\n" + stderr
+		if strings.Contains(output, "CONFLICT") {
+			return &RevertConflictError{Hash: hash, Files: parseConflictFiles(output)}
+		}
+		return fmt.Errorf("revert failed: %s: %w", stderr, err)
+	}
+	return nil
+}
+
+// revertArgs builds the git revert command for hash.
+func revertArgs(hash string, noCommit bool) []string {
+	if noCommit {
+		return []string{"revert", "--no-commit", hash}
+	}
+	return []string{"revert", "--no-edit", hash}
+}
+
+// RevertConflictError is returned when reverting a commit conflicts with later
+// changes. Files lists the conflicting paths.
+type RevertConflictError struct {
+	Hash  string
+	Files []string
+}
+
+func (e *RevertConflictError) Error() string {
+	if len(e.Files) == 0 {
+		return fmt.Sprintf("revert conflict: reverting %s conflicts with later changes", e.Hash)
+	}
+	return fmt.Sprintf("revert conflict in %s", strings.Join(e.Files, ", "))
+}
+
+// CherryPick applies commits onto the current branch with git cherry-pick,
+// in the order given. If a commit conflicts, the whole cherry-pick is aborted,
+// so none of the commits are applied, and a *CherryPickConflictError is returned.
+func (e *ExecOperations) CherryPick(ctx context.Context, repoPath string, hashes []string) error {
+	if len(hashes) == 0 {
+		return errors.New("no commits to cherry-pick")
+	}
+
+	args := append([]string{"cherry-pick"}, hashes...)
+	stdout, stderr, err := e.execGit(ctx, repoPath, args...)
+	if err != nil {
+		output := stdout + "\n" + stderr
+		if strings.Contains(output, "CONFLICT") {
+			conflict := &CherryPickConflictError{
+				Commit: parseFailedPick(stderr),
+				Files:  parseConflictFiles(output),
+			}
+			if abortErr := e.CherryPickAbort(ctx, repoPath); abortErr != nil {
+				return fmt.Errorf("%w (abort failed: %v)", conflict, abortErr)
+			}
+			return conflict
+		}
+		// Leave no half-applied sequence behind, e.g. when a pick becomes empty
+		_ = e.CherryPickAbort(ctx, repoPath)
+		return fmt.Errorf("cherry-pick failed: %s: %w", stderr, err)
+	}
+	return nil
+}
+
+// CherryPickAbort abandons an in-progress cherry-pick, restoring the branch
+// to where it was before the cherry-pick started.
+func (e *ExecOperations) CherryPickAbort(ctx context.Context, repoPath string) error {
+	_, stderr, err := e.execGit(ctx, repoPath, "cherry-pick", "--abort")
+	if err != nil {
+		// It's okay if there's no cherry-pick in progress
+		if strings.Contains(stderr, "no cherry-pick") {
+			return nil
+		}
+		return fmt.Errorf("failed to abort cherry-pick: %s: %w", stderr, err)
+	}
+	return nil
+}
+
+// parseFailedPick extracts the abbreviated hash of the commit that stopped a
+// cherry-pick from "error: could not apply <hash>... <subject>".
+func parseFailedPick(stderr string) string {
+	for _, line := range strings.Split(stderr, "\n") {
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(line), "error: could not apply "); ok {
+			hash, _, _ := strings.Cut(rest, "...")
+			return hash
+		}
+	}
+	return ""
+}
+
+// CherryPickConflictError is returned when a cherry-picked commit conflicts
+// with the current branch. The cherry-pick has been aborted.
+type CherryPickConflictError struct {
+	Commit string // Abbreviated hash of the conflicting commit, if git reported it
+	Files  []string
+}
+
+func (e *CherryPickConflictError) Error() string {
+	msg := "cherry-pick conflict"
+	if e.Commit != "" {
+		msg += " applying " + e.Commit
+	}
+	if len(e.Files) > 0 {
+		msg += " in " + strings.Join(e.Files, ", ")
+	}
+	return msg
+}
+
 // rebaseBranch rebases the current branch onto the source branch.
 func (e *ExecOperations) rebaseBranch(ctx context.Context, repoPath, sourceBranch string) error {
-	_, stderr, err := e.execGit(ctx, repoPath, "rebase", sourceBranch)
+	stdout, stderr, err := e.execGit(ctx, repoPath, MergeArgs(sourceBranch, "rebase", "")...)
 	if err != nil {
-		if strings.Contains(stderr, "CONFLICT") {
-			return fmt.Errorf("rebase conflict: %s", stderr)
+		output := stdout + "\n" + stderr
+		if strings.Contains(output, "CONFLICT") {
+			return &RebaseConflictError{Files: parseConflictFiles(output)}
 		}
 		return fmt.Errorf("rebase failed: %s: %w", stderr, err)
 	}
 	return nil
 }
 
-// CanMerge checks if sourceBranch can be merged into targetBranch without conflicts.
+// ContinueRebase continues a rebase whose conflicts have been resolved and
+// staged, keeping each commit's message. It returns a *RebaseConflictError
+// when a later commit conflicts too.
+func (e *ExecOperations) ContinueRebase(ctx context.Context, repoPath string) error {
+	// core.editor=true accepts the message git would otherwise open an editor for
+	stdout, stderr, err := e.execGit(ctx, repoPath, "-c", "core.editor=true", "rebase", "--continue")
+	if err != nil {
+		output := stdout + "\n" + stderr
+		if strings.Contains(output, "CONFLICT") {
+			return &RebaseConflictError{Files: parseConflictFiles(output)}
+		}
+		return fmt.Errorf("failed to continue rebase: %s: %w", stderr, err)
+	}
+	return nil
+}
+
+// CanMerge checks if sourceBranch can be merged into targetBranch without
+// conflicts. The trial merge runs in git merge-tree, leaving the working tree
+// and HEAD alone. On git older than 2.38, which lacks merge-tree
+// --write-tree, it falls back to a trial merge in a checkout of targetBranch,
+// refused when the working tree has uncommitted changes.
 func (e *ExecOperations) CanMerge(ctx context.Context, repoPath, sourceBranch, targetBranch string) (bool, []string, error) {
 	if sourceBranch == "" || targetBranch == "" {
 		return false, nil, errors.New("branch names cannot be empty")
 	}
 
+	// Exits 0 for a clean merge and 1 for conflicts, listing the conflicted files
+	stdout, stderr, err := e.execGit(ctx, repoPath, "merge-tree", "--write-tree", "--name-only", "--no-messages", targetBranch, sourceBranch)
+	if err == nil {
+		return true, nil, nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		return false, parseMergeTreeConflicts(stdout), nil
+	}
+	if !mergeTreeUnsupported(stderr) {
+		return false, nil, fmt.Errorf("merge preview failed: %s: %w", stderr, err)
+	}
+
+	// Checking out the target would carry uncommitted changes along, or fail
+	status, stderr, err := e.execGit(ctx, repoPath, "status", "--porcelain", "--untracked-files=no")
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to get status: %s: %w", stderr, err)
+	}
+	if status != "" {
+		return false, nil, errors.New("cannot preview the merge: git is older than 2.38 and the working tree has uncommitted changes; commit or stash them first")
+	}
+	return e.canMergeInCheckout(ctx, repoPath, sourceBranch, targetBranch)
+}
+
+// mergeTreeUnsupported reports whether git rejected merge-tree --write-tree,
+// printing its usage as git before 2.38 does
+func mergeTreeUnsupported(stderr string) bool {
+	return strings.Contains(stderr, "usage: git merge-tree") || strings.Contains(stderr, "unknown option")
+}
+
+// parseMergeTreeConflicts parses git merge-tree --write-tree --name-only
+// output: the merged tree's hash, then each conflicted file.
+func parseMergeTreeConflicts(output string) []string {
+	lines := strings.Split(output, "\n")
+	var conflicts []string
+	seen := make(map[string]bool)
+	for _, line := range lines[1:] {
+		if line == "" {
+			break // Informational messages follow, unless --no-messages
+		}
+		if !seen[line] {
+			seen[line] = true
+			conflicts = append(conflicts, line)
+		}
+	}
+	return conflicts
+}
+
+// canMergeInCheckout previews the merge by checking out targetBranch and
+// merging without committing, then aborting and returning to the branch
+// that was checked out.
+func (e *ExecOperations) canMergeInCheckout(ctx context.Context, repoPath, sourceBranch, targetBranch string) (bool, []string, error) {
 	// Save current branch
 	currentBranch, err := e.GetCurrentBranch(ctx, repoPath)
 	if err != nil {
@@ -1045,6 +2498,19 @@ func (e *ExecOperations) AbortMerge(ctx context.Context, repoPath string) error
 	return nil
 }
 
+// AbortRebase aborts an in-progress rebase, restoring the branch as it was.
+func (e *ExecOperations) AbortRebase(ctx context.Context, repoPath string) error {
+	_, stderr, err := e.execGit(ctx, repoPath, "rebase", "--abort")
+	if err != nil {
+		// It's okay if there's no rebase in progress
+		if strings.Contains(stderr, "No rebase in progress") {
+			return nil
+		}
+		return fmt.Errorf("failed to abort rebase: %s: %w", stderr, err)
+	}
+	return nil
+}
+
 // IsGitHubRemote returns true if the remote URL is a GitHub repository.
 func IsGitHubRemote(remoteURL string) bool {
 	if remoteURL == "" {
@@ -1139,7 +2605,7 @@ func (e *ExecOperations) DeleteRemoteBranch(ctx context.Context, repoPath, remot
 	}
 
 	// Use git push <remote> --delete <branch>
-	_, stderr, err := e.execGit(ctx, repoPath, "push", remoteName, "--delete", branchName)
+	_, stderr, err := e.execGitNetwork(ctx, repoPath, "push", remoteName, "--delete", branchName)
 	if err != nil {
 		if strings.Contains(stderr, "remote ref does not exist") ||
 			strings.Contains(stderr, "unable to delete") {
@@ -1212,3 +2678,315 @@ func (e *ExecOperations) SetUpstreamBranch(ctx context.Context, repoPath, branch
 
 	return nil
 }
+
+// ListTags returns tag names, newest first.
+func (e *ExecOperations) ListTags(ctx context.Context, repoPath string) ([]string, error) {
+	stdout, stderr, err := e.execGit(ctx, repoPath, "tag", "--list", "--sort=-creatordate")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %s: %w", stderr, err)
+	}
+
+	var tags []string
+	for _, line := range strings.Split(stdout, "\n") {
+		if tag := strings.TrimSpace(line); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags, nil
+}
+
+// CreateTag creates a tag at HEAD: annotated (git tag -a) when annotated is set,
+// lightweight otherwise.
+func (e *ExecOperations) CreateTag(ctx context.Context, repoPath, name, message string, annotated bool) error {
+	if name == "" {
+		return errors.New("tag name cannot be empty")
+	}
+
+	_, stderr, err := e.execGit(ctx, repoPath, tagArgs(name, message, annotated)...)
+	if err != nil {
+		if strings.Contains(stderr, "already exists") {
+			return fmt.Errorf("tag '%s' already exists", name)
+		}
+		if strings.Contains(stderr, "is not a valid tag name") {
+			return fmt.Errorf("'%s' is not a valid tag name", name)
+		}
+		return fmt.Errorf("failed to create tag: %s: %w", stderr, err)
+	}
+
+	return nil
+}
+
+// tagArgs builds the git arguments for an unsigned tag.
+// Annotated tags need a message, so the tag name is used when none is given.
+func tagArgs(name, message string, annotated bool) []string {
+	if !annotated {
+		return []string{"tag", name}
+	}
+	if message == "" {
+		message = name
+	}
+	return []string{"tag", "-a", "-m", message, name}
+}
+
+// CreateSignedTag creates a GPG-signed annotated tag at HEAD.
+func (e *ExecOperations) CreateSignedTag(ctx context.Context, repoPath, name, message, signingKey string) error {
+	if name == "" {
+		return errors.New("tag name cannot be empty")
+	}
+
+	_, stderr, err := e.execGit(ctx, repoPath, signedTagArgs(name, message, signingKey)...)
+	if err != nil {
+		if strings.Contains(stderr, "already exists") {
+			return fmt.Errorf("tag '%s' already exists", name)
+		}
+		if strings.Contains(stderr, "gpg failed") || strings.Contains(stderr, "secret key not available") {
+			return fmt.Errorf("failed to sign tag '%s' (check your GPG key and signing_key setting): %s: %w", name, stderr, err)
+		}
+		return fmt.Errorf("failed to create tag: %s: %w", stderr, err)
+	}
+
+	return nil
+}
+
+// signedTagArgs builds the git arguments for a signed tag.
+// The tag name doubles as the message when none is given, since signed tags must be annotated.
+func signedTagArgs(name, message, signingKey string) []string {
+	if message == "" {
+		message = name
+	}
+
+	args := []string{"tag", "-s"}
+	if signingKey != "" {
+		args = append(args, "-u", signingKey)
+	}
+	return append(args, "-m", message, name)
+}
+
+// VerifyTag checks a tag's signature with git tag -v.
+func (e *ExecOperations) VerifyTag(ctx context.Context, repoPath, name string) (*domain.TagVerification, error) {
+	if name == "" {
+		return nil, errors.New("tag name cannot be empty")
+	}
+
+	// gpg writes its status to stderr; a non-zero exit just means the signature didn't verify
+	_, stderr, err := e.execGit(ctx, repoPath, "tag", "-v", name)
+	if err != nil && strings.Contains(stderr, "not found") {
+		return nil, fmt.Errorf("tag '%s' not found", name)
+	}
+
+	return parseTagVerification(name, stderr, err), nil
+}
+
+// parseTagVerification interprets the output of git tag -v.
+func parseTagVerification(name, output string, verifyErr error) *domain.TagVerification {
+	result := &domain.TagVerification{Tag: name, Detail: output}
+
+	switch {
+	case strings.Contains(output, "no signature found"):
+		result.Status = domain.TagSignatureUnsigned
+	case strings.Contains(output, "BAD signature"):
+		result.Status = domain.TagSignatureBad
+	case strings.Contains(output, "No public key") || strings.Contains(output, "Can't check signature"):
+		result.Status = domain.TagSignatureUnknownKey
+	case strings.Contains(output, "Good signature") && verifyErr == nil:
+		result.Status = domain.TagSignatureGood
+		result.Signer = parseGoodSignatureSigner(output)
+	default:
+		result.Status = domain.TagSignatureError
+		if output == "" && verifyErr != nil {
+			result.Detail = verifyErr.Error()
+		}
+	}
+
+	return result
+}
+
+// parseGoodSignatureSigner extracts X from a gpg line like `Good signature from "X" [ultimate]`.
+func parseGoodSignatureSigner(output string) string {
+	for _, line := range strings.Split(output, "\n") {
+		idx := strings.Index(line, "Good signature from")
+		if idx < 0 {
+			continue
+		}
+		rest := line[idx+len("Good signature from"):]
+		if start := strings.Index(rest, "\""); start >= 0 {
+			if end := strings.Index(rest[start+1:], "\""); end >= 0 {
+				return rest[start+1 : start+1+end]
+			}
+		}
+		return strings.TrimSpace(rest)
+	}
+	return ""
+}
+
+// ListHooks returns the names of the hooks git will run, honoring core.hooksPath.
+// Only executable scripts count; git skips the rest (and the *.sample templates).
+func (e *ExecOperations) ListHooks(ctx context.Context, repoPath string) ([]string, error) {
+	// --git-path resolves core.hooksPath when set, relative to repoPath
+	stdout, stderr, err := e.execGit(ctx, repoPath, "rev-parse", "--git-path", "hooks")
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate hooks directory: %s: %w", stderr, err)
+	}
+
+	hooksDir := stdout
+	if !filepath.IsAbs(hooksDir) {
+		hooksDir = filepath.Join(repoPath, hooksDir)
+	}
+
+	entries, err := os.ReadDir(hooksDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read hooks directory: %w", err)
+	}
+
+	var hooks []string
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasSuffix(entry.Name(), ".sample") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		// Windows has no executable bit; git for Windows runs any hook script
+		if runtime.GOOS != "windows" && info.Mode().Perm()&0111 == 0 {
+			continue
+		}
+		hooks = append(hooks, entry.Name())
+	}
+	sort.Strings(hooks)
+
+	return hooks, nil
+}
+
+// GitPath returns the absolute path of name inside the git directory.
+func (e *ExecOperations) GitPath(ctx context.Context, repoPath, name string) (string, error) {
+	stdout, stderr, err := e.execGit(ctx, repoPath, "rev-parse", "--git-path", name)
+	if err != nil {
+		return "", fmt.Errorf("failed to locate %s: %s: %w", name, stderr, err)
+	}
+
+	if !filepath.IsAbs(stdout) {
+		stdout = filepath.Join(repoPath, stdout)
+	}
+	return stdout, nil
+}
+
+// GetCommitTemplate returns the content of the commit.template file, or "" when
+// none is configured. Relative paths are resolved against repoPath.
+func (e *ExecOperations) GetCommitTemplate(ctx context.Context, repoPath string) (string, error) {
+	// --path expands a leading ~ like git commit does
+	stdout, _, err := e.execGit(ctx, repoPath, "config", "--get", "--path", "commit.template")
+	if err != nil || stdout == "" {
+		// Config key not found is not an error, just means no template
+		return "", nil
+	}
+
+	templatePath := stdout
+	if !filepath.IsAbs(templatePath) {
+		templatePath = filepath.Join(repoPath, templatePath)
+	}
+
+	content, err := os.ReadFile(templatePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read commit template %s: %w", templatePath, err)
+	}
+
+	return strings.TrimSpace(string(content)), nil
+}
+
+// Stash saves uncommitted changes to a new stash entry.
+func (e *ExecOperations) Stash(ctx context.Context, repoPath, message string) error {
+	args := []string{"stash", "push"}
+	if message != "" {
+		args = append(args, "-m", message)
+	}
+
+	stdout, stderr, err := e.execGit(ctx, repoPath, args...)
+	if err != nil {
+		return fmt.Errorf("failed to stash changes: %s: %w", stderr, err)
+	}
+	// git exits 0 without creating an entry when there is nothing to stash
+	if strings.Contains(stdout, "No local changes to save") {
+		return errors.New("no local changes to stash")
+	}
+
+	return nil
+}
+
+// StashList returns the stash entries, newest first.
+func (e *ExecOperations) StashList(ctx context.Context, repoPath string) ([]StashEntry, error) {
+	stdout, stderr, err := e.execGit(ctx, repoPath, "stash", "list")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stashes: %s: %w", stderr, err)
+	}
+
+	return parseStashList(stdout), nil
+}
+
+// StashApply applies stash@{index} to the working tree, keeping the entry.
+func (e *ExecOperations) StashApply(ctx context.Context, repoPath string, index int) error {
+	if index < 0 {
+		return fmt.Errorf("invalid stash index: %d", index)
+	}
+
+	_, stderr, err := e.execGit(ctx, repoPath, "stash", "apply", stashRef(index))
+	if err != nil {
+		return fmt.Errorf("failed to apply %s: %s: %w", stashRef(index), stderr, err)
+	}
+
+	return nil
+}
+
+// StashPop applies stash@{index} and drops it from the stash.
+// On conflicts git keeps the entry, so nothing is lost.
+func (e *ExecOperations) StashPop(ctx context.Context, repoPath string, index int) error {
+	if index < 0 {
+		return fmt.Errorf("invalid stash index: %d", index)
+	}
+
+	_, stderr, err := e.execGit(ctx, repoPath, "stash", "pop", stashRef(index))
+	if err != nil {
+		return fmt.Errorf("failed to pop %s: %s: %w", stashRef(index), stderr, err)
+	}
+
+	return nil
+}
+
+// stashRef returns the git reference for the stash entry at index.
+func stashRef(index int) string {
+	return fmt.Sprintf("stash@{%d}", index)
+}
+
+// parseStashList parses git stash list output. Entries look like
+// "stash@{0}: On main: message" for stashes made with a message and
+// "stash@{1}: WIP on main: abc1234 last commit subject" for those without.
+func parseStashList(output string) []StashEntry {
+	var entries []StashEntry
+	for _, line := range strings.Split(output, "\n") {
+		ref, rest, found := strings.Cut(strings.TrimSpace(line), ": ")
+		if !found {
+			continue
+		}
+		var index int
+		if _, err := fmt.Sscanf(ref, "stash@{%d}", &index); err != nil {
+			continue
+		}
+
+		entry := StashEntry{Index: index, Message: rest}
+		for _, prefix := range []string{"On ", "WIP on "} {
+			if strings.HasPrefix(rest, prefix) {
+				if branch, message, ok := strings.Cut(strings.TrimPrefix(rest, prefix), ": "); ok {
+					entry.Branch = branch
+					entry.Message = message
+				}
+				break
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
@@ -2,9 +2,14 @@ package git
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/yourusername/gitman/internal/domain"
 )
@@ -151,94 +156,1965 @@ func TestParseStatus_StatusCodes(t *testing.T) {
 	}
 }
 
+func TestParseStatus_TwoColumnCodes(t *testing.T) {
+	ops := NewExecOperations()
+
+	tests := []struct {
+		name         string
+		statusLine   string
+		wantStatus   domain.ChangeStatus
+		wantStaged   bool
+		wantUnstaged bool
+	}{
+		{"staged and unstaged", "MM both.go", domain.StatusModified, true, true},
+		{"staged only", "M  staged.go", domain.StatusModified, true, false},
+		{"unstaged only", " M unstaged.go", domain.StatusModified, false, true},
+		{"added then edited", "AM new.go", domain.StatusAdded, true, true},
+		{"deleted in working tree", " D gone.go", domain.StatusDeleted, false, true},
+		{"untracked", "?? notes.txt", domain.StatusUntracked, false, true},
+		{"added", "A  new.go", domain.StatusAdded, true, false},
+		{"added then deleted", "AD gone.go", domain.StatusAdded, true, true},
+		{"staged deletion", "D  old.go", domain.StatusDeleted, true, false},
+		{"modified then deleted", "MD gone.go", domain.StatusModified, true, true},
+		{"renamed", "R  old.go -> new.go", domain.StatusRenamed, true, false},
+		{"renamed then edited", "RM old.go -> new.go", domain.StatusRenamed, true, true},
+		{"copied", "C  a.go -> b.go", domain.StatusAdded, true, false},
+		{"type changed", " T link", domain.StatusModified, false, true},
+		{"both modified conflict", "UU merge.go", domain.StatusModified, false, true},
+		{"both added conflict", "AA merge.go", domain.StatusModified, false, true},
+		{"both deleted conflict", "DD merge.go", domain.StatusModified, false, true},
+		{"deleted by them conflict", "UD merge.go", domain.StatusModified, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := ops.parseStatus(tt.statusLine)
+			if err != nil {
+				t.Fatalf("parseStatus() error = %v", err)
+			}
+			if len(changes) != 1 {
+				t.Fatalf("parseStatus() returned %d changes, want 1", len(changes))
+			}
+			change := changes[0]
+			if change.Status != tt.wantStatus || change.Staged != tt.wantStaged || change.Unstaged != tt.wantUnstaged {
+				t.Errorf("parseStatus(%q) = %s staged=%v unstaged=%v, want %s staged=%v unstaged=%v",
+					tt.statusLine, change.Status, change.Staged, change.Unstaged, tt.wantStatus, tt.wantStaged, tt.wantUnstaged)
+			}
+		})
+	}
+}
+
+func TestParseStatus_SkipsIgnored(t *testing.T) {
+	changes, err := NewExecOperations().parseStatus("!! build/\n M main.go")
+	if err != nil {
+		t.Fatalf("parseStatus() error = %v", err)
+	}
+	if len(changes) != 1 || changes[0].Path != "main.go" {
+		t.Errorf("parseStatus() = %+v, want only main.go", changes)
+	}
+}
+
+func TestExecOperations_GetStatus_UnstagedFirst(t *testing.T) {
+	repo := t.TempDir()
+	ops := NewExecOperations()
+	ctx := context.Background()
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"config", "user.email", "test@example.com"},
+		{"config", "user.name", "Test"},
+	} {
+		if _, stderr, err := ops.execGit(ctx, repo, args...); err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+	}
+	path := filepath.Join(repo, "main.go")
+	if err := os.WriteFile(path, []byte("package main\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, stderr, err := ops.execGit(ctx, repo, "add", "main.go"); err != nil {
+		t.Fatalf("git add: %s: %v", stderr, err)
+	}
+	if _, stderr, err := ops.execGit(ctx, repo, "commit", "-q", "-m", "init"); err != nil {
+		t.Fatalf("git commit: %s: %v", stderr, err)
+	}
+	if err := os.WriteFile(path, []byte("package main\n\nfunc main() {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// " M main.go" is the first line; its leading space must survive
+	repository, err := ops.GetStatus(ctx, repo)
+	if err != nil {
+		t.Fatalf("GetStatus() error = %v", err)
+	}
+	changes := repository.Changes()
+	if len(changes) != 1 || changes[0].Path != "main.go" || changes[0].Staged || !changes[0].Unstaged {
+		t.Errorf("GetStatus() changes = %+v, want unstaged main.go", changes)
+	}
+}
+
 func TestParseLog(t *testing.T) {
 	tests := []struct {
-		name   string
-		output string
-		want   int
+		name   string
+		output string
+		want   int
+	}{
+		{
+			name:   "empty output",
+			output: "",
+			want:   0,
+		},
+		{
+			name: "single commit",
+			output: `abc123
+John Doe
+2024-01-15T10:30:00Z
+Initial commit
+---END---`,
+			want: 1,
+		},
+		{
+			name: "multiple commits",
+			output: `abc123
+John Doe
+2024-01-15T10:30:00Z
+Initial commit
+---END---
+def456
+Jane Smith
+2024-01-16T14:20:00Z
+Add feature
+---END---`,
+			want: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commits := parseLog(tt.output)
+			if len(commits) != tt.want {
+				t.Errorf("parseLog() returned %d commits, want %d", len(commits), tt.want)
+			}
+
+			if tt.want > 0 && len(commits) > 0 {
+				if commits[0].Hash == "" {
+					t.Error("First commit hash is empty")
+				}
+				if commits[0].Author == "" {
+					t.Error("First commit author is empty")
+				}
+				if commits[0].Message == "" {
+					t.Error("First commit message is empty")
+				}
+			}
+		})
+	}
+}
+
+func TestParseGitVersion(t *testing.T) {
+	tests := []struct {
+		output string
+		want   string
+	}{
+		{"git version 2.43.0", "2.43.0"},
+		{"git version 2.39.3 (Apple Git-146)", "2.39.3"},
+		{"git version 2.43.0.windows.1", "2.43.0"},
+		{"git version 2.22", "2.22"},
+		{"not git", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := parseGitVersion(tt.output); got != tt.want {
+			t.Errorf("parseGitVersion(%q) = %q, want %q", tt.output, got, tt.want)
+		}
+	}
+}
+
+func TestParseSubmoduleLog(t *testing.T) {
+	tests := []struct {
+		name         string
+		output       string
+		wantCount    int
+		wantPath     string
+		wantOld      string
+		wantNew      string
+		wantNote     string
+		wantCommits  []string
+		wantReverted []string
+	}{
+		{
+			name:      "empty output",
+			output:    "",
+			wantCount: 0,
+		},
+		{
+			name: "fast-forward bump",
+			output: `Submodule libs/core 1a2b3c4..5d6e7f8:
+  > Add retry helper
+  > Fix nil check in parser
+`,
+			wantCount:   1,
+			wantPath:    "libs/core",
+			wantOld:     "1a2b3c4",
+			wantNew:     "5d6e7f8",
+			wantCommits: []string{"Add retry helper", "Fix nil check in parser"},
+		},
+		{
+			name: "rewind",
+			output: `Submodule vendor/ui 5d6e7f8...1a2b3c4 (rewind):
+  < Experimental layout
+  > Stable layout
+`,
+			wantCount:    1,
+			wantPath:     "vendor/ui",
+			wantOld:      "5d6e7f8",
+			wantNew:      "1a2b3c4",
+			wantNote:     "rewind",
+			wantCommits:  []string{"Stable layout"},
+			wantReverted: []string{"Experimental layout"},
+		},
+		{
+			name:      "new submodule",
+			output:    "Submodule docs/theme 0000000...9f8e7d6 (new submodule)\n",
+			wantCount: 1,
+			wantPath:  "docs/theme",
+			wantOld:   "",
+			wantNew:   "9f8e7d6",
+			wantNote:  "new submodule",
+		},
+		{
+			name: "multiple submodules with dirty content line",
+			output: `Submodule a 1111111..2222222:
+  > First
+Submodule b contains modified content
+Submodule c 3333333..4444444:
+  > Second
+`,
+			wantCount:   2,
+			wantPath:    "a",
+			wantOld:     "1111111",
+			wantNew:     "2222222",
+			wantCommits: []string{"First"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates := parseSubmoduleLog(tt.output)
+			if len(updates) != tt.wantCount {
+				t.Fatalf("parseSubmoduleLog() returned %d updates, want %d", len(updates), tt.wantCount)
+			}
+			if tt.wantCount == 0 {
+				return
+			}
+
+			got := updates[0]
+			if got.Path != tt.wantPath {
+				t.Errorf("Path = %q, want %q", got.Path, tt.wantPath)
+			}
+			if got.OldSHA != tt.wantOld {
+				t.Errorf("OldSHA = %q, want %q", got.OldSHA, tt.wantOld)
+			}
+			if got.NewSHA != tt.wantNew {
+				t.Errorf("NewSHA = %q, want %q", got.NewSHA, tt.wantNew)
+			}
+			if got.Note != tt.wantNote {
+				t.Errorf("Note = %q, want %q", got.Note, tt.wantNote)
+			}
+			if strings.Join(got.Commits, "|") != strings.Join(tt.wantCommits, "|") {
+				t.Errorf("Commits = %v, want %v", got.Commits, tt.wantCommits)
+			}
+			if strings.Join(got.Reverted, "|") != strings.Join(tt.wantReverted, "|") {
+				t.Errorf("Reverted = %v, want %v", got.Reverted, tt.wantReverted)
+			}
+		})
+	}
+}
+
+func TestExecOperations_Commit_EmptyMessage(t *testing.T) {
+	ops := NewExecOperations()
+	ctx := context.Background()
+
+	err := ops.Commit(ctx, ".", "", nil)
+	if err == nil {
+		t.Error("Commit() with empty message should return error")
+	}
+	if err.Error() != "commit message cannot be empty" {
+		t.Errorf("Commit() error = %v, want 'commit message cannot be empty'", err)
+	}
+}
+
+func TestExecOperations_CreateBranch_EmptyName(t *testing.T) {
+	ops := NewExecOperations()
+	ctx := context.Background()
+
+	err := ops.CreateBranch(ctx, ".", "")
+	if err == nil {
+		t.Error("CreateBranch() with empty name should return error")
+	}
+}
+
+func TestExecOperations_CheckoutBranch_EmptyName(t *testing.T) {
+	ops := NewExecOperations()
+	ctx := context.Background()
+
+	err := ops.CheckoutBranch(ctx, ".", "")
+	if err == nil {
+		t.Error("CheckoutBranch() with empty name should return error")
+	}
+}
+
+func TestCommitMessageArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    []string
+	}{
+		{"subject only", "Add login", []string{"-m", "Add login"}},
+		{"subject and body", "Add login\n\n- Validate passwords\n- Lock after 5 tries", []string{"-m", "Add login", "-m", "- Validate passwords\n- Lock after 5 tries"}},
+		{"blank body", "Add login\n\n  \n", []string{"-m", "Add login"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := commitMessageArgs(tt.message)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("commitMessageArgs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignedTagArgs(t *testing.T) {
+	tests := []struct {
+		name       string
+		tag        string
+		message    string
+		signingKey string
+		want       []string
+	}{
+		{"default key", "v1.0.0", "Release 1.0.0", "", []string{"tag", "-s", "-m", "Release 1.0.0", "v1.0.0"}},
+		{"explicit key", "v1.0.0", "Release 1.0.0", "ABCD1234", []string{"tag", "-s", "-u", "ABCD1234", "-m", "Release 1.0.0", "v1.0.0"}},
+		{"message defaults to tag", "v2.0.0", "", "", []string{"tag", "-s", "-m", "v2.0.0", "v2.0.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := signedTagArgs(tt.tag, tt.message, tt.signingKey)
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("signedTagArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagArgs(t *testing.T) {
+	tests := []struct {
+		name      string
+		tag       string
+		message   string
+		annotated bool
+		want      []string
+	}{
+		{"lightweight", "v1.0.0", "", false, []string{"tag", "v1.0.0"}},
+		{"lightweight ignores message", "v1.0.0", "Release", false, []string{"tag", "v1.0.0"}},
+		{"annotated", "v1.0.0", "Release 1.0.0", true, []string{"tag", "-a", "-m", "Release 1.0.0", "v1.0.0"}},
+		{"annotated message defaults to tag", "v2.0.0", "", true, []string{"tag", "-a", "-m", "v2.0.0", "v2.0.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tagArgs(tt.tag, tt.message, tt.annotated)
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("tagArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRevertArgs(t *testing.T) {
+	if got := revertArgs("abc1234", false); strings.Join(got, " ") != "revert --no-edit abc1234" {
+		t.Errorf("revertArgs() = %v", got)
+	}
+	if got := revertArgs("abc1234", true); strings.Join(got, " ") != "revert --no-commit abc1234" {
+		t.Errorf("revertArgs() with noCommit = %v", got)
+	}
+}
+
+func TestExecOperations_RevertCommit_Conflict(t *testing.T) {
+	repo := t.TempDir()
+	ops := NewExecOperations()
+	ctx := context.Background()
+	run := func(args ...string) {
+		t.Helper()
+		if _, stderr, err := ops.execGit(ctx, repo, args...); err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+	}
+	write := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repo, "file.txt"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "Test")
+	write("one\n")
+	run("add", "file.txt")
+	run("commit", "-q", "-m", "one")
+	write("two\n")
+	run("commit", "-q", "-am", "two")
+	two, _, _ := ops.execGit(ctx, repo, "rev-parse", "HEAD")
+	write("three\n")
+	run("commit", "-q", "-am", "three")
+
+	// Reverting "two" conflicts with "three", which changed the same line
+	err := ops.RevertCommit(ctx, repo, two, false)
+	var conflict *RevertConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("Expected RevertConflictError, got %v", err)
+	}
+	if len(conflict.Files) != 1 || conflict.Files[0] != "file.txt" {
+		t.Errorf("Conflict files = %v, want [file.txt]", conflict.Files)
+	}
+	run("revert", "--abort")
+
+	// Reverting the latest commit applies cleanly
+	three, _, _ := ops.execGit(ctx, repo, "rev-parse", "HEAD")
+	if err := ops.RevertCommit(ctx, repo, three, false); err != nil {
+		t.Fatalf("RevertCommit() error = %v", err)
+	}
+	subject, _, _ := ops.execGit(ctx, repo, "log", "-1", "--format=%s")
+	if subject != `Revert "three"` {
+		t.Errorf("Expected a revert commit, got %q", subject)
+	}
+}
+
+func TestParseReflog(t *testing.T) {
+	output := "aaa111\tHEAD@{2024-03-02 10:00:00 +0100}\tcheckout: moving from feature to main\n" +
+		"bbb222\tHEAD@{2024-03-01 09:30:00 +0100}\tcommit (amend): Fix login: keep redirect\n" +
+		"ccc333\tHEAD@{2024-03-01 09:00:00 +0100}\tclone\n"
+
+	want := []ReflogEntry{
+		{Hash: "aaa111", Date: "2024-03-02 10:00:00 +0100", Action: "checkout", Message: "moving from feature to main"},
+		{Hash: "bbb222", Date: "2024-03-01 09:30:00 +0100", Action: "commit (amend)", Message: "Fix login: keep redirect"},
+		{Hash: "ccc333", Date: "2024-03-01 09:00:00 +0100", Action: "clone"},
+	}
+	if got := parseReflog(output); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseReflog() = %+v, want %+v", got, want)
+	}
+	if got := parseReflog(""); len(got) != 0 {
+		t.Errorf("parseReflog(\"\") = %+v, want none", got)
+	}
+}
+
+func TestParseRemotes(t *testing.T) {
+	output := "origin\tgit@github.com:acme/app.git (fetch)\n" +
+		"origin\tgit@github.com:acme/app.git (push)\n" +
+		"upstream\thttps://github.com/up/app.git (fetch)\n" +
+		"upstream\tno_push (push)\n"
+
+	want := []Remote{
+		{Name: "origin", URL: "git@github.com:acme/app.git"},
+		{Name: "upstream", URL: "https://github.com/up/app.git"},
+	}
+	if got := parseRemotes(output); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseRemotes() = %+v, want %+v", got, want)
+	}
+	if got := parseRemotes(""); len(got) != 0 {
+		t.Errorf("parseRemotes(\"\") = %+v, want none", got)
+	}
+}
+
+func TestParseBlamePorcelain(t *testing.T) {
+	hash := "3f2a9c1e8b7d6a5f4e3d2c1b0a9f8e7d6c5b4a39"
+	zero := "0000000000000000000000000000000000000000"
+	output := hash + " 1 1 2\n" +
+		"author Ada Lovelace\n" +
+		"author-mail <ada@example.com>\n" +
+		"author-time 1709280000\n" +
+		"author-tz +0100\n" +
+		"summary Add login\n" +
+		"filename auth.go\n" +
+		"\tpackage auth\n" +
+		hash + " 2 2\n" +
+		"author Ada Lovelace\n" +
+		"author-time 1709280000\n" +
+		"summary Add login\n" +
+		"filename auth.go\n" +
+		"\t\n" +
+		zero + " 3 3 1\n" +
+		"author Not Committed Yet\n" +
+		"author-time 1709366400\n" +
+		"filename auth.go\n" +
+		"\tfunc Login() {}"
+
+	got := parseBlamePorcelain(output)
+	want := []BlameLine{
+		{Hash: hash, Author: "Ada Lovelace", Time: time.Unix(1709280000, 0), Line: 1, Content: "package auth"},
+		{Hash: hash, Author: "Ada Lovelace", Time: time.Unix(1709280000, 0), Line: 2, Content: ""},
+		{Hash: zero, Author: "Not Committed Yet", Time: time.Unix(1709366400, 0), Line: 3, Content: "func Login() {}"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseBlamePorcelain() = %+v, want %+v", got, want)
+	}
+	if got[0].Uncommitted() || !got[2].Uncommitted() {
+		t.Error("Expected only the all-zero hash to be uncommitted")
+	}
+	if got := parseBlamePorcelain(""); len(got) != 0 {
+		t.Errorf("parseBlamePorcelain(\"\") = %+v, want none", got)
+	}
+}
+
+func TestExecOperations_ManageRemotes(t *testing.T) {
+	repo := t.TempDir()
+	ops := NewExecOperations()
+	ctx := context.Background()
+	if _, stderr, err := ops.execGit(ctx, repo, "init", "-q", "-b", "main"); err != nil {
+		t.Fatalf("git init: %s: %v", stderr, err)
+	}
+
+	if err := ops.AddRemote(ctx, repo, "origin", "https://example.com/app.git"); err != nil {
+		t.Fatalf("AddRemote() error = %v", err)
+	}
+	if err := ops.AddRemote(ctx, repo, "upstream", "https://example.com/up.git"); err != nil {
+		t.Fatalf("AddRemote() error = %v", err)
+	}
+
+	// Taken names are refused before git runs
+	if err := ops.AddRemote(ctx, repo, "origin", "https://example.com/other.git"); err == nil || !strings.Contains(err.Error(), `a remote named "origin" already exists`) {
+		t.Errorf("AddRemote() duplicate error = %v", err)
+	}
+	if err := ops.RenameRemote(ctx, repo, "upstream", "origin"); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("RenameRemote() onto a taken name error = %v", err)
+	}
+
+	if err := ops.RenameRemote(ctx, repo, "upstream", "mirror"); err != nil {
+		t.Fatalf("RenameRemote() error = %v", err)
+	}
+	if err := ops.RemoveRemote(ctx, repo, "origin"); err != nil {
+		t.Fatalf("RemoveRemote() error = %v", err)
+	}
+
+	remotes, err := ops.ListRemotesWithURLs(ctx, repo)
+	if err != nil {
+		t.Fatalf("ListRemotesWithURLs() error = %v", err)
+	}
+	want := []Remote{{Name: "mirror", URL: "https://example.com/up.git"}}
+	if !reflect.DeepEqual(remotes, want) {
+		t.Errorf("ListRemotesWithURLs() = %+v, want %+v", remotes, want)
+	}
+}
+
+func TestExecOperations_MergeTarget(t *testing.T) {
+	repo := t.TempDir()
+	ops := NewExecOperations()
+	ctx := context.Background()
+	if _, stderr, err := ops.execGit(ctx, repo, "init", "-q", "-b", "main"); err != nil {
+		t.Fatalf("git init: %s: %v", stderr, err)
+	}
+
+	if target, err := ops.GetMergeTarget(ctx, repo, "feature/login"); err != nil || target != "" {
+		t.Fatalf("GetMergeTarget() = %q, %v, want nothing remembered", target, err)
+	}
+	if err := ops.SetMergeTarget(ctx, repo, "feature/login", "release"); err != nil {
+		t.Fatalf("SetMergeTarget() error = %v", err)
+	}
+	if target, err := ops.GetMergeTarget(ctx, repo, "feature/login"); err != nil || target != "release" {
+		t.Errorf("GetMergeTarget() = %q, %v, want release", target, err)
+	}
+
+	// The parent is left alone
+	if parent, _ := ops.GetParentBranch(ctx, repo, "feature/login"); parent != "" {
+		t.Errorf("GetParentBranch() = %q, want the parent untouched", parent)
+	}
+}
+
+func TestExecOperations_BaseBranch(t *testing.T) {
+	repo := t.TempDir()
+	ops := NewExecOperations()
+	ctx := context.Background()
+	if _, stderr, err := ops.execGit(ctx, repo, "init", "-q", "-b", "main"); err != nil {
+		t.Fatalf("git init: %s: %v", stderr, err)
+	}
+
+	if base, err := ops.GetBaseBranch(ctx, repo); err != nil || base != "" {
+		t.Fatalf("GetBaseBranch() = %q, %v, want none set", base, err)
+	}
+	if err := ops.SetBaseBranch(ctx, repo, "develop"); err != nil {
+		t.Fatalf("SetBaseBranch() error = %v", err)
+	}
+	if base, err := ops.GetBaseBranch(ctx, repo); err != nil || base != "develop" {
+		t.Errorf("GetBaseBranch() = %q, %v, want develop", base, err)
+	}
+}
+
+func TestExecOperations_GetSuperproject(t *testing.T) {
+	ops := NewExecOperations()
+	ctx := context.Background()
+	run := func(dir string, args ...string) {
+		t.Helper()
+		if _, stderr, err := ops.execGit(ctx, dir, args...); err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+	}
+
+	library := t.TempDir()
+	run(library, "init", "-q", "-b", "main")
+	run(library, "-c", "user.email=test@example.com", "-c", "user.name=Test", "commit", "-q", "--allow-empty", "-m", "initial")
+
+	parent := t.TempDir()
+	run(parent, "init", "-q", "-b", "main")
+	run(parent, "-c", "protocol.file.allow=always", "submodule", "add", "-q", library, "lib")
+
+	if superproject, err := ops.GetSuperproject(ctx, parent); err != nil || superproject != "" {
+		t.Errorf("GetSuperproject(parent) = %q, %v, want none", superproject, err)
+	}
+
+	// Git reports the resolved path, which differs from TempDir's on macOS
+	wantParent, err := filepath.EvalSymlinks(parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	superproject, err := ops.GetSuperproject(ctx, filepath.Join(parent, "lib"))
+	if err != nil {
+		t.Fatalf("GetSuperproject(submodule) error = %v", err)
+	}
+	if superproject != wantParent {
+		t.Errorf("GetSuperproject(submodule) = %q, want %q", superproject, wantParent)
+	}
+
+	repo, err := ops.GetStatus(ctx, filepath.Join(parent, "lib"))
+	if err != nil {
+		t.Fatalf("GetStatus(submodule) error = %v", err)
+	}
+	if !repo.IsSubmodule() {
+		t.Error("GetStatus(submodule).IsSubmodule() = false, want true")
+	}
+}
+
+func TestExecOperations_GetBranchInfo_ParentAndEmptyBranches(t *testing.T) {
+	repo := t.TempDir()
+	ops := NewExecOperations()
+	ctx := context.Background()
+	run := func(args ...string) {
+		t.Helper()
+		if _, stderr, err := ops.execGit(ctx, repo, args...); err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+	}
+
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "Test")
+	run("commit", "-q", "--allow-empty", "-m", "initial")
+
+	// A feature branch whose parent was deleted after it branched off
+	run("checkout", "-q", "-b", "develop")
+	run("checkout", "-q", "-b", "feature/login")
+	run("commit", "-q", "--allow-empty", "-m", "add login page")
+	if err := ops.SetParentBranch(ctx, repo, "feature/login", "develop"); err != nil {
+		t.Fatalf("SetParentBranch() error = %v", err)
+	}
+	run("branch", "-q", "-D", "develop")
+
+	info, err := ops.GetBranchInfo(ctx, repo, nil)
+	if err != nil {
+		t.Fatalf("GetBranchInfo() error = %v", err)
+	}
+	if info.Parent() != "" || info.LostParent() != "develop" || info.CommitCount() != 0 {
+		t.Errorf("GetBranchInfo() parent = %q, lost parent = %q, commits = %d, want the deleted parent reported as lost",
+			info.Parent(), info.LostParent(), info.CommitCount())
+	}
+
+	// An orphan branch without commits, with a parent that does exist
+	run("checkout", "-q", "--orphan", "gh-pages")
+	if err := ops.SetParentBranch(ctx, repo, "gh-pages", "main"); err != nil {
+		t.Fatalf("SetParentBranch() error = %v", err)
+	}
+
+	info, err = ops.GetBranchInfo(ctx, repo, nil)
+	if err != nil {
+		t.Fatalf("GetBranchInfo() error = %v", err)
+	}
+	if info.Name() != "gh-pages" || info.Parent() != "main" || info.LostParent() != "" {
+		t.Errorf("GetBranchInfo() = %q with parent %q (lost %q), want gh-pages with parent main",
+			info.Name(), info.Parent(), info.LostParent())
+	}
+	if info.CommitCount() != 0 || info.AheadBy() != 0 || info.BehindBy() != 0 || info.Upstream() != "" {
+		t.Errorf("GetBranchInfo() counts = %d commits, +%d/-%d, upstream %q, want all zero for a branch without commits",
+			info.CommitCount(), info.AheadBy(), info.BehindBy(), info.Upstream())
+	}
+
+	// No parent configured at all
+	run("checkout", "-q", "main")
+	info, err = ops.GetBranchInfo(ctx, repo, nil)
+	if err != nil {
+		t.Fatalf("GetBranchInfo() error = %v", err)
+	}
+	if info.Parent() != "" || info.LostParent() != "" {
+		t.Errorf("GetBranchInfo() parent = %q, lost parent = %q, want neither", info.Parent(), info.LostParent())
+	}
+}
+
+func TestExecOperations_RecoverDeletedBranch(t *testing.T) {
+	repo := t.TempDir()
+	ops := NewExecOperations()
+	ctx := context.Background()
+	run := func(args ...string) {
+		t.Helper()
+		if _, stderr, err := ops.execGit(ctx, repo, args...); err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+	}
+
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "Test")
+	run("commit", "-q", "--allow-empty", "-m", "initial")
+	run("checkout", "-q", "-b", "feature")
+	run("commit", "-q", "--allow-empty", "-m", "unmerged work")
+	run("checkout", "-q", "main")
+	run("branch", "-q", "-D", "feature")
+
+	entries, err := ops.GetReflog(ctx, repo, 10)
+	if err != nil {
+		t.Fatalf("GetReflog() error = %v", err)
+	}
+	var lost ReflogEntry
+	for _, entry := range entries {
+		if entry.Action == "commit" && entry.Message == "unmerged work" {
+			lost = entry
+		}
+	}
+	if lost.Hash == "" {
+		t.Fatalf("Expected the deleted branch's commit in the reflog, got %+v", entries)
+	}
+
+	if err := ops.CreateBranchAt(ctx, repo, "recovered", lost.Hash); err != nil {
+		t.Fatalf("CreateBranchAt() error = %v", err)
+	}
+	subject, _, _ := ops.execGit(ctx, repo, "log", "-1", "--format=%s", "recovered")
+	if subject != "unmerged work" {
+		t.Errorf("Expected the recovered branch at the lost commit, got %q", subject)
+	}
+}
+
+func TestExecOperations_MergeConflictResolution(t *testing.T) {
+	repo := t.TempDir()
+	ops := NewExecOperations()
+	ctx := context.Background()
+	run := func(args ...string) {
+		t.Helper()
+		if _, stderr, err := ops.execGit(ctx, repo, args...); err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+	}
+	write := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repo, "file.txt"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "Test")
+	write("one\n")
+	run("add", "file.txt")
+	run("commit", "-q", "-m", "one")
+	run("checkout", "-q", "-b", "feature")
+	write("feature\n")
+	run("commit", "-q", "-am", "feature")
+	run("checkout", "-q", "main")
+	write("main\n")
+	run("commit", "-q", "-am", "main")
+
+	// Both branches changed the same line
+	err := ops.Merge(ctx, repo, "feature", "regular", "Merge feature")
+	var conflict *MergeConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("Expected MergeConflictError, got %v", err)
+	}
+	if len(conflict.Files) != 1 || conflict.Files[0] != "file.txt" {
+		t.Errorf("Conflict files = %v, want [file.txt]", conflict.Files)
+	}
+
+	files, err := ops.GetConflictedFiles(ctx, repo)
+	if err != nil || len(files) != 1 || files[0] != "file.txt" {
+		t.Fatalf("GetConflictedFiles() = %v, %v, want [file.txt]", files, err)
+	}
+
+	// Resolving and staging the file clears the conflict
+	write("resolved\n")
+	if err := ops.Add(ctx, repo, []string{"file.txt"}); err != nil {
+		t.Fatal(err)
+	}
+	if files, err := ops.GetConflictedFiles(ctx, repo); err != nil || len(files) != 0 {
+		t.Fatalf("GetConflictedFiles() after resolving = %v, %v, want none", files, err)
+	}
+
+	if err := ops.ContinueMerge(ctx, repo, ""); err != nil {
+		t.Fatalf("ContinueMerge() error = %v", err)
+	}
+	parents, _, _ := ops.execGit(ctx, repo, "log", "-1", "--format=%P")
+	if len(strings.Fields(parents)) != 2 {
+		t.Errorf("Expected a merge commit with two parents, got %q", parents)
+	}
+	subject, _, _ := ops.execGit(ctx, repo, "log", "-1", "--format=%s")
+	if subject != "Merge feature" {
+		t.Errorf("Expected the prepared merge message, got %q", subject)
+	}
+}
+
+func TestExecOperations_PullRebaseConflict(t *testing.T) {
+	origin := t.TempDir()
+	clone := filepath.Join(t.TempDir(), "clone")
+	ops := NewExecOperations()
+	ctx := context.Background()
+	run := func(dir string, args ...string) {
+		t.Helper()
+		if _, stderr, err := ops.execGit(ctx, dir, args...); err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+	}
+	write := func(dir, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, "file.txt"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run(origin, "init", "-q", "-b", "main")
+	run(origin, "config", "user.email", "test@example.com")
+	run(origin, "config", "user.name", "Test")
+	write(origin, "one\n")
+	run(origin, "add", "file.txt")
+	run(origin, "commit", "-q", "-m", "one")
+	run(origin, "clone", "-q", origin, clone)
+	run(clone, "config", "user.email", "test@example.com")
+	run(clone, "config", "user.name", "Test")
+
+	// Both sides change the same line
+	write(origin, "remote\n")
+	run(origin, "commit", "-q", "-am", "remote")
+	write(clone, "local\n")
+	run(clone, "commit", "-q", "-am", "local")
+
+	err := ops.Pull(ctx, clone, "", true)
+	var conflict *RebaseConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("Expected RebaseConflictError, got %v", err)
+	}
+	if len(conflict.Files) != 1 || conflict.Files[0] != "file.txt" {
+		t.Errorf("Conflict files = %v, want [file.txt]", conflict.Files)
+	}
+	if files, err := ops.GetConflictedFiles(ctx, clone); err != nil || len(files) != 1 {
+		t.Fatalf("GetConflictedFiles() = %v, %v, want [file.txt]", files, err)
+	}
+
+	write(clone, "resolved\n")
+	if err := ops.Add(ctx, clone, []string{"file.txt"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ops.ContinueRebase(ctx, clone); err != nil {
+		t.Fatalf("ContinueRebase() error = %v", err)
+	}
+
+	// The local commit sits on top of the remote one, without a merge commit
+	subjects, _, _ := ops.execGit(ctx, clone, "log", "--format=%s")
+	if subjects != "local\nremote\none" {
+		t.Errorf("Expected a linear history, got %q", subjects)
+	}
+}
+
+func TestExecOperations_PushAndPullChosenRemote(t *testing.T) {
+	origin := t.TempDir()
+	fork := t.TempDir()
+	repo := filepath.Join(t.TempDir(), "repo")
+	other := filepath.Join(t.TempDir(), "other")
+	ops := NewExecOperations()
+	ctx := context.Background()
+	run := func(dir string, args ...string) string {
+		t.Helper()
+		stdout, stderr, err := ops.execGit(ctx, dir, args...)
+		if err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+		return stdout
+	}
+
+	run(origin, "init", "-q", "--bare", "-b", "main")
+	run(fork, "init", "-q", "--bare", "-b", "main")
+	run(filepath.Dir(repo), "init", "-q", "-b", "main", repo)
+	run(repo, "config", "user.email", "test@example.com")
+	run(repo, "config", "user.name", "Test")
+	run(repo, "remote", "add", "fork", fork)
+	run(repo, "remote", "add", "origin", origin)
+	run(repo, "commit", "-q", "--allow-empty", "-m", "one")
+
+	// No remote pushes to the primary one, origin, and tracks it
+	if err := ops.Push(ctx, repo, "", "", false); err != nil {
+		t.Fatalf("Push() error = %v", err)
+	}
+	if tracked := run(repo, "config", "branch.main.remote"); tracked != "origin" {
+		t.Errorf("Expected main to track origin, got %q", tracked)
+	}
+
+	// Pushing to the fork moves the upstream there
+	if err := ops.Push(ctx, repo, "fork", "main", false); err != nil {
+		t.Fatalf("Push(fork) error = %v", err)
+	}
+	if head := run(fork, "rev-parse", "main"); head != run(repo, "rev-parse", "main") {
+		t.Errorf("Expected the fork to have main at %s, got %s", run(repo, "rev-parse", "main"), head)
+	}
+	if tracked := run(repo, "config", "branch.main.remote"); tracked != "fork" {
+		t.Errorf("Expected main to track fork, got %q", tracked)
+	}
+
+	// Pulling from origin takes its main even though main tracks the fork
+	run(filepath.Dir(other), "clone", "-q", origin, other)
+	run(other, "config", "user.email", "test@example.com")
+	run(other, "config", "user.name", "Test")
+	run(other, "commit", "-q", "--allow-empty", "-m", "two")
+	run(other, "push", "-q", "origin", "main")
+	if err := ops.Pull(ctx, repo, "origin", false); err != nil {
+		t.Fatalf("Pull(origin) error = %v", err)
+	}
+	if subject := run(repo, "log", "-1", "--format=%s"); subject != "two" {
+		t.Errorf("Expected origin's commit pulled, got %q", subject)
+	}
+
+	// No remote now pushes to the tracked fork, leaving the upstream alone
+	run(repo, "commit", "-q", "--allow-empty", "-m", "fork only")
+	if remote, tracking, err := ops.PushRemote(ctx, repo, "main"); err != nil || remote != "fork" || !tracking {
+		t.Errorf("PushRemote() = %q, %v, %v, want the tracked fork", remote, tracking, err)
+	}
+	if err := ops.Push(ctx, repo, "", "", false); err != nil {
+		t.Fatalf("Push() error = %v", err)
+	}
+	if head := run(fork, "rev-parse", "main"); head != run(repo, "rev-parse", "main") {
+		t.Errorf("Expected the fork to have main at %s, got %s", run(repo, "rev-parse", "main"), head)
+	}
+	if head := run(origin, "log", "-1", "--format=%s", "main"); head != "two" {
+		t.Errorf("Expected origin left at its own commit, got %q", head)
+	}
+	if tracked := run(repo, "config", "branch.main.remote"); tracked != "fork" {
+		t.Errorf("Expected main to keep tracking fork, got %q", tracked)
+	}
+}
+
+// fakeGit points ops at a shell script standing in for git
+func fakeGit(t *testing.T, ops *ExecOperations, script string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake git is a shell script")
+	}
+	path := filepath.Join(t.TempDir(), "git")
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	ops.SetGitPath(path)
+}
+
+func TestExecOperations_NetworkCancellation(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout time.Duration // 0 cancels instead
+		want    error
+	}{
+		{"cancelled", 0, context.Canceled},
+		{"timed out", 100 * time.Millisecond, context.DeadlineExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := NewExecOperations()
+			// A fetch stuck on an unresponsive remote
+			fakeGit(t, ops, "exec sleep 30\n")
+
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.timeout > 0 {
+				ctx, cancel = context.WithTimeout(context.Background(), tt.timeout)
+			} else {
+				time.AfterFunc(100*time.Millisecond, cancel)
+			}
+			defer cancel()
+
+			start := time.Now()
+			err := ops.Fetch(ctx, t.TempDir())
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Fetch() error = %v, want %v", err, tt.want)
+			}
+			if elapsed := time.Since(start); elapsed > 10*time.Second {
+				t.Errorf("Fetch() took %s, expected git to be killed", elapsed)
+			}
+		})
+	}
+}
+
+func TestExecOperations_NetworkCredentialPrompt(t *testing.T) {
+	ops := NewExecOperations()
+	// Git refusing to prompt, as it does with GIT_TERMINAL_PROMPT=0
+	fakeGit(t, ops, `if [ "$GIT_TERMINAL_PROMPT" = 0 ]; then
+  echo "fatal: could not read Username for 'https://example.com': terminal prompts disabled" >&2
+  exit 128
+fi
+exec sleep 30
+`)
+
+	tests := []struct {
+		name string
+		run  func(ctx context.Context, repo string) error
+	}{
+		{"Pull", func(ctx context.Context, repo string) error {
+			return ops.Pull(ctx, repo, "", false)
+		}},
+		{"DeleteRemoteBranch", func(ctx context.Context, repo string) error {
+			return ops.DeleteRemoteBranch(ctx, repo, "origin", "feature/old")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			err := tt.run(ctx, t.TempDir())
+			var credentialErr *CredentialPromptError
+			if !errors.As(err, &credentialErr) {
+				t.Fatalf("%s() error = %v, want CredentialPromptError", tt.name, err)
+			}
+			if !strings.Contains(err.Error(), "credential.helper") {
+				t.Errorf("Expected advice to configure a credential helper, got %q", err)
+			}
+		})
+	}
+}
+
+func TestExecOperations_CherryPick(t *testing.T) {
+	repo := t.TempDir()
+	ops := NewExecOperations()
+	ctx := context.Background()
+	run := func(args ...string) string {
+		t.Helper()
+		stdout, stderr, err := ops.execGit(ctx, repo, args...)
+		if err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+		return stdout
+	}
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repo, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "Test")
+	write("file.txt", "one\n")
+	run("add", "file.txt")
+	run("commit", "-q", "-m", "one")
+
+	// feature: a conflicting change, then two independent files
+	run("checkout", "-q", "-b", "feature")
+	write("file.txt", "feature\n")
+	run("commit", "-q", "-am", "change file")
+	conflicting := run("rev-parse", "HEAD")
+	write("a.txt", "a\n")
+	run("add", "a.txt")
+	run("commit", "-q", "-m", "add a")
+	addA := run("rev-parse", "HEAD")
+	write("b.txt", "b\n")
+	run("add", "b.txt")
+	run("commit", "-q", "-m", "add b")
+	addB := run("rev-parse", "HEAD")
+
+	run("checkout", "-q", "main")
+	write("file.txt", "main\n")
+	run("commit", "-q", "-am", "change file on main")
+	before := run("rev-parse", "HEAD")
+
+	// A conflict part way through aborts the whole cherry-pick
+	err := ops.CherryPick(ctx, repo, []string{addA, conflicting})
+	var conflict *CherryPickConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("Expected CherryPickConflictError, got %v", err)
+	}
+	if len(conflict.Files) != 1 || conflict.Files[0] != "file.txt" || !strings.HasPrefix(conflicting, conflict.Commit) {
+		t.Errorf("Conflict = %+v, want file.txt in %s", conflict, conflicting)
+	}
+	if head := run("rev-parse", "HEAD"); head != before {
+		t.Errorf("Expected HEAD restored to %s after the abort, got %s", before, head)
+	}
+	if status := run("status", "--porcelain"); status != "" {
+		t.Errorf("Expected a clean tree after the abort, got %q", status)
+	}
+
+	// Several commits apply in order
+	if err := ops.CherryPick(ctx, repo, []string{addA, addB}); err != nil {
+		t.Fatalf("CherryPick() error = %v", err)
+	}
+	if log := run("log", "--format=%s", before+"..HEAD"); log != "add b\nadd a" {
+		t.Errorf("Expected both commits picked in order, got %q", log)
+	}
+}
+
+func TestParseFailedPick(t *testing.T) {
+	stderr := "error: could not apply 6cae2a7... two\nhint: After resolving the conflicts, mark them with"
+	if got := parseFailedPick(stderr); got != "6cae2a7" {
+		t.Errorf("parseFailedPick() = %q, want 6cae2a7", got)
+	}
+	if got := parseFailedPick("fatal: bad revision"); got != "" {
+		t.Errorf("parseFailedPick() = %q, want empty", got)
+	}
+}
+
+func TestExecOperations_PathScope(t *testing.T) {
+	repo := t.TempDir()
+	ops := NewExecOperations()
+	ctx := context.Background()
+	run := func(args ...string) string {
+		t.Helper()
+		stdout, stderr, err := ops.execGit(ctx, repo, args...)
+		if err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+		return stdout
+	}
+	write := func(name, content string) {
+		t.Helper()
+		path := filepath.Join(repo, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "Test")
+	write("services/api/main.go", "package main\n")
+	write("services/api/util.go", "package main\n")
+	write("services/web/index.js", "export {}\n")
+	run("add", "-A")
+	run("commit", "-q", "-m", "init")
+
+	write("services/api/main.go", "package main\n\nfunc main() {}\n")
+	write("services/api/handler.go", "package main\n")
+	write("services/api/util.go", "package main\n\nfunc util() {}\n")
+	write("services/web/index.js", "export default {}\n")
+	run("add", "services/api/main.go")
+
+	ops.SetPathScope("./services/api/")
+	if got := ops.scoped("diff", "--cached"); strings.Join(got, " ") != "diff --cached -- services/api" {
+		t.Errorf("scoped() = %v, want the pathspec after --", got)
+	}
+
+	repository, err := ops.GetStatus(ctx, repo)
+	if err != nil {
+		t.Fatalf("GetStatus() error = %v", err)
+	}
+	var paths []string
+	for _, change := range repository.Changes() {
+		paths = append(paths, change.Path)
+	}
+	if strings.Join(paths, ",") != "services/api/main.go,services/api/util.go,services/api/handler.go" {
+		t.Errorf("Scoped status = %v, want only services/api", paths)
+	}
+
+	diff, err := ops.GetDiff(ctx, repo, false)
+	if err != nil {
+		t.Fatalf("GetDiff() error = %v", err)
+	}
+	if !strings.Contains(diff, "services/api/util.go") || strings.Contains(diff, "services/web") {
+		t.Errorf("Scoped diff should cover only services/api, got:\n%s", diff)
+	}
+
+	// Staging everything stays inside the scope
+	if err := ops.Add(ctx, repo, nil); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if staged := run("diff", "--cached", "--name-only"); staged != "services/api/handler.go\nservices/api/main.go\nservices/api/util.go" {
+		t.Errorf("Staged %q, want only services/api", staged)
+	}
+
+	// "." is the whole repository
+	ops.SetPathScope(".")
+	if ops.PathScope() != "" {
+		t.Errorf("PathScope() = %q after SetPathScope(\".\"), want empty", ops.PathScope())
+	}
+}
+
+func TestParseBranchList(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   []string
+	}{
+		{
+			name:   "current and other branches",
+			output: "  develop\n* main\n  feature/login",
+			want:   []string{"develop", "main", "feature/login"},
+		},
+		{
+			name:   "detached HEAD is skipped",
+			output: "* (HEAD detached at abc123)\n  main\n  feature/login",
+			want:   []string{"main", "feature/login"},
+		},
+		{
+			name:   "rebase in progress is skipped",
+			output: "* (no branch, rebasing feature/login)\n  feature/login\n  main",
+			want:   []string{"feature/login", "main"},
+		},
+		{
+			name:   "worktree marker is stripped",
+			output: "+ feature/api\n* main\n  develop",
+			want:   []string{"feature/api", "main", "develop"},
+		},
+		{
+			name: "all branches with remotes",
+			output: "* (HEAD detached at origin/main)\n" +
+				"+ feature/api\n" +
+				"  main\n" +
+				"  remotes/origin/HEAD -> origin/main\n" +
+				"  remotes/origin/feature/api\n" +
+				"  remotes/origin/main",
+			want: []string{"feature/api", "main", "origin/feature/api", "origin/main"},
+		},
+		{
+			name:   "no branches",
+			output: "",
+			want:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseBranchList(tt.output)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") || len(got) != len(tt.want) {
+				t.Errorf("parseBranchList() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyRefDecorations(t *testing.T) {
+	tests := []struct {
+		name       string
+		decoration string
+		want       domain.CommitNode
+	}{
+		{
+			name:       "head branch with remote, second local branch and tag",
+			decoration: "HEAD -> refs/heads/main, refs/remotes/origin/main, refs/heads/feature/x, tag: refs/tags/v1.0",
+			want: domain.CommitNode{
+				IsHead:         true,
+				HeadBranch:     "main",
+				LocalBranches:  []string{"main", "feature/x"},
+				RemoteBranches: []string{"origin/main"},
+				Tags:           []string{"v1.0"},
+			},
+		},
+		{
+			name:       "detached HEAD",
+			decoration: "HEAD, refs/remotes/upstream/release/2.0, tag: refs/tags/v2.0-rc1",
+			want: domain.CommitNode{
+				IsHead:         true,
+				DetachedHead:   true,
+				RemoteBranches: []string{"upstream/release/2.0"},
+				Tags:           []string{"v2.0-rc1"},
+			},
+		},
+		{
+			name:       "remote HEAD pointer and stash are skipped",
+			decoration: "refs/remotes/origin/HEAD, refs/remotes/origin/develop, refs/stash",
+			want: domain.CommitNode{
+				RemoteBranches: []string{"origin/develop"},
+			},
+		},
+		{
+			name:       "short decorations",
+			decoration: "HEAD -> main, develop, tag: v0.1",
+			want: domain.CommitNode{
+				IsHead:        true,
+				HeadBranch:    "main",
+				LocalBranches: []string{"main", "develop"},
+				Tags:          []string{"v0.1"},
+			},
+		},
+		{
+			name:       "no refs",
+			decoration: "",
+			want:       domain.CommitNode{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got domain.CommitNode
+			applyRefDecorations(&got, tt.decoration)
+
+			if got.IsHead != tt.want.IsHead || got.DetachedHead != tt.want.DetachedHead || got.HeadBranch != tt.want.HeadBranch {
+				t.Errorf("head = (%v, detached %v, %q), want (%v, detached %v, %q)",
+					got.IsHead, got.DetachedHead, got.HeadBranch, tt.want.IsHead, tt.want.DetachedHead, tt.want.HeadBranch)
+			}
+			if strings.Join(got.LocalBranches, ",") != strings.Join(tt.want.LocalBranches, ",") {
+				t.Errorf("LocalBranches = %q, want %q", got.LocalBranches, tt.want.LocalBranches)
+			}
+			if strings.Join(got.RemoteBranches, ",") != strings.Join(tt.want.RemoteBranches, ",") {
+				t.Errorf("RemoteBranches = %q, want %q", got.RemoteBranches, tt.want.RemoteBranches)
+			}
+			if strings.Join(got.Tags, ",") != strings.Join(tt.want.Tags, ",") {
+				t.Errorf("Tags = %q, want %q", got.Tags, tt.want.Tags)
+			}
+		})
+	}
+}
+
+func TestCommitGraphArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		refs     []string
+		limit    int
+		wantRevs []string
+	}{
+		{
+			name:     "no refs uses all",
+			refs:     nil,
+			limit:    50,
+			wantRevs: []string{"--all"},
+		},
+		{
+			name:     "relevant refs are deduplicated and end with separator",
+			refs:     []string{"feature/x", "main", "", "main"},
+			limit:    50,
+			wantRevs: []string{"feature/x", "main", "--"},
+		},
+		{
+			name:     "blank refs fall back to all",
+			refs:     []string{" ", ""},
+			limit:    50,
+			wantRevs: []string{"--all"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := commitGraphArgs(tt.refs, tt.limit)
+			if len(args) < 6 || args[0] != "log" || args[1] != "--graph" || args[4] != "-50" {
+				t.Fatalf("unexpected leading args: %q", args)
+			}
+			if got := strings.Join(args[6:], " "); got != strings.Join(tt.wantRevs, " ") {
+				t.Errorf("revisions = %q, want %q", args[6:], tt.wantRevs)
+			}
+		})
+	}
+
+	if args := commitGraphArgs(nil, 0); args[4] != "-100" {
+		t.Errorf("default limit arg = %q, want -100", args[4])
+	}
+}
+
+func TestParseCommitGraph(t *testing.T) {
+	sep := graphFieldSep
+	output := strings.Join([]string{
+		"*   " + strings.Join([]string{"", "c3", "c3s", "c2 b1", "Ada", "2024-05-02", "HEAD -> refs/heads/main, refs/remotes/origin/main", "Merge branch 'feature'"}, sep),
+		"|\\",
+		"| * " + strings.Join([]string{"", "b1", "b1s", "c1", "Bob", "2024-05-01", "refs/heads/feature", "feat: add x"}, sep),
+		"* | " + strings.Join([]string{"", "c2", "c2s", "c1", "Ada", "2024-05-01", "", "fix: y"}, sep),
+		"|/",
+		"* " + strings.Join([]string{"", "c1", "c1s", "", "Ada", "2024-04-30", "tag: refs/tags/v0.1", "initial"}, sep),
+	}, "\n")
+
+	graph := parseCommitGraph(output)
+	if len(graph.Nodes) != 4 {
+		t.Fatalf("got %d nodes, want 4", len(graph.Nodes))
+	}
+
+	merge := graph.Nodes[0]
+	if !merge.IsMerge() || merge.GraphLine != "*" || merge.Message != "Merge branch 'feature'" {
+		t.Errorf("merge node = %+v", merge)
+	}
+	if head := graph.Head(); head == nil || head.Hash != "c3" || head.HeadBranch != "main" {
+		t.Errorf("Head() = %+v, want c3 on main", head)
+	}
+	if graph.Nodes[1].GraphLine != "| *" || graph.Nodes[1].LocalBranches[0] != "feature" {
+		t.Errorf("feature node = %+v", graph.Nodes[1])
+	}
+	if root := graph.Nodes[3]; len(root.Parents) != 0 || len(root.Tags) != 1 || root.Tags[0] != "v0.1" {
+		t.Errorf("root node = %+v", root)
+	}
+}
+
+func TestRenameArgs(t *testing.T) {
+	tests := []struct {
+		mode string
+		want []string
+	}{
+		{domain.RenameDetectionOff, []string{"--no-renames"}},
+		{domain.RenameDetectionNormal, []string{"-M"}},
+		{domain.RenameDetectionAggressive, []string{"-M30%", "-C", "--find-copies-harder"}},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			got := renameArgs(tt.mode)
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("renameArgs(%q) = %q, want %q", tt.mode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenamedPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"main.go", "main.go"},
+		{"old.go -> new.go", "new.go"},
+		{"old.go => new.go", "new.go"},
+		{"internal/{old => new}/file.go", "internal/new/file.go"},
+		{"internal/{ => sub}/file.go", "internal/sub/file.go"},
+		{"internal/{sub => }/file.go", "internal/file.go"},
+	}
+
+	for _, tt := range tests {
+		if got := renamedPath(tt.path); got != tt.want {
+			t.Errorf("renamedPath(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestExecOperations_CommitArgsSigning(t *testing.T) {
+	tests := []struct {
+		name string
+		sign bool
+		key  string
+		want string
+	}{
+		{"signing off leaves git config in charge", false, "ABC123", "commit -m Fix"},
+		{"default key", true, "", "commit -S -m Fix"},
+		{"configured key", true, "ABC123", "commit -SABC123 -m Fix"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := NewExecOperations()
+			ops.SetCommitSigning(tt.sign, tt.key)
+			if got := strings.Join(ops.CommitArgs("Fix", nil, false), " "); got != tt.want {
+				t.Errorf("CommitArgs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsMissingSigningKey(t *testing.T) {
+	tests := []struct {
+		stderr string
+		want   bool
+	}{
+		{"gpg: skipped \"Dev <dev@example.com>\": No secret key\ngpg: signing failed: No secret key\nerror: gpg failed to sign the data", true},
+		{"error: Either user.signingkey or gpg.ssh.defaultKeyCommand needs to be configured", true},
+		{"error: gpg failed to sign the data\ngpg: signing failed: Inappropriate ioctl for device", false},
+		{"nothing to commit, working tree clean", false},
+	}
+
+	for _, tt := range tests {
+		if got := isMissingSigningKey(tt.stderr); got != tt.want {
+			t.Errorf("isMissingSigningKey(%q) = %v, want %v", tt.stderr, got, tt.want)
+		}
+	}
+}
+
+func TestParseTagVerification(t *testing.T) {
+	tests := []struct {
+		name       string
+		output     string
+		err        error
+		wantStatus domain.TagSignatureStatus
+		wantSigner string
+	}{
+		{
+			name:       "good signature",
+			output:     "gpg: Signature made Mon 01 Jan 2024\ngpg: Good signature from \"Jane Dev <jane@example.com>\" [ultimate]",
+			wantStatus: domain.TagSignatureGood,
+			wantSigner: "Jane Dev <jane@example.com>",
+		},
+		{
+			name:       "bad signature",
+			output:     "gpg: BAD signature from \"Jane Dev <jane@example.com>\" [ultimate]",
+			err:        errors.New("exit status 1"),
+			wantStatus: domain.TagSignatureBad,
+		},
+		{
+			name:       "unsigned tag",
+			output:     "error: no signature found",
+			err:        errors.New("exit status 1"),
+			wantStatus: domain.TagSignatureUnsigned,
+		},
+		{
+			name:       "missing public key",
+			output:     "gpg: Can't check signature: No public key",
+			err:        errors.New("exit status 1"),
+			wantStatus: domain.TagSignatureUnknownKey,
+		},
+		{
+			name:       "gpg unavailable",
+			output:     "",
+			err:        errors.New("cannot run gpg"),
+			wantStatus: domain.TagSignatureError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseTagVerification("v1.0.0", tt.output, tt.err)
+			if got.Status != tt.wantStatus {
+				t.Errorf("Status = %v, want %v", got.Status, tt.wantStatus)
+			}
+			if got.Signer != tt.wantSigner {
+				t.Errorf("Signer = %q, want %q", got.Signer, tt.wantSigner)
+			}
+		})
+	}
+}
+
+func TestParseStashList(t *testing.T) {
+	output := "stash@{0}: On feature/login: wip before switching\n" +
+		"stash@{1}: WIP on main: abc1234 Add login page\n" +
+		"stash@{2}: On main: message: with colon\n" +
+		"not a stash line"
+
+	want := []StashEntry{
+		{Index: 0, Branch: "feature/login", Message: "wip before switching"},
+		{Index: 1, Branch: "main", Message: "abc1234 Add login page"},
+		{Index: 2, Branch: "main", Message: "message: with colon"},
+	}
+
+	got := parseStashList(output)
+	if len(got) != len(want) {
+		t.Fatalf("parseStashList() returned %d entries, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("entry %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	if entries := parseStashList(""); len(entries) != 0 {
+		t.Errorf("parseStashList(\"\") = %+v, want no entries", entries)
+	}
+}
+
+func TestParseIsShallow(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      string
+		wantShallow bool
+		wantOK      bool
+	}{
+		{name: "shallow clone", output: "true\n", wantShallow: true, wantOK: true},
+		{name: "full clone", output: "false", wantShallow: false, wantOK: true},
+		{name: "git without the flag echoes it back", output: "--is-shallow-repository", wantShallow: false, wantOK: false},
+		{name: "empty output", output: "", wantShallow: false, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shallow, ok := parseIsShallow(tt.output)
+			if shallow != tt.wantShallow || ok != tt.wantOK {
+				t.Errorf("parseIsShallow(%q) = (%v, %v), want (%v, %v)", tt.output, shallow, ok, tt.wantShallow, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestParseCountObjects(t *testing.T) {
+	output := "count: 6812\n" +
+		"size: 27.43 MiB\n" +
+		"in-pack: 1523\n" +
+		"packs: 2\n" +
+		"size-pack: 1.21 MiB\n" +
+		"prune-packable: 3\n" +
+		"garbage: 0\n" +
+		"size-garbage: 0 bytes\n"
+
+	want := &ObjectStats{
+		Loose:         6812,
+		LooseSize:     "27.43 MiB",
+		Packed:        1523,
+		Packs:         2,
+		PackSize:      "1.21 MiB",
+		PrunePackable: 3,
+		GarbageSize:   "0 bytes",
+	}
+	if got := parseCountObjects(output); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseCountObjects() = %+v, want %+v", got, want)
+	}
+	if got := parseCountObjects(""); !reflect.DeepEqual(got, &ObjectStats{}) {
+		t.Errorf("parseCountObjects(\"\") = %+v, want zero stats", got)
+	}
+}
+
+func TestObjectStats_MaintenanceReason(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats ObjectStats
+		want  string
 	}{
-		{
-			name:   "empty output",
-			output: "",
-			want:   0,
-		},
-		{
-			name: "single commit",
-			output: `abc123
-John Doe
-2024-01-15T10:30:00Z
-Initial commit
----END---`,
-			want: 1,
-		},
-		{
-			name: "multiple commits",
-			output: `abc123
-John Doe
-2024-01-15T10:30:00Z
-Initial commit
----END---
-def456
-Jane Smith
-2024-01-16T14:20:00Z
-Add feature
----END---`,
-			want: 2,
-		},
+		{name: "healthy", stats: ObjectStats{Loose: 120, Packs: 3}, want: ""},
+		{name: "many loose objects", stats: ObjectStats{Loose: 6700, Packs: 1}, want: "6700 loose objects"},
+		{name: "many packs", stats: ObjectStats{Packs: 50}, want: "50 packs"},
+		{name: "duplicates and garbage", stats: ObjectStats{PrunePackable: 4, Garbage: 2}, want: "4 duplicated objects, 2 garbage files"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			commits := parseLog(tt.output)
-			if len(commits) != tt.want {
-				t.Errorf("parseLog() returned %d commits, want %d", len(commits), tt.want)
+			if got := tt.stats.MaintenanceReason(); got != tt.want {
+				t.Errorf("MaintenanceReason() = %q, want %q", got, tt.want)
 			}
+		})
+	}
+}
 
-			if tt.want > 0 && len(commits) > 0 {
-				if commits[0].Hash == "" {
-					t.Error("First commit hash is empty")
-				}
-				if commits[0].Author == "" {
-					t.Error("First commit author is empty")
-				}
-				if commits[0].Message == "" {
-					t.Error("First commit message is empty")
-				}
+func TestExecOperations_RunMaintenance(t *testing.T) {
+	repo := t.TempDir()
+	ops := NewExecOperations()
+	ctx := context.Background()
+	run := func(args ...string) {
+		t.Helper()
+		if _, stderr, err := ops.execGit(ctx, repo, args...); err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+	}
+
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "Test")
+	for _, message := range []string{"first", "second", "third"} {
+		run("commit", "-q", "--allow-empty", "-m", message)
+	}
+
+	before, err := ops.CountObjects(ctx, repo)
+	if err != nil {
+		t.Fatalf("CountObjects() error = %v", err)
+	}
+	if before.Loose == 0 {
+		t.Fatal("Expected new commits to be stored loose")
+	}
+
+	if err := ops.RunMaintenance(ctx, repo); err != nil {
+		t.Fatalf("RunMaintenance() error = %v", err)
+	}
+	after, err := ops.CountObjects(ctx, repo)
+	if err != nil {
+		t.Fatalf("CountObjects() error = %v", err)
+	}
+	if after.Loose != 0 || after.Packed < before.Loose || after.Packs != 1 {
+		t.Errorf("Expected every object repacked into one pack, got %+v", after)
+	}
+}
+
+func TestExecOperations_ListHooks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("executable bit is not meaningful on Windows")
+	}
+
+	ops := NewExecOperations()
+	ctx := context.Background()
+	tempDir := t.TempDir()
+
+	if _, stderr, err := ops.execGit(ctx, tempDir, "init"); err != nil {
+		t.Fatalf("Failed to init git repo: %s: %v", stderr, err)
+	}
+
+	writeHook := func(dir, name string, mode os.FileMode) {
+		t.Helper()
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("MkdirAll() error = %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\nexit 0\n"), mode); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	// Default hooks directory: only executable, non-sample scripts count
+	defaultDir := filepath.Join(tempDir, ".git", "hooks")
+	writeHook(defaultDir, "pre-commit", 0755)
+	writeHook(defaultDir, "commit-msg", 0644)
+	writeHook(defaultDir, "pre-push.sample", 0755)
+
+	hooks, err := ops.ListHooks(ctx, tempDir)
+	if err != nil {
+		t.Fatalf("ListHooks() error = %v", err)
+	}
+	if strings.Join(hooks, ",") != "pre-commit" {
+		t.Errorf("ListHooks() = %v, want [pre-commit]", hooks)
+	}
+
+	// core.hooksPath replaces .git/hooks
+	writeHook(filepath.Join(tempDir, ".githooks"), "commit-msg", 0755)
+	writeHook(filepath.Join(tempDir, ".githooks"), "post-commit", 0755)
+	if _, stderr, err := ops.execGit(ctx, tempDir, "config", "core.hooksPath", ".githooks"); err != nil {
+		t.Fatalf("Failed to set core.hooksPath: %s: %v", stderr, err)
+	}
+
+	hooks, err = ops.ListHooks(ctx, tempDir)
+	if err != nil {
+		t.Fatalf("ListHooks() error = %v", err)
+	}
+	if strings.Join(hooks, ",") != "commit-msg,post-commit" {
+		t.Errorf("ListHooks() with core.hooksPath = %v, want [commit-msg post-commit]", hooks)
+	}
+}
+
+func TestExecOperations_SnapshotAndRestoreIndex(t *testing.T) {
+	ops := NewExecOperations()
+	ctx := context.Background()
+	tempDir := t.TempDir()
+
+	if _, stderr, err := ops.execGit(ctx, tempDir, "init"); err != nil {
+		t.Fatalf("Failed to init git repo: %s: %v", stderr, err)
+	}
+	for _, name := range []string{"staged.txt", "unstaged.txt"} {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(name), 0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	// The user staged one file themselves
+	if err := ops.Add(ctx, tempDir, []string{"staged.txt"}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	snapshot, err := ops.SnapshotIndex(ctx, tempDir)
+	if err != nil {
+		t.Fatalf("SnapshotIndex() error = %v", err)
+	}
+
+	// Stage everything, then restore
+	if err := ops.Add(ctx, tempDir, nil); err != nil {
+		t.Fatalf("Add(all) error = %v", err)
+	}
+	if err := ops.RestoreIndex(ctx, tempDir, snapshot); err != nil {
+		t.Fatalf("RestoreIndex() error = %v", err)
+	}
+
+	staged, _, err := ops.execGit(ctx, tempDir, "diff", "--cached", "--name-only")
+	if err != nil {
+		t.Fatalf("diff --cached error = %v", err)
+	}
+	if staged != "staged.txt" {
+		t.Errorf("staged files after restore = %q, want %q", staged, "staged.txt")
+	}
+}
+
+func TestExecOperations_IsWhitespaceOnlyChange(t *testing.T) {
+	ops := NewExecOperations()
+	ctx := context.Background()
+	tempDir := t.TempDir()
+
+	if _, stderr, err := ops.execGit(ctx, tempDir, "init"); err != nil {
+		t.Fatalf("Failed to init git repo: %s: %v", stderr, err)
+	}
+	writeFile := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+	writeFile("main.go", "package main\n\nfunc main() {\n\tprintln(\"hi\")\n}\n")
+	if err := ops.Add(ctx, tempDir, nil); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if _, stderr, err := ops.execGit(ctx, tempDir, "-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "-m", "Initial"); err != nil {
+		t.Fatalf("Failed to commit: %s: %v", stderr, err)
+	}
+
+	steps := []struct {
+		name   string
+		change func()
+		want   bool
+	}{
+		{"clean tree", func() {}, false},
+		{"CRLF and indentation churn", func() {
+			writeFile("main.go", "package main\r\n\r\nfunc main() {\r\n    println(\"hi\")\r\n}\r\n")
+		}, true},
+		{"staged whitespace churn", func() {
+			if err := ops.Add(ctx, tempDir, nil); err != nil {
+				t.Fatalf("Add() error = %v", err)
 			}
-		})
+		}, true},
+		{"untracked file", func() { writeFile("new.go", "package main\n") }, false},
+		{"real change", func() {
+			if err := os.Remove(filepath.Join(tempDir, "new.go")); err != nil {
+				t.Fatalf("Remove() error = %v", err)
+			}
+			writeFile("main.go", "package main\n\nfunc main() {\n\tprintln(\"bye\")\n}\n")
+		}, false},
+	}
+
+	// Steps build on each other
+	for _, step := range steps {
+		step.change()
+		got, err := ops.IsWhitespaceOnlyChange(ctx, tempDir)
+		if err != nil {
+			t.Fatalf("%s: IsWhitespaceOnlyChange() error = %v", step.name, err)
+		}
+		if got != step.want {
+			t.Errorf("%s: IsWhitespaceOnlyChange() = %v, want %v", step.name, got, step.want)
+		}
 	}
 }
 
-func TestExecOperations_Commit_EmptyMessage(t *testing.T) {
+func TestExecOperations_GetCommitTemplate(t *testing.T) {
 	ops := NewExecOperations()
 	ctx := context.Background()
+	tempDir := t.TempDir()
 
-	err := ops.Commit(ctx, ".", "", nil)
-	if err == nil {
-		t.Error("Commit() with empty message should return error")
+	if _, stderr, err := ops.execGit(ctx, tempDir, "init"); err != nil {
+		t.Fatalf("Failed to init git repo: %s: %v", stderr, err)
 	}
-	if err.Error() != "commit message cannot be empty" {
-		t.Errorf("Commit() error = %v, want 'commit message cannot be empty'", err)
+
+	// No template configured
+	template, err := ops.GetCommitTemplate(ctx, tempDir)
+	if err != nil || template != "" {
+		t.Fatalf("GetCommitTemplate() = %q, %v; want empty without a template", template, err)
+	}
+
+	// A relative path resolves against the repository
+	content := "[TICKET-###] Summary\n\n# Explain why\n"
+	if err := os.WriteFile(filepath.Join(tempDir, ".gitmessage"), []byte(content), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if _, stderr, err := ops.execGit(ctx, tempDir, "config", "commit.template", ".gitmessage"); err != nil {
+		t.Fatalf("Failed to set commit.template: %s: %v", stderr, err)
+	}
+
+	template, err = ops.GetCommitTemplate(ctx, tempDir)
+	if err != nil {
+		t.Fatalf("GetCommitTemplate() error = %v", err)
+	}
+	if template != strings.TrimSpace(content) {
+		t.Errorf("GetCommitTemplate() = %q, want %q", template, strings.TrimSpace(content))
+	}
+
+	// A configured but missing file is reported
+	if _, stderr, err := ops.execGit(ctx, tempDir, "config", "commit.template", "missing.txt"); err != nil {
+		t.Fatalf("Failed to set commit.template: %s: %v", stderr, err)
+	}
+	if _, err := ops.GetCommitTemplate(ctx, tempDir); err == nil {
+		t.Error("GetCommitTemplate() expected error for a missing template file")
 	}
 }
 
-func TestExecOperations_CreateBranch_EmptyName(t *testing.T) {
+func TestExecOperations_RemoteSyncStatusMatchesDivergence(t *testing.T) {
 	ops := NewExecOperations()
 	ctx := context.Background()
+	tempDir := t.TempDir()
+	remoteDir := t.TempDir()
 
-	err := ops.CreateBranch(ctx, ".", "")
-	if err == nil {
-		t.Error("CreateBranch() with empty name should return error")
+	run := func(dir string, args ...string) {
+		t.Helper()
+		if _, stderr, err := ops.execGit(ctx, dir, args...); err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+	}
+	commit := func(message string) {
+		t.Helper()
+		run(tempDir, "commit", "--allow-empty", "-m", message)
+	}
+
+	run(remoteDir, "init", "--bare")
+	run(tempDir, "init")
+	run(tempDir, "config", "user.name", "Test User")
+	run(tempDir, "config", "user.email", "test@example.com")
+	run(tempDir, "checkout", "-b", "main")
+	run(tempDir, "remote", "add", "origin", remoteDir)
+
+	// origin/main gets one commit the local branch drops, and no upstream is set
+	commit("Base")
+	commit("Remote only")
+	run(tempDir, "push", "origin", "main")
+	run(tempDir, "reset", "--hard", "HEAD~1")
+	commit("Local one")
+	commit("Local two")
+
+	ahead, behind, err := ops.GetRemoteSyncStatus(ctx, tempDir, "main")
+	if err != nil {
+		t.Fatalf("GetRemoteSyncStatus() error = %v", err)
+	}
+	if ahead != 2 || behind != 1 {
+		t.Errorf("GetRemoteSyncStatus() = %d ahead, %d behind, want 2 ahead, 1 behind", ahead, behind)
+	}
+
+	ahead, behind, err = ops.GetDivergence(ctx, tempDir, "main", "origin/main")
+	if err != nil {
+		t.Fatalf("GetDivergence() error = %v", err)
+	}
+	if ahead != 2 || behind != 1 {
+		t.Errorf("GetDivergence() = %d ahead, %d behind, want 2 ahead, 1 behind", ahead, behind)
 	}
 }
 
-func TestExecOperations_CheckoutBranch_EmptyName(t *testing.T) {
+func TestExecOperations_CommitSelectedFiles(t *testing.T) {
 	ops := NewExecOperations()
 	ctx := context.Background()
+	tempDir := t.TempDir()
 
-	err := ops.CheckoutBranch(ctx, ".", "")
-	if err == nil {
-		t.Error("CheckoutBranch() with empty name should return error")
+	run := func(args ...string) string {
+		t.Helper()
+		stdout, stderr, err := ops.execGit(ctx, tempDir, args...)
+		if err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+		return stdout
+	}
+
+	run("init")
+	run("config", "user.name", "Test User")
+	run("config", "user.email", "test@example.com")
+	run("commit", "--allow-empty", "-m", "Base")
+
+	for _, name := range []string{"picked.txt", "left.txt"} {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(name+"\n"), 0644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	if err := ops.Commit(ctx, tempDir, "Add picked file", []string{"picked.txt"}); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+
+	if files := run("show", "--name-only", "--format=", "HEAD"); files != "picked.txt" {
+		t.Errorf("committed files = %q, want only picked.txt", files)
+	}
+	if status := run("status", "--porcelain"); status != "?? left.txt" {
+		t.Errorf("status = %q, want left.txt still unstaged", status)
+	}
+
+	// A staged rename, picked by its status line, stages and commits both sides
+	run("mv", "picked.txt", "renamed.txt")
+	files := StatusPaths("picked.txt -> renamed.txt")
+	if err := ops.Add(ctx, tempDir, files); err != nil {
+		t.Fatalf("Add(%v) error = %v", files, err)
+	}
+	if err := ops.Commit(ctx, tempDir, "Rename picked file", files); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	if changes := run("show", "--name-status", "--format=", "HEAD"); !strings.HasPrefix(changes, "R100") {
+		t.Errorf("committed changes = %q, want the rename", changes)
+	}
+	if status := run("status", "--porcelain"); status != "?? left.txt" {
+		t.Errorf("status = %q, want only left.txt left", status)
 	}
 }
 
@@ -395,4 +2271,179 @@ func TestExecOperations_Integration(t *testing.T) {
 			t.Error("GetDiff(staged) returned empty diff, want non-empty for staged changes")
 		}
 	})
+
+	t.Run("AmendCommit", func(t *testing.T) {
+		// test2.txt is staged; amending folds it into the last commit
+		if err := ops.AmendCommit(ctx, tempDir, "Initial commit with test2", nil); err != nil {
+			t.Fatalf("AmendCommit() error = %v", err)
+		}
+
+		commits, err := ops.GetLog(ctx, tempDir, 10)
+		if err != nil {
+			t.Fatalf("GetLog() error = %v", err)
+		}
+		if len(commits) != 1 || commits[0].Message != "Initial commit with test2" {
+			t.Errorf("GetLog() = %+v, want the single amended commit", commits)
+		}
+
+		repo, err := ops.GetStatus(ctx, tempDir)
+		if err != nil {
+			t.Fatalf("GetStatus() error = %v", err)
+		}
+		if !repo.IsClean() {
+			t.Error("IsClean() = false, want the staged file included in the amended commit")
+		}
+	})
+}
+
+func TestExecOperations_RefsFingerprint(t *testing.T) {
+	repo := t.TempDir()
+	ops := NewExecOperations()
+	ctx := context.Background()
+	run := func(args ...string) {
+		t.Helper()
+		if _, stderr, err := ops.execGit(ctx, repo, args...); err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+	}
+	fingerprint := func() string {
+		t.Helper()
+		got, err := ops.RefsFingerprint(ctx, repo)
+		if err != nil {
+			t.Fatalf("RefsFingerprint() error = %v", err)
+		}
+		return got
+	}
+
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "Test")
+	empty := fingerprint() // No HEAD yet
+
+	run("commit", "-q", "--allow-empty", "-m", "first")
+	committed := fingerprint()
+	if committed == empty || fingerprint() != committed {
+		t.Fatal("Expected the fingerprint to change with a commit and only then")
+	}
+
+	// A new branch at the same commit, then switching to it
+	run("branch", "feature")
+	branched := fingerprint()
+	run("checkout", "-q", "feature")
+	if branched == committed || fingerprint() == branched {
+		t.Error("Expected creating and checking out a branch to change the fingerprint")
+	}
+
+	run("tag", "v1.0.0")
+	tagged := fingerprint()
+	run("checkout", "-q", "main")
+	run("branch", "-D", "feature")
+	if deleted := fingerprint(); deleted == tagged {
+		t.Error("Expected deleting a branch to change the fingerprint")
+	}
+}
+
+func TestParseMergeTreeConflicts(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   []string
+	}{
+		{"conflicts", "4b825dc642cb6eb9a060e54bf8d69288fbee4904\nauth.go\nlogin.go", []string{"auth.go", "login.go"}},
+		{"messages after a blank line", "4b825dc642cb6eb9a060e54bf8d69288fbee4904\nauth.go\n\nAuto-merging auth.go\nCONFLICT (content): Merge conflict in auth.go", []string{"auth.go"}},
+		{"repeated file", "4b825dc642cb6eb9a060e54bf8d69288fbee4904\nauth.go\nauth.go", []string{"auth.go"}},
+		{"tree only", "4b825dc642cb6eb9a060e54bf8d69288fbee4904", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseMergeTreeConflicts(tt.output); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseMergeTreeConflicts() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecOperations_CanMergeLeavesWorkingTree(t *testing.T) {
+	repo := t.TempDir()
+	ops := NewExecOperations()
+	ctx := context.Background()
+	run := func(args ...string) string {
+		t.Helper()
+		stdout, stderr, err := ops.execGit(ctx, repo, args...)
+		if err != nil {
+			t.Fatalf("git %v: %s: %v", args, stderr, err)
+		}
+		return stdout
+	}
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repo, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "Test")
+	write("auth.go", "one\n")
+	write("notes.txt", "notes\n")
+	run("add", "-A")
+	run("commit", "-q", "-m", "first")
+
+	run("checkout", "-q", "-b", "feature")
+	write("auth.go", "feature\n")
+	run("commit", "-q", "-am", "feature change")
+	run("checkout", "-q", "-b", "docs", "main")
+	write("notes.txt", "more notes\n")
+	run("commit", "-q", "-am", "docs change")
+	run("checkout", "-q", "main")
+	write("auth.go", "main\n")
+	run("commit", "-q", "-am", "main change")
+
+	// Uncommitted changes stay put through both previews
+	write("notes.txt", "work in progress\n")
+	head := run("rev-parse", "HEAD")
+
+	clean, conflicts, err := ops.CanMerge(ctx, repo, "feature", "main")
+	if err != nil {
+		t.Fatalf("CanMerge(feature) error = %v", err)
+	}
+	if clean || !reflect.DeepEqual(conflicts, []string{"auth.go"}) {
+		t.Errorf("CanMerge(feature) = %v, %q, want a conflict in auth.go", clean, conflicts)
+	}
+
+	clean, conflicts, err = ops.CanMerge(ctx, repo, "docs", "feature")
+	if err != nil || !clean || len(conflicts) != 0 {
+		t.Errorf("CanMerge(docs into feature) = %v, %q, %v, want a clean merge", clean, conflicts, err)
+	}
+
+	if branch := run("branch", "--show-current"); branch != "main" || run("rev-parse", "HEAD") != head {
+		t.Errorf("Expected HEAD to stay on main at %s, got %s at %s", head, branch, run("rev-parse", "HEAD"))
+	}
+	if content, _ := os.ReadFile(filepath.Join(repo, "notes.txt")); string(content) != "work in progress\n" {
+		t.Errorf("Expected the uncommitted change kept, got %q", content)
+	}
+}
+
+func TestExecOperations_CanMergeFallbackRefusesDirtyTree(t *testing.T) {
+	ops := NewExecOperations()
+	// Git before 2.38: merge-tree only takes three trees; the working tree is dirty
+	fakeGit(t, ops, `case "$1" in
+merge-tree)
+  echo "usage: git merge-tree <base-tree> <branch1> <branch2>" >&2
+  exit 129 ;;
+status)
+  echo " M auth.go"
+  exit 0 ;;
+*)
+  echo "unexpected git $*" >&2
+  exit 1 ;;
+esac
+`)
+
+	_, _, err := ops.CanMerge(context.Background(), t.TempDir(), "feature", "main")
+	if err == nil || !strings.Contains(err.Error(), "uncommitted changes") {
+		t.Errorf("CanMerge() error = %v, want the dirty working tree refused", err)
+	}
 }
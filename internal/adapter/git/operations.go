@@ -2,6 +2,8 @@ package git
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/yourusername/gitman/internal/domain"
 )
@@ -17,6 +19,21 @@ type Operations interface {
 	// If staged is true, returns diff for staged changes; otherwise unstaged changes.
 	GetDiff(ctx context.Context, repoPath string, staged bool) (string, error)
 
+	// GetBranchDiff returns the committed changes on head since it diverged from base (git diff base...head).
+	GetBranchDiff(ctx context.Context, repoPath, base, head string) (string, error)
+
+	// GetCommitDiff returns a commit's header, message and diff against its
+	// parent (git show).
+	GetCommitDiff(ctx context.Context, repoPath, hash string) (string, error)
+
+	// IsWhitespaceOnlyChange reports whether all uncommitted changes are whitespace
+	// or line-ending churn (git diff HEAD is non-empty but empty with --ignore-all-space).
+	IsWhitespaceOnlyChange(ctx context.Context, repoPath string) (bool, error)
+
+	// GetSubmoduleUpdates returns submodule pointer changes relative to HEAD,
+	// including the commit subjects each update pulls in.
+	GetSubmoduleUpdates(ctx context.Context, repoPath string) ([]domain.SubmoduleUpdate, error)
+
 	// GetCurrentBranch returns the name of the current branch.
 	GetCurrentBranch(ctx context.Context, repoPath string) (string, error)
 
@@ -26,6 +43,9 @@ type Operations interface {
 	// CreateBranch creates a new branch with the given name.
 	CreateBranch(ctx context.Context, repoPath, branchName string) error
 
+	// CreateBranchAt creates a new branch pointing at startPoint, a commit hash or ref.
+	CreateBranchAt(ctx context.Context, repoPath, branchName, startPoint string) error
+
 	// CheckoutBranch switches to the specified branch.
 	CheckoutBranch(ctx context.Context, repoPath, branchName string) error
 
@@ -33,20 +53,67 @@ type Operations interface {
 	// If files is empty, commits all staged changes.
 	Commit(ctx context.Context, repoPath string, message string, files []string) error
 
+	// AmendCommit replaces the last commit with one that also includes the
+	// staged changes (git commit --amend). If files is not empty, they are staged first.
+	AmendCommit(ctx context.Context, repoPath string, message string, files []string) error
+
+	// CommitArgs returns the arguments Commit (or AmendCommit, with amend)
+	// passes to git, signing flags included, so a dry run shows what will run.
+	CommitArgs(message string, files []string, amend bool) []string
+
+	// SnapshotIndex records the current index (staging area) as a tree object and returns its hash.
+	SnapshotIndex(ctx context.Context, repoPath string) (string, error)
+
+	// RestoreIndex replaces the index with a snapshot from SnapshotIndex.
+	// The working tree is not touched.
+	RestoreIndex(ctx context.Context, repoPath, tree string) error
+
 	// Add stages files for commit.
 	// If files is empty, stages all changes (git add -A).
 	Add(ctx context.Context, repoPath string, files []string) error
 
-	// Push pushes commits to the remote repository.
-	// If branch is empty, pushes the current branch.
-	Push(ctx context.Context, repoPath, branch string, force bool) error
+	// Push pushes commits to remote, or to PushRemote's choice if empty. If
+	// branch is empty, pushes the current branch. The upstream is set to
+	// remote unless the branch already tracks a branch there.
+	Push(ctx context.Context, repoPath, remote, branch string, force bool) error
+
+	// PushRemote returns the remote a push of branch goes to: the remote the
+	// branch tracks, or the primary remote (GetRemoteName) if it tracks none.
+	// tracking reports whether the branch already tracks that remote.
+	PushRemote(ctx context.Context, repoPath, branch string) (remote string, tracking bool, err error)
 
-	// Pull pulls changes from the remote repository.
-	Pull(ctx context.Context, repoPath string) error
+	// PushTags pushes every local tag to remote, the primary remote if empty.
+	PushTags(ctx context.Context, repoPath, remote string) error
+
+	// PushAllBranches pushes every local branch to remote, the primary
+	// remote if empty.
+	PushAllBranches(ctx context.Context, repoPath, remote string) error
+
+	// Pull pulls changes from remote, or from the branch's upstream if empty,
+	// with --rebase when rebase is set. A conflicted rebase returns *RebaseConflictError.
+	Pull(ctx context.Context, repoPath, remote string, rebase bool) error
 
 	// Fetch fetches updates from the remote repository without merging.
 	Fetch(ctx context.Context, repoPath string) error
 
+	// IsShallowRepository reports whether the repository is a shallow clone,
+	// whose truncated history limits the graph, merge bases and divergence counts.
+	IsShallowRepository(ctx context.Context, repoPath string) (bool, error)
+
+	// Unshallow fetches the full history of a shallow clone (git fetch --unshallow).
+	Unshallow(ctx context.Context, repoPath string) error
+
+	// GetSuperproject returns the working tree of the repository repoPath is a
+	// submodule of, or "" when it isn't a submodule.
+	GetSuperproject(ctx context.Context, repoPath string) (string, error)
+
+	// CountObjects reports how the object database is stored (git count-objects -vH).
+	CountObjects(ctx context.Context, repoPath string) (*ObjectStats, error)
+
+	// RunMaintenance repacks objects and prunes garbage with git maintenance
+	// run, falling back to git gc on git older than 2.29.
+	RunMaintenance(ctx context.Context, repoPath string) error
+
 	// HasUpstream checks if the specified branch has an upstream tracking branch.
 	// If branch is empty, checks the current branch.
 	HasUpstream(ctx context.Context, repoPath, branch string) (bool, error)
@@ -65,15 +132,52 @@ type Operations interface {
 	// GetRemoteName returns the primary remote name (defaults to "origin").
 	GetRemoteName(ctx context.Context, repoPath string) (string, error)
 
+	// ListRemotes returns the names of all configured remotes.
+	ListRemotes(ctx context.Context, repoPath string) ([]string, error)
+
+	// ListRemotesWithURLs returns all configured remotes with their fetch URLs.
+	ListRemotesWithURLs(ctx context.Context, repoPath string) ([]Remote, error)
+
+	// AddRemote adds a remote called name at url. A name already in use
+	// returns an error without calling git.
+	AddRemote(ctx context.Context, repoPath, name, url string) error
+
+	// RemoveRemote removes a remote along with its remote-tracking branches.
+	RemoveRemote(ctx context.Context, repoPath, name string) error
+
+	// RenameRemote renames a remote and its remote-tracking branches. A new
+	// name already in use returns an error without calling git.
+	RenameRemote(ctx context.Context, repoPath, oldName, newName string) error
+
+	// ListRemoteBranches returns remote-tracking branches as "<remote>/<branch>".
+	ListRemoteBranches(ctx context.Context, repoPath string) ([]string, error)
+
 	// GetRemoteSyncStatus returns commits ahead/behind relative to remote tracking branch.
 	GetRemoteSyncStatus(ctx context.Context, repoPath, branch string) (ahead, behind int, err error)
 
+	// Version returns the installed git version, e.g. "2.43.0".
+	Version(ctx context.Context) (string, error)
+
 	// IsGitRepo returns true if the path is a valid git repository.
 	IsGitRepo(ctx context.Context, path string) (bool, error)
 
 	// GetLog returns recent commit history (limited to count).
 	GetLog(ctx context.Context, repoPath string, count int) ([]CommitInfo, error)
 
+	// GetReflog returns the most recent count reflog entries for HEAD, newest
+	// first, including commits no branch points at any more.
+	GetReflog(ctx context.Context, repoPath string, count int) ([]ReflogEntry, error)
+
+	// RefsFingerprint returns a string that changes whenever HEAD moves or is
+	// switched to another branch, or any branch, remote-tracking branch or tag
+	// is created, moved or deleted. Results derived from refs can be reused
+	// while it stays the same.
+	RefsFingerprint(ctx context.Context, repoPath string) (string, error)
+
+	// Blame returns who last changed each line of filePath (git blame
+	// --line-porcelain). Lines not yet committed have an all-zero hash.
+	Blame(ctx context.Context, repoPath, filePath string) ([]BlameLine, error)
+
 	// Branch Intelligence Operations
 
 	// GetBranchInfo returns detailed information about the current branch.
@@ -85,6 +189,21 @@ type Operations interface {
 	// GetBranchCommits returns commits unique to a branch (not in excludeBranch).
 	GetBranchCommits(ctx context.Context, repoPath, branch, excludeBranch string) ([]CommitInfo, error)
 
+	// GetMergePreviewStats returns per-file line stats for the changes merging
+	// source into target would bring in (git diff --numstat target...source).
+	GetMergePreviewStats(ctx context.Context, repoPath, target, source string) ([]FileStat, error)
+
+	// GetCommitsBetween returns non-merge commits reachable from b but not from a (git log a..b).
+	GetCommitsBetween(ctx context.Context, repoPath, a, b string) ([]CommitInfo, error)
+
+	// GetCommitGraph returns up to limit commits reachable from refs as drawn by
+	// git log --graph, with the branches, tags, and HEAD decorating each commit.
+	// If refs is empty, commits from all refs are included (--all).
+	GetCommitGraph(ctx context.Context, repoPath string, refs []string, limit int) (*domain.CommitGraph, error)
+
+	// GetCommitMessage returns the full message (subject and body) of a commit.
+	GetCommitMessage(ctx context.Context, repoPath, hash string) (string, error)
+
 	// ListBranches returns all local and optionally remote branches.
 	ListBranches(ctx context.Context, repoPath string, includeRemote bool) ([]string, error)
 
@@ -99,18 +218,59 @@ type Operations interface {
 	// SetParentBranch sets the parent branch for the given branch in git config.
 	SetParentBranch(ctx context.Context, repoPath, branch, parent string) error
 
+	// GetMergeTarget returns the branch remembered as branch's merge target,
+	// or "" if none was chosen.
+	GetMergeTarget(ctx context.Context, repoPath, branch string) (string, error)
+
+	// SetMergeTarget remembers target as the default branch to merge branch into.
+	SetMergeTarget(ctx context.Context, repoPath, branch, target string) error
+
+	// GetBaseBranch returns the repository's configured base branch, the
+	// integration branch divergence is measured against, or "" if none is set.
+	GetBaseBranch(ctx context.Context, repoPath string) (string, error)
+
+	// SetBaseBranch sets the repository's base branch.
+	SetBaseBranch(ctx context.Context, repoPath, base string) error
+
 	// Merge Operations
 
 	// Merge merges sourceBranch into the current branch using the specified strategy.
+	// Conflicts return *MergeConflictError and leave the merge in progress.
 	Merge(ctx context.Context, repoPath, sourceBranch, strategy, message string) error
 
-	// CanMerge checks if sourceBranch can be merged into targetBranch without conflicts.
+	// CanMerge checks if sourceBranch can be merged into targetBranch without conflicts,
+	// leaving the working tree and HEAD untouched.
 	// Returns true if merge is clean, false + conflict list if there are conflicts.
 	CanMerge(ctx context.Context, repoPath, sourceBranch, targetBranch string) (bool, []string, error)
 
 	// AbortMerge aborts an in-progress merge.
 	AbortMerge(ctx context.Context, repoPath string) error
 
+	// GetConflictedFiles lists the files with unresolved merge conflicts.
+	GetConflictedFiles(ctx context.Context, repoPath string) ([]string, error)
+
+	// ContinueMerge commits a merge whose conflicts are resolved and staged,
+	// with message, or with git's prepared message when it is empty.
+	ContinueMerge(ctx context.Context, repoPath, message string) error
+
+	// RevertCommit reverts a commit (git revert --no-edit), or only stages the
+	// reverting changes when noCommit is set. Conflicts return *RevertConflictError.
+	RevertCommit(ctx context.Context, repoPath, hash string, noCommit bool) error
+
+	// CherryPick applies commits onto the current branch in the order given.
+	// On conflict the cherry-pick is aborted and *CherryPickConflictError is returned.
+	CherryPick(ctx context.Context, repoPath string, hashes []string) error
+
+	// CherryPickAbort abandons an in-progress cherry-pick.
+	CherryPickAbort(ctx context.Context, repoPath string) error
+
+	// ContinueRebase continues a rebase whose conflicts are resolved and staged.
+	// A later commit that conflicts returns *RebaseConflictError.
+	ContinueRebase(ctx context.Context, repoPath string) error
+
+	// AbortRebase aborts an in-progress rebase, restoring the branch as it was.
+	AbortRebase(ctx context.Context, repoPath string) error
+
 	// Branch Management Operations
 
 	// DeleteBranch deletes a local branch.
@@ -128,6 +288,48 @@ type Operations interface {
 	// SetUpstreamBranch sets the upstream tracking branch for a local branch.
 	// upstream should be in the format "remote/branch" (e.g., "origin/main").
 	SetUpstreamBranch(ctx context.Context, repoPath, branch, upstream string) error
+
+	// ListHooks returns the names of executable hooks in the hooks directory
+	// (core.hooksPath if set, otherwise .git/hooks), e.g. "pre-commit".
+	ListHooks(ctx context.Context, repoPath string) ([]string, error)
+
+	// GetCommitTemplate returns the content of the file configured as commit.template,
+	// or "" when no template is configured.
+	GetCommitTemplate(ctx context.Context, repoPath string) (string, error)
+
+	// GitPath returns the absolute path of name inside the git directory
+	// (git rev-parse --git-path), e.g. for state files kept alongside .git/MERGE_HEAD.
+	GitPath(ctx context.Context, repoPath, name string) (string, error)
+
+	// Tag operations
+
+	// ListTags returns tag names, newest first.
+	ListTags(ctx context.Context, repoPath string) ([]string, error)
+
+	// CreateTag creates a tag at HEAD, annotated with message when annotated is set.
+	CreateTag(ctx context.Context, repoPath, name, message string, annotated bool) error
+
+	// CreateSignedTag creates a GPG-signed annotated tag at HEAD.
+	// signingKey selects the key; empty uses git's user.signingkey.
+	CreateSignedTag(ctx context.Context, repoPath, name, message, signingKey string) error
+
+	// VerifyTag checks a tag's signature with git tag -v.
+	// Signature problems are reported in the result; an error means the tag could not be checked.
+	VerifyTag(ctx context.Context, repoPath, name string) (*domain.TagVerification, error)
+
+	// Stash operations
+
+	// Stash saves uncommitted changes to a new stash entry (git stash push -m).
+	Stash(ctx context.Context, repoPath, message string) error
+
+	// StashList returns the stash entries, newest (stash@{0}) first.
+	StashList(ctx context.Context, repoPath string) ([]StashEntry, error)
+
+	// StashApply applies stash@{index} to the working tree, keeping the entry.
+	StashApply(ctx context.Context, repoPath string, index int) error
+
+	// StashPop applies stash@{index} and drops it from the stash.
+	StashPop(ctx context.Context, repoPath string, index int) error
 }
 
 // CommitInfo represents information about a commit.
@@ -138,6 +340,46 @@ type CommitInfo struct {
 	Message string
 }
 
+// Remote is a configured remote repository.
+type Remote struct {
+	Name string
+	URL  string // Fetch URL
+}
+
+// ObjectStats describes the object database, as reported by git count-objects -vH.
+type ObjectStats struct {
+	Loose         int    // Objects stored loose, one file each
+	LooseSize     string // Disk used by loose objects, e.g. "1.20 MiB"
+	Packed        int    // Objects stored in packs
+	Packs         int
+	PackSize      string
+	PrunePackable int // Loose objects that are also packed
+	Garbage       int // Files in the object database that are not objects
+	GarbageSize   string
+}
+
+// ReflogEntry is one move of HEAD recorded in the reflog.
+type ReflogEntry struct {
+	Hash    string
+	Date    string // When HEAD moved, in ISO format
+	Action  string // What moved HEAD, e.g. "commit", "checkout" or "reset"
+	Message string // Details of the move, e.g. the commit subject
+}
+
+// BlameLine is one line of a file with the commit that last changed it.
+type BlameLine struct {
+	Hash    string
+	Author  string
+	Time    time.Time // Author time of the commit
+	Line    int       // Line number in the file, from 1
+	Content string
+}
+
+// Uncommitted reports whether the line has changes not yet committed.
+func (l BlameLine) Uncommitted() bool {
+	return strings.Trim(l.Hash, "0") == ""
+}
+
 // DiffStats represents statistics about a diff.
 type DiffStats struct {
 	FilesChanged int
@@ -145,6 +387,21 @@ type DiffStats struct {
 	Deletions    int
 }
 
+// FileStat represents line statistics for a single file in a diff.
+type FileStat struct {
+	Path      string
+	Additions int
+	Deletions int
+	Binary    bool // Binary files report no line counts
+}
+
+// StashEntry represents an entry in the stash list.
+type StashEntry struct {
+	Index   int    // n in stash@{n}
+	Branch  string // Branch the changes were stashed on
+	Message string
+}
+
 // GitHubRepo represents parsed GitHub repository information from a git URL.
 type GitHubRepo struct {
 	Owner string
@@ -0,0 +1,95 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The *Args functions build the arguments ExecOperations passes to git, so
+// a dry run can list exactly the commands a real run would execute.
+
+// AddArgs returns the arguments for staging files; no files stages everything.
+func AddArgs(files []string) []string {
+	if len(files) == 0 {
+		return []string{"add", "-A"}
+	}
+	return append([]string{"add"}, files...)
+}
+
+// CommitArgs returns the arguments for committing message. With files, only
+// those paths are committed and anything else stays as it is in the index.
+func CommitArgs(message string, files []string, amend bool) []string {
+	args := []string{"commit"}
+	if amend {
+		args = append(args, "--amend")
+	}
+	args = append(args, commitMessageArgs(message)...)
+
+	if len(files) > 0 {
+		args = append(append(args, "--"), files...)
+	}
+	return args
+}
+
+// MergeArgs returns the arguments for merging sourceBranch with strategy.
+// The rebase strategy runs git rebase instead, and a squash merge is
+// followed by a separate commit (see Merge).
+func MergeArgs(sourceBranch, strategy, message string) []string {
+	if strategy == "rebase" {
+		return []string{"rebase", sourceBranch}
+	}
+
+	args := []string{"merge"}
+	switch strategy {
+	case "squash":
+		args = append(args, "--squash")
+	case "fast-forward":
+		args = append(args, "--ff-only")
+	case "regular":
+		args = append(args, "--no-ff")
+	}
+
+	// Squash merges take the message on the separate commit
+	if message != "" && strategy != "squash" {
+		args = append(args, "-m", message)
+	}
+
+	return append(args, sourceBranch)
+}
+
+// PushArgs returns the arguments for pushing branch to remote, setting its
+// upstream there when it doesn't track a branch on remote yet.
+func PushArgs(remote, branch string, setUpstream, force bool) []string {
+	args := []string{"push"}
+	if setUpstream {
+		args = append(args, "--set-upstream")
+	}
+	args = append(args, remote, branch)
+	if force {
+		args = append(args, "--force")
+	}
+	return args
+}
+
+// FormatCommand renders git arguments as a shell command line, quoting the
+// arguments that a shell would otherwise split or expand.
+func FormatCommand(args ...string) string {
+	parts := make([]string, 0, len(args)+1)
+	parts = append(parts, "git")
+	for _, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\n'\"\\$`!*?;&|<>()#~") {
+			arg = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		}
+		parts = append(parts, arg)
+	}
+	return strings.Join(parts, " ")
+}
+
+// SquashMessage returns the message a squash merge of sourceBranch is
+// committed with: message, or a default naming the branch when it is empty.
+func SquashMessage(sourceBranch, message string) string {
+	if message != "" {
+		return message
+	}
+	return fmt.Sprintf("Merge branch '%s' (squashed)", sourceBranch)
+}
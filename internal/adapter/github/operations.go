@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os/exec"
 	"strings"
@@ -40,6 +41,35 @@ func CheckGHAuthenticated(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+// IsAuthenticated reports whether gh is logged in to GitHub, according to
+// gh auth status. An error means the status couldn't be determined, e.g.
+// because gh isn't installed.
+func IsAuthenticated(ctx context.Context) (bool, error) {
+	cmd := exec.CommandContext(ctx, "gh", "auth", "status")
+	output, err := cmd.CombinedOutput()
+	if errors.Is(err, exec.ErrNotFound) {
+		return false, fmt.Errorf("gh CLI is not installed: %w", err)
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return false, fmt.Errorf("gh auth status did not finish: %w", ctxErr)
+	}
+
+	// gh exits non-zero when logged out, so the output decides
+	return parseAuthStatus(string(output)), nil
+}
+
+// parseAuthStatus reports whether gh auth status output shows at least one
+// logged-in account ("✓ Logged in to github.com account octocat (keyring)"
+// or, in older versions, "✓ Logged in to github.com as octocat").
+func parseAuthStatus(output string) bool {
+	for _, line := range strings.Split(output, "\n") {
+		if strings.Contains(line, "Logged in to ") {
+			return true
+		}
+	}
+	return false
+}
+
 // CreateRepository creates a new GitHub repository using gh CLI
 func CreateRepository(ctx context.Context, opts CreateRepoOptions) error {
 	if opts.Name == "" {
@@ -377,6 +407,17 @@ func CreatePR(ctx context.Context, repoPath string, opts *domain.PROptions) (*do
 	return GetPR(ctx, repoPath, prNumber)
 }
 
+// CreatePullRequest opens a pull request proposing head for base with the
+// given title and body, returning it with its URL.
+func CreatePullRequest(ctx context.Context, repoPath, base, head, title, body string) (*domain.PRInfo, error) {
+	opts, err := domain.NewPROptions(title, base, head)
+	if err != nil {
+		return nil, err
+	}
+	opts.SetBody(body)
+	return CreatePR(ctx, repoPath, opts)
+}
+
 // ListPRs lists pull requests for the current repository
 func ListPRs(ctx context.Context, repoPath string, state string) ([]*domain.PRInfo, error) {
 	// Valid states: "open", "closed", "merged", "all"
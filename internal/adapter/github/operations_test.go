@@ -0,0 +1,54 @@
+package github
+
+import "testing"
+
+func TestParseAuthStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   bool
+	}{
+		{
+			name: "logged in",
+			output: `github.com
+  ✓ Logged in to github.com account octocat (keyring)
+  - Active account: true
+  - Git operations protocol: https
+  - Token: gho_************************************`,
+			want: true,
+		},
+		{
+			name: "logged in, older gh",
+			output: `github.com
+  ✓ Logged in to github.com as octocat (/home/octocat/.config/gh/hosts.yml)
+  ✓ Git operations for github.com configured to use https protocol.`,
+			want: true,
+		},
+		{
+			name:   "not logged in",
+			output: "You are not logged into any GitHub hosts. To log in, run: gh auth login",
+			want:   false,
+		},
+		{
+			name: "invalid token",
+			output: `github.com
+  X Failed to log in to github.com account octocat (default)
+  - Active account: true
+  - The token in default is invalid.`,
+			want: false,
+		},
+		{
+			name:   "empty",
+			output: "",
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseAuthStatus(tt.output); got != tt.want {
+				t.Errorf("parseAuthStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ const (
 	TierFree
 	// TierPro indicates a paid tier API key with higher rate limits.
 	TierPro
+	// TierUnlimited indicates a local provider with no rate limits or token quotas.
+	TierUnlimited
 )
 
 // String returns the string representation of the API tier.
@@ -24,6 +26,8 @@ func (t APITier) String() string {
 		return "free"
 	case TierPro:
 		return "pro"
+	case TierUnlimited:
+		return "unlimited"
 	case TierUnknown:
 		return "unknown"
 	default:
@@ -38,6 +42,8 @@ func ParseAPITier(s string) (APITier, error) {
 		return TierFree, nil
 	case "pro":
 		return TierPro, nil
+	case "unlimited":
+		return TierUnlimited, nil
 	case "unknown":
 		return TierUnknown, nil
 	default:
@@ -52,9 +58,16 @@ type APIKey struct {
 	tier     APITier
 }
 
+// ProviderRequiresAPIKey reports whether provider needs an API key.
+// Local providers such as Ollama run without one.
+func ProviderRequiresAPIKey(provider string) bool {
+	return provider != "ollama"
+}
+
 // NewAPIKey creates a new APIKey with unknown tier.
+// The key may be empty only for providers that do not require one.
 func NewAPIKey(key, provider string) (*APIKey, error) {
-	if key == "" {
+	if key == "" && ProviderRequiresAPIKey(provider) {
 		return nil, errors.New("API key cannot be empty")
 	}
 	if provider == "" {
@@ -25,6 +25,12 @@ func TestNewAPIKey(t *testing.T) {
 			wantErr:     true,
 			errContains: "API key cannot be empty",
 		},
+		{
+			name:     "empty key for local provider",
+			key:      "",
+			provider: "ollama",
+			wantErr:  false,
+		},
 		{
 			name:        "empty provider",
 			key:         "sk-test-123456",
@@ -167,6 +173,7 @@ func TestAPIKey_ShouldReduceContext(t *testing.T) {
 		{"free tier should reduce", TierFree, true},
 		{"pro tier should not reduce", TierPro, false},
 		{"unknown tier should reduce", TierUnknown, true},
+		{"unlimited tier should not reduce", TierUnlimited, false},
 	}
 
 	for _, tt := range tests {
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 )
 
@@ -56,9 +57,13 @@ type BranchInfo struct {
 	name        string
 	branchType  BranchType
 	parent      string // Parent/base branch
+	lostParent  string // Configured parent that no longer resolves
 	upstream    string // Upstream tracking branch
 	aheadBy     int    // Commits ahead of upstream
 	behindBy    int    // Commits behind of upstream
+	base        string // Integration branch the base divergence is measured against
+	aheadOfBase int    // Commits the base branch doesn't have
+	behindBase  int    // Commits on the base branch this branch doesn't have
 	commitCount int    // Number of commits on this branch (relative to parent)
 	isProtected bool   // Whether this is a protected branch
 }
@@ -98,6 +103,18 @@ func (bi *BranchInfo) SetParent(parent string) {
 	bi.parent = parent
 }
 
+// LostParent returns the parent configured for the branch when it no longer
+// resolves, e.g. because it was deleted. Parent is empty then; both are
+// empty when no parent was configured at all.
+func (bi *BranchInfo) LostParent() string {
+	return bi.lostParent
+}
+
+// SetLostParent sets the configured parent that no longer resolves.
+func (bi *BranchInfo) SetLostParent(parent string) {
+	bi.lostParent = parent
+}
+
 // Upstream returns the upstream tracking branch.
 func (bi *BranchInfo) Upstream() string {
 	return bi.upstream
@@ -128,6 +145,24 @@ func (bi *BranchInfo) SetBehindBy(count int) {
 	bi.behindBy = count
 }
 
+// Base returns the integration branch (main, develop, ...) the branch's base
+// divergence was measured against, or "" if it wasn't measured.
+func (bi *BranchInfo) Base() string {
+	return bi.base
+}
+
+// BaseDivergence returns the commits the branch is ahead of and behind its base.
+func (bi *BranchInfo) BaseDivergence() (ahead, behind int) {
+	return bi.aheadOfBase, bi.behindBase
+}
+
+// SetBaseDivergence sets the commits the branch is ahead of and behind base.
+func (bi *BranchInfo) SetBaseDivergence(base string, ahead, behind int) {
+	bi.base = base
+	bi.aheadOfBase = ahead
+	bi.behindBase = behind
+}
+
 // CommitCount returns the number of commits on this branch.
 func (bi *BranchInfo) CommitCount() int {
 	return bi.commitCount
@@ -246,3 +281,30 @@ func (bi *BranchInfo) SuggestedMergeTarget() string {
 	// Default to main
 	return "main"
 }
+
+// ValidateUpstream validates an upstream tracking ref in "<remote>/<branch>" form.
+// If remotes is non-empty, the remote part must be one of them.
+func ValidateUpstream(upstream string, remotes []string) error {
+	if strings.TrimSpace(upstream) == "" {
+		return errors.New("upstream cannot be empty")
+	}
+	if strings.ContainsAny(upstream, " \t") {
+		return errors.New("upstream cannot contain spaces")
+	}
+
+	remote, branch, ok := strings.Cut(upstream, "/")
+	if !ok || remote == "" || branch == "" || strings.HasSuffix(branch, "/") {
+		return errors.New("upstream must be in the form <remote>/<branch>")
+	}
+
+	if len(remotes) > 0 {
+		for _, r := range remotes {
+			if r == remote {
+				return nil
+			}
+		}
+		return fmt.Errorf("remote '%s' does not exist (available: %s)", remote, strings.Join(remotes, ", "))
+	}
+
+	return nil
+}
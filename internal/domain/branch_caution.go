@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CrossCuttingDirs is how many top-level directories a changeset must touch
+// to count as cross-cutting.
+const CrossCuttingDirs = 4
+
+// IsLongLived returns true for branches that outlive any single change:
+// protected branches like main or develop, and release branches.
+func (bi *BranchInfo) IsLongLived() bool {
+	return bi.isProtected || bi.branchType == BranchTypeProtected || bi.branchType == BranchTypeRelease
+}
+
+// TopLevelDirs returns the top-level directories the changes touch, sorted.
+// Files at the repository root, like go.mod, belong to none.
+func (r *Repository) TopLevelDirs() []string {
+	seen := make(map[string]bool)
+	for _, change := range r.changes {
+		dir, _, found := strings.Cut(change.Path, "/")
+		if found {
+			seen[dir] = true
+		}
+	}
+
+	dirs := make([]string, 0, len(seen))
+	for dir := range seen {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
+// DirectCommitCaution warns when committing repo's changes straight to
+// branch looks like a mistake: a large or cross-cutting change on a
+// long-lived branch usually belongs on a branch of its own. It returns ""
+// when there is nothing to warn about; the warning never blocks the commit.
+func DirectCommitCaution(branch *BranchInfo, repo *Repository) string {
+	if branch == nil || repo == nil || !branch.IsLongLived() {
+		return ""
+	}
+
+	if repo.IsLargeChangeset() {
+		return fmt.Sprintf("This commits %d files (+%d/-%d) straight to %s. Changes this large usually go on a feature branch first.",
+			repo.TotalChanges(), repo.TotalAdditions(), repo.TotalDeletions(), branch.Name())
+	}
+
+	if dirs := repo.TopLevelDirs(); len(dirs) >= CrossCuttingDirs {
+		return fmt.Sprintf("This commits changes across %d areas (%s) straight to %s. Cross-cutting changes usually go on a feature branch first.",
+			len(dirs), strings.Join(dirs, ", "), branch.Name())
+	}
+
+	return ""
+}
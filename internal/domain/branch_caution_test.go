@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDirectCommitCaution(t *testing.T) {
+	// 25 files under one directory: large, but not cross-cutting
+	large := make([]FileChange, 25)
+	for i := range large {
+		large[i] = FileChange{Path: fmt.Sprintf("internal/api/handler%d.go", i), Status: StatusModified, Additions: 10}
+	}
+	crossCutting := []FileChange{
+		{Path: "cmd/gm/main.go", Additions: 4},
+		{Path: "internal/ui/view.go", Additions: 12},
+		{Path: "docs/usage.md", Additions: 3},
+		{Path: "scripts/release.sh", Additions: 2},
+		{Path: "go.mod", Additions: 1},
+	}
+	small := []FileChange{{Path: "internal/ui/view.go", Additions: 3}, {Path: "README.md", Additions: 1}}
+
+	tests := []struct {
+		name    string
+		branch  string
+		changes []FileChange
+		want    string // Substring of the caution; "" expects none
+	}{
+		{"large change on main", "main", large, "25 files (+250/-0) straight to main"},
+		{"large change on a release branch", "release/1.4", large, "straight to release/1.4"},
+		{"cross-cutting change on develop", "develop", crossCutting, "4 areas (cmd, docs, internal, scripts)"},
+		{"small change on main", "main", small, ""},
+		{"large change on a feature branch", "feature/api", large, ""},
+		{"cross-cutting change on a feature branch", "feature/release-script", crossCutting, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, err := NewRepository("/tmp/repo")
+			if err != nil {
+				t.Fatal(err)
+			}
+			repo.SetChanges(tt.changes)
+			branch, err := NewBranchInfo(tt.branch)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := DirectCommitCaution(branch, repo)
+			if tt.want == "" {
+				if got != "" {
+					t.Errorf("DirectCommitCaution() = %q, want no caution", got)
+				}
+				return
+			}
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("DirectCommitCaution() = %q, want it to contain %q", got, tt.want)
+			}
+		})
+	}
+}
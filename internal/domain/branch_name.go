@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// maxBranchSlugLength keeps generated branch descriptions readable
+const maxBranchSlugLength = 50
+
+// SlugifyBranchName turns free text (typically a commit subject) into a
+// branch-safe description: lowercase words joined by hyphens, with any
+// conventional commit prefix removed.
+func SlugifyBranchName(text string) string {
+	text = conventionalPrefixPattern.ReplaceAllString(strings.TrimSpace(text), "")
+
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(text) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			pendingHyphen = false
+			continue
+		}
+		pendingHyphen = true
+	}
+
+	slug := b.String()
+	if len(slug) > maxBranchSlugLength {
+		slug = slug[:maxBranchSlugLength]
+		// Don't leave half a word behind
+		if i := strings.LastIndexByte(slug, '-'); i > 0 {
+			slug = slug[:i]
+		}
+	}
+	return slug
+}
+
+// GenerateBranchName builds a branch name from free text using the
+// configured naming pattern (e.g. "feature/{description}").
+func (c *Config) GenerateBranchName(text string) string {
+	slug := SlugifyBranchName(text)
+	if slug == "" {
+		return ""
+	}
+
+	pattern := c.Naming.Pattern
+	if !strings.Contains(pattern, "{description}") {
+		pattern = "feature/{description}"
+	}
+	return strings.ReplaceAll(pattern, "{description}", slug)
+}
+
+// ValidateBranchName checks that name is a valid git branch name and, when
+// naming is enforced, that it starts with an allowed prefix.
+func (c *Config) ValidateBranchName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("branch name cannot be empty")
+	}
+	if strings.ContainsAny(name, " ~^:?*[\\") {
+		return errors.New("branch name cannot contain spaces or any of ~^:?*[\\")
+	}
+	if strings.HasPrefix(name, "-") || strings.HasPrefix(name, "/") || strings.HasSuffix(name, "/") {
+		return errors.New("branch name cannot start with '-' or start or end with '/'")
+	}
+	if strings.Contains(name, "..") || strings.Contains(name, "//") || strings.Contains(name, "@{") {
+		return errors.New("branch name cannot contain '..', '//' or '@{'")
+	}
+	if strings.HasSuffix(name, ".") || strings.HasSuffix(name, ".lock") {
+		return errors.New("branch name cannot end with '.' or '.lock'")
+	}
+
+	if c.Naming.Enforce {
+		prefix, _, found := strings.Cut(name, "/")
+		if !found || !c.IsValidBranchPrefix(prefix) {
+			return fmt.Errorf("branch name must start with one of: %s/", strings.Join(c.Naming.AllowedPrefixes, "/, "))
+		}
+	}
+	return nil
+}
+
+// NamingRules describes the branch naming rules for display
+func (c *Config) NamingRules() string {
+	if !c.Naming.Enforce {
+		return "Any valid git branch name is allowed"
+	}
+	return fmt.Sprintf("Pattern: %s  •  Allowed prefixes: %s", c.Naming.Pattern, strings.Join(c.Naming.AllowedPrefixes, ", "))
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "testing"
+
+func TestSlugifyBranchName(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Add OAuth login", "add-oauth-login"},
+		{"feat(auth)!: Support SSO & 2FA.", "support-sso-2fa"},
+		{"  --Fix   the   bug--  ", "fix-the-bug"},
+		{"", ""},
+		{"this subject is long enough that it needs to be cut at a word boundary", "this-subject-is-long-enough-that-it-needs-to-be"},
+	}
+
+	for _, tt := range tests {
+		if got := SlugifyBranchName(tt.input); got != tt.want {
+			t.Errorf("SlugifyBranchName(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestConfig_ValidateBranchName(t *testing.T) {
+	cfg := NewDefaultConfig()
+	enforced := NewDefaultConfig()
+	enforced.Naming.Enforce = true
+
+	tests := []struct {
+		name    string
+		cfg     *Config
+		branch  string
+		wantErr bool
+	}{
+		{"plain name when not enforced", cfg, "my-feature", false},
+		{"empty", cfg, " ", true},
+		{"spaces", cfg, "my feature", true},
+		{"double dot", cfg, "feature/a..b", true},
+		{"lock suffix", cfg, "feature/x.lock", true},
+		{"allowed prefix", enforced, "bugfix/crash", false},
+		{"missing prefix", enforced, "crash", true},
+		{"disallowed prefix", enforced, "wip/crash", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.ValidateBranchName(tt.branch)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBranchName(%q) error = %v, wantErr %v", tt.branch, err, tt.wantErr)
+			}
+		})
+	}
+}
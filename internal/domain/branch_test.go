@@ -0,0 +1,33 @@
+package domain
+
+import "testing"
+
+func TestValidateUpstream(t *testing.T) {
+	remotes := []string{"origin", "upstream"}
+
+	tests := []struct {
+		name     string
+		upstream string
+		remotes  []string
+		wantErr  bool
+	}{
+		{"valid", "origin/main", remotes, false},
+		{"valid nested branch", "upstream/feature/login", remotes, false},
+		{"valid without known remotes", "fork/main", nil, false},
+		{"empty", "", remotes, true},
+		{"missing slash", "main", remotes, true},
+		{"missing remote", "/main", remotes, true},
+		{"missing branch", "origin/", remotes, true},
+		{"contains spaces", "origin/my branch", remotes, true},
+		{"unknown remote", "fork/main", remotes, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUpstream(tt.upstream, tt.remotes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpstream(%q) error = %v, wantErr %v", tt.upstream, err, tt.wantErr)
+			}
+		})
+	}
+}
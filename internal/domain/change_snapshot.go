@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ChangeSnapshot fingerprints each changed file at analysis time, keyed by
+// path, so a later analysis can tell which files changed since.
+type ChangeSnapshot map[string]string
+
+// NewChangeSnapshot fingerprints changes by their section of diff. Files the
+// diff doesn't cover (untracked files) fall back to their status and line counts.
+func NewChangeSnapshot(changes []FileChange, diff string) ChangeSnapshot {
+	sections := SplitDiffByFile(diff)
+
+	snapshot := make(ChangeSnapshot, len(changes))
+	for _, change := range changes {
+		content, ok := sections[change.Path]
+		if !ok {
+			content = fmt.Sprintf("%s +%d -%d", change.Status, change.Additions, change.Deletions)
+		}
+		sum := sha256.Sum256([]byte(content))
+		snapshot[change.Path] = hex.EncodeToString(sum[:8])
+	}
+	return snapshot
+}
+
+// ChangedSince returns the files, sorted, that are new since prior or whose
+// changes differ from it. Files that were in prior but are gone are not listed.
+func (s ChangeSnapshot) ChangedSince(prior ChangeSnapshot) []string {
+	var changed []string
+	for path, fingerprint := range s {
+		if prior[path] != fingerprint {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// SplitDiffByFile splits git diff output into per-file sections keyed by the
+// file's new path. A file that appears more than once (staged and unstaged)
+// gets its sections concatenated.
+func SplitDiffByFile(diff string) map[string]string {
+	sections := make(map[string]string)
+
+	var current string
+	var sb strings.Builder
+	flush := func() {
+		if current != "" {
+			sections[current] += sb.String()
+		}
+		sb.Reset()
+	}
+
+	for _, line := range strings.Split(diff, "\n") {
+		if strings.HasPrefix(line, "diff --git ") {
+			flush()
+			current = ""
+			// diff --git a/<old> b/<new>; the new path may contain spaces
+			if i := strings.LastIndex(line, " b/"); i >= 0 {
+				current = line[i+len(" b/"):]
+			}
+		}
+		if current != "" {
+			sb.WriteString(line + "\n")
+		}
+	}
+	flush()
+
+	return sections
+}
+
+// FilterDiff keeps only the sections of diff for paths.
+func FilterDiff(diff string, paths []string) string {
+	sections := SplitDiffByFile(diff)
+
+	var sb strings.Builder
+	for _, path := range paths {
+		sb.WriteString(sections[path])
+	}
+	return sb.String()
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChangeSnapshot_ChangedSince(t *testing.T) {
+	changes := []FileChange{
+		{Path: "auth/login.go", Status: StatusModified, Additions: 2, Deletions: 1},
+		{Path: "README.md", Status: StatusModified, Additions: 1},
+	}
+	diff := "diff --git a/auth/login.go b/auth/login.go\n" +
+		"@@ -1,3 +1,4 @@\n-old\n+new\n+more\n" +
+		"diff --git a/README.md b/README.md\n" +
+		"@@ -1 +1,2 @@\n+docs\n"
+	prior := NewChangeSnapshot(changes, diff)
+
+	// README.md is edited again after the analysis; login.go is untouched
+	changes[1].Additions = 2
+	diff = "diff --git a/auth/login.go b/auth/login.go\n" +
+		"@@ -1,3 +1,4 @@\n-old\n+new\n+more\n" +
+		"diff --git a/README.md b/README.md\n" +
+		"@@ -1 +1,3 @@\n+docs\n+more docs\n"
+	current := NewChangeSnapshot(changes, diff)
+
+	if got, want := current.ChangedSince(prior), []string{"README.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangedSince() = %v, want %v", got, want)
+	}
+
+	// An untracked file that appears later is new
+	changes = append(changes, FileChange{Path: "notes.txt", Status: StatusUntracked, Additions: 3})
+	current = NewChangeSnapshot(changes, diff)
+	if got, want := current.ChangedSince(prior), []string{"README.md", "notes.txt"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangedSince() with a new file = %v, want %v", got, want)
+	}
+
+	if got := prior.ChangedSince(prior); len(got) != 0 {
+		t.Errorf("ChangedSince() of itself = %v, want nothing", got)
+	}
+}
+
+func TestFilterDiff(t *testing.T) {
+	diff := "diff --git a/a.go b/a.go\n+a\n" +
+		"diff --git a/dir/my file.go b/dir/my file.go\n+b\n" +
+		"diff --git a/c.go b/c.go\n+c\n"
+
+	got := FilterDiff(diff, []string{"dir/my file.go"})
+	want := "diff --git a/dir/my file.go b/dir/my file.go\n+b\n"
+	if got != want {
+		t.Errorf("FilterDiff() = %q, want %q", got, want)
+	}
+}
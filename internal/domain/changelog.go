@@ -0,0 +1,96 @@
+package domain
+
+import "strings"
+
+// OtherChangesHeading heads the group for commits that aren't features,
+// fixes or breaking changes, including commits that aren't conventional.
+const OtherChangesHeading = "Other changes"
+
+// Changelog is a release changelog section grouped by change type.
+type Changelog struct {
+	Title    string   // Section heading, e.g. "v1.2.0 (2024-05-01)"
+	Breaking []string // Changes that require action from users
+	Features []string // New functionality
+	Fixes    []string // Bug fixes
+	Other    []string // Refactors, docs, chores, and anything else worth noting
+}
+
+// IsEmpty returns true if the changelog has no entries.
+func (c Changelog) IsEmpty() bool {
+	return len(c.Breaking) == 0 && len(c.Features) == 0 && len(c.Fixes) == 0 && len(c.Other) == 0
+}
+
+// ChangelogGroup is one headed group of changelog entries.
+type ChangelogGroup struct {
+	Heading string
+	Entries []string
+}
+
+// Groups returns the changelog's groups in release-note order, breaking
+// changes first. Empty groups are included.
+func (c Changelog) Groups() []ChangelogGroup {
+	return []ChangelogGroup{
+		{"Breaking Changes", c.Breaking},
+		{"Features", c.Features},
+		{"Fixes", c.Fixes},
+		{OtherChangesHeading, c.Other},
+	}
+}
+
+// Markdown renders the changelog as a markdown section. Empty groups are omitted.
+func (c Changelog) Markdown() string {
+	var sb strings.Builder
+	sb.WriteString("## " + c.Title + "\n")
+
+	for _, group := range c.Groups() {
+		if len(group.Entries) == 0 {
+			continue
+		}
+		sb.WriteString("\n### " + group.Heading + "\n\n")
+		for _, entry := range group.Entries {
+			sb.WriteString("- " + strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(entry), "- ")) + "\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// GroupCommitsByType buckets commit messages by conventional-commit type:
+// feat under Features, fix under Fixes, and anything marked breaking ("feat!:"
+// or a BREAKING CHANGE footer) under Breaking. Other types, and commits that
+// aren't conventional, go under Other. Entries are the subject without its
+// type prefix, with the scope in bold, in the order given.
+func GroupCommitsByType(messages []string) *Changelog {
+	changelog := &Changelog{}
+	for _, message := range messages {
+		subject, body, _ := strings.Cut(strings.TrimSpace(message), "\n")
+		subject = strings.TrimSpace(subject)
+		if subject == "" {
+			continue
+		}
+
+		match := conventionalHeaderPattern.FindStringSubmatch(subject)
+		if match == nil || strings.TrimSpace(match[4]) == "" {
+			changelog.Other = append(changelog.Other, subject)
+			continue
+		}
+
+		commitType, scope, breaking, description := match[1], strings.TrimSpace(match[2]), match[3], strings.TrimSpace(match[4])
+		entry := description
+		if scope != "" {
+			entry = "**" + scope + ":** " + description
+		}
+
+		switch {
+		case breaking != "" || strings.Contains(body, "BREAKING CHANGE:") || strings.Contains(body, "BREAKING-CHANGE:"):
+			changelog.Breaking = append(changelog.Breaking, entry)
+		case commitType == "feat":
+			changelog.Features = append(changelog.Features, entry)
+		case commitType == "fix":
+			changelog.Fixes = append(changelog.Fixes, entry)
+		default:
+			changelog.Other = append(changelog.Other, entry)
+		}
+	}
+	return changelog
+}
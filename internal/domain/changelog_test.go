@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGroupCommitsByType(t *testing.T) {
+	changelog := GroupCommitsByType([]string{
+		"feat(ui): add tags view",
+		"fix: handle detached HEAD\n\nCloses #12.",
+		"refactor!: rename config keys",
+		"feat: drop Go 1.20 support\n\nBREAKING CHANGE: needs Go 1.21",
+		"docs: explain changelog flags",
+		"Update README",
+		"WIP:",
+		"",
+	})
+
+	want := &Changelog{
+		Breaking: []string{"rename config keys", "drop Go 1.20 support"},
+		Features: []string{"**ui:** add tags view"},
+		Fixes:    []string{"handle detached HEAD"},
+		Other:    []string{"explain changelog flags", "Update README", "WIP:"},
+	}
+	if !reflect.DeepEqual(changelog, want) {
+		t.Errorf("GroupCommitsByType() = %+v, want %+v", changelog, want)
+	}
+}
+
+func TestChangelog_MarkdownOtherChanges(t *testing.T) {
+	changelog := GroupCommitsByType([]string{"Update README"})
+	changelog.Title = "v1.1.0"
+
+	want := "## v1.1.0\n\n### Other changes\n\n- Update README\n"
+	if got := changelog.Markdown(); got != want {
+		t.Errorf("Markdown() = %q, want %q", got, want)
+	}
+}
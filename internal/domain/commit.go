@@ -15,7 +15,8 @@ type CommitMessage struct {
 	scope       string // optional scope in conventional commits
 }
 
-// NewCommitMessage creates a new commit message.
+// NewCommitMessage creates a new commit message. A multi-line message is
+// split into its subject line and body, as git does.
 func NewCommitMessage(title string) (*CommitMessage, error) {
 	if title == "" {
 		return nil, errors.New("commit title cannot be empty")
@@ -24,6 +25,13 @@ func NewCommitMessage(title string) (*CommitMessage, error) {
 	// Trim whitespace
 	title = strings.TrimSpace(title)
 
+	// Everything after the first line is the body
+	var body string
+	if subject, rest, ok := strings.Cut(title, "\n"); ok {
+		title = strings.TrimSpace(subject)
+		body = strings.TrimSpace(rest)
+	}
+
 	// If title is too long, truncate it intelligently
 	if len(title) > 72 {
 		// Try to truncate at a word boundary
@@ -37,6 +45,7 @@ func NewCommitMessage(title string) (*CommitMessage, error) {
 
 	return &CommitMessage{
 		title: title,
+		body:  body,
 	}, nil
 }
 
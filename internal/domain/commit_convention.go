@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// conventionalHeaderPattern splits a conventional commit header into its
+// type, optional scope, optional breaking marker and description.
+var conventionalHeaderPattern = regexp.MustCompile(`^([a-z]+)(?:\(([^)]*)\))?(!)?:\s*(.*)$`)
+
+// ValidateCommitSubject checks that subject follows the configured commit
+// convention. Only the "conventional" and "gitmoji" conventions are checked
+// (a leading gitmoji is optional); "custom" and "none" accept any non-empty subject.
+func (c *Config) ValidateCommitSubject(subject string) error {
+	subject = strings.TrimSpace(subject)
+	if subject == "" {
+		return errors.New("commit message cannot be empty")
+	}
+	if !c.UsesCommitTypes() {
+		return nil
+	}
+	if c.Commits.Convention == "gitmoji" {
+		subject = StripGitmoji(subject)
+	}
+
+	match := conventionalHeaderPattern.FindStringSubmatch(subject)
+	if match == nil {
+		return fmt.Errorf("commit message must start with a type, e.g. \"feat: ...\" (allowed: %s)", strings.Join(c.Commits.Types, ", "))
+	}
+
+	commitType, scope, breaking, description := match[1], match[2], match[3], match[4]
+	if !c.IsValidCommitType(commitType) {
+		return fmt.Errorf("commit type %q is not allowed (allowed: %s)", commitType, strings.Join(c.Commits.Types, ", "))
+	}
+	if c.Commits.RequireScope && strings.TrimSpace(scope) == "" {
+		return fmt.Errorf("commit message must include a scope, e.g. \"%s(api): ...\"", commitType)
+	}
+	if c.Commits.RequireBreaking && breaking == "" {
+		return fmt.Errorf("commit message must mark the change as breaking, e.g. \"%s!: ...\"", commitType)
+	}
+	if strings.TrimSpace(description) == "" {
+		return errors.New("commit message needs a description after the type")
+	}
+	return nil
+}
+
+// genericScopeDirs are layout directories that say nothing about what changed,
+// so they are never used as a scope on their own.
+var genericScopeDirs = map[string]bool{"internal": true, "pkg": true, "src": true, "lib": true}
+
+// ScopeFromPaths suggests a conventional commit scope from changed file paths:
+// the innermost directory they all share, e.g. "ui" for files under
+// internal/ui/. It returns "" when the files share no meaningful directory.
+func ScopeFromPaths(paths []string) string {
+	if len(paths) == 0 {
+		return ""
+	}
+
+	var common []string
+	for i, path := range paths {
+		dirs := strings.Split(filepath.ToSlash(path), "/")
+		dirs = dirs[:len(dirs)-1] // Drop the file name
+		if i == 0 {
+			common = dirs
+			continue
+		}
+
+		n := 0
+		for n < len(common) && n < len(dirs) && common[n] == dirs[n] {
+			n++
+		}
+		common = common[:n]
+	}
+
+	if len(common) == 0 {
+		return ""
+	}
+	scope := strings.ToLower(common[len(common)-1])
+	if genericScopeDirs[scope] {
+		return ""
+	}
+	return scope
+}
+
+// AddScope inserts scope into a conventional title that has none, e.g.
+// "feat: add login" becomes "feat(ui): add login". It reports whether the
+// title changed; titles that are not conventional or already scoped are kept.
+func (cm *CommitMessage) AddScope(scope string) bool {
+	if cm == nil || scope == "" {
+		return false
+	}
+
+	match := conventionalHeaderPattern.FindStringSubmatch(cm.title)
+	if match == nil || match[2] != "" {
+		return false
+	}
+
+	commitType, breaking, description := match[1], match[3], match[4]
+	cm.title = fmt.Sprintf("%s(%s)%s: %s", commitType, scope, breaking, description)
+	cm.scope = scope
+	return true
+}
@@ -0,0 +1,85 @@
+package domain
+
+import "testing"
+
+func TestConfig_ValidateCommitSubject(t *testing.T) {
+	cfg := NewDefaultConfig()
+	scoped := NewDefaultConfig()
+	scoped.Commits.RequireScope = true
+	none := NewDefaultConfig()
+	none.Commits.Convention = "none"
+
+	tests := []struct {
+		name    string
+		cfg     *Config
+		subject string
+		wantErr bool
+	}{
+		{"valid", cfg, "feat: add login page", false},
+		{"valid with scope and breaking marker", cfg, "fix(auth)!: reject expired tokens", false},
+		{"missing type", cfg, "Add login page", true},
+		{"unknown type", cfg, "feature: add login page", true},
+		{"missing description", cfg, "feat: ", true},
+		{"empty", cfg, "  ", true},
+		{"scope required", scoped, "feat: add login page", true},
+		{"scope given", scoped, "feat(ui): add login page", false},
+		{"no convention", none, "Add login page", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.ValidateCommitSubject(tt.subject)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCommitSubject(%q) error = %v, wantErr %v", tt.subject, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScopeFromPaths(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths []string
+		want  string
+	}{
+		{"shared package", []string{"internal/ui/commit_view.go", "internal/ui/styles.go"}, "ui"},
+		{"nested directories", []string{"internal/adapter/git/exec.go", "internal/adapter/ai/cerebras.go"}, "adapter"},
+		{"only a layout directory in common", []string{"internal/ui/app_model.go", "internal/usecase/execute_commit.go"}, ""},
+		{"root file", []string{"README.md", "docs/setup.md"}, ""},
+		{"single file", []string{"cmd/gm/main.go"}, "gm"},
+		{"no files", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ScopeFromPaths(tt.paths); got != tt.want {
+				t.Errorf("ScopeFromPaths(%v) = %q, want %q", tt.paths, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommitMessage_AddScope(t *testing.T) {
+	tests := []struct {
+		title     string
+		wantTitle string
+		wantAdded bool
+	}{
+		{"feat: add login page", "feat(ui): add login page", true},
+		{"fix!: reject expired tokens", "fix(ui)!: reject expired tokens", true},
+		{"feat(auth): add login page", "feat(auth): add login page", false},
+		{"Add login page", "Add login page", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			msg, err := NewCommitMessage(tt.title)
+			if err != nil {
+				t.Fatalf("NewCommitMessage() error = %v", err)
+			}
+			if added := msg.AddScope("ui"); added != tt.wantAdded || msg.Title() != tt.wantTitle {
+				t.Errorf("AddScope() = %v, title %q, want %v, %q", added, msg.Title(), tt.wantAdded, tt.wantTitle)
+			}
+		})
+	}
+}
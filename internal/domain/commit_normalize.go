@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NormalizeRules controls post-processing applied to AI-generated commit subjects.
+// All rules are disabled by default so messages are presented unchanged.
+type NormalizeRules struct {
+	Capitalize          bool `json:"capitalize"`            // Uppercase the first letter of the description
+	StripTrailingPeriod bool `json:"strip_trailing_period"` // Remove trailing "." from the subject
+	Imperative          bool `json:"imperative"`            // Rewrite "Added"/"Adds"/"Adding" to "Add"
+}
+
+// Any returns true if at least one rule is enabled.
+func (r NormalizeRules) Any() bool {
+	return r.Capitalize || r.StripTrailingPeriod || r.Imperative
+}
+
+// conventionalPrefixPattern matches a conventional commit prefix such as "feat(ui)!: ".
+var conventionalPrefixPattern = regexp.MustCompile(`^[a-z]+(\([^)]*\))?!?:\s*`)
+
+// imperativeVerbs lists base verbs recognised by the imperative heuristic.
+// Only these verbs are rewritten, which keeps the heuristic from mangling
+// subjects that merely start with a word ending in "s" or "ed".
+var imperativeVerbs = []string{
+	"add", "adjust", "allow", "bump", "change", "clean", "convert", "create",
+	"delete", "deprecate", "disable", "document", "drop", "enable", "ensure",
+	"extract", "fix", "handle", "implement", "improve", "introduce", "merge",
+	"move", "optimize", "prevent", "refactor", "remove", "rename", "reorganize",
+	"replace", "restore", "revert", "simplify", "support", "update", "upgrade",
+	"use", "validate",
+}
+
+// doubledVerbs double their final consonant when inflected (drop -> dropped).
+var doubledVerbs = map[string]bool{"drop": true}
+
+// imperativeForms maps inflected forms (lowercase) to their imperative base.
+var imperativeForms = buildImperativeForms(imperativeVerbs)
+
+func buildImperativeForms(verbs []string) map[string]string {
+	forms := make(map[string]string, len(verbs)*4)
+	for _, verb := range verbs {
+		// Third person: adds, fixes
+		if strings.HasSuffix(verb, "x") || strings.HasSuffix(verb, "sh") || strings.HasSuffix(verb, "ch") {
+			forms[verb+"es"] = verb
+		} else {
+			forms[verb+"s"] = verb
+		}
+
+		// Past tense and gerund: added/adding, removed/removing, dropped/dropping
+		if doubledVerbs[verb] {
+			last := verb[len(verb)-1:]
+			forms[verb+last+"ed"] = verb
+			forms[verb+last+"ing"] = verb
+		} else if strings.HasSuffix(verb, "e") {
+			forms[verb+"d"] = verb
+			forms[strings.TrimSuffix(verb, "e")+"ing"] = verb
+		} else {
+			forms[verb+"ed"] = verb
+			forms[verb+"ing"] = verb
+		}
+	}
+
+	return forms
+}
+
+// NormalizeSubject applies the enabled rules to a commit subject line.
+// A conventional commit prefix ("type(scope): ") is preserved and the rules
+// are applied to the description that follows it.
+func NormalizeSubject(s string, rules NormalizeRules) string {
+	s = strings.TrimSpace(s)
+	if s == "" || !rules.Any() {
+		return s
+	}
+
+	prefix := conventionalPrefixPattern.FindString(s)
+	description := strings.TrimSpace(s[len(prefix):])
+	if prefix != "" {
+		prefix = strings.TrimSpace(prefix) + " "
+	}
+
+	if rules.StripTrailingPeriod {
+		description = strings.TrimRight(description, ".")
+		description = strings.TrimSpace(description)
+	}
+
+	if rules.Imperative {
+		description = toImperative(description)
+	}
+
+	if rules.Capitalize {
+		description = capitalizeFirst(description)
+	}
+
+	if description == "" {
+		return strings.TrimSpace(prefix)
+	}
+
+	return prefix + description
+}
+
+// toImperative rewrites the first word to its imperative form if it is a known verb.
+func toImperative(description string) string {
+	firstWord, rest, _ := strings.Cut(description, " ")
+	base, ok := imperativeForms[strings.ToLower(firstWord)]
+	if !ok {
+		return description
+	}
+
+	// Preserve the original capitalisation of the first letter
+	if r, _ := utf8.DecodeRuneInString(firstWord); unicode.IsUpper(r) {
+		base = capitalizeFirst(base)
+	}
+
+	if rest == "" {
+		return base
+	}
+	return base + " " + rest
+}
+
+// capitalizeFirst uppercases the first rune of s.
+func capitalizeFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+// Normalize applies the rules to the commit message title in place.
+func (cm *CommitMessage) Normalize(rules NormalizeRules) {
+	if cm == nil || !rules.Any() {
+		return
+	}
+
+	if normalized := NormalizeSubject(cm.title, rules); normalized != "" {
+		cm.title = normalized
+	}
+}
@@ -0,0 +1,119 @@
+package domain
+
+import "testing"
+
+func TestNormalizeSubject(t *testing.T) {
+	tests := []struct {
+		name    string
+		subject string
+		rules   NormalizeRules
+		want    string
+	}{
+		{
+			name:    "no rules leaves subject unchanged",
+			subject: "added login page.",
+			rules:   NormalizeRules{},
+			want:    "added login page.",
+		},
+		{
+			name:    "capitalize plain subject",
+			subject: "add login page",
+			rules:   NormalizeRules{Capitalize: true},
+			want:    "Add login page",
+		},
+		{
+			name:    "capitalize conventional description",
+			subject: "feat(auth): add login page",
+			rules:   NormalizeRules{Capitalize: true},
+			want:    "feat(auth): Add login page",
+		},
+		{
+			name:    "capitalize keeps breaking marker",
+			subject: "feat!: drop legacy api",
+			rules:   NormalizeRules{Capitalize: true},
+			want:    "feat!: Drop legacy api",
+		},
+		{
+			name:    "strip single trailing period",
+			subject: "Fix typo in README.",
+			rules:   NormalizeRules{StripTrailingPeriod: true},
+			want:    "Fix typo in README",
+		},
+		{
+			name:    "strip multiple trailing periods",
+			subject: "fix: handle nil config...",
+			rules:   NormalizeRules{StripTrailingPeriod: true},
+			want:    "fix: handle nil config",
+		},
+		{
+			name:    "strip period keeps inner periods",
+			subject: "Bump go to 1.24.2",
+			rules:   NormalizeRules{StripTrailingPeriod: true},
+			want:    "Bump go to 1.24.2",
+		},
+		{
+			name:    "imperative past tense",
+			subject: "Added retry logic",
+			rules:   NormalizeRules{Imperative: true},
+			want:    "Add retry logic",
+		},
+		{
+			name:    "imperative third person",
+			subject: "fix: fixes race in watcher",
+			rules:   NormalizeRules{Imperative: true},
+			want:    "fix: fix race in watcher",
+		},
+		{
+			name:    "imperative gerund with trailing e",
+			subject: "removing dead code",
+			rules:   NormalizeRules{Imperative: true},
+			want:    "remove dead code",
+		},
+		{
+			name:    "imperative doubled consonant",
+			subject: "Dropped support for go 1.20",
+			rules:   NormalizeRules{Imperative: true},
+			want:    "Drop support for go 1.20",
+		},
+		{
+			name:    "imperative ignores unknown verbs",
+			subject: "Needs more tests",
+			rules:   NormalizeRules{Imperative: true},
+			want:    "Needs more tests",
+		},
+		{
+			name:    "all rules combined",
+			subject: "refactor(ui): updated dashboard layout.",
+			rules:   NormalizeRules{Capitalize: true, StripTrailingPeriod: true, Imperative: true},
+			want:    "refactor(ui): Update dashboard layout",
+		},
+		{
+			name:    "empty subject",
+			subject: "   ",
+			rules:   NormalizeRules{Capitalize: true},
+			want:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NormalizeSubject(tt.subject, tt.rules)
+			if got != tt.want {
+				t.Errorf("NormalizeSubject(%q) = %q, want %q", tt.subject, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommitMessage_Normalize(t *testing.T) {
+	msg, err := NewCommitMessage("updated docs.")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() unexpected error = %v", err)
+	}
+
+	msg.Normalize(NormalizeRules{Capitalize: true, StripTrailingPeriod: true, Imperative: true})
+
+	if msg.Title() != "Update docs" {
+		t.Errorf("Title() = %q, want %q", msg.Title(), "Update docs")
+	}
+}
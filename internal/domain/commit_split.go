@@ -0,0 +1,8 @@
+package domain
+
+// CommitGroup is one commit of a split: the files it stages and its message.
+type CommitGroup struct {
+	Files     []string       // Paths relative to the repository root
+	Message   *CommitMessage // Message for this group's commit
+	Reasoning string         // Why these files belong together
+}
@@ -196,6 +196,20 @@ func TestCommitMessage_Body(t *testing.T) {
 	}
 }
 
+func TestNewCommitMessage_MultiLine(t *testing.T) {
+	msg, err := NewCommitMessage("Add session refresh\n\n- Refresh tokens before they expire\n- Retry once on 401\n")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() error = %v", err)
+	}
+
+	if msg.Title() != "Add session refresh" {
+		t.Errorf("Title() = %q, want the first line", msg.Title())
+	}
+	if want := "- Refresh tokens before they expire\n- Retry once on 401"; msg.Body() != want {
+		t.Errorf("Body() = %q, want %q", msg.Body(), want)
+	}
+}
+
 func TestCommitMessage_FullMessage(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"fmt"
+	"time"
 )
 
 // Config represents the complete GitMind configuration
@@ -17,21 +18,58 @@ type Config struct {
 
 // GitConfig holds git-related configuration
 type GitConfig struct {
-	MainBranch        string   `json:"main_branch"`
-	ProtectedBranches []string `json:"protected_branches"`
-	AutoPush          bool     `json:"auto_push"`
-	AutoPull          bool     `json:"auto_pull"`
+	MainBranch           string   `json:"main_branch"`
+	ProtectedBranches    []string `json:"protected_branches"`
+	AutoPush             bool     `json:"auto_push"`
+	AutoPull             bool     `json:"auto_pull"`
+	PullRebase           bool     `json:"pull_rebase"`            // Pull with --rebase so local commits stay on top instead of a merge commit
+	PostCommitCommand    string   `json:"post_commit_command"`    // Shell command run after each successful commit
+	DefaultMergeStrategy string   `json:"default_merge_strategy"` // Strategy used when AI is disabled ("squash", "regular", "fast-forward")
+	SignTags             bool     `json:"sign_tags"`              // Create GPG-signed tags (git tag -s)
+	SignCommits          bool     `json:"sign_commits"`           // Sign commits (git commit -S); off leaves git's commit.gpgsign in charge
+	SigningKey           string   `json:"signing_key"`            // Key ID for signed tags and commits; empty uses git's user.signingkey
+	KeepStagedOnFailure  bool     `json:"keep_staged_on_failure"` // Leave files staged by GitMind when a commit fails (default restores the previous index)
+	AutoFetchOnOpen      bool     `json:"auto_fetch_on_open"`     // Fetch in the background when the dashboard opens so ahead/behind stays current
+	RenameDetection      string   `json:"rename_detection"`       // Rename detection for diffs: "off", "normal" (-M), or "aggressive" (also detects copies)
+	PushMode             string   `json:"push_mode"`              // What auto-push sends after a commit: "current", "current+tags", or "all" branches
+	NetworkTimeout       int      `json:"network_timeout"`        // Seconds before fetch, pull and push are cancelled; 0 uses DefaultNetworkTimeout
+	RewritePushed        string   `json:"rewrite_pushed"`         // Amending or rebasing pushed commits: "confirm" asks first, "refuse" never does it, "allow" goes ahead
 }
 
+// DefaultNetworkTimeout is how long fetch, pull and push may run when
+// cfg.Git.NetworkTimeout is unset
+const DefaultNetworkTimeout = 2 * time.Minute
+
+// Rename detection modes for cfg.Git.RenameDetection
+const (
+	RenameDetectionOff        = "off"
+	RenameDetectionNormal     = "normal"
+	RenameDetectionAggressive = "aggressive"
+)
+
+// Push modes for cfg.Git.PushMode; empty means PushModeCurrent
+const (
+	PushModeCurrent     = "current"
+	PushModeCurrentTags = "current+tags"
+	PushModeAll         = "all"
+)
+
+// Policies for cfg.Git.RewritePushed; empty means RewritePushedConfirm
+const (
+	RewritePushedConfirm = "confirm"
+	RewritePushedRefuse  = "refuse"
+	RewritePushedAllow   = "allow"
+)
+
 // GitHubConfig holds GitHub integration settings
 type GitHubConfig struct {
-	Enabled           bool     `json:"enabled"`
-	DefaultVisibility string   `json:"default_visibility"` // "public" or "private"
-	DefaultLicense    string   `json:"default_license"`
-	DefaultGitIgnore  string   `json:"default_gitignore"`
-	EnableIssues      bool     `json:"enable_issues"`
-	EnableWiki        bool     `json:"enable_wiki"`
-	EnableProjects    bool     `json:"enable_projects"`
+	Enabled           bool   `json:"enabled"`
+	DefaultVisibility string `json:"default_visibility"` // "public" or "private"
+	DefaultLicense    string `json:"default_license"`
+	DefaultGitIgnore  string `json:"default_gitignore"`
+	EnableIssues      bool   `json:"enable_issues"`
+	EnableWiki        bool   `json:"enable_wiki"`
+	EnableProjects    bool   `json:"enable_projects"`
 	// PR Configuration
 	PRDefaultBase      string   `json:"pr_default_base"`       // Default base branch for PRs
 	PRUseTemplate      bool     `json:"pr_use_template"`       // Load .github/PULL_REQUEST_TEMPLATE.md
@@ -42,13 +80,26 @@ type GitHubConfig struct {
 
 // CommitsConfig holds commit convention settings
 type CommitsConfig struct {
-	Convention      string   `json:"convention"`       // "conventional", "custom", or "none"
-	Types           []string `json:"types"`            // Allowed commit types
-	RequireScope    bool     `json:"require_scope"`    // Require scope in conventional commits
-	RequireBreaking bool     `json:"require_breaking"` // Require breaking change marker
-	CustomTemplate  string   `json:"custom_template"`  // Custom commit template
+	Convention      string         `json:"convention"`       // "conventional", "gitmoji" (conventional with a type emoji), "custom", or "none"
+	Types           []string       `json:"types"`            // Allowed commit types
+	RequireScope    bool           `json:"require_scope"`    // Require scope in conventional commits
+	RequireBreaking bool           `json:"require_breaking"` // Require breaking change marker
+	CustomTemplate  string         `json:"custom_template"`  // Custom commit template
+	Normalize       NormalizeRules `json:"normalize"`        // Post-processing applied to AI subjects
+	AnalyzeStaged   bool           `json:"analyze_staged"`   // Last choice: analyze staged changes only instead of all changes
+	AnalysisScope   string         `json:"analysis_scope"`   // "changes" (uncommitted delta only) or "branch" (whole branch since parent)
+	GeneratedPaths  []string       `json:"generated_paths"`  // Lockfiles and generated code the AI treats as incidental (see IsGeneratedPath)
+
+	IssueReferences IssueReferenceRules `json:"issue_references"` // Issue named by the branch, added to commit descriptions
+	Trailers        []Trailer           `json:"trailers"`         // Footers added to every commit description, e.g. Reviewed-by
 }
 
+// Analysis scopes for commit analysis
+const (
+	AnalysisScopeChanges = "changes" // Only the uncommitted working-tree changes
+	AnalysisScopeBranch  = "branch"  // Everything on the branch since it left its parent, plus uncommitted changes
+)
+
 // NamingConfig holds branch naming convention settings
 type NamingConfig struct {
 	Enforce         bool     `json:"enforce"`
@@ -65,11 +116,56 @@ type AIConfig struct {
 	FallbackModel  string `json:"fallback_model"`
 	MaxDiffSize    int    `json:"max_diff_size"`
 	IncludeContext bool   `json:"include_context"`
+
+	// What to do when the AI returns an empty or too-short commit message:
+	// "retry" asks once more before falling back to a message derived from
+	// the changed files; "fallback" uses that message straight away
+	WeakMessagePolicy string `json:"weak_message_policy"`
+
+	// How many earlier commits are included as context: recent commits when
+	// analyzing a commit, and commits being merged when writing a merge message.
+	// Larger values give the AI more history at the cost of tokens.
+	MaxContextCommits      int `json:"max_context_commits"`
+	MaxMergeContextCommits int `json:"max_merge_context_commits"`
+
+	// Show the commit analysis as it streams in instead of waiting for the
+	// whole response; turn off for endpoints that don't support streaming
+	StreamAnalysis bool `json:"stream_analysis"`
 }
 
+// Default context commit limits for cfg.AI.MaxContextCommits and MaxMergeContextCommits
+const (
+	DefaultMaxContextCommits      = 3
+	DefaultMaxMergeContextCommits = 10
+)
+
+// Weak commit message policies for cfg.AI.WeakMessagePolicy
+const (
+	WeakMessageRetry    = "retry"
+	WeakMessageFallback = "fallback"
+)
+
+// Commit time formats for cfg.UI.TimeFormat
+const (
+	TimeFormatRelative = "relative"
+	TimeFormatAbsolute = "absolute"
+	TimeFormatBoth     = "both"
+)
+
 // UIConfig holds UI/theme settings
 type UIConfig struct {
-	Theme string `json:"theme"` // Theme name (e.g., "claude-warm", "ocean-blue")
+	Theme                  string `json:"theme"`                   // Theme name (e.g., "claude-warm", "ocean-blue")
+	AlternativesActionable bool   `json:"alternatives_actionable"` // Allow executing the AI's alternative actions; false shows them for information only
+	GraphAllRefs           bool   `json:"graph_all_refs"`          // Commit graph shows every ref; false shows only current, parent and main branches
+	TimeFormat             string `json:"time_format"`             // Commit times in lists: "relative" ("2h ago", the default), "absolute", or "both"
+
+	// Semantic colors replaced on top of the selected theme, e.g. {"primary": "#7aa2f7"}
+	// (see ValidateColorOverrides for the names)
+	ColorOverrides map[string]string `json:"color_overrides"`
+
+	// Confirmation categories to proceed through without asking, e.g. ["leave_commit"].
+	// Destructive actions (force delete, discard, protected push, ...) always ask.
+	SkipConfirmations []string `json:"skip_confirmations"`
 }
 
 // NewDefaultConfig creates a new config with sensible defaults
@@ -77,10 +173,16 @@ func NewDefaultConfig() *Config {
 	return &Config{
 		Version: "2.0",
 		Git: GitConfig{
-			MainBranch:        "main",
-			ProtectedBranches: []string{"main", "master", "develop"},
-			AutoPush:          false,
-			AutoPull:          false,
+			MainBranch:           "main",
+			ProtectedBranches:    []string{"main", "master", "develop"},
+			AutoPush:             false,
+			AutoPull:             false,
+			PullRebase:           false,
+			PostCommitCommand:    "",
+			DefaultMergeStrategy: "regular",
+			RenameDetection:      RenameDetectionNormal,
+			PushMode:             PushModeCurrent,
+			RewritePushed:        RewritePushedConfirm,
 		},
 		GitHub: GitHubConfig{
 			Enabled:            false,
@@ -102,6 +204,15 @@ func NewDefaultConfig() *Config {
 			RequireScope:    false,
 			RequireBreaking: false,
 			CustomTemplate:  "",
+			Normalize:       NormalizeRules{},
+			AnalyzeStaged:   false,
+			AnalysisScope:   AnalysisScopeChanges,
+			GeneratedPaths:  append([]string(nil), DefaultGeneratedPaths...),
+			IssueReferences: IssueReferenceRules{
+				Enabled: true,
+				Pattern: DefaultIssuePattern,
+				Format:  DefaultIssueFormat,
+			},
 		},
 		Naming: NamingConfig{
 			Enforce:         false,
@@ -109,16 +220,22 @@ func NewDefaultConfig() *Config {
 			AllowedPrefixes: []string{"feature", "hotfix", "bugfix", "release", "refactor"},
 		},
 		AI: AIConfig{
-			Provider:       "cerebras",
-			APIKey:         "",
-			APITier:        "free",
-			DefaultModel:   "llama-3.3-70b",
-			FallbackModel:  "llama3.1-8b",
-			MaxDiffSize:    100000,
-			IncludeContext: true,
+			Provider:               "cerebras",
+			APIKey:                 "",
+			APITier:                "free",
+			DefaultModel:           "llama-3.3-70b",
+			FallbackModel:          "llama3.1-8b",
+			MaxDiffSize:            100000,
+			IncludeContext:         true,
+			WeakMessagePolicy:      WeakMessageRetry,
+			MaxContextCommits:      DefaultMaxContextCommits,
+			MaxMergeContextCommits: DefaultMaxMergeContextCommits,
+			StreamAnalysis:         true,
 		},
 		UI: UIConfig{
-			Theme: "claude-warm",
+			Theme:                  "claude-warm",
+			AlternativesActionable: true,
+			TimeFormat:             TimeFormatRelative,
 		},
 	}
 }
@@ -129,6 +246,19 @@ func (c *Config) Validate() error {
 	if c.Git.MainBranch == "" {
 		return fmt.Errorf("git.main_branch cannot be empty")
 	}
+	switch c.Git.PushMode {
+	case "", PushModeCurrent, PushModeCurrentTags, PushModeAll:
+	default:
+		return fmt.Errorf("git.push_mode must be '%s', '%s', or '%s'", PushModeCurrent, PushModeCurrentTags, PushModeAll)
+	}
+	switch c.Git.RewritePushed {
+	case "", RewritePushedConfirm, RewritePushedRefuse, RewritePushedAllow:
+	default:
+		return fmt.Errorf("git.rewrite_pushed must be '%s', '%s', or '%s'", RewritePushedConfirm, RewritePushedRefuse, RewritePushedAllow)
+	}
+	if c.Git.NetworkTimeout < 0 {
+		return fmt.Errorf("git.network_timeout cannot be negative")
+	}
 
 	// Validate GitHub config
 	if c.GitHub.Enabled {
@@ -138,26 +268,52 @@ func (c *Config) Validate() error {
 	}
 
 	// Validate Commits config
-	if c.Commits.Convention != "conventional" && c.Commits.Convention != "custom" && c.Commits.Convention != "none" {
-		return fmt.Errorf("commits.convention must be 'conventional', 'custom', or 'none'")
+	switch c.Commits.Convention {
+	case "conventional", "gitmoji", "custom", "none":
+	default:
+		return fmt.Errorf("commits.convention must be 'conventional', 'gitmoji', 'custom', or 'none'")
 	}
-	if c.Commits.Convention == "conventional" && len(c.Commits.Types) == 0 {
+	if c.UsesCommitTypes() && len(c.Commits.Types) == 0 {
 		return fmt.Errorf("commits.types cannot be empty when using conventional commits")
 	}
 	if c.Commits.Convention == "custom" && c.Commits.CustomTemplate == "" {
 		return fmt.Errorf("commits.custom_template cannot be empty when using custom convention")
 	}
+	if err := c.Commits.IssueReferences.Validate(); err != nil {
+		return fmt.Errorf("commits.issue_references: %w", err)
+	}
+	for i, trailer := range c.Commits.Trailers {
+		if err := trailer.Validate(); err != nil {
+			return fmt.Errorf("commits.trailers[%d]: %w", i, err)
+		}
+	}
 
 	// Validate AI config
 	if c.AI.Provider == "" {
 		return fmt.Errorf("ai.provider cannot be empty")
 	}
-	if c.AI.APIKey == "" {
+	if c.AI.APIKey == "" && ProviderRequiresAPIKey(c.AI.Provider) {
 		return fmt.Errorf("ai.api_key cannot be empty")
 	}
 	if c.AI.DefaultModel == "" {
 		return fmt.Errorf("ai.default_model cannot be empty")
 	}
+	if c.AI.MaxContextCommits < 1 {
+		return fmt.Errorf("ai.max_context_commits must be positive")
+	}
+	if c.AI.MaxMergeContextCommits < 1 {
+		return fmt.Errorf("ai.max_merge_context_commits must be positive")
+	}
+
+	// Validate UI config
+	switch c.UI.TimeFormat {
+	case "", TimeFormatRelative, TimeFormatAbsolute, TimeFormatBoth:
+	default:
+		return fmt.Errorf("ui.time_format must be '%s', '%s', or '%s'", TimeFormatRelative, TimeFormatAbsolute, TimeFormatBoth)
+	}
+	if err := ValidateColorOverrides(c.UI.ColorOverrides); err != nil {
+		return fmt.Errorf("ui.color_overrides: %w", err)
+	}
 
 	return nil
 }
@@ -177,6 +333,25 @@ func (c *Config) IsProtectedBranch(branch string) bool {
 	return false
 }
 
+// SkipsConfirmation checks if the user turned off the confirmation for category
+func (c *Config) SkipsConfirmation(category string) bool {
+	for _, skipped := range c.UI.SkipConfirmations {
+		if skipped == category {
+			return true
+		}
+	}
+	return false
+}
+
+// NetworkTimeout returns how long fetch, pull and push may run before they
+// are cancelled.
+func (c *Config) NetworkTimeout() time.Duration {
+	if c.Git.NetworkTimeout <= 0 {
+		return DefaultNetworkTimeout
+	}
+	return time.Duration(c.Git.NetworkTimeout) * time.Second
+}
+
 // GetCommitTypes returns the allowed commit types
 func (c *Config) GetCommitTypes() []string {
 	return c.Commits.Types
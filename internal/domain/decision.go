@@ -21,6 +21,9 @@ const (
 	ActionMerge
 	// ActionCreatePR recommends creating a pull request instead of direct merge.
 	ActionCreatePR
+	// ActionAmend folds the changes into the previous commit. It is offered
+	// by the commit view rather than recommended by the AI.
+	ActionAmend
 )
 
 // String returns the string representation of the action type.
@@ -38,6 +41,8 @@ func (at ActionType) String() string {
 		return "merge"
 	case ActionCreatePR:
 		return "create-pr"
+	case ActionAmend:
+		return "amend"
 	default:
 		return fmt.Sprintf("ActionType(%d)", at)
 	}
@@ -73,6 +78,7 @@ type Decision struct {
 	confidence     float64
 	reasoning      string
 	suggestedMsg   *CommitMessage
+	candidates     []*CommitMessage // Alternative phrasings of suggestedMsg
 	branchName     string
 	alternatives   []Alternative
 	requiresReview bool
@@ -123,6 +129,29 @@ func (d *Decision) SetSuggestedMessage(msg *CommitMessage) {
 	d.suggestedMsg = msg
 }
 
+// Candidates returns the commit messages to choose from: the suggested
+// message first, then any alternative phrasings. Without alternatives it
+// holds just the suggested message (or nothing when there is none).
+func (d *Decision) Candidates() []*CommitMessage {
+	var candidates []*CommitMessage
+	if d.suggestedMsg != nil {
+		candidates = append(candidates, d.suggestedMsg)
+	}
+	for _, candidate := range d.candidates {
+		if d.suggestedMsg == nil || candidate.FullMessage() != d.suggestedMsg.FullMessage() {
+			candidates = append(candidates, candidate)
+		}
+	}
+	return candidates
+}
+
+// AddCandidate adds an alternative phrasing of the suggested message.
+func (d *Decision) AddCandidate(msg *CommitMessage) {
+	if msg != nil {
+		d.candidates = append(d.candidates, msg)
+	}
+}
+
 // BranchName returns the suggested branch name (if ActionCreateBranch).
 func (d *Decision) BranchName() string {
 	return d.branchName
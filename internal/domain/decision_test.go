@@ -257,6 +257,27 @@ func TestDecision_SuggestedMessage(t *testing.T) {
 	}
 }
 
+func TestDecision_Candidates(t *testing.T) {
+	decision, _ := NewDecision(ActionCommitDirect, 0.9, "test")
+
+	// The single suggested message is the only candidate
+	msg, _ := NewCommitMessage("Add login page")
+	decision.SetSuggestedMessage(msg)
+	if got := decision.Candidates(); len(got) != 1 || got[0] != msg {
+		t.Fatalf("Candidates() = %v, want just the suggested message", got)
+	}
+
+	// Alternatives follow it; a repeat of the suggestion is dropped
+	alt, _ := NewCommitMessage("Introduce the login screen")
+	dup, _ := NewCommitMessage("Add login page")
+	decision.AddCandidate(alt)
+	decision.AddCandidate(dup)
+	got := decision.Candidates()
+	if len(got) != 2 || got[0] != msg || got[1] != alt {
+		t.Errorf("Candidates() = %v, want the suggestion then the alternative", got)
+	}
+}
+
 func TestDecision_BranchName(t *testing.T) {
 	decision, _ := NewDecision(ActionCreateBranch, 0.8, "test")
 
@@ -0,0 +1,18 @@
+package domain
+
+// CheckStatus is the outcome of one environment check run by gm doctor.
+type CheckStatus string
+
+const (
+	CheckPass CheckStatus = "pass"
+	CheckWarn CheckStatus = "warn" // Works, but a feature is degraded or unavailable
+	CheckFail CheckStatus = "fail" // GitMind can't work until this is fixed
+)
+
+// DoctorCheck is one line of the gm doctor checklist.
+type DoctorCheck struct {
+	Name   string
+	Status CheckStatus
+	Detail string // What was found, e.g. "2.43.0"
+	Hint   string // How to fix a warning or failure
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"path"
+	"strings"
+)
+
+// DefaultGeneratedPaths lists lockfiles and generated code that rarely deserve
+// a description of their own in a commit message.
+var DefaultGeneratedPaths = []string{
+	"go.sum",
+	"package-lock.json",
+	"yarn.lock",
+	"pnpm-lock.yaml",
+	"Cargo.lock",
+	"poetry.lock",
+	"Pipfile.lock",
+	"Gemfile.lock",
+	"composer.lock",
+	"*.pb.go",
+	"*_generated.go",
+	"*.gen.go",
+	"*.min.js",
+	"*.min.css",
+	"vendor/",
+	"node_modules/",
+}
+
+// IsGeneratedPath reports whether filePath matches one of patterns.
+// A pattern ending in "/" matches everything under that directory, a pattern
+// containing "/" is matched against the whole path, and any other pattern is
+// matched against the file name in every directory (go.sum, *.pb.go).
+func IsGeneratedPath(filePath string, patterns []string) bool {
+	for _, pattern := range patterns {
+		switch {
+		case pattern == "":
+			continue
+		case strings.HasSuffix(pattern, "/"):
+			if strings.HasPrefix(filePath, pattern) || strings.Contains(filePath, "/"+pattern) {
+				return true
+			}
+		case strings.Contains(pattern, "/"):
+			if ok, _ := path.Match(pattern, filePath); ok {
+				return true
+			}
+		default:
+			if ok, _ := path.Match(pattern, path.Base(filePath)); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GeneratedFiles returns the changed files that match patterns (see IsGeneratedPath).
+func (r *Repository) GeneratedFiles(patterns []string) []string {
+	var files []string
+	for _, change := range r.changes {
+		if IsGeneratedPath(change.Path, patterns) {
+			files = append(files, change.Path)
+		}
+	}
+	return files
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "testing"
+
+func TestIsGeneratedPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"go.sum", true},
+		{"web/package-lock.json", true},
+		{"api/v1/user.pb.go", true},
+		{"vendor/github.com/pkg/errors/errors.go", true},
+		{"web/node_modules/react/index.js", true},
+		{"go.mod", false},
+		{"internal/vendors/list.go", false},
+		{"cmd/gm/main.go", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := IsGeneratedPath(tt.path, DefaultGeneratedPaths); got != tt.want {
+				t.Errorf("IsGeneratedPath(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
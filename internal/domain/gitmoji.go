@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"strings"
+)
+
+// gitmojiByType maps conventional commit types to their gitmoji
+// (https://gitmoji.dev), used by the "gitmoji" commit convention.
+var gitmojiByType = map[string]string{
+	"feat":     "✨",
+	"fix":      "🐛",
+	"docs":     "📝",
+	"style":    "🎨",
+	"refactor": "♻️",
+	"perf":     "⚡️",
+	"test":     "✅",
+	"chore":    "🔧",
+	"build":    "📦️",
+	"ci":       "👷",
+	"revert":   "⏪️",
+}
+
+// Gitmoji returns the gitmoji for a conventional commit type, or "" if the
+// type has none.
+func Gitmoji(commitType string) string {
+	return gitmojiByType[commitType]
+}
+
+// UsesCommitTypes reports whether the commit convention prefixes subjects with
+// a conventional type: "conventional", and "gitmoji" which adds the emoji.
+func (c *Config) UsesCommitTypes() bool {
+	return c.Commits.Convention == "conventional" || c.Commits.Convention == "gitmoji"
+}
+
+// StripGitmoji removes a leading gitmoji (and the space after it) from subject.
+func StripGitmoji(subject string) string {
+	for _, emoji := range gitmojiByType {
+		if rest, ok := strings.CutPrefix(subject, emoji); ok {
+			return strings.TrimLeft(rest, " ")
+		}
+		// Emoji written without the variation selector, e.g. "♻" for "♻️"
+		if bare := strings.TrimSuffix(emoji, "\ufe0f"); bare != emoji {
+			if rest, ok := strings.CutPrefix(subject, bare); ok {
+				return strings.TrimLeft(rest, " ")
+			}
+		}
+	}
+	return subject
+}
+
+// AddGitmoji prefixes a conventional title with its type's gitmoji, e.g.
+// "feat: add login" becomes "✨ feat: add login". A gitmoji already on the
+// title is replaced so it matches the type. It reports whether the title
+// changed; titles that are not conventional or whose type has no gitmoji are kept.
+func (cm *CommitMessage) AddGitmoji() bool {
+	if cm == nil {
+		return false
+	}
+
+	subject := StripGitmoji(cm.title)
+	match := conventionalHeaderPattern.FindStringSubmatch(subject)
+	if match == nil {
+		return false
+	}
+	emoji := Gitmoji(match[1])
+	if emoji == "" {
+		return false
+	}
+
+	title := emoji + " " + subject
+	if title == cm.title {
+		return false
+	}
+	cm.title = title
+	return true
+}
@@ -0,0 +1,48 @@
+package domain
+
+import "testing"
+
+func TestCommitMessage_AddGitmoji(t *testing.T) {
+	tests := []struct {
+		name        string
+		title       string
+		want        string
+		wantChanged bool
+	}{
+		{"feat", "feat: add login", "✨ feat: add login", true},
+		{"scoped fix", "fix(auth): handle expired tokens", "🐛 fix(auth): handle expired tokens", true},
+		{"already prefixed", "✨ feat: add login", "✨ feat: add login", false},
+		{"wrong emoji replaced", "🐛 feat: add login", "✨ feat: add login", true},
+		{"not conventional", "Add login", "Add login", false},
+		{"type without gitmoji", "wip: add login", "wip: add login", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := NewCommitMessage(tt.title)
+			if err != nil {
+				t.Fatalf("NewCommitMessage() error = %v", err)
+			}
+			if changed := msg.AddGitmoji(); changed != tt.wantChanged {
+				t.Errorf("AddGitmoji() = %v, want %v", changed, tt.wantChanged)
+			}
+			if msg.Title() != tt.want {
+				t.Errorf("Title() = %q, want %q", msg.Title(), tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_ValidateCommitSubjectGitmoji(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.Commits.Convention = "gitmoji"
+
+	for _, subject := range []string{"✨ feat: add login", "feat: add login", "♻ refactor: split parser"} {
+		if err := cfg.ValidateCommitSubject(subject); err != nil {
+			t.Errorf("ValidateCommitSubject(%q) = %v, want nil", subject, err)
+		}
+	}
+	if err := cfg.ValidateCommitSubject("✨ add login"); err == nil {
+		t.Error("ValidateCommitSubject() without a type should fail")
+	}
+}
@@ -0,0 +1,67 @@
+package domain
+
+// CommitNode is one commit in the commit graph, with the refs that point at it.
+type CommitNode struct {
+	Hash      string
+	ShortHash string
+	Parents   []string // Full parent hashes, first parent first
+	Author    string
+	Date      string
+	Message   string // Subject line
+	GraphLine string // ASCII graph prefix from git log --graph (e.g. "| * ")
+
+	IsHead         bool     // HEAD points at this commit
+	DetachedHead   bool     // HEAD is detached at this commit
+	HeadBranch     string   // Branch checked out at this commit (HEAD -> branch)
+	LocalBranches  []string // Local branches at this commit, including HeadBranch
+	RemoteBranches []string // Remote-tracking branches, e.g. "origin/main"
+	Tags           []string
+}
+
+// IsMerge reports whether the commit has more than one parent.
+func (n CommitNode) IsMerge() bool {
+	return len(n.Parents) > 1
+}
+
+// HasRefs reports whether any branch, tag, or HEAD points at the commit.
+func (n CommitNode) HasRefs() bool {
+	return n.IsHead || len(n.LocalBranches) > 0 || len(n.RemoteBranches) > 0 || len(n.Tags) > 0
+}
+
+// CommitGraph is a slice of history as drawn by git log --graph, newest first.
+type CommitGraph struct {
+	Nodes []CommitNode
+}
+
+// Head returns the commit HEAD points at, or nil if it is not in the graph.
+func (g *CommitGraph) Head() *CommitNode {
+	for i := range g.Nodes {
+		if g.Nodes[i].IsHead {
+			return &g.Nodes[i]
+		}
+	}
+	return nil
+}
+
+// RelevantGraphRefs returns the refs a focused commit graph is seeded from:
+// the current branch, its parent, and the main branch, without duplicates.
+// Use it instead of every ref when cfg.UI.GraphAllRefs is off.
+func RelevantGraphRefs(current, parent, mainBranch string) []string {
+	var refs []string
+	for _, ref := range []string{current, parent, mainBranch} {
+		if ref == "" {
+			continue
+		}
+		duplicate := false
+		for _, r := range refs {
+			if r == ref {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
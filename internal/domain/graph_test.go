@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRelevantGraphRefs(t *testing.T) {
+	tests := []struct {
+		name                      string
+		current, parent, mainName string
+		want                      []string
+	}{
+		{"feature branch", "feature/x", "develop", "main", []string{"feature/x", "develop", "main"}},
+		{"on main", "main", "", "main", []string{"main"}},
+		{"parent is main", "fix/y", "main", "main", []string{"fix/y", "main"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RelevantGraphRefs(tt.current, tt.parent, tt.mainName)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("RelevantGraphRefs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
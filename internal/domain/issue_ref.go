@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultIssuePattern finds the issue a branch is named after: a GitHub issue
+// number or a Jira-style key starting a segment of the name and followed by
+// "-", "_", "/" or its end, as in "feature/123-login" or "PROJ-456-fix-auth".
+// Keys match case-insensitively since branch names are often lowercased.
+const DefaultIssuePattern = `(?i)(?:^|/)(\d+|[a-z][a-z0-9]+-\d+)(?:[-_/]|$)`
+
+// DefaultIssueFormat is the commit description line added for an issue reference.
+const DefaultIssueFormat = "Refs: {ref}"
+
+// IssueReferenceRules configures adding the issue a branch is named after to
+// the description of its commits.
+type IssueReferenceRules struct {
+	Enabled bool   `json:"enabled"` // Add the reference when the branch name contains one
+	Pattern string `json:"pattern"` // Regexp finding it; the first group, if any, is the reference
+	Format  string `json:"format"`  // Description line with {ref} replaced, e.g. "Refs: {ref}"
+}
+
+// issuePattern compiles the configured pattern, or DefaultIssuePattern if none is set
+func (r IssueReferenceRules) issuePattern() (*regexp.Regexp, error) {
+	pattern := r.Pattern
+	if pattern == "" {
+		pattern = DefaultIssuePattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issue pattern %q: %w", pattern, err)
+	}
+	return re, nil
+}
+
+// Validate reports an issue pattern that doesn't compile or a format without {ref}.
+func (r IssueReferenceRules) Validate() error {
+	if _, err := r.issuePattern(); err != nil {
+		return err
+	}
+	if r.Format != "" && !strings.Contains(r.Format, "{ref}") {
+		return fmt.Errorf("issue format %q must contain {ref}", r.Format)
+	}
+	return nil
+}
+
+// ExtractIssueReference returns the issue branch is named after, or "" if
+// the name contains none. A number becomes a GitHub reference ("#123") and a
+// Jira-style key is uppercased ("PROJ-456").
+func (r IssueReferenceRules) ExtractIssueReference(branch string) string {
+	re, err := r.issuePattern()
+	if err != nil {
+		return ""
+	}
+	match := re.FindStringSubmatch(branch)
+	if match == nil {
+		return ""
+	}
+	ref := match[0]
+	if len(match) > 1 {
+		ref = match[1]
+	}
+	if ref == "" {
+		return ""
+	}
+	if strings.Trim(ref, "0123456789") == "" {
+		return "#" + ref
+	}
+	return strings.ToUpper(ref)
+}
+
+// IssueReferenceLine returns the description line referencing the issue
+// branch is named after, e.g. "Refs: #123", or "" if it names none.
+func (r IssueReferenceRules) IssueReferenceLine(branch string) string {
+	ref := r.ExtractIssueReference(branch)
+	if ref == "" {
+		return ""
+	}
+	format := r.Format
+	if format == "" {
+		format = DefaultIssueFormat
+	}
+	return strings.ReplaceAll(format, "{ref}", ref)
+}
+
+// HasIssueReference reports whether body already contains line.
+func HasIssueReference(body, line string) bool {
+	for _, existing := range strings.Split(body, "\n") {
+		if strings.TrimSpace(existing) == line {
+			return true
+		}
+	}
+	return false
+}
+
+// AddIssueReference appends line to body's trailers in its last paragraph
+// (see AddTrailers). A body already containing it is returned unchanged.
+func AddIssueReference(body, line string) string {
+	return AddTrailers(body, []string{line})
+}
+
+// RemoveIssueReference removes line from body, with the blank line that
+// separated it.
+func RemoveIssueReference(body, line string) string {
+	var kept []string
+	for _, existing := range strings.Split(body, "\n") {
+		if strings.TrimSpace(existing) != line {
+			kept = append(kept, existing)
+		}
+	}
+	return strings.TrimSpace(strings.Join(kept, "\n"))
+}
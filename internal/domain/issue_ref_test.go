@@ -0,0 +1,78 @@
+package domain
+
+import "testing"
+
+func TestIssueReferenceRules_ExtractIssueReference(t *testing.T) {
+	tests := []struct {
+		name   string
+		branch string
+		want   string
+	}{
+		{"github number after prefix", "feature/123-login", "#123"},
+		{"github number alone", "fix/42", "#42"},
+		{"github number at start", "77_cleanup", "#77"},
+		{"jira key", "PROJ-456-fix-auth", "PROJ-456"},
+		{"jira key after prefix", "bugfix/ABC2-9-token-refresh", "ABC2-9"},
+		{"lowercased jira key", "feature/proj-456-fix-auth", "PROJ-456"},
+		{"no reference", "feature/login-page", ""},
+		{"version is not an issue", "release/1.2.0", ""},
+		{"number inside a word", "feature/oauth2-login", ""},
+		{"main branch", "main", ""},
+	}
+
+	rules := NewDefaultConfig().Commits.IssueReferences
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rules.ExtractIssueReference(tt.branch); got != tt.want {
+				t.Errorf("ExtractIssueReference(%q) = %q, want %q", tt.branch, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssueReferenceRules_CustomPatternAndFormat(t *testing.T) {
+	rules := IssueReferenceRules{Pattern: `gh-(\d+)`, Format: "Closes {ref}"}
+	if got := rules.IssueReferenceLine("feature/gh-88-dark-mode"); got != "Closes #88" {
+		t.Errorf("IssueReferenceLine() = %q, want %q", got, "Closes #88")
+	}
+	if got := rules.IssueReferenceLine("feature/123-login"); got != "" {
+		t.Errorf("Expected the custom pattern to replace the default, got %q", got)
+	}
+
+	// Without a group the whole match is the reference
+	rules = IssueReferenceRules{Pattern: `[A-Z]+-\d+`}
+	if got := rules.IssueReferenceLine("feature/OPS-7"); got != "Refs: OPS-7" {
+		t.Errorf("IssueReferenceLine() = %q, want the default format", got)
+	}
+
+	if err := (IssueReferenceRules{Pattern: `(`}).Validate(); err == nil {
+		t.Error("Expected an invalid pattern to be rejected")
+	}
+	if err := (IssueReferenceRules{Format: "Refs"}).Validate(); err == nil {
+		t.Error("Expected a format without {ref} to be rejected")
+	}
+}
+
+func TestAddIssueReference(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"empty body", "", "Refs: #123"},
+		{"appended as last paragraph", "Keep the return URL.", "Keep the return URL.\n\nRefs: #123"},
+		{"already present", "Keep the return URL.\n\nRefs: #123", "Keep the return URL.\n\nRefs: #123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AddIssueReference(tt.body, "Refs: #123"); got != tt.want {
+				t.Errorf("AddIssueReference() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got := RemoveIssueReference("Keep the return URL.\n\nRefs: #123", "Refs: #123"); got != "Keep the return URL." {
+		t.Errorf("RemoveIssueReference() = %q, want the line and its blank line removed", got)
+	}
+}
@@ -0,0 +1,69 @@
+package domain
+
+import "strings"
+
+// PatchVerdict is the overall assessment of a reviewed patch.
+type PatchVerdict string
+
+const (
+	// PatchLooksGood means the patch can be applied as is
+	PatchLooksGood PatchVerdict = "looks-good"
+	// PatchNeedsChanges means the patch should be revised before applying
+	PatchNeedsChanges PatchVerdict = "needs-changes"
+	// PatchRisky means the patch may break behavior and needs careful review
+	PatchRisky PatchVerdict = "risky"
+)
+
+// PatchReview is an assessment of a patch received from elsewhere (a .patch
+// file or a pasted diff), independent of any local repository state.
+type PatchReview struct {
+	Summary     string       // What the patch does
+	Verdict     PatchVerdict // Overall assessment
+	Concerns    []string     // Bugs, risks, or missing pieces
+	Suggestions []string     // Optional improvements
+	Files       []string     // Files the patch touches
+}
+
+// ParsePatchFiles returns the files touched by a unified diff or git patch,
+// in order of appearance. Deleted files are reported under their old path.
+func ParsePatchFiles(patch string) []string {
+	var files []string
+	seen := make(map[string]bool)
+	add := func(path string) {
+		if path == "" || path == "/dev/null" || seen[path] {
+			return
+		}
+		seen[path] = true
+		files = append(files, path)
+	}
+
+	oldPath := ""
+	for _, line := range strings.Split(patch, "\n") {
+		line = strings.TrimRight(line, "\r")
+		switch {
+		case strings.HasPrefix(line, "--- "):
+			oldPath = stripPatchPrefix(strings.TrimPrefix(line, "--- "))
+		case strings.HasPrefix(line, "+++ "):
+			newPath := stripPatchPrefix(strings.TrimPrefix(line, "+++ "))
+			if newPath == "/dev/null" {
+				newPath = oldPath
+			}
+			add(newPath)
+		}
+	}
+
+	return files
+}
+
+// stripPatchPrefix removes the a/ or b/ prefix and any trailing timestamp
+// from a ---/+++ header path.
+func stripPatchPrefix(path string) string {
+	if i := strings.IndexByte(path, '\t'); i >= 0 {
+		path = path[:i]
+	}
+	path = strings.TrimSpace(path)
+	if strings.HasPrefix(path, "a/") || strings.HasPrefix(path, "b/") {
+		path = path[2:]
+	}
+	return path
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParsePatchFiles(t *testing.T) {
+	patch := `From 1a2b3c Mon Sep 17 00:00:00 2001
+Subject: [PATCH] Fix login
+
+diff --git a/internal/auth/login.go b/internal/auth/login.go
+--- a/internal/auth/login.go
++++ b/internal/auth/login.go
+@@ -1,3 +1,3 @@
+-old
++new
+diff --git a/docs/new.md b/docs/new.md
+new file mode 100644
+--- /dev/null
++++ b/docs/new.md
+@@ -0,0 +1 @@
++hello
+diff --git a/legacy.go b/legacy.go
+deleted file mode 100644
+--- a/legacy.go
++++ /dev/null
+@@ -1 +0,0 @@
+-gone
+`
+	want := []string{"internal/auth/login.go", "docs/new.md", "legacy.go"}
+	if got := ParsePatchFiles(patch); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("ParsePatchFiles() = %q, want %q", got, want)
+	}
+
+	// Plain diff -u output has no a/ b/ prefixes but may carry timestamps
+	plain := "--- main.go\t2024-05-01 10:00:00\n+++ main.go\t2024-05-02 10:00:00\n@@ -1 +1 @@\n-a\n+b\n"
+	if got := ParsePatchFiles(plain); len(got) != 1 || got[0] != "main.go" {
+		t.Errorf("ParsePatchFiles(plain) = %q, want [main.go]", got)
+	}
+
+	if got := ParsePatchFiles("just some text"); len(got) != 0 {
+		t.Errorf("ParsePatchFiles(text) = %q, want none", got)
+	}
+}
@@ -0,0 +1,17 @@
+package domain
+
+// RepoConfig holds per-repository overrides loaded from .gitmind.toml.
+// Empty fields mean "use the global config value".
+type RepoConfig struct {
+	AI RepoAIConfig
+}
+
+// RepoAIConfig holds per-repository AI overrides.
+type RepoAIConfig struct {
+	DefaultModel string // Overrides ai.default_model for this repository
+}
+
+// NewRepoConfig creates an empty per-repository config with no overrides.
+func NewRepoConfig() *RepoConfig {
+	return &RepoConfig{}
+}
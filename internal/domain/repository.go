@@ -14,6 +14,8 @@ type FileChange struct {
 	Deletions    int
 	IsBinary     bool
 	PatchPreview string // First few lines of diff for context
+	Staged       bool   // The index has changes to the file (first porcelain status column)
+	Unstaged     bool   // The working tree has changes not yet staged, including untracked files (second column)
 }
 
 // ChangeStatus represents the type of change made to a file.
@@ -47,6 +49,8 @@ type Repository struct {
 	isGitHubRemote bool
 	commitsAhead   int
 	commitsBehind  int
+	isShallow      bool
+	superproject   string
 	isClean        bool
 	changes        []FileChange
 }
@@ -233,6 +237,32 @@ func (r *Repository) SetCommitsBehind(count int) {
 	r.commitsBehind = count
 }
 
+// IsShallow returns true if the repository is a shallow clone with truncated history.
+func (r *Repository) IsShallow() bool {
+	return r.isShallow
+}
+
+// SetIsShallow sets whether the repository is a shallow clone.
+func (r *Repository) SetIsShallow(isShallow bool) {
+	r.isShallow = isShallow
+}
+
+// Superproject returns the working tree of the repository this one is a
+// submodule of, or "" when it isn't a submodule.
+func (r *Repository) Superproject() string {
+	return r.superproject
+}
+
+// SetSuperproject sets the working tree of the repository this one is a submodule of.
+func (r *Repository) SetSuperproject(path string) {
+	r.superproject = path
+}
+
+// IsSubmodule returns true if the repository is a submodule of another repository.
+func (r *Repository) IsSubmodule() bool {
+	return r.superproject != ""
+}
+
 // SyncStatusSummary returns a human-readable summary of sync status with remote.
 func (r *Repository) SyncStatusSummary() string {
 	if !r.hasRemote {
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SubmoduleUpdate describes a change to a submodule's recorded commit.
+type SubmoduleUpdate struct {
+	Path     string   // Submodule path relative to the repository root
+	OldSHA   string   // Previously recorded commit (abbreviated, empty for new submodules)
+	NewSHA   string   // Newly recorded commit (abbreviated, empty for removed submodules)
+	Commits  []string // Subjects of commits added by the update
+	Reverted []string // Subjects of commits dropped by the update (rewinds)
+	Note     string   // Extra state reported by git, e.g. "new submodule" or "rewind"
+}
+
+// Summary returns a one-line description such as "libs/foo 1a2b3c4..5d6e7f8 (3 commits)".
+func (s SubmoduleUpdate) Summary() string {
+	var sb strings.Builder
+	sb.WriteString(s.Path)
+
+	switch {
+	case s.OldSHA != "" && s.NewSHA != "":
+		sb.WriteString(fmt.Sprintf(" %s..%s", s.OldSHA, s.NewSHA))
+	case s.NewSHA != "":
+		sb.WriteString(" " + s.NewSHA)
+	case s.OldSHA != "":
+		sb.WriteString(" " + s.OldSHA)
+	}
+
+	var details []string
+	if s.Note != "" {
+		details = append(details, s.Note)
+	}
+	if n := len(s.Commits); n > 0 {
+		details = append(details, pluralize(n, "commit"))
+	}
+	if n := len(s.Reverted); n > 0 {
+		details = append(details, pluralize(n, "reverted commit"))
+	}
+	if len(details) > 0 {
+		sb.WriteString(" (" + strings.Join(details, ", ") + ")")
+	}
+
+	return sb.String()
+}
+
+// FormatSubmoduleUpdates renders updates as a commit message body section.
+func FormatSubmoduleUpdates(updates []SubmoduleUpdate) string {
+	if len(updates) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	for i, update := range updates {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("Submodule " + update.Summary() + ":\n")
+		for _, subject := range update.Commits {
+			sb.WriteString("  > " + subject + "\n")
+		}
+		for _, subject := range update.Reverted {
+			sb.WriteString("  < " + subject + "\n")
+		}
+	}
+
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// pluralize returns "1 commit" or "3 commits".
+func pluralize(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
@@ -0,0 +1,49 @@
+package domain
+
+import "fmt"
+
+// TagSignatureStatus is the outcome of verifying a tag's signature.
+type TagSignatureStatus string
+
+const (
+	TagSignatureGood       TagSignatureStatus = "good"        // Signature verified
+	TagSignatureBad        TagSignatureStatus = "bad"         // Signature does not match the tag contents
+	TagSignatureUnsigned   TagSignatureStatus = "unsigned"    // Lightweight or unsigned annotated tag
+	TagSignatureUnknownKey TagSignatureStatus = "unknown-key" // Signed, but the public key is not available
+	TagSignatureError      TagSignatureStatus = "error"       // Verification could not be performed
+)
+
+// TagVerification is the result of running git tag -v on a tag.
+type TagVerification struct {
+	Tag    string
+	Status TagSignatureStatus
+	Signer string // Signer identity reported by gpg, when known
+	Detail string // Raw verifier output, kept for troubleshooting
+}
+
+// Verified reports whether the tag carries a good signature.
+func (v TagVerification) Verified() bool {
+	return v.Status == TagSignatureGood
+}
+
+// Summary returns a one-line, user-facing description of the result.
+func (v TagVerification) Summary() string {
+	switch v.Status {
+	case TagSignatureGood:
+		if v.Signer != "" {
+			return fmt.Sprintf("Good signature from %s", v.Signer)
+		}
+		return "Good signature"
+	case TagSignatureBad:
+		return "BAD signature - tag contents do not match the signature"
+	case TagSignatureUnsigned:
+		return "Not signed"
+	case TagSignatureUnknownKey:
+		return "Signed, but the public key is not in your keyring"
+	default:
+		if v.Detail != "" {
+			return fmt.Sprintf("Verification failed: %s", v.Detail)
+		}
+		return "Verification failed"
+	}
+}
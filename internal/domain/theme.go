@@ -3,6 +3,8 @@ package domain
 import (
 	"fmt"
 	"regexp"
+	"sort"
+	"strings"
 )
 
 // Theme represents a visual theme for the TUI.
@@ -110,6 +112,66 @@ func (t Theme) Validate() error {
 	return nil
 }
 
+// colorFields maps the names accepted in cfg.UI.ColorOverrides to the palette entries they replace.
+func (c *ThemeColors) colorFields() map[string]*string {
+	return map[string]*string{
+		"primary":           &c.Primary,
+		"secondary":         &c.Secondary,
+		"success":           &c.Success,
+		"warning":           &c.Warning,
+		"error":             &c.Error,
+		"muted":             &c.Muted,
+		"border":            &c.Border,
+		"selected":          &c.Selected,
+		"text":              &c.Text,
+		"high_confidence":   &c.HighConfidence,
+		"medium_confidence": &c.MediumConfidence,
+		"low_confidence":    &c.LowConfidence,
+	}
+}
+
+// ValidateColorOverrides checks that every override names a semantic color
+// (primary, success, error, ...) and is a hex color.
+func ValidateColorOverrides(overrides map[string]string) error {
+	fields := (&ThemeColors{}).colorFields()
+
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, ok := fields[name]; !ok {
+			valid := make([]string, 0, len(fields))
+			for field := range fields {
+				valid = append(valid, field)
+			}
+			sort.Strings(valid)
+			return fmt.Errorf("unknown theme color %q (valid: %s)", name, strings.Join(valid, ", "))
+		}
+		if !hexColorRegex.MatchString(overrides[name]) {
+			return fmt.Errorf("invalid hex color for %s: %s", name, overrides[name])
+		}
+	}
+
+	return nil
+}
+
+// WithColorOverrides returns a copy of the theme with overrides applied to its palette.
+func (t Theme) WithColorOverrides(overrides map[string]string) (Theme, error) {
+	if err := ValidateColorOverrides(overrides); err != nil {
+		return t, err
+	}
+
+	fields := t.Colors.colorFields()
+	for name, color := range overrides {
+		*fields[name] = color
+	}
+
+	return t, nil
+}
+
 // GetName returns the theme's name.
 func (t Theme) GetName() string {
 	return t.Name
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Trailer is a commit message footer git reads as "Key: Value", such as
+// "Reviewed-by: Ada <ada@example.com>" or "Closes: {issue}". Its value may
+// contain placeholders: {branch} is the branch being committed to and {issue}
+// the issue that branch is named after (see IssueReferenceRules).
+type Trailer struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// trailerKeyPattern matches the keys git accepts for trailers: letters,
+// digits and hyphens, like "Reviewed-by" or "See-also"
+var trailerKeyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]*$`)
+
+// trailerLinePattern matches a "Key: Value" trailer line
+var trailerLinePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]*: \S`)
+
+// trailerPlaceholderPattern matches a {placeholder} in a trailer value
+var trailerPlaceholderPattern = regexp.MustCompile(`\{[^{}]*\}`)
+
+// Validate reports a trailer that wouldn't be read back as "Key: Value": a
+// key that isn't a single hyphenated word, an empty or multi-line value, or
+// an unknown placeholder.
+func (t Trailer) Validate() error {
+	if !trailerKeyPattern.MatchString(t.Key) {
+		return fmt.Errorf("trailer key %q must be letters, digits and hyphens, e.g. Reviewed-by", t.Key)
+	}
+	if strings.TrimSpace(t.Value) == "" {
+		return fmt.Errorf("trailer %s has an empty value", t.Key)
+	}
+	if strings.ContainsAny(t.Value, "\r\n") {
+		return fmt.Errorf("trailer %s must fit on one line", t.Key)
+	}
+	for _, placeholder := range trailerPlaceholderPattern.FindAllString(t.Value, -1) {
+		if placeholder != "{branch}" && placeholder != "{issue}" {
+			return fmt.Errorf("trailer %s uses unknown placeholder %s; use {branch} or {issue}", t.Key, placeholder)
+		}
+	}
+	return nil
+}
+
+// Render returns the "Key: Value" line with the placeholders filled in, or ""
+// when a placeholder has nothing to fill it with, e.g. {issue} on a branch
+// that names no issue.
+func (t Trailer) Render(branch, issue string) string {
+	value := t.Value
+	for placeholder, replacement := range map[string]string{"{branch}": branch, "{issue}": issue} {
+		if !strings.Contains(value, placeholder) {
+			continue
+		}
+		if replacement == "" {
+			return ""
+		}
+		value = strings.ReplaceAll(value, placeholder, replacement)
+	}
+	return t.Key + ": " + strings.TrimSpace(value)
+}
+
+// CommitTrailers renders the configured trailers for a commit on branch,
+// skipping those whose placeholders can't be filled.
+func (c *Config) CommitTrailers(branch string) []string {
+	issue := c.Commits.IssueReferences.ExtractIssueReference(branch)
+	var lines []string
+	for _, trailer := range c.Commits.Trailers {
+		if line := trailer.Render(branch, issue); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// isTrailerBlock reports whether every line of paragraph is a trailer
+func isTrailerBlock(paragraph string) bool {
+	for _, line := range strings.Split(paragraph, "\n") {
+		if !trailerLinePattern.MatchString(strings.TrimSpace(line)) {
+			return false
+		}
+	}
+	return true
+}
+
+// AddTrailers appends lines to body's trailers: to its last paragraph when
+// that already holds only trailers, otherwise as a new last paragraph, since
+// git reads trailers from there. Lines already in body are not repeated.
+func AddTrailers(body string, lines []string) string {
+	body = strings.TrimSpace(body)
+
+	var missing []string
+	for _, line := range lines {
+		if line != "" && !HasIssueReference(body, line) {
+			missing = append(missing, line)
+		}
+	}
+	if len(missing) == 0 {
+		return body
+	}
+	if body == "" {
+		return strings.Join(missing, "\n")
+	}
+
+	paragraphs := strings.Split(body, "\n\n")
+	separator := "\n\n"
+	if isTrailerBlock(paragraphs[len(paragraphs)-1]) {
+		separator = "\n"
+	}
+	return body + separator + strings.Join(missing, "\n")
+}
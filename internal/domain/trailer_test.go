@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConfig_CommitTrailers(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.Commits.Trailers = []Trailer{
+		{Key: "Reviewed-by", Value: "Ada <ada@example.com>"},
+		{Key: "Closes", Value: "{issue}"},
+		{Key: "See-also", Value: "https://ci.example.com/branches/{branch}"},
+	}
+
+	tests := []struct {
+		name   string
+		branch string
+		want   []string
+	}{
+		{
+			name:   "branch naming an issue",
+			branch: "feature/123-login",
+			want:   []string{"Reviewed-by: Ada <ada@example.com>", "Closes: #123", "See-also: https://ci.example.com/branches/feature/123-login"},
+		},
+		{
+			name:   "jira key",
+			branch: "PROJ-9-fix",
+			want:   []string{"Reviewed-by: Ada <ada@example.com>", "Closes: PROJ-9", "See-also: https://ci.example.com/branches/PROJ-9-fix"},
+		},
+		{
+			name:   "no issue skips the trailer that needs one",
+			branch: "feature/login",
+			want:   []string{"Reviewed-by: Ada <ada@example.com>", "See-also: https://ci.example.com/branches/feature/login"},
+		},
+		{
+			name:   "no branch",
+			branch: "",
+			want:   []string{"Reviewed-by: Ada <ada@example.com>"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.CommitTrailers(tt.branch); strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("CommitTrailers(%q) = %q, want %q", tt.branch, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrailer_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		trailer Trailer
+		wantErr bool
+	}{
+		{"plain", Trailer{Key: "Reviewed-by", Value: "Ada <ada@example.com>"}, false},
+		{"placeholders", Trailer{Key: "Closes", Value: "{issue} on {branch}"}, false},
+		{"key with a space", Trailer{Key: "Reviewed by", Value: "Ada"}, true},
+		{"key with a colon", Trailer{Key: "Closes:", Value: "#1"}, true},
+		{"empty value", Trailer{Key: "Closes", Value: " "}, true},
+		{"multi-line value", Trailer{Key: "Closes", Value: "#1\n#2"}, true},
+		{"unknown placeholder", Trailer{Key: "Closes", Value: "{ticket}"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.trailer.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	cfg := NewDefaultConfig()
+	cfg.Commits.Trailers = []Trailer{{Key: "Reviewed by", Value: "Ada"}}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "commits.trailers[0]") {
+		t.Errorf("Expected the config to reject the trailer, got %v", err)
+	}
+}
+
+func TestAddTrailers(t *testing.T) {
+	lines := []string{"Reviewed-by: Ada", "Closes: #123"}
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"empty body", "", "Reviewed-by: Ada\nCloses: #123"},
+		{"new paragraph after prose", "Keep the return URL.", "Keep the return URL.\n\nReviewed-by: Ada\nCloses: #123"},
+		{"joins existing trailers", "Keep the return URL.\n\nRefs: #123", "Keep the return URL.\n\nRefs: #123\nReviewed-by: Ada\nCloses: #123"},
+		{"skips lines already present", "Fix.\n\nReviewed-by: Ada", "Fix.\n\nReviewed-by: Ada\nCloses: #123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AddTrailers(tt.body, lines); got != tt.want {
+				t.Errorf("AddTrailers() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,7 +2,10 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os/exec"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -51,6 +54,10 @@ const (
 	StateBranchList
 	StateBranchManaging
 	StateOnboarding
+	StateConflicts        // Resolving the conflicts a merge or pulled rebase stopped on
+	StateNetworkOperation // Fetching, pulling or pushing; Esc cancels
+	StateMaintenance      // Repacking and pruning the object database
+	StatePRCreate         // Drafting and opening a pull request for the current branch
 )
 
 // Tab constants
@@ -59,8 +66,12 @@ type Tab int
 const (
 	TabDashboard Tab = iota
 	TabSettings
+	TabGraph
 )
 
+// tabCount is the number of tabs, for cycling with ctrl+tab
+const tabCount = 3
+
 // AppModel is the root model that manages the entire application lifecycle
 type AppModel struct {
 	// State management
@@ -74,10 +85,13 @@ type AppModel struct {
 	commitView     *CommitViewModel
 	mergeView      *MergeViewModel
 	settingsView   *SettingsView
+	graphView      *GraphViewModel
 	onboardingView *OnboardingModel
 	prListView     *PRListViewModel
 	prDetailView   *PRDetailViewModel
 	branchView     *BranchViewModel
+	conflictView   *ConflictViewModel
+	prCreateView   *PRCreateViewModel
 
 	// Dependencies
 	gitOps     git.Operations
@@ -97,6 +111,15 @@ type AppModel struct {
 	// Loading state
 	loadingMessage string
 	loadingDots    int
+	streamed       string // AI response of the running commit analysis as it streams in
+
+	// Fingerprints of the files at the last commit analysis, kept until a
+	// commit is made so re-analysis can tell what changed since
+	lastAnalysis domain.ChangeSnapshot
+
+	// Branch list and commit graph from the last few seconds, shared by the
+	// branch and graph views and invalidated by commits, merges, rebases and pulls
+	refCache *usecase.RefCache
 
 	// Results from async operations
 	commitAnalysisResult *usecase.AnalyzeCommitResponse
@@ -109,19 +132,46 @@ type AppModel struct {
 
 	// Confirmation dialog state
 	showingConfirmation     bool
-	confirmationMessage     string
+	confirmation            Confirmation
 	confirmationCallback    func() tea.Cmd
 	confirmationSelectedBtn int // 0 = No (default), 1 = Yes
 
 	// Error modal state
 	showingError bool
 	errorMessage string
+
+	// Dry run: commit and merge list their git commands instead of running them
+	dryRun bool
+
+	// Info modal listing the commands a dry run would have executed
+	showingPlan     bool
+	plannedCommands []string
+
+	// Success overlay after a commit or merge (nil when hidden)
+	successSummary *SuccessSummary
+
+	// In-flight analysis. Results from an older epoch are stale (cancelled or
+	// superseded) and are dropped so they never reach the commit/merge views.
+	analysisEpoch  int
+	analysisCancel context.CancelFunc
+
+	// In-flight fetch, pull or push. Cancelling it kills git.
+	networkCancel context.CancelFunc
+
+	// Free-tier rate limit modal state
+	showingRateLimit   bool
+	rateLimitMessage   string
+	rateLimitRemaining int  // Seconds until analysis can be retried
+	rateLimitAutoRetry bool // Retry analysis automatically when the countdown expires
 }
 
 // NewAppModel creates a new root application model
 func NewAppModel(gitOps git.Operations, aiProvider ai.Provider, cfg *domain.Config, cfgManager *config.Manager, repoPath, version string) AppModel {
 	dashboard := NewDashboardModel(gitOps, repoPath, cfg)
 	dashboard.SetVersion(version)
+	if aiProvider == nil {
+		dashboard.SetAIUnavailable()
+	}
 	githubOps := GitHubOps{}
 
 	return AppModel{
@@ -138,7 +188,42 @@ func NewAppModel(gitOps git.Operations, aiProvider ai.Provider, cfg *domain.Conf
 		windowWidth:  150,
 		windowHeight: 40,
 		actionParams: make(map[string]interface{}),
+		refCache:     usecase.NewRefCache(usecase.RefCacheTTL),
+	}
+}
+
+// SetActiveModel records the resolved AI model so the dashboard can display it
+func (m *AppModel) SetActiveModel(model string) {
+	if m.dashboard != nil {
+		m.dashboard.SetActiveModel(model)
+	}
+}
+
+// SetAIDisabled turns AI analysis off for the session (--no-ai)
+func (m *AppModel) SetAIDisabled(disabled bool) {
+	if m.dashboard != nil {
+		m.dashboard.SetAIDisabled(disabled)
+	}
+}
+
+// SetDryRun makes commit and merge execution preview their git commands
+// without running them (--dry-run)
+func (m *AppModel) SetDryRun(dryRun bool) {
+	m.dryRun = dryRun
+}
+
+// aiDisabled returns true if analysis should bypass the AI provider, either
+// because the user turned it off or because no provider is configured.
+func (m AppModel) aiDisabled() bool {
+	return m.aiProvider == nil || (m.dashboard != nil && m.dashboard.AIDisabled())
+}
+
+// commitLoadingMessage returns the loading text for commit analysis
+func (m AppModel) commitLoadingMessage() string {
+	if m.aiDisabled() {
+		return "Preparing commit"
 	}
+	return "Analyzing changes with AI"
 }
 
 // NewAppModelWithOnboarding creates an AppModel that starts in onboarding mode
@@ -159,6 +244,7 @@ func NewAppModelWithOnboarding(gitOps git.Operations, cfg *domain.Config, cfgMan
 		windowWidth:    150,
 		windowHeight:   40,
 		actionParams:   make(map[string]interface{}),
+		refCache:       usecase.NewRefCache(usecase.RefCacheTTL),
 	}
 }
 
@@ -167,20 +253,54 @@ func NewAppModelWithOnboarding(gitOps git.Operations, cfg *domain.Config, cfgMan
 type commitAnalysisMsg struct {
 	result *usecase.AnalyzeCommitResponse
 	err    error
+	epoch  int // Analysis generation that produced this result
+}
+
+// analysisTokenMsg carries the next piece of a streaming analysis response
+type analysisTokenMsg struct {
+	tokens <-chan string
+	token  string
+	epoch  int
 }
 
 type mergeAnalysisMsg struct {
 	result *usecase.AnalyzeMergeResponse
 	err    error
+	epoch  int // Analysis generation that produced this result
 }
 
 type commitExecutionMsg struct {
 	err       error
 	pushed    bool
 	pushError error
+	localOnly bool                           // Auto-push skipped because the repository has no remote
+	response  *usecase.ExecuteCommitResponse // Set when a commit was made
+	subject   string                         // Subject line of the committed message
+}
+
+// postCommitHookMsg carries the result of the configured post-commit command
+type postCommitHookMsg struct {
+	output string
+	err    error
 }
 
 type mergeExecutionMsg struct {
+	err          error
+	response     *usecase.ExecuteMergeResponse
+	sourceBranch string
+	targetBranch string
+	offerTarget  bool // The user chose a target other than the parent or remembered one
+}
+
+// rebaseExecutionMsg carries the result of rebasing the current branch onto its parent
+type rebaseExecutionMsg struct {
+	err      error
+	response *usecase.ExecuteRebaseResponse
+	plan     *usecase.RebasePlan
+}
+
+// ghLoginFinishedMsg is sent when the interactive gh auth login exits
+type ghLoginFinishedMsg struct {
 	err error
 }
 
@@ -202,8 +322,41 @@ type prManageMsg struct {
 	err     error
 }
 
+// startCommitMsg carries out a commit option, e.g. once a confirmation is accepted
+type startCommitMsg struct {
+	option *CommitOption
+}
+
+// startNetworkOpMsg starts a fetch, pull or push, e.g. once a confirmation is accepted
+type startNetworkOpMsg struct {
+	action DashboardAction
+	remote string // Remote to push to; empty for the primary remote
+	branch string // Branch to push
+}
+
+// networkOpMsg reports a finished (or cancelled) fetch, pull or push
+type networkOpMsg struct {
+	action DashboardAction
+	remote string
+	branch string
+	err    error
+}
+
+// startMaintenanceMsg starts repository maintenance once it is confirmed
+type startMaintenanceMsg struct{}
+
+// maintenanceMsg reports finished maintenance with the object database
+// statistics from before and after it
+type maintenanceMsg struct {
+	before, after *git.ObjectStats
+	err           error
+}
+
 type loadingTickMsg time.Time
 
+// rateLimitTickMsg advances the free-tier countdown by one second
+type rateLimitTickMsg time.Time
+
 // Init initializes the application
 func (m AppModel) Init() tea.Cmd {
 	// If in onboarding state, init onboarding
@@ -241,12 +394,72 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.settingsView != nil {
 			_, _ = m.settingsView.Update(msg)
 		}
+		if m.graphView != nil {
+			updated, _ := m.graphView.Update(msg)
+			m.graphView = &updated
+		}
+		if m.conflictView != nil {
+			updated, _ := m.conflictView.Update(msg)
+			m.conflictView = &updated
+		}
+		if m.prCreateView != nil {
+			updated, _ := m.prCreateView.Update(msg)
+			m.prCreateView = &updated
+		}
 		if m.onboardingView != nil {
 			_, _ = m.onboardingView.Update(msg)
 		}
 		return m, cmd
 
 	case tea.KeyMsg:
+		// Handle rate limit countdown modal
+		if m.showingRateLimit {
+			switch msg.String() {
+			case "a":
+				m.rateLimitAutoRetry = !m.rateLimitAutoRetry
+				return m, nil
+			case "r", "enter":
+				if m.rateLimitRemaining <= 0 {
+					return m.retryCommitAnalysis()
+				}
+				return m, nil
+			case "esc", "q":
+				m.showingRateLimit = false
+				m.rateLimitAutoRetry = false
+				return m, nil
+			case "ctrl+c":
+				return m, tea.Quit
+			}
+			return m, nil
+		}
+
+		// Handle success overlay: a step key runs that action, anything else
+		// dismisses. A confirmation shown over it is answered first.
+		if m.successSummary != nil && !m.showingConfirmation {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			step, ok := m.successSummary.StepForKey(msg.String())
+			m.successSummary = nil
+			if ok {
+				m.currentTab = TabDashboard
+				params := step.Params
+				if params == nil {
+					params = make(map[string]interface{})
+				}
+				return m.handleDashboardAction(step.Action, params, nil)
+			}
+			return m, nil
+		}
+
+		// Handle dry run modal
+		if m.showingPlan {
+			// Any key dismisses the planned commands
+			m.showingPlan = false
+			m.plannedCommands = nil
+			return m, nil
+		}
+
 		// Handle error modal
 		if m.showingError {
 			// Any key dismisses error modal
@@ -273,10 +486,7 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.confirmationSelectedBtn = 0 // Reset for next time
 
 				if selectedYes && m.confirmationCallback != nil {
-					// Execute callback and return to dashboard
-					m.state = StateDashboard
-					cmd := m.confirmationCallback()
-					return m, cmd
+					return m.acceptConfirmation(m.confirmationCallback)
 				}
 				return m, nil
 			case "esc":
@@ -288,36 +498,19 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
-		// Handle tab switching (only in dashboard state)
-		if m.state == StateDashboard {
+		// Handle tab switching (only in dashboard state, and not while typing)
+		if m.state == StateDashboard && !m.dashboard.CapturingInput() {
 			switch msg.String() {
 			case "1":
-				m.currentTab = TabDashboard
-				return m, nil
+				return m.selectTab(TabDashboard)
 			case "2":
-				m.currentTab = TabSettings
-				// Lazy-init settings view
-				if m.settingsView == nil {
-					settings := NewSettingsView(m.cfg, m.cfgManager)
-					m.settingsView = settings
-				}
-				return m, nil
+				return m.selectTab(TabSettings)
+			case "3":
+				return m.selectTab(TabGraph)
 			case "ctrl+tab":
-				m.currentTab = (m.currentTab + 1) % 2
-				// Lazy-init settings if needed
-				if m.currentTab == TabSettings && m.settingsView == nil {
-					settings := NewSettingsView(m.cfg, m.cfgManager)
-					m.settingsView = settings
-				}
-				return m, nil
+				return m.selectTab((m.currentTab + 1) % tabCount)
 			case "ctrl+shift+tab":
-				m.currentTab = (m.currentTab - 1 + 2) % 2
-				// Lazy-init settings if needed
-				if m.currentTab == TabSettings && m.settingsView == nil {
-					settings := NewSettingsView(m.cfg, m.cfgManager)
-					m.settingsView = settings
-				}
-				return m, nil
+				return m.selectTab((m.currentTab - 1 + tabCount) % tabCount)
 			}
 		}
 
@@ -333,39 +526,32 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			switch m.state {
 			case StateCommitAnalyzing:
 				// Show confirmation to cancel analysis
-				m.showingConfirmation = true
-				m.confirmationSelectedBtn = 0 // Default to No
-				m.confirmationMessage = "Cancel commit analysis?"
-				m.confirmationCallback = func() tea.Cmd {
-					return m.dashboard.Init()
-				}
-				return m, nil
+				return m.confirm(ConfirmCancelCommitAnalysis, "", m.dashboard.Init)
 
 			case StateCommitView:
-				// Show confirmation to return to dashboard
-				m.showingConfirmation = true
-				m.confirmationSelectedBtn = 0 // Default to No
-				m.confirmationMessage = "Return to dashboard without committing?"
-				m.confirmationCallback = func() tea.Cmd {
-					return m.dashboard.Init()
+				// Esc closes the diff viewer or file selection instead
+				if m.commitView != nil && (m.commitView.ShowingDiff() || m.commitView.ChoosingFiles()) {
+					break
 				}
-				return m, nil
+				// Show confirmation to return to dashboard
+				return m.confirm(ConfirmLeaveCommit, "", m.dashboard.Init)
 
 			case StateMergeAnalyzing:
-				m.showingConfirmation = true
-				m.confirmationSelectedBtn = 0 // Default to No
-				m.confirmationMessage = "Cancel merge analysis?"
-				m.confirmationCallback = func() tea.Cmd {
-					return m.dashboard.Init()
-				}
-				return m, nil
+				return m.confirm(ConfirmCancelMergeAnalysis, "", m.dashboard.Init)
 
 			case StateMergeView:
-				m.showingConfirmation = true
-				m.confirmationSelectedBtn = 0 // Default to No
-				m.confirmationMessage = "Return to dashboard without merging?"
-				m.confirmationCallback = func() tea.Cmd {
-					return m.dashboard.Init()
+				return m.confirm(ConfirmLeaveMerge, "", m.dashboard.Init)
+
+			case StateConflicts:
+				if m.conflictView != nil {
+					return m.confirm(ConfirmLeaveConflicts, m.conflictView.Kind().String(), m.dashboard.Init)
+				}
+
+			case StateNetworkOperation:
+				// Kill git; its result reports the cancellation
+				if m.networkCancel != nil {
+					m.networkCancel()
+					m.loadingMessage = "Cancelling"
 				}
 				return m, nil
 
@@ -373,6 +559,9 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// These views can return directly without confirmation
 				m.state = StateDashboard
 				return m, m.dashboard.Init()
+
+			case StatePRCreate:
+				return m.leavePRCreate()
 			}
 		}
 
@@ -381,15 +570,36 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Quit
 		}
 
+	case analysisTokenMsg:
+		// Stale pieces are dropped, but the stream is drained until it closes
+		if msg.epoch == m.analysisEpoch {
+			m.streamed += msg.token
+		}
+		return m, waitForToken(msg.tokens, msg.epoch)
+
 	case commitAnalysisMsg:
+		if msg.epoch != m.analysisEpoch {
+			return m, nil // Stale result from a cancelled analysis
+		}
+		m.finishAnalysis()
 		m.commitAnalysisResult = msg.result
 		m.commitAnalysisError = msg.err
 
 		if msg.err != nil {
+			m.state = StateDashboard
+
+			// Free-tier limits get a countdown instead of a dead-end error
+			var freeTierErr *ai.FreeTierLimitError
+			if errors.As(msg.err, &freeTierErr) {
+				m.showingRateLimit = true
+				m.rateLimitMessage = freeTierErr.Message
+				m.rateLimitRemaining = freeTierErr.RetryAfter
+				return m, tea.Batch(m.dashboard.Init(), rateLimitTick())
+			}
+
 			// Show error modal instead of returning immediately
 			m.showingError = true
 			m.errorMessage = fmt.Sprintf("Commit Analysis Failed\n\n%v\n\nPress any key to continue", msg.err)
-			m.state = StateDashboard
 			return m, m.dashboard.Init()
 		}
 
@@ -404,9 +614,27 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.windowWidth,
 			m.windowHeight,
 		)
+		m.commitView.SetHooks(msg.result.Hooks)
+		m.commitView.SetSuggestedScope(msg.result.SuggestedScope)
+		m.commitView.SetDiffSource(m.gitOps, m.repoPath)
+		m.commitView.SetLastCommit(msg.result.LastCommit)
+		m.commitView.SetConfig(m.cfg)
+		m.commitView.SetTemplate(msg.result.Template)
+		m.commitView.SetChangedSinceLast(msg.result.ChangedSinceLast, msg.result.DeltaOnly)
+		stagedOnly, _ := m.actionParams["staged_only"].(bool)
+		m.commitView.SetStagedOnly(stagedOnly)
+		m.commitView.CheckConvention()
+		if msg.result.Snapshot != nil {
+			m.lastAnalysis = msg.result.Snapshot
+			m.dashboard.SetHasPriorAnalysis(true)
+		}
 		return m, m.commitView.Init()
 
 	case mergeAnalysisMsg:
+		if msg.epoch != m.analysisEpoch {
+			return m, nil // Stale result from a cancelled analysis
+		}
+		m.finishAnalysis()
 		m.mergeAnalysisResult = msg.result
 		m.mergeAnalysisError = msg.err
 
@@ -425,27 +653,141 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, m.mergeView.Init()
 
 	case commitExecutionMsg:
-		if msg.err != nil {
+		m.refCache.Invalidate()
+		var signingErr *git.SigningKeyError
+		if errors.As(msg.err, &signingErr) {
+			m.showingError = true
+			m.errorMessage = "Commit Signing Failed\n\n" +
+				"Git could not find a key to sign the commit with. Set a Signing Key in\n" +
+				"Settings > Git, or configure one for git itself:\n\n" +
+				"  git config --global user.signingkey <key-id>\n" +
+				"  git config --global gpg.format ssh   (for SSH keys)\n\n" +
+				"Nothing was committed. Press any key to continue"
+		} else if msg.err != nil {
 			PrintError(fmt.Sprintf("Commit failed: %v", msg.err))
+		} else if m.dryRun && msg.response != nil {
+			// Nothing was committed: show the plan and leave the hook alone
+			m.showPlannedCommands(msg.response.PlannedCommands)
+			m.state = StateDashboard
+			return m, m.dashboard.Init()
+		} else if msg.response != nil {
+			var repo *domain.Repository
+			var branchInfo *domain.BranchInfo
+			if m.commitAnalysisResult != nil {
+				repo = m.commitAnalysisResult.Repository
+				branchInfo = m.commitAnalysisResult.BranchInfo
+			}
+			m.successSummary = newCommitSuccessSummary(msg.response, repo, branchInfo)
+			m.recordCommit(msg)
+
+			// The next analysis starts from the new commit
+			m.lastAnalysis = nil
+			m.dashboard.SetHasPriorAnalysis(false)
 		} else if msg.pushed {
 			PrintSuccess("Commit successful and pushed to remote!")
 		} else if msg.pushError != nil {
 			PrintWarning(fmt.Sprintf("Commit successful, but push failed: %v", msg.pushError))
+		} else if msg.localOnly {
+			PrintSuccess("Committed locally (no remote configured)")
 		} else {
 			PrintSuccess("Commit successful!")
 		}
 		// Return to dashboard
 		m.state = StateDashboard
+
+		// Fire the post-commit hook in the background; it never affects the commit
+		if msg.err == nil && m.cfg.Git.PostCommitCommand != "" {
+			return m, tea.Batch(m.dashboard.Init(), m.runPostCommitHook())
+		}
+		return m, m.dashboard.Init()
+
+	case postCommitHookMsg:
+		output := strings.TrimSpace(msg.output)
+		if msg.err != nil {
+			entry := fmt.Sprintf("post-commit: %v", msg.err)
+			if output != "" {
+				entry += ": " + output
+			}
+			m.dashboard.AddActivity(entry)
+		} else if output != "" {
+			m.dashboard.AddActivity("post-commit: " + output)
+		} else {
+			m.dashboard.AddActivity("post-commit: done")
+		}
+		return m, nil
+
+	case startCommitMsg:
+		return m.startCommit(msg.option)
+
+	case startNetworkOpMsg:
+		return m.startNetworkOp(msg.action, msg.remote, msg.branch)
+
+	case networkOpMsg:
+		m.refCache.Invalidate()
+		return m.handleNetworkOp(msg)
+
+	case startMaintenanceMsg:
+		return m.startMaintenance()
+
+	case maintenanceMsg:
+		return m.handleMaintenance(msg)
+
+	case rebaseExecutionMsg:
+		m.refCache.Invalidate()
+		if msg.err != nil {
+			m.showingError = true
+			m.errorMessage = fmt.Sprintf("Rebase Failed\n\n%v\n\nPress any key to continue", msg.err)
+		} else {
+			PrintSuccess(fmt.Sprintf("Rebased %s onto %s", msg.plan.Branch, msg.plan.Parent))
+			m.dashboard.AddActivity(fmt.Sprintf("Rebased %s onto %s at %s (%d new commits from parent)", msg.plan.Branch, msg.plan.Parent, msg.response.Head, msg.plan.Behind))
+			if msg.plan.RewritesPushed {
+				m.dashboard.AddActivity("Rewrote pushed commits: update the remote with git push --force-with-lease")
+			}
+		}
+		m.state = StateDashboard
 		return m, m.dashboard.Init()
 
+	case ghLoginFinishedMsg:
+		if msg.err != nil {
+			m.dashboard.AddActivity(fmt.Sprintf("gh auth login failed: %v", msg.err))
+		} else {
+			m.dashboard.AddActivity("Logged in with gh")
+		}
+		return m, m.dashboard.RecheckGHAuth()
+
 	case mergeExecutionMsg:
+		m.refCache.Invalidate()
+		// A conflicted merge stays in progress; walk through resolving it
+		var conflictErr *git.MergeConflictError
+		if errors.As(msg.err, &conflictErr) {
+			conflictView := NewConflictViewModel(m.gitOps, m.repoPath, ConflictMerge,
+				fmt.Sprintf("%s → %s", msg.sourceBranch, msg.targetBranch), conflictErr.Files)
+			if m.mergeView != nil && m.mergeView.GetSelectedStrategy() == "squash" {
+				conflictView = conflictView.WithContinueMessage(git.SquashMessage(msg.sourceBranch, m.mergeView.GetMergeMessage()))
+			}
+			return m.showConflicts(conflictView)
+		}
 		if msg.err != nil {
 			PrintError(fmt.Sprintf("Merge failed: %v", msg.err))
+		} else if m.dryRun && msg.response != nil {
+			m.showPlannedCommands(msg.response.PlannedCommands)
+		} else if msg.response != nil {
+			hasRemote := m.dashboard != nil && m.dashboard.repo != nil && m.dashboard.repo.HasRemote()
+			m.successSummary = newMergeSuccessSummary(msg.response, msg.sourceBranch, msg.targetBranch, hasRemote)
 		} else {
 			PrintSuccess("Merge successful!")
 		}
 		// Return to dashboard
 		m.state = StateDashboard
+		if msg.err == nil && msg.offerTarget && !m.dryRun {
+			// Offer to skip re-selecting the target on the next merge
+			source, target := msg.sourceBranch, msg.targetBranch
+			var cmd tea.Cmd
+			m, cmd = m.confirm(ConfirmRememberMergeTarget, fmt.Sprintf("%s into %s", source, target), func() tea.Cmd {
+				return m.rememberMergeTarget(source, target)
+			})
+			return m, tea.Batch(m.dashboard.Init(), cmd)
+		}
 		return m, m.dashboard.Init()
 
 	case prExecutionMsg:
@@ -496,9 +838,23 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.state = StatePRDetail
 		return m, nil
 
+	case rateLimitTickMsg:
+		if !m.showingRateLimit || m.rateLimitRemaining <= 0 {
+			return m, nil
+		}
+		m.rateLimitRemaining--
+		if m.rateLimitRemaining > 0 {
+			return m, rateLimitTick()
+		}
+		if m.rateLimitAutoRetry {
+			return m.retryCommitAnalysis()
+		}
+		return m, nil
+
 	case loadingTickMsg:
 		// Animate loading dots
-		if m.state == StateCommitAnalyzing || m.state == StateMergeAnalyzing || m.state == StateCommitExecuting || m.state == StateMergeExecuting {
+		if m.state == StateCommitAnalyzing || m.state == StateMergeAnalyzing || m.state == StateCommitExecuting || m.state == StateMergeExecuting || m.state == StateNetworkOperation ||
+			m.state == StateMaintenance {
 			m.loadingDots = (m.loadingDots + 1) % 4
 			return m, tea.Tick(500*time.Millisecond, func(t time.Time) tea.Msg {
 				return loadingTickMsg(t)
@@ -517,6 +873,11 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.settingsView = &updated
 			return m, cmd
 		}
+		if m.currentTab == TabGraph && m.graphView != nil {
+			updated, cmd := m.graphView.Update(msg)
+			m.graphView = &updated
+			return m, cmd
+		}
 
 		// Dashboard tab
 		updated, cmd := m.dashboard.Update(msg)
@@ -533,163 +894,7 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.dashboard.actionParams = make(map[string]interface{})
 		}
 
-		switch action {
-		case ActionCommit:
-			// Start commit analysis
-			m.actionParams = params
-			m.state = StateCommitAnalyzing
-			m.loadingMessage = "Analyzing changes with AI"
-			return m, tea.Batch(
-				m.startCommitAnalysis(params),
-				tea.Tick(500*time.Millisecond, func(t time.Time) tea.Msg {
-					return loadingTickMsg(t)
-				}),
-			)
-
-		case ActionMerge:
-			// Start merge analysis
-			m.actionParams = params
-			m.state = StateMergeAnalyzing
-			m.loadingMessage = "Analyzing merge with AI"
-			return m, tea.Batch(
-				m.startMergeAnalysis(params),
-				tea.Tick(500*time.Millisecond, func(t time.Time) tea.Msg {
-					return loadingTickMsg(t)
-				}),
-			)
-
-		case ActionListPRs:
-			// List pull requests
-			m.loadingMessage = "Loading pull requests"
-			return m, m.listPRs("all")
-
-		case ActionManageBranches:
-			// Open branch management view
-			branchView := NewBranchViewModel(m.repoPath, m.cfg, m.gitOps)
-			m.branchView = &branchView
-			m.state = StateBranchList
-			return m, m.branchView.Init()
-
-		case ActionCreatePR:
-			// Create pull request - analyze merge first to suggest PR
-			m.actionParams = params
-			m.state = StateMergeAnalyzing
-			m.loadingMessage = "Analyzing for PR creation"
-			return m, tea.Batch(
-				m.startMergeAnalysis(params),
-				tea.Tick(500*time.Millisecond, func(t time.Time) tea.Msg {
-					return loadingTickMsg(t)
-				}),
-			)
-
-		case ActionSwitchBranch:
-			// Handle branch switching
-			branch, _ := params["branch"].(string)
-			if branch != "" {
-				ctx := context.Background()
-				if err := m.gitOps.CheckoutBranch(ctx, m.repoPath, branch); err != nil {
-					PrintError(fmt.Sprintf("Failed to switch branch: %v", err))
-				} else {
-					PrintSuccess(fmt.Sprintf("Switched to branch: %s", branch))
-				}
-				// Refresh dashboard
-				return m, m.dashboard.Init()
-			}
-
-		case ActionFetch:
-			// Fetch updates from remote
-			ctx := context.Background()
-			PrintInfo("Fetching from remote...")
-			if err := m.gitOps.Fetch(ctx, m.repoPath); err != nil {
-				PrintError(fmt.Sprintf("Failed to fetch: %v", err))
-			} else {
-				PrintSuccess("Fetched updates from remote")
-			}
-			// Refresh dashboard to show new sync status
-			return m, m.dashboard.Init()
-
-		case ActionPull:
-			// Pull changes from remote
-			ctx := context.Background()
-			PrintInfo("Pulling from remote...")
-			if err := m.gitOps.Pull(ctx, m.repoPath); err != nil {
-				PrintError(fmt.Sprintf("Failed to pull: %v", err))
-			} else {
-				PrintSuccess("Pulled changes from remote")
-			}
-			// Refresh dashboard
-			return m, m.dashboard.Init()
-
-		case ActionPush:
-			// Push commits to remote
-			ctx := context.Background()
-			branch, _ := m.gitOps.GetCurrentBranch(ctx, m.repoPath)
-			PrintInfo(fmt.Sprintf("Pushing to remote (%s)...", branch))
-			if err := m.gitOps.Push(ctx, m.repoPath, branch, false); err != nil {
-				PrintError(fmt.Sprintf("Failed to push: %v", err))
-			} else {
-				PrintSuccess("Pushed commits to remote")
-			}
-			// Refresh dashboard
-			return m, m.dashboard.Init()
-
-		case ActionViewGitHub:
-			// Open repository in browser using gh CLI
-			ctx := context.Background()
-			PrintInfo("Opening repository in browser...")
-			if err := m.githubOps.ViewRepoWeb(ctx, m.repoPath); err != nil {
-				PrintError(fmt.Sprintf("Failed to open repository: %v", err))
-			} else {
-				PrintSuccess("Opened repository in browser")
-			}
-			// Stay on dashboard
-			return m, cmd
-
-		case ActionShowGitHubInfo:
-			// Show GitHub repository information
-			ctx := context.Background()
-			PrintInfo("Fetching GitHub repository info...")
-			info, err := m.githubOps.GetRepoInfo(ctx, m.repoPath)
-			if err != nil {
-				PrintError(fmt.Sprintf("Failed to get repository info: %v", err))
-			} else {
-				// Display basic info
-				PrintInfo(fmt.Sprintf("\nGitHub Repository: %s", info.FullName))
-				if info.Description != "" {
-					PrintInfo(fmt.Sprintf("Description: %s", info.Description))
-				}
-				if info.IsPrivate {
-					PrintInfo("Visibility: Private")
-				} else {
-					PrintInfo("Visibility: Public")
-				}
-				if info.HTMLURL != "" {
-					PrintInfo(fmt.Sprintf("URL: %s", info.HTMLURL))
-				}
-			}
-			// Stay on dashboard
-			return m, cmd
-
-		case ActionSetupRemote:
-			// Transition to onboarding GitHub step
-			PrintInfo("Launching remote setup...")
-			onboarding := NewOnboardingModel(m.cfg, m.cfgManager, m.gitOps, m.repoPath)
-			// Jump directly to GitHub step
-			onboarding.state = OnboardingGitHub
-			onboarding.currentStep = 3 // GitHub is step 3
-			screen := NewOnboardingGitHubScreen(3, 8, m.cfg, m.repoPath)
-			onboarding.githubScreen = &screen
-			m.onboardingView = &onboarding
-			m.state = StateOnboarding
-			return m, screen.Init()
-
-		case ActionRefresh:
-			// Refresh dashboard
-			PrintInfo("Refreshing dashboard...")
-			return m, m.dashboard.Init()
-		}
-
-		return m, cmd
+		return m.handleDashboardAction(action, params, cmd)
 
 	case StateOnboarding:
 		if m.onboardingView == nil {
@@ -702,7 +907,7 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Check if onboarding is complete
 		if m.onboardingView.IsCompleted() {
 			// Reload config after onboarding
-			cfg, err := m.cfgManager.Load()
+			cfg, err := m.cfgManager.LoadForRepo(m.repoPath)
 			if err == nil {
 				m.cfg = cfg
 			}
@@ -745,14 +950,14 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Check if commit view has a decision
 		if m.commitView.HasDecision() {
 			selectedOption := m.commitView.GetSelectedOption()
-			m.state = StateCommitExecuting
-			m.loadingMessage = "Executing commit"
-			return m, tea.Batch(
-				m.executeCommit(selectedOption),
-				tea.Tick(500*time.Millisecond, func(t time.Time) tea.Msg {
-					return loadingTickMsg(t)
-				}),
-			)
+			if hash := m.commitView.PushedAmendTarget(); hash != "" {
+				// Stay on the commit view unless the rewrite goes ahead
+				m.commitView.WithdrawDecision()
+				return m.confirmRewrite(ConfirmAmendPushed, hash, func() tea.Cmd {
+					return func() tea.Msg { return startCommitMsg{option: selectedOption} }
+				})
+			}
+			return m.startCommit(selectedOption)
 		}
 
 		return m, cmd
@@ -802,6 +1007,38 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		return m, cmd
 
+	case StateConflicts:
+		if m.conflictView == nil {
+			return m, nil
+		}
+
+		updated, cmd := m.conflictView.Update(msg)
+		m.conflictView = &updated
+
+		// Continued or aborted: back to the dashboard with the outcome
+		if m.conflictView.Done() {
+			m.dashboard.AddActivity(m.conflictView.Outcome())
+			m.conflictView = nil
+			m.state = StateDashboard
+			return m, m.dashboard.Init()
+		}
+
+		return m, cmd
+
+	case StatePRCreate:
+		if m.prCreateView == nil {
+			return m, nil
+		}
+
+		updated, cmd := m.prCreateView.Update(msg)
+		m.prCreateView = &updated
+
+		if m.prCreateView.Done() {
+			return m.leavePRCreate()
+		}
+
+		return m, cmd
+
 	case StateBranchList:
 		if m.branchView == nil {
 			return m, nil
@@ -917,7 +1154,7 @@ func (m AppModel) View() string {
 				overlayView = m.commitView.View()
 			}
 
-		case StateMergeAnalyzing, StateMergeExecuting:
+		case StateMergeAnalyzing, StateMergeExecuting, StateNetworkOperation, StateMaintenance:
 			overlayView = m.renderLoadingOverlay()
 
 		case StateMergeView:
@@ -925,6 +1162,16 @@ func (m AppModel) View() string {
 				overlayView = m.mergeView.View()
 			}
 
+		case StateConflicts:
+			if m.conflictView != nil {
+				overlayView = m.conflictView.View()
+			}
+
+		case StatePRCreate:
+			if m.prCreateView != nil {
+				overlayView = m.prCreateView.View()
+			}
+
 		case StateBranchList:
 			if m.branchView != nil {
 				overlayView = m.branchView.View()
@@ -957,6 +1204,14 @@ func (m AppModel) View() string {
 			return m.renderErrorModal()
 		}
 
+		if m.showingRateLimit {
+			return m.renderRateLimitModal()
+		}
+
+		if m.showingPlan {
+			return m.renderPlanModal()
+		}
+
 		return overlayView
 	}
 
@@ -970,6 +1225,21 @@ func (m AppModel) View() string {
 		return m.renderErrorModal()
 	}
 
+	// Show rate limit countdown if active (blocks dashboard)
+	if m.showingRateLimit {
+		return m.renderRateLimitModal()
+	}
+
+	// Show the commands a dry run would have executed
+	if m.showingPlan {
+		return m.renderPlanModal()
+	}
+
+	// Show commit/merge success overlay with next steps
+	if m.successSummary != nil {
+		return renderSuccessOverlay(m.successSummary)
+	}
+
 	// Render tab bar
 	tabBar := m.renderTabBar()
 
@@ -983,6 +1253,12 @@ func (m AppModel) View() string {
 		} else {
 			content = "Loading settings..."
 		}
+	case TabGraph:
+		if m.graphView != nil {
+			content = m.graphView.View()
+		} else {
+			content = "Loading commit graph..."
+		}
 	}
 
 	// Combine tab bar and content
@@ -991,15 +1267,35 @@ func (m AppModel) View() string {
 	return view
 }
 
+// streamedTail returns the end of a streaming response wrapped to width,
+// at most maxLines lines, so the overlay keeps its size as the text grows
+func streamedTail(streamed string, width, maxLines int) string {
+	lines := strings.Split(wrapText(strings.Join(strings.Fields(streamed), " "), width), "\n")
+	if len(lines) > maxLines {
+		lines = lines[len(lines)-maxLines:]
+	}
+	return strings.Join(lines, "\n")
+}
+
 // renderLoadingOverlay renders a loading message overlay
 func (m AppModel) renderLoadingOverlay() string {
 	styles := GetGlobalThemeManager().GetStyles()
 
 	// Title
+	titleText := "ℹ AI ANALYSIS"
+	hint := "Please wait while we process your request..."
+	switch m.state {
+	case StateNetworkOperation:
+		titleText = "⇅ REMOTE"
+		hint = fmt.Sprintf("Esc: cancel • stops automatically after %s", m.networkTimeout())
+	case StateMaintenance:
+		titleText = "⚙ MAINTENANCE"
+		hint = "This can take several minutes on a large repository"
+	}
 	title := lipgloss.NewStyle().
 		Bold(true).
 		Foreground(styles.ColorPrimary).
-		Render("ℹ AI ANALYSIS")
+		Render(titleText)
 
 	// Operation type
 	operation := "Analyzing Changes"
@@ -1010,6 +1306,10 @@ func (m AppModel) renderLoadingOverlay() string {
 		operation = "Executing Commit"
 	case StateMergeExecuting:
 		operation = "Executing Merge"
+	case StateNetworkOperation:
+		operation = "Syncing with Remote"
+	case StateMaintenance:
+		operation = "Optimizing Repository"
 	}
 
 	opText := lipgloss.NewStyle().
@@ -1028,16 +1328,12 @@ func (m AppModel) renderLoadingOverlay() string {
 	loadingText := styles.Loading.Render(m.loadingMessage + dots)
 
 	// Content
-	content := lipgloss.JoinVertical(
-		lipgloss.Center,
-		title,
-		"",
-		opText,
-		"",
-		loadingText,
-		"",
-		lipgloss.NewStyle().Foreground(styles.ColorMuted).Render("Please wait while we process your request..."),
-	)
+	lines := []string{title, "", opText, "", loadingText, ""}
+	if m.state == StateCommitAnalyzing && m.streamed != "" {
+		lines = append(lines, lipgloss.NewStyle().Foreground(styles.ColorSecondary).Render(streamedTail(m.streamed, 50, 4)), "")
+	}
+	lines = append(lines, lipgloss.NewStyle().Foreground(styles.ColorMuted).Render(hint))
+	content := lipgloss.JoinVertical(lipgloss.Center, lines...)
 
 	// Create a centered box
 	box := styles.CommitBox.
@@ -1057,16 +1353,24 @@ func (m AppModel) renderLoadingOverlay() string {
 func (m AppModel) renderConfirmationDialog() string {
 	styles := GetGlobalThemeManager().GetStyles()
 
-	// Title
-	title := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(styles.ColorText).
-		Render("ℹ Confirmation")
+	// Title (destructive actions are flagged in the warning colour)
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.ColorText)
+	titleText := "ℹ " + m.confirmation.Title
+	if m.confirmation.Destructive {
+		titleStyle = titleStyle.Foreground(styles.ColorWarning)
+		titleText = "⚠ " + m.confirmation.Title
+	}
+	title := titleStyle.Render(titleText)
 
-	// Message
+	// Message, followed by what can't be undone
+	messageText := m.confirmation.Message
+	if m.confirmation.Consequence != "" {
+		messageText += "\n\n" + m.confirmation.Consequence
+	}
 	message := lipgloss.NewStyle().
 		Foreground(styles.ColorText).
-		Render(m.confirmationMessage)
+		Width(52).
+		Render(messageText)
 
 	// Button styles
 	buttonStyle := lipgloss.NewStyle().
@@ -1086,7 +1390,7 @@ func (m AppModel) renderConfirmationDialog() string {
 
 	// Render buttons
 	noBtn := "No"
-	yesBtn := "Yes"
+	yesBtn := m.confirmation.ConfirmLabel
 
 	if m.confirmationSelectedBtn == 0 {
 		noBtn = buttonActiveStyle.Render(noBtn)
@@ -1152,6 +1456,113 @@ func (m AppModel) renderErrorModal() string {
 		Render(content)
 }
 
+// showPlannedCommands opens the dry run modal listing commands
+func (m *AppModel) showPlannedCommands(commands []string) {
+	m.showingPlan = true
+	m.plannedCommands = commands
+}
+
+// renderPlanModal renders the git commands a dry run would have executed
+func (m AppModel) renderPlanModal() string {
+	styles := GetGlobalThemeManager().GetStyles()
+
+	title := lipgloss.NewStyle().
+		Foreground(styles.ColorPrimary).
+		Bold(true).
+		Render("DRY RUN - no changes were made")
+
+	var b strings.Builder
+	if len(m.plannedCommands) == 0 {
+		b.WriteString(lipgloss.NewStyle().Foreground(styles.ColorMuted).Render("No git commands would run"))
+	} else {
+		b.WriteString("GitMind would run:\n")
+		for _, command := range m.plannedCommands {
+			b.WriteString("\n  " + command)
+		}
+	}
+
+	help := lipgloss.NewStyle().
+		Foreground(styles.ColorMuted).
+		Render("Press any key to continue")
+
+	content := title + "\n\n" + b.String() + "\n\n" + help
+
+	return styles.CommitBox.
+		BorderForeground(styles.ColorPrimary).
+		Render(content)
+}
+
+// renderRateLimitModal renders the free-tier limit modal with a live countdown
+func (m AppModel) renderRateLimitModal() string {
+	styles := GetGlobalThemeManager().GetStyles()
+
+	title := lipgloss.NewStyle().
+		Foreground(styles.ColorWarning).
+		Bold(true).
+		Render("⏳ RATE LIMIT REACHED")
+
+	message := lipgloss.NewStyle().
+		Foreground(styles.ColorText).
+		Render(m.rateLimitMessage)
+
+	var status string
+	if m.rateLimitRemaining > 0 {
+		status = lipgloss.NewStyle().
+			Foreground(styles.ColorWarning).
+			Bold(true).
+			Render(fmt.Sprintf("Retry available in %ds", m.rateLimitRemaining))
+	} else {
+		status = styles.StatusOk.Render("Ready to retry")
+	}
+
+	autoRetry := "off"
+	if m.rateLimitAutoRetry {
+		autoRetry = "on"
+	}
+
+	shortcuts := fmt.Sprintf("%s retry  •  %s auto-retry (%s)  •  %s dismiss",
+		styles.ShortcutKey.Render("r"),
+		styles.ShortcutKey.Render("a"),
+		autoRetry,
+		styles.ShortcutKey.Render("esc"),
+	)
+
+	hint := lipgloss.NewStyle().
+		Foreground(styles.ColorMuted).
+		Render("Upgrade to a pro API key for higher limits")
+
+	content := title + "\n\n" + message + "\n\n" + status + "\n\n" + shortcuts + "\n" + hint
+
+	return styles.CommitBox.
+		BorderForeground(styles.ColorWarning).
+		Render(content)
+}
+
+// selectTab switches tabs, creating the settings view on first use and
+// reloading the commit graph each time its tab opens
+func (m AppModel) selectTab(tab Tab) (AppModel, tea.Cmd) {
+	m.currentTab = tab
+
+	switch tab {
+	case TabSettings:
+		if m.settingsView == nil {
+			m.settingsView = NewSettingsView(m.cfg, m.cfgManager)
+		}
+	case TabGraph:
+		if m.graphView == nil {
+			graph := NewGraphViewModel(m.repoPath, m.cfg, m.gitOps).WithRefCache(m.refCache)
+			if m.windowWidth > 0 {
+				graph, _ = graph.Update(tea.WindowSizeMsg{Width: m.windowWidth, Height: m.windowHeight})
+			}
+			m.graphView = &graph
+		}
+		// Reload so commits and tags made since are shown
+		return m, m.graphView.Init()
+	}
+
+	return m, nil
+}
+
 // renderTabBar renders the tab bar at the top
 func (m AppModel) renderTabBar() string {
 	styles := GetGlobalThemeManager().GetStyles()
@@ -1174,54 +1585,635 @@ func (m AppModel) renderTabBar() string {
 		tabs = append(tabs, styles.TabInactive.Render("[2] Settings"))
 	}
 
+	// Spacer
+	tabs = append(tabs, "  ")
+
+	// Graph tab
+	if m.currentTab == TabGraph {
+		tabs = append(tabs, styles.TabActive.Render("[3] Graph"))
+	} else {
+		tabs = append(tabs, styles.TabInactive.Render("[3] Graph"))
+	}
+
 	tabLine := lipgloss.JoinHorizontal(lipgloss.Top, tabs...)
 	return styles.TabBar.Render(tabLine)
 }
 
-// startCommitAnalysis initiates the commit analysis workflow
-func (m AppModel) startCommitAnalysis(params map[string]interface{}) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
+// beginAnalysis starts a new analysis generation, cancelling any analysis
+// still in flight. The returned context and epoch belong to the new analysis.
+func (m *AppModel) beginAnalysis() (context.Context, int) {
+	m.cancelAnalysis()
+	ctx, cancel := context.WithCancel(context.Background())
+	m.analysisCancel = cancel
+	m.streamed = ""
+	return ctx, m.analysisEpoch
+}
 
-		// Get parameters
-		customMessage, _ := params["message"].(string)
-		useConventional, _ := params["conventional"].(bool)
+// confirm asks before running callback, unless the user listed the action's
+// category in cfg.UI.SkipConfirmations. Destructive actions always ask.
+func (m AppModel) confirm(action ConfirmAction, subject string, callback func() tea.Cmd) (AppModel, tea.Cmd) {
+	confirmation := confirmationFor(action, subject)
+	if !confirmation.Destructive && m.cfg != nil && m.cfg.SkipsConfirmation(confirmation.Category) {
+		return m.acceptConfirmation(callback)
+	}
+
+	m.showingConfirmation = true
+	m.confirmationSelectedBtn = 0 // Default to No
+	m.confirmation = confirmation
+	m.confirmationCallback = callback
+	return m, nil
+}
+
+// confirmRewrite guards an action that rewrites pushed commits as
+// cfg.Git.RewritePushed says: with a confirmation that can't be skipped,
+// refusing it outright, or going ahead without asking.
+func (m AppModel) confirmRewrite(action ConfirmAction, subject string, callback func() tea.Cmd) (AppModel, tea.Cmd) {
+	policy := domain.RewritePushedConfirm
+	if m.cfg != nil && m.cfg.Git.RewritePushed != "" {
+		policy = m.cfg.Git.RewritePushed
+	}
+
+	switch policy {
+	case domain.RewritePushedRefuse:
+		confirmation := confirmationFor(action, subject)
+		m.dashboard.AddActivity(fmt.Sprintf("%s refused: git.rewrite_pushed is %q", confirmation.Title, policy))
+		PrintWarning(confirmation.Title + " refused: rewriting pushed history is turned off (git.rewrite_pushed)")
+		return m, nil
+	case domain.RewritePushedAllow:
+		return m.acceptConfirmation(callback)
+	}
+	return m.confirm(action, subject, callback)
+}
+
+// acceptConfirmation runs callback as confirmed and returns to the dashboard
+func (m AppModel) acceptConfirmation(callback func() tea.Cmd) (AppModel, tea.Cmd) {
+	// Leaving an analysis cancels it and discards its pending result
+	if m.state == StateCommitAnalyzing || m.state == StateMergeAnalyzing {
+		m.cancelAnalysis()
+	}
+
+	m.state = StateDashboard
+	return m, callback()
+}
+
+// cancelAnalysis cancels the in-flight analysis (if any) and advances the
+// epoch so its result is ignored if it still arrives.
+func (m *AppModel) cancelAnalysis() {
+	if m.analysisCancel != nil {
+		m.analysisCancel()
+		m.analysisCancel = nil
+	}
+	m.analysisEpoch++
+}
+
+// finishAnalysis releases the context of a completed analysis
+func (m *AppModel) finishAnalysis() {
+	if m.analysisCancel != nil {
+		m.analysisCancel()
+		m.analysisCancel = nil
+	}
+}
+
+// startCommitAnalysis initiates the commit analysis workflow. With
+// cfg.AI.StreamAnalysis the AI's response is shown as it streams in.
+func (m AppModel) startCommitAnalysis(ctx context.Context, epoch int, params map[string]interface{}) tea.Cmd {
+	var tokens chan string
+	if m.cfg != nil && m.cfg.AI.StreamAnalysis {
+		tokens = make(chan string, 64)
+	}
+
+	analyze := func() tea.Msg {
+		if tokens != nil {
+			defer close(tokens) // Ends waitForToken however the analysis ends
+		}
 
 		// Create use case
 		analyzeUC := usecase.NewAnalyzeCommitUseCase(m.gitOps, m.aiProvider)
 
-		// Create API key
-		apiKey, err := domain.NewAPIKey(m.cfg.AI.APIKey, m.cfg.AI.Provider)
+		// Build request
+		req, err := m.buildAnalyzeCommitRequest(params)
 		if err != nil {
-			return commitAnalysisMsg{result: nil, err: err}
+			return commitAnalysisMsg{result: nil, err: err, epoch: epoch}
+		}
+		if tokens != nil {
+			req.Tokens = tokens
+		}
+
+		// Execute analysis
+		result, err := analyzeUC.Execute(ctx, req)
+
+		return commitAnalysisMsg{result: result, err: err, epoch: epoch}
+	}
+
+	if tokens == nil {
+		return analyze
+	}
+	return tea.Batch(analyze, waitForToken(tokens, epoch))
+}
+
+// waitForToken delivers the next piece of a streaming analysis response, or
+// nothing once the stream is closed
+func waitForToken(tokens <-chan string, epoch int) tea.Cmd {
+	return func() tea.Msg {
+		token, ok := <-tokens
+		if !ok {
+			return nil
+		}
+		return analysisTokenMsg{tokens: tokens, token: token, epoch: epoch}
+	}
+}
+
+// rateLimitTick schedules the next free-tier countdown tick
+func rateLimitTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return rateLimitTickMsg(t)
+	})
+}
+
+// retryCommitAnalysis closes the rate limit modal and re-runs the last commit analysis
+func (m AppModel) retryCommitAnalysis() (tea.Model, tea.Cmd) {
+	m.showingRateLimit = false
+	m.rateLimitAutoRetry = false
+	m.state = StateCommitAnalyzing
+	m.loadingMessage = m.commitLoadingMessage()
+	ctx, epoch := m.beginAnalysis()
+	return m, tea.Batch(
+		m.startCommitAnalysis(ctx, epoch, m.actionParams),
+		tea.Tick(500*time.Millisecond, func(t time.Time) tea.Msg {
+			return loadingTickMsg(t)
+		}),
+	)
+}
+
+// handleDashboardAction performs an action requested by the dashboard (or by a
+// next step on the success overlay). cmd is returned when the action has no
+// command of its own.
+func (m AppModel) handleDashboardAction(action DashboardAction, params map[string]interface{}, cmd tea.Cmd) (tea.Model, tea.Cmd) {
+	switch action {
+	case ActionCommit:
+		// Start commit analysis
+		m.actionParams = params
+		m.rememberAnalyzeMode(params)
+		m.state = StateCommitAnalyzing
+		m.loadingMessage = m.commitLoadingMessage()
+		ctx, epoch := m.beginAnalysis()
+		return m, tea.Batch(
+			m.startCommitAnalysis(ctx, epoch, params),
+			tea.Tick(500*time.Millisecond, func(t time.Time) tea.Msg {
+				return loadingTickMsg(t)
+			}),
+		)
+
+	case ActionMerge:
+		// Start merge analysis
+		m.actionParams = params
+		m.state = StateMergeAnalyzing
+		m.loadingMessage = "Analyzing merge with AI"
+		if m.aiDisabled() {
+			m.loadingMessage = "Preparing merge"
+		}
+		ctx, epoch := m.beginAnalysis()
+		return m, tea.Batch(
+			m.startMergeAnalysis(ctx, epoch, params),
+			tea.Tick(500*time.Millisecond, func(t time.Time) tea.Msg {
+				return loadingTickMsg(t)
+			}),
+		)
+
+	case ActionListPRs:
+		// List pull requests
+		m.loadingMessage = "Loading pull requests"
+		return m, m.listPRs("all")
+
+	case ActionManageBranches:
+		// Open branch management view
+		branchView := NewBranchViewModel(m.repoPath, m.cfg, m.gitOps).WithRefCache(m.refCache)
+		m.branchView = &branchView
+		m.state = StateBranchList
+		return m, m.branchView.Init()
+
+	case ActionCreatePR, ActionSummarizeBranch:
+		// Draft a pull request for the current branch from its commits, or
+		// summarize the whole branch for one
+		target, _ := params["target"].(string)
+		req := usecase.DraftPullRequestRequest{
+			RepoPath:          m.repoPath,
+			BaseBranch:        target,
+			ProtectedBranches: m.cfg.Git.ProtectedBranches,
+			SkipAI:            m.aiDisabled(),
 		}
-		tier, err := domain.ParseAPITier(m.cfg.AI.APITier)
+		if !req.SkipAI {
+			apiKey, err := m.newAPIKey()
+			if err != nil {
+				PrintError(fmt.Sprintf("Failed to prepare API key: %v", err))
+				return m, cmd
+			}
+			req.APIKey = apiKey
+		}
+		createPR := usecase.NewCreatePullRequestUseCase(m.gitOps, m.aiProvider)
+		if action == ActionSummarizeBranch {
+			return m.showPRCreate(NewPRSummaryViewModel(createPR, m.repoPath, req))
+		}
+		return m.showPRCreate(NewPRCreateViewModel(createPR, m.repoPath, req))
+
+	case ActionSwitchBranch:
+		// Handle branch switching
+		branch, _ := params["branch"].(string)
+		if branch != "" {
+			ctx := context.Background()
+			if stashed, err := m.checkoutWithAutoStash(ctx, branch); err != nil {
+				PrintError(fmt.Sprintf("Failed to switch branch: %v", err))
+			} else {
+				PrintSuccess(fmt.Sprintf("Switched to branch: %s", branch))
+				m.dashboard.recordSessionEvent(sessionBranchSwitch, "Switched to "+branch)
+				if stashed {
+					m.dashboard.AddActivity(fmt.Sprintf("Stashed local changes before switching to %s; restore them with git stash pop", branch))
+				}
+			}
+			// Refresh dashboard
+			return m, m.dashboard.Init()
+		}
+
+	case ActionRebase:
+		// Rebase the current branch onto its parent, if it has fallen behind
+		plan, err := usecase.NewRebaseBranchUseCase(m.gitOps).Plan(context.Background(), usecase.PlanRebaseRequest{
+			RepoPath:          m.repoPath,
+			ProtectedBranches: m.cfg.Git.ProtectedBranches,
+		})
 		if err != nil {
-			tier = domain.TierUnknown
+			PrintError(fmt.Sprintf("Failed to check branch: %v", err))
+			return m, cmd
+		}
+		if !plan.NeedsRebase {
+			m.dashboard.AddActivity("Rebase skipped: " + plan.Reason)
+			return m, cmd
+		}
+		if plan.RewritesPushed {
+			// Rebasing pushed commits means a force push afterwards
+			return m.confirmRewrite(ConfirmRebasePushed, plan.Branch, func() tea.Cmd {
+				return m.rebaseOntoParent(plan)
+			})
 		}
-		apiKey.SetTier(tier)
+		return m, m.rebaseOntoParent(plan)
 
-		// Build request
-		req := usecase.AnalyzeCommitRequest{
-			RepoPath:               m.repoPath,
-			ProtectedBranches:      m.cfg.Git.ProtectedBranches,
-			UseConventionalCommits: useConventional,
-			UserPrompt:             customMessage,
-			APIKey:                 apiKey,
+	case ActionFetch, ActionUnshallow, ActionPull:
+		// Talk to the remote in the background so Esc can cancel it
+		return m.startNetworkOp(action, "", "")
+
+	case ActionPush:
+		// Push commits to the remote chosen on the dashboard, or the primary one
+		ctx := context.Background()
+		remote, _ := params["remote"].(string)
+		branch, _ := m.gitOps.GetCurrentBranch(ctx, m.repoPath)
+		if m.cfg != nil && m.cfg.IsProtectedBranch(branch) {
+			return m.confirm(ConfirmPushProtected, branch, func() tea.Cmd {
+				return m.pushBranch(remote, branch)
+			})
 		}
+		return m, m.pushBranch(remote, branch)
 
-		// Execute analysis
-		result, err := analyzeUC.Execute(ctx, req)
+	case ActionViewGitHub:
+		// Open repository in browser using gh CLI
+		ctx := context.Background()
+		PrintInfo("Opening repository in browser...")
+		if err := m.githubOps.ViewRepoWeb(ctx, m.repoPath); err != nil {
+			PrintError(fmt.Sprintf("Failed to open repository: %v", err))
+		} else {
+			PrintSuccess("Opened repository in browser")
+		}
+		// Stay on dashboard
+		return m, cmd
 
-		return commitAnalysisMsg{result: result, err: err}
+	case ActionLoginGH:
+		// gh auth login is interactive, so hand it the terminal
+		login := exec.Command("gh", "auth", "login")
+		return m, tea.ExecProcess(login, func(err error) tea.Msg {
+			return ghLoginFinishedMsg{err: err}
+		})
+
+	case ActionShowGitHubInfo:
+		// Show GitHub repository information
+		ctx := context.Background()
+		PrintInfo("Fetching GitHub repository info...")
+		info, err := m.githubOps.GetRepoInfo(ctx, m.repoPath)
+		if err != nil {
+			PrintError(fmt.Sprintf("Failed to get repository info: %v", err))
+		} else {
+			// Display basic info
+			PrintInfo(fmt.Sprintf("\nGitHub Repository: %s", info.FullName))
+			if info.Description != "" {
+				PrintInfo(fmt.Sprintf("Description: %s", info.Description))
+			}
+			if info.IsPrivate {
+				PrintInfo("Visibility: Private")
+			} else {
+				PrintInfo("Visibility: Public")
+			}
+			if info.HTMLURL != "" {
+				PrintInfo(fmt.Sprintf("URL: %s", info.HTMLURL))
+			}
+		}
+		// Stay on dashboard
+		return m, cmd
+
+	case ActionSetupRemote:
+		// Transition to onboarding GitHub step
+		PrintInfo("Launching remote setup...")
+		onboarding := NewOnboardingModel(m.cfg, m.cfgManager, m.gitOps, m.repoPath)
+		// Jump directly to GitHub step
+		onboarding.state = OnboardingGitHub
+		onboarding.currentStep = 3 // GitHub is step 3
+		screen := NewOnboardingGitHubScreen(3, onboarding.totalSteps, m.cfg, m.repoPath)
+		onboarding.githubScreen = &screen
+		m.onboardingView = &onboarding
+		m.state = StateOnboarding
+		return m, screen.Init()
+
+	case ActionMaintenance:
+		subject := "the object database"
+		if stats := m.dashboard.objectStats; stats != nil {
+			subject = objectStatsSummary(stats)
+		}
+		return m.confirm(ConfirmRunMaintenance, subject, func() tea.Cmd {
+			return func() tea.Msg { return startMaintenanceMsg{} }
+		})
+
+	case ActionRefresh:
+		// Refresh dashboard
+		PrintInfo("Refreshing dashboard...")
+		return m, m.dashboard.Init()
 	}
+
+	return m, cmd
 }
 
-// startMergeAnalysis initiates the merge analysis workflow
-func (m AppModel) startMergeAnalysis(params map[string]interface{}) tea.Cmd {
+// checkoutWithAutoStash switches to branch. When uncommitted changes block the
+// checkout they are stashed and the checkout retried; if it still fails the
+// changes are restored. Reports whether the changes were left stashed.
+func (m AppModel) checkoutWithAutoStash(ctx context.Context, branch string) (bool, error) {
+	err := m.gitOps.CheckoutBranch(ctx, m.repoPath, branch)
+	if err == nil || !isDirtyCheckoutError(err) {
+		return false, err
+	}
+
+	if stashErr := m.gitOps.Stash(ctx, m.repoPath, "GitMind: auto-stash before switching to "+branch); stashErr != nil {
+		return false, fmt.Errorf("%w (auto-stash failed: %v)", err, stashErr)
+	}
+	if err := m.gitOps.CheckoutBranch(ctx, m.repoPath, branch); err != nil {
+		if popErr := m.gitOps.StashPop(ctx, m.repoPath, 0); popErr != nil {
+			return false, fmt.Errorf("%w (your changes are still in stash@{0}: %v)", err, popErr)
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// isDirtyCheckoutError reports whether a checkout failed because local
+// changes would be overwritten
+func isDirtyCheckoutError(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "would be overwritten by checkout") ||
+		strings.Contains(msg, "Please commit your changes or stash them")
+}
+
+// buildAnalyzeCommitRequest builds the analysis request from dashboard params and config
+func (m AppModel) buildAnalyzeCommitRequest(params map[string]interface{}) (usecase.AnalyzeCommitRequest, error) {
+	customMessage, _ := params["message"].(string)
+	useConventional, _ := params["conventional"].(bool)
+	stagedOnly, _ := params["staged_only"].(bool)
+	deltaOnly, _ := params["delta_only"].(bool)
+
+	req := usecase.AnalyzeCommitRequest{
+		RepoPath:               m.repoPath,
+		ProtectedBranches:      m.cfg.Git.ProtectedBranches,
+		UseConventionalCommits: useConventional,
+		UserPrompt:             customMessage,
+		Normalize:              m.cfg.Commits.Normalize,
+		AnalyzeStaged:          stagedOnly,
+		SkipAI:                 m.aiDisabled(),
+		Scope:                  m.cfg.Commits.AnalysisScope,
+		MaxContextCommits:      m.cfg.AI.MaxContextCommits,
+		GeneratedPaths:         m.cfg.Commits.GeneratedPaths,
+		RequireScope:           m.cfg.Commits.RequireScope,
+		Gitmoji:                m.cfg.Commits.Convention == "gitmoji",
+		PriorSnapshot:          m.lastAnalysis,
+		DeltaOnly:              deltaOnly,
+	}
+
+	// No API key is needed when AI is bypassed
+	if req.SkipAI {
+		return req, nil
+	}
+
+	// Create API key
+	apiKey, err := m.newAPIKey()
+	if err != nil {
+		return usecase.AnalyzeCommitRequest{}, err
+	}
+	req.APIKey = apiKey
+
+	return req, nil
+}
+
+// newAPIKey builds the API key from config with its tier applied
+func (m AppModel) newAPIKey() (*domain.APIKey, error) {
+	apiKey, err := domain.NewAPIKey(m.cfg.AI.APIKey, m.cfg.AI.Provider)
+	if err != nil {
+		return nil, err
+	}
+	tier, err := domain.ParseAPITier(m.cfg.AI.APITier)
+	if err != nil {
+		tier = domain.TierUnknown
+	}
+	apiKey.SetTier(tier)
+
+	return apiKey, nil
+}
+
+// rememberAnalyzeMode persists the staged-only/all-changes choice for next time
+func (m AppModel) rememberAnalyzeMode(params map[string]interface{}) {
+	stagedOnly, _ := params["staged_only"].(bool)
+	if deltaOnly, _ := params["delta_only"].(bool); deltaOnly || m.cfg.Commits.AnalyzeStaged == stagedOnly {
+		return
+	}
+
+	m.cfg.Commits.AnalyzeStaged = stagedOnly
+	if m.cfgManager != nil {
+		_ = m.cfgManager.Save(m.cfg)
+	}
+}
+
+// pushBranch starts pushing branch to remote, the primary remote if empty.
+func (m AppModel) pushBranch(remote, branch string) tea.Cmd {
 	return func() tea.Msg {
+		return startNetworkOpMsg{action: ActionPush, remote: remote, branch: branch}
+	}
+}
+
+// networkOpLabel describes a network operation in progress
+func networkOpLabel(action DashboardAction, remote, branch string) string {
+	switch action {
+	case ActionUnshallow:
+		return "Fetching full history"
+	case ActionPull:
+		return "Pulling from remote"
+	case ActionPush:
+		if remote != "" {
+			return fmt.Sprintf("Pushing to %s (%s)", remote, branch)
+		}
+		return fmt.Sprintf("Pushing to remote (%s)", branch)
+	default:
+		return "Fetching from remote"
+	}
+}
+
+// startNetworkOp runs a fetch, unshallow, pull or push under the configured
+// network timeout, showing the loading overlay until it finishes. Esc cancels
+// it, killing git.
+func (m AppModel) startNetworkOp(action DashboardAction, remote, branch string) (AppModel, tea.Cmd) {
+	pullRebase := m.cfg != nil && m.cfg.Git.PullRebase
+	ctx, cancel := context.WithTimeout(context.Background(), m.networkTimeout())
+
+	m.networkCancel = cancel
+	m.state = StateNetworkOperation
+	m.loadingMessage = networkOpLabel(action, remote, branch)
+
+	gitOps, repoPath := m.gitOps, m.repoPath
+	run := func() tea.Msg {
+		defer cancel()
+		var err error
+		switch action {
+		case ActionFetch:
+			err = gitOps.Fetch(ctx, repoPath)
+		case ActionUnshallow:
+			err = gitOps.Unshallow(ctx, repoPath)
+		case ActionPull:
+			err = gitOps.Pull(ctx, repoPath, "", pullRebase)
+		case ActionPush:
+			err = gitOps.Push(ctx, repoPath, remote, branch, false)
+		}
+		return networkOpMsg{action: action, remote: remote, branch: branch, err: err}
+	}
+	return m, tea.Batch(run, tea.Tick(500*time.Millisecond, func(t time.Time) tea.Msg {
+		return loadingTickMsg(t)
+	}))
+}
+
+// handleNetworkOp reports a finished network operation and returns to the
+// dashboard, or to resolving the conflicts a pull with rebase stopped on
+func (m AppModel) handleNetworkOp(msg networkOpMsg) (AppModel, tea.Cmd) {
+	m.networkCancel = nil
+	m.state = StateDashboard
+	label := networkOpLabel(msg.action, msg.remote, msg.branch)
+
+	var conflictErr *git.RebaseConflictError
+	var credentialErr *git.CredentialPromptError
+	switch {
+	case msg.err == nil:
+		switch msg.action {
+		case ActionFetch:
+			PrintSuccess("Fetched updates from remote")
+		case ActionUnshallow:
+			PrintSuccess("Fetched full history, the repository is no longer shallow")
+		case ActionPull:
+			PrintSuccess("Pulled changes from remote")
+		case ActionPush:
+			if msg.remote != "" {
+				PrintSuccess("Pushed commits to " + msg.remote)
+			} else {
+				PrintSuccess("Pushed commits to remote")
+			}
+			m.dashboard.recordSessionEvent(sessionPush, "Pushed "+msg.branch)
+		}
+
+	case errors.As(msg.err, &conflictErr):
+		// A conflicted rebase stays in progress; walk through resolving it
+		branch, _ := m.gitOps.GetCurrentBranch(context.Background(), m.repoPath)
+		return m.showConflicts(NewConflictViewModel(m.gitOps, m.repoPath, ConflictRebase,
+			fmt.Sprintf("pulling %s with rebase", branch), conflictErr.Files))
+
+	case errors.Is(msg.err, context.Canceled):
+		m.dashboard.AddActivity("Cancelled: " + label)
+
+	case errors.Is(msg.err, context.DeadlineExceeded):
+		m.showingError = true
+		m.errorMessage = fmt.Sprintf("%s Timed Out\n\nGit was stopped after %s. Check your connection, or raise git.network_timeout for slow remotes.\n\nPress any key to continue",
+			label, m.networkTimeout())
+
+	case errors.As(msg.err, &credentialErr):
+		m.showingError = true
+		m.errorMessage = fmt.Sprintf("%s Needs Credentials\n\n%v\n\nPress any key to continue", label, credentialErr)
+
+	default:
+		PrintError(fmt.Sprintf("%s failed: %v", label, msg.err))
+	}
+
+	// Refresh dashboard to show the new sync status
+	return m, m.dashboard.Init()
+}
+
+// startMaintenance runs git maintenance in the background, showing the
+// loading overlay until it finishes
+func (m AppModel) startMaintenance() (AppModel, tea.Cmd) {
+	m.state = StateMaintenance
+	m.loadingMessage = "Repacking objects"
+
+	gitOps, repoPath := m.gitOps, m.repoPath
+	run := func() tea.Msg {
 		ctx := context.Background()
+		before, _ := gitOps.CountObjects(ctx, repoPath)
+		if err := gitOps.RunMaintenance(ctx, repoPath); err != nil {
+			return maintenanceMsg{before: before, err: err}
+		}
+		after, _ := gitOps.CountObjects(ctx, repoPath)
+		return maintenanceMsg{before: before, after: after}
+	}
+	return m, tea.Batch(run, tea.Tick(500*time.Millisecond, func(t time.Time) tea.Msg {
+		return loadingTickMsg(t)
+	}))
+}
+
+// handleMaintenance reports finished maintenance, with the size before and
+// after when both could be counted, and returns to the dashboard
+func (m AppModel) handleMaintenance(msg maintenanceMsg) (AppModel, tea.Cmd) {
+	m.state = StateDashboard
+	if msg.err != nil {
+		PrintError(fmt.Sprintf("Repository maintenance failed: %v", msg.err))
+		return m, m.dashboard.Init()
+	}
 
+	PrintSuccess("Optimized the repository")
+	if msg.before != nil && msg.after != nil {
+		m.dashboard.AddActivity(fmt.Sprintf("Maintenance: %s → %s", objectStatsSummary(msg.before), objectStatsSummary(msg.after)))
+	}
+	m.dashboard.objectStats = msg.after
+	return m, m.dashboard.Init()
+}
+
+// networkTimeout is how long fetch, pull and push may run
+func (m AppModel) networkTimeout() time.Duration {
+	if m.cfg == nil {
+		return domain.DefaultNetworkTimeout
+	}
+	return m.cfg.NetworkTimeout()
+}
+
+// rebaseOntoParent rebases the current branch onto the parent from plan
+func (m AppModel) rebaseOntoParent(plan *usecase.RebasePlan) tea.Cmd {
+	return func() tea.Msg {
+		rebaseUC := usecase.NewRebaseBranchUseCase(m.gitOps)
+		resp, err := rebaseUC.Execute(context.Background(), usecase.ExecuteRebaseRequest{
+			RepoPath: m.repoPath,
+			Parent:   plan.Parent,
+		})
+		return rebaseExecutionMsg{err: err, response: resp, plan: plan}
+	}
+}
+
+// startMergeAnalysis initiates the merge analysis workflow
+func (m AppModel) startMergeAnalysis(ctx context.Context, epoch int, params map[string]interface{}) tea.Cmd {
+	return func() tea.Msg {
 		// Get parameters
 		sourceBranch, _ := params["source"].(string)
 		targetBranch, _ := params["target"].(string)
@@ -1229,104 +2221,163 @@ func (m AppModel) startMergeAnalysis(params map[string]interface{}) tea.Cmd {
 		// Create use case
 		analyzeUC := usecase.NewAnalyzeMergeUseCase(m.gitOps, m.aiProvider)
 
-		// Create API key
-		apiKey, err := domain.NewAPIKey(m.cfg.AI.APIKey, m.cfg.AI.Provider)
-		if err != nil {
-			return mergeAnalysisMsg{result: nil, err: err}
-		}
-		tier, err := domain.ParseAPITier(m.cfg.AI.APITier)
-		if err != nil {
-			tier = domain.TierUnknown
-		}
-		apiKey.SetTier(tier)
-
 		// Build request
 		req := usecase.AnalyzeMergeRequest{
 			RepoPath:          m.repoPath,
 			SourceBranch:      sourceBranch,
 			TargetBranch:      targetBranch,
 			ProtectedBranches: m.cfg.Git.ProtectedBranches,
-			APIKey:            apiKey,
+			SkipAI:            m.aiDisabled(),
+			DefaultStrategy:   m.cfg.Git.DefaultMergeStrategy,
+		}
+
+		// Create API key (not needed when AI is bypassed)
+		if !req.SkipAI {
+			apiKey, err := m.newAPIKey()
+			if err != nil {
+				return mergeAnalysisMsg{result: nil, err: err, epoch: epoch}
+			}
+			req.APIKey = apiKey
 		}
 
 		// Execute analysis
 		result, err := analyzeUC.Execute(ctx, req)
 
-		return mergeAnalysisMsg{result: result, err: err}
+		return mergeAnalysisMsg{result: result, err: err, epoch: epoch}
 	}
 }
 
+// startCommit shows the commit in progress while option is carried out
+func (m AppModel) startCommit(option *CommitOption) (AppModel, tea.Cmd) {
+	m.state = StateCommitExecuting
+	m.loadingMessage = "Executing commit"
+	return m, tea.Batch(
+		m.executeCommit(option),
+		tea.Tick(500*time.Millisecond, func(t time.Time) tea.Msg {
+			return loadingTickMsg(t)
+		}),
+	)
+}
+
 // executeCommit executes the selected commit action
 func (m AppModel) executeCommit(option *CommitOption) tea.Cmd {
+	// nil unless the user narrowed the commit to some of the changed files
+	var files []string
+	if m.commitView != nil {
+		files = m.commitView.SelectedFiles()
+	}
+
 	return func() tea.Msg {
 		ctx := context.Background()
 
 		// Create execute use case
 		executeUC := usecase.NewExecuteCommitUseCase(m.gitOps)
 
+		// Staged-only analysis commits the index as-is
+		stagedOnly, _ := m.actionParams["staged_only"].(bool)
+
 		// Use the message from the option if available, otherwise fallback to decision
 		msg := option.Message
 		if msg == nil {
 			msg = m.commitAnalysisResult.Decision.SuggestedMessage()
 		}
 
+		push := usecase.PushesAfterCommit(m.cfg.Git.AutoPush, option.Action, m.commitAnalysisResult.LastCommit)
+
 		// Build request
 		req := usecase.ExecuteCommitRequest{
-			RepoPath:      m.repoPath,
-			Decision:      m.commitAnalysisResult.Decision,
-			Action:        option.Action,
-			CommitMessage: msg,
-			BranchName:    option.BranchName,
-			StageAll:      true,
-		}
-
-		// Execute commit
+			RepoPath:            m.repoPath,
+			Decision:            m.commitAnalysisResult.Decision,
+			Action:              option.Action,
+			CommitMessage:       msg,
+			BranchName:          option.BranchName,
+			StageAll:            !stagedOnly,
+			Files:               files,
+			Push:                push,
+			PushMode:            m.cfg.Git.PushMode,
+			KeepStagedOnFailure: m.cfg.Git.KeepStagedOnFailure,
+			DryRun:              m.dryRun,
+		}
+
+		// Execute commit (and push, if auto-push is enabled)
 		resp, err := executeUC.Execute(ctx, req)
 		if err != nil {
 			return commitExecutionMsg{err: err, pushed: false}
 		}
 
-		// If manual review, don't push
-		if req.Action == domain.ActionReview {
-			return commitExecutionMsg{err: nil, pushed: false}
+		result := commitExecutionMsg{err: nil, pushed: resp.Pushed, pushError: resp.PushError, localOnly: resp.LocalOnly, subject: msg.Title()}
+		if option.Action != domain.ActionReview {
+			result.response = resp
 		}
+		return result
+	}
+}
 
-		// Check if auto-push is enabled
-		if !m.cfg.Git.AutoPush {
-			return commitExecutionMsg{err: nil, pushed: false}
-		}
+// recordCommit records a successful commit, and the branch switch and push
+// that may have come with it, for the session summary
+func (m AppModel) recordCommit(msg commitExecutionMsg) {
+	resp := msg.response
+	if resp.BranchCreated != "" {
+		m.dashboard.recordSessionEvent(sessionBranchSwitch, "Created and switched to "+resp.BranchCreated)
+	}
 
-		// Determine branch to push
-		branchToPush := req.BranchName
-		if branchToPush == "" {
-			if resp.BranchCreated != "" {
-				branchToPush = resp.BranchCreated
-			} else {
-				var err error
-				branchToPush, err = m.gitOps.GetCurrentBranch(ctx, m.repoPath)
-				if err != nil {
-					// Commit was successful, just couldn't push
-					return commitExecutionMsg{err: nil, pushed: false, pushError: fmt.Errorf("failed to get current branch: %w", err)}
-				}
-			}
-		}
+	commit := strings.TrimSpace(resp.CommitHash + " " + msg.subject)
+	if resp.Branch != "" {
+		commit += fmt.Sprintf(" (%s)", resp.Branch)
+	}
+	m.dashboard.recordSessionEvent(sessionCommit, commit)
 
-		// Check if we have a remote before attempting to push
-		hasRemote, err := m.gitOps.HasRemote(ctx, m.repoPath)
-		if err != nil || !hasRemote {
-			// Commit was successful, but no remote configured
-			return commitExecutionMsg{err: nil, pushed: false, pushError: fmt.Errorf("no remote configured")}
-		}
+	if resp.Pushed {
+		m.dashboard.recordSessionEvent(sessionPush, "Pushed "+resp.Branch)
+	}
+}
+
+// runPostCommitHook runs cfg.Git.PostCommitCommand asynchronously
+func (m AppModel) runPostCommitHook() tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
 
-		// Push changes
-		// The Push implementation automatically handles -u if upstream is missing
-		if err := m.gitOps.Push(ctx, m.repoPath, branchToPush, false); err != nil {
-			// Commit was successful, but push failed
-			return commitExecutionMsg{err: nil, pushed: false, pushError: err}
+		hookUC := usecase.NewPostCommitHookUseCase(m.gitOps, git.RunShellCommand)
+		resp, err := hookUC.Execute(ctx, usecase.PostCommitHookRequest{
+			RepoPath: m.repoPath,
+			Command:  m.cfg.Git.PostCommitCommand,
+		})
+
+		output := ""
+		if resp != nil {
+			output = resp.Output
 		}
+		return postCommitHookMsg{output: output, err: err}
+	}
+}
 
-		return commitExecutionMsg{err: nil, pushed: true}
+// showPRCreate switches to drafting a pull request in prCreateView
+func (m AppModel) showPRCreate(prCreateView PRCreateViewModel) (AppModel, tea.Cmd) {
+	updated, _ := prCreateView.Update(tea.WindowSizeMsg{Width: m.windowWidth, Height: m.windowHeight})
+	m.prCreateView = &updated
+	m.state = StatePRCreate
+	return m, m.prCreateView.Init()
+}
+
+// leavePRCreate returns to the dashboard, recording the pull request if one was opened
+func (m AppModel) leavePRCreate() (AppModel, tea.Cmd) {
+	if m.prCreateView != nil {
+		if prInfo := m.prCreateView.Created(); prInfo != nil {
+			m.dashboard.AddActivity(fmt.Sprintf("Opened pull request #%d: %s", prInfo.Number(), prInfo.HTMLURL()))
+		}
 	}
+	m.prCreateView = nil
+	m.state = StateDashboard
+	return m, m.dashboard.Init()
+}
+
+// showConflicts switches to resolving the conflicts in conflictView
+func (m AppModel) showConflicts(conflictView ConflictViewModel) (AppModel, tea.Cmd) {
+	updated, _ := conflictView.Update(tea.WindowSizeMsg{Width: m.windowWidth, Height: m.windowHeight})
+	m.conflictView = &updated
+	m.state = StateConflicts
+	return m, m.conflictView.Init()
 }
 
 // executeMerge executes the selected merge strategy
@@ -1347,15 +2398,40 @@ func (m AppModel) executeMerge(strategy string, message string) tea.Cmd {
 			TargetBranch: m.mergeAnalysisResult.TargetBranch,
 			Strategy:     strategy,
 			MergeMessage: mergeMsg,
+			DryRun:       m.dryRun,
 		}
 
 		// Execute merge
-		_, err := executeUC.Execute(ctx, req)
+		resp, err := executeUC.Execute(ctx, req)
 
-		return mergeExecutionMsg{err: err}
+		// A target picked from the dashboard may be worth remembering
+		offerTarget := false
+		if chosen, _ := m.actionParams["target"].(string); err == nil && chosen == req.TargetBranch {
+			parent, _ := m.gitOps.GetParentBranch(ctx, m.repoPath, req.SourceBranch)
+			remembered, _ := m.gitOps.GetMergeTarget(ctx, m.repoPath, req.SourceBranch)
+			offerTarget = chosen != parent && chosen != remembered
+		}
+
+		return mergeExecutionMsg{
+			err:          err,
+			response:     resp,
+			sourceBranch: req.SourceBranch,
+			targetBranch: req.TargetBranch,
+			offerTarget:  offerTarget,
+		}
 	}
 }
 
+// rememberMergeTarget makes target the default merge target for source
+func (m AppModel) rememberMergeTarget(source, target string) tea.Cmd {
+	if err := m.gitOps.SetMergeTarget(context.Background(), m.repoPath, source, target); err != nil {
+		m.dashboard.AddActivity(fmt.Sprintf("Failed to remember merge target: %v", err))
+		return nil
+	}
+	m.dashboard.AddActivity(fmt.Sprintf("%s now merges into %s by default", source, target))
+	return nil
+}
+
 // executePR creates a pull request
 func (m AppModel) executePR(strategy string, message string) tea.Cmd {
 	return func() tea.Msg {
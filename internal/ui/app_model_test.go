@@ -0,0 +1,469 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/gitman/internal/adapter/ai"
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+	"github.com/yourusername/gitman/internal/usecase"
+)
+
+func newTestAppModel() AppModel {
+	return NewAppModel(nil, nil, domain.NewDefaultConfig(), nil, "/tmp/repo", "test")
+}
+
+// TestAppModel_FreeTierLimitShowsCountdown tests that rate limits get a countdown modal
+func TestAppModel_FreeTierLimitShowsCountdown(t *testing.T) {
+	m := newTestAppModel()
+
+	limitErr := &ai.FreeTierLimitError{Message: "Rate limit reached", RetryAfter: 30}
+	updated, cmd := m.Update(commitAnalysisMsg{err: fmt.Errorf("AI analysis failed: %w", limitErr)})
+	app := updated.(AppModel)
+
+	if !app.showingRateLimit {
+		t.Fatal("Expected rate limit modal to be shown")
+	}
+	if app.showingError {
+		t.Error("Expected generic error modal not to be shown")
+	}
+	if app.rateLimitRemaining != 30 {
+		t.Errorf("Expected 30s remaining, got %d", app.rateLimitRemaining)
+	}
+	if cmd == nil {
+		t.Error("Expected countdown tick command")
+	}
+
+	view := app.View()
+	if !strings.Contains(view, "Retry available in 30s") {
+		t.Errorf("Expected countdown in view, got:\n%s", view)
+	}
+
+	// One tick decrements the countdown
+	updated, _ = app.Update(rateLimitTickMsg(time.Now()))
+	app = updated.(AppModel)
+	if app.rateLimitRemaining != 29 {
+		t.Errorf("Expected 29s remaining after tick, got %d", app.rateLimitRemaining)
+	}
+}
+
+// TestAppModel_GenericAnalysisErrorShowsErrorModal tests that other errors keep the error modal
+func TestAppModel_GenericAnalysisErrorShowsErrorModal(t *testing.T) {
+	m := newTestAppModel()
+
+	updated, _ := m.Update(commitAnalysisMsg{err: errors.New("network unreachable")})
+	app := updated.(AppModel)
+
+	if !app.showingError {
+		t.Error("Expected generic error modal to be shown")
+	}
+	if app.showingRateLimit {
+		t.Error("Expected rate limit modal not to be shown")
+	}
+}
+
+// TestAppModel_AnalyzeModeFlowsIntoRequest tests that the commit submenu choice reaches the analysis request
+func TestAppModel_AnalyzeModeFlowsIntoRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		submenuIndex int
+		wantStaged   bool
+	}{
+		{"analyze all changes", 0, false},
+		{"analyze staged only", 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestAppModel()
+			m.cfg.AI.APIKey = "csk-test-key"
+
+			// Select the option in the commit submenu
+			m.dashboard.activeSubmenu = CommitOptionsMenu
+			m.dashboard.submenuIndex = tt.submenuIndex
+			updated, _ := m.dashboard.handleSubmenuSelection()
+			dash := updated.(DashboardModel)
+
+			if dash.GetAction() != ActionCommit {
+				t.Fatalf("Expected ActionCommit, got %v", dash.GetAction())
+			}
+
+			req, err := m.buildAnalyzeCommitRequest(dash.GetActionParams())
+			if err != nil {
+				t.Fatalf("buildAnalyzeCommitRequest() unexpected error = %v", err)
+			}
+			if req.AnalyzeStaged != tt.wantStaged {
+				t.Errorf("AnalyzeStaged = %v, want %v", req.AnalyzeStaged, tt.wantStaged)
+			}
+
+			// The choice is remembered for next time
+			m.rememberAnalyzeMode(dash.GetActionParams())
+			if m.cfg.Commits.AnalyzeStaged != tt.wantStaged {
+				t.Errorf("cfg.Commits.AnalyzeStaged = %v, want %v", m.cfg.Commits.AnalyzeStaged, tt.wantStaged)
+			}
+		})
+	}
+}
+
+// TestAppModel_StaleAnalysisDroppedAfterCancel tests that a result from a cancelled analysis never reaches the commit view
+func TestAppModel_StaleAnalysisDroppedAfterCancel(t *testing.T) {
+	m := newTestAppModel()
+
+	// Start an analysis, then cancel it through the confirmation dialog
+	_, epoch := m.beginAnalysis()
+	m.state = StateCommitAnalyzing
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(AppModel)
+	m.confirmationSelectedBtn = 1 // Yes
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(AppModel)
+
+	if m.state != StateDashboard {
+		t.Fatalf("Expected StateDashboard after cancel, got %v", m.state)
+	}
+
+	// The cancelled analysis finishes late
+	decision, err := domain.NewDecision(domain.ActionCommitDirect, 0.9, "partial")
+	if err != nil {
+		t.Fatalf("NewDecision() error = %v", err)
+	}
+	updated, cmd := m.Update(commitAnalysisMsg{
+		result: &usecase.AnalyzeCommitResponse{Decision: decision},
+		epoch:  epoch,
+	})
+	m = updated.(AppModel)
+
+	if cmd != nil {
+		t.Error("Expected no command for a stale analysis result")
+	}
+	if m.state != StateDashboard {
+		t.Errorf("Expected to stay on dashboard, got state %v", m.state)
+	}
+	if m.commitAnalysisResult != nil {
+		t.Error("Expected stale result to be discarded, but commitAnalysisResult was set")
+	}
+}
+
+// TestAppModel_StreamedAnalysisShowsInOverlay tests that streamed pieces of the current analysis appear in the loading overlay
+func TestAppModel_StreamedAnalysisShowsInOverlay(t *testing.T) {
+	m := newTestAppModel()
+	m.windowWidth, m.windowHeight = 120, 40
+	_, stale := m.beginAnalysis()
+	_, epoch := m.beginAnalysis()
+	m.state = StateCommitAnalyzing
+
+	tokens := make(chan string)
+	close(tokens) // Each update only arms the next read
+	for _, msg := range []analysisTokenMsg{
+		{tokens: tokens, token: `{"commit_message":"Refresh expired `, epoch: epoch},
+		{tokens: tokens, token: "cancelled analysis", epoch: stale},
+		{tokens: tokens, token: "login sessions", epoch: epoch},
+	} {
+		updated, cmd := m.Update(msg)
+		m = updated.(AppModel)
+		if cmd == nil {
+			t.Fatal("Expected to keep reading the stream")
+		}
+		if next := cmd(); next != nil {
+			t.Errorf("Expected nothing from a closed stream, got %+v", next)
+		}
+	}
+
+	if want := `{"commit_message":"Refresh expired login sessions`; m.streamed != want {
+		t.Errorf("streamed = %q, want %q without the stale piece", m.streamed, want)
+	}
+	if view := m.View(); !strings.Contains(view, "Refresh expired login sessions") {
+		t.Errorf("Expected the streamed text in the overlay, got:\n%s", view)
+	}
+}
+
+// TestAppModel_SessionSummaryReflectsActions tests that the exit summary lists the session's commits, switches and pushes
+func TestAppModel_SessionSummaryReflectsActions(t *testing.T) {
+	m := newTestAppModel()
+	if summary := m.SessionSummary(); summary != "" {
+		t.Fatalf("Expected no summary before any action, got:\n%s", summary)
+	}
+
+	m.dashboard.recordSessionEvent(sessionBranchSwitch, "Switched to develop")
+	updated, _ := m.Update(commitExecutionMsg{
+		subject: "Add login page",
+		response: &usecase.ExecuteCommitResponse{
+			Success:       true,
+			BranchCreated: "feature/login",
+			Branch:        "feature/login",
+			CommitHash:    "abc1234",
+			Pushed:        true,
+		},
+	})
+	app := updated.(AppModel)
+
+	summary := app.SessionSummary()
+	for _, want := range []string{
+		"Commits: 1",
+		"abc1234 Add login page (feature/login)",
+		"Branch switches: 2",
+		"Switched to develop",
+		"Created and switched to feature/login",
+		"Pushes: 1",
+		"Pushed feature/login",
+	} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("Expected %q in summary, got:\n%s", want, summary)
+		}
+	}
+
+	// Failed commits are not recorded
+	updated, _ = app.Update(commitExecutionMsg{err: errors.New("hook rejected")})
+	if got := updated.(AppModel).SessionSummary(); got != summary {
+		t.Errorf("Expected summary unchanged after a failed commit, got:\n%s", got)
+	}
+}
+
+// dirtyCheckoutGitOps rejects checkouts while there are unstashed changes
+type dirtyCheckoutGitOps struct {
+	git.Operations
+
+	dirty        bool
+	blockAlways  bool // Checkout fails even after stashing
+	stashMessage string
+	popCalls     int
+}
+
+func (f *dirtyCheckoutGitOps) CheckoutBranch(ctx context.Context, repoPath, branchName string) error {
+	if f.dirty || f.blockAlways {
+		return errors.New("failed to checkout branch: error: Your local changes to the following files would be overwritten by checkout:\n\tmain.go\nPlease commit your changes or stash them before you switch branches.: exit status 1")
+	}
+	return nil
+}
+
+func (f *dirtyCheckoutGitOps) Stash(ctx context.Context, repoPath, message string) error {
+	f.dirty = false
+	f.stashMessage = message
+	return nil
+}
+
+func (f *dirtyCheckoutGitOps) StashPop(ctx context.Context, repoPath string, index int) error {
+	f.dirty = true
+	f.popCalls++
+	return nil
+}
+
+// TestAppModel_CheckoutAutoStashesDirtyTree tests that a checkout blocked by local changes stashes them and retries
+func TestAppModel_CheckoutAutoStashesDirtyTree(t *testing.T) {
+	ops := &dirtyCheckoutGitOps{dirty: true}
+	m := NewAppModel(ops, nil, domain.NewDefaultConfig(), nil, "/tmp/repo", "test")
+
+	stashed, err := m.checkoutWithAutoStash(context.Background(), "feature/login")
+	if err != nil {
+		t.Fatalf("checkoutWithAutoStash() error = %v", err)
+	}
+	if !stashed {
+		t.Error("Expected the changes to be reported as stashed")
+	}
+	if !strings.Contains(ops.stashMessage, "feature/login") {
+		t.Errorf("stash message = %q, want it to name the target branch", ops.stashMessage)
+	}
+
+	// When the retry still fails, the stash is popped so nothing is left behind
+	ops = &dirtyCheckoutGitOps{dirty: true, blockAlways: true}
+	m = NewAppModel(ops, nil, domain.NewDefaultConfig(), nil, "/tmp/repo", "test")
+	if stashed, err := m.checkoutWithAutoStash(context.Background(), "feature/login"); err == nil || stashed {
+		t.Fatalf("Expected failure without a stash left behind, got stashed=%v err=%v", stashed, err)
+	}
+	if ops.popCalls != 1 || !ops.dirty {
+		t.Errorf("Expected the stash to be popped back, popCalls=%d dirty=%v", ops.popCalls, ops.dirty)
+	}
+}
+
+// TestAppModel_SkipConfirmations tests that listed benign confirmations are skipped while destructive ones still ask
+func TestAppModel_SkipConfirmations(t *testing.T) {
+	m := newTestAppModel()
+	m.cfg.UI.SkipConfirmations = []string{"leave_commit", "push_protected"}
+
+	// Leaving the commit view goes straight back to the dashboard
+	m.state = StateCommitView
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	app := updated.(AppModel)
+	if app.showingConfirmation {
+		t.Error("Expected leaving the commit view not to ask for confirmation")
+	}
+	if app.state != StateDashboard {
+		t.Errorf("Expected StateDashboard, got %v", app.state)
+	}
+
+	// A protected push asks even though it is listed
+	called := false
+	app, _ = app.confirm(ConfirmPushProtected, "main", func() tea.Cmd {
+		called = true
+		return nil
+	})
+	if !app.showingConfirmation {
+		t.Error("Expected a protected push to ask for confirmation")
+	}
+	if called {
+		t.Error("Expected the push not to run before it is confirmed")
+	}
+
+	// Unlisted benign confirmations still ask
+	app.showingConfirmation = false
+	app.state = StateMergeView
+	updated, _ = app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !updated.(AppModel).showingConfirmation {
+		t.Error("Expected leaving the merge view to ask for confirmation")
+	}
+}
+
+// stuckFetchGitOps fetches from a remote that never answers, until cancelled
+type stuckFetchGitOps struct {
+	git.Operations
+}
+
+func (f *stuckFetchGitOps) Fetch(ctx context.Context, repoPath string) error {
+	<-ctx.Done()
+	return fmt.Errorf("failed to fetch: cancelled: %w", ctx.Err())
+}
+
+// TestAppModel_CancelNetworkOperation tests that Esc during a fetch cancels its context and returns to the dashboard
+func TestAppModel_CancelNetworkOperation(t *testing.T) {
+	m := NewAppModel(&stuckFetchGitOps{}, nil, domain.NewDefaultConfig(), nil, "/tmp/repo", "test")
+	m, cmd := m.startNetworkOp(ActionFetch, "", "")
+	if m.state != StateNetworkOperation {
+		t.Fatalf("Expected StateNetworkOperation, got %v", m.state)
+	}
+	if view := m.View(); !strings.Contains(view, "Fetching from remote") || !strings.Contains(view, "Esc: cancel") {
+		t.Errorf("Expected the fetch with a cancel hint, got:\n%s", view)
+	}
+
+	// The fetch only returns once Esc cancels it
+	done := make(chan tea.Msg, 1)
+	run := cmd().(tea.BatchMsg)[0]
+	go func() { done <- run() }()
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(AppModel)
+
+	var msg tea.Msg
+	select {
+	case msg = <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected Esc to cancel the fetch")
+	}
+
+	updated, _ = m.Update(msg)
+	m = updated.(AppModel)
+	if m.state != StateDashboard || m.showingError {
+		t.Errorf("Expected the dashboard without an error, got state %v error %v", m.state, m.showingError)
+	}
+	if activity := m.dashboard.Activity(); len(activity) == 0 || !strings.Contains(activity[0], "Cancelled: Fetching from remote") {
+		t.Errorf("Expected the cancellation in activity, got %v", activity)
+	}
+}
+
+// mergeTargetGitOps remembers merge targets per branch
+type mergeTargetGitOps struct {
+	git.Operations
+
+	targets map[string]string
+}
+
+func (f *mergeTargetGitOps) SetMergeTarget(ctx context.Context, repoPath, branch, target string) error {
+	f.targets[branch] = target
+	return nil
+}
+
+// TestAppModel_RemembersChosenMergeTarget tests that a merge into a branch other than the parent offers to remember it
+func TestAppModel_RemembersChosenMergeTarget(t *testing.T) {
+	ops := &mergeTargetGitOps{targets: map[string]string{}}
+	m := NewAppModel(ops, nil, domain.NewDefaultConfig(), nil, "/tmp/repo", "test")
+	m.state = StateMergeExecuting
+
+	updated, _ := m.Update(mergeExecutionMsg{
+		response:     &usecase.ExecuteMergeResponse{Success: true, Strategy: "regular"},
+		sourceBranch: "feature/login",
+		targetBranch: "release",
+		offerTarget:  true,
+	})
+	app := updated.(AppModel)
+	if !app.showingConfirmation || !strings.Contains(app.confirmation.Message, "feature/login into release") {
+		t.Fatalf("Expected an offer to remember the target, got %+v", app.confirmation)
+	}
+
+	// The confirmation is answered before the success overlay takes keys
+	app.confirmationSelectedBtn = 1
+	updated, _ = app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	app = updated.(AppModel)
+	if ops.targets["feature/login"] != "release" {
+		t.Errorf("Expected release remembered for feature/login, got %v", ops.targets)
+	}
+	if app.successSummary == nil {
+		t.Error("Expected the merge summary to remain after answering")
+	}
+
+	// Merges into the parent or the remembered target don't ask
+	updated, _ = m.Update(mergeExecutionMsg{
+		response:     &usecase.ExecuteMergeResponse{Success: true},
+		sourceBranch: "feature/login",
+		targetBranch: "develop",
+	})
+	if updated.(AppModel).showingConfirmation {
+		t.Error("Expected no offer when the target needs no remembering")
+	}
+}
+
+// maintenanceGitOps repacks loose objects when maintenance runs
+type maintenanceGitOps struct {
+	git.Operations
+
+	stats git.ObjectStats
+	ran   bool
+}
+
+func (f *maintenanceGitOps) CountObjects(ctx context.Context, repoPath string) (*git.ObjectStats, error) {
+	stats := f.stats
+	return &stats, nil
+}
+
+func (f *maintenanceGitOps) RunMaintenance(ctx context.Context, repoPath string) error {
+	f.ran = true
+	f.stats = git.ObjectStats{Packed: 7000, Packs: 1, PackSize: "2.10 MiB", LooseSize: "0 bytes"}
+	return nil
+}
+
+// TestAppModel_RunsMaintenanceAfterConfirming tests that optimizing asks first, then reports the size before and after
+func TestAppModel_RunsMaintenanceAfterConfirming(t *testing.T) {
+	ops := &maintenanceGitOps{stats: git.ObjectStats{Loose: 6900, LooseSize: "27.43 MiB", Packs: 1, PackSize: "1.21 MiB"}}
+	m := NewAppModel(ops, nil, domain.NewDefaultConfig(), nil, "/tmp/repo", "test")
+
+	updated, cmd := m.handleDashboardAction(ActionMaintenance, nil, nil)
+	m = updated.(AppModel)
+	if cmd != nil || !m.showingConfirmation || m.confirmation.Title != "Optimize Repository" {
+		t.Fatalf("Expected maintenance to ask first, got %+v", m.confirmation)
+	}
+
+	m.confirmationSelectedBtn = 1
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(AppModel)
+	updated, cmd = m.Update(cmd())
+	m = updated.(AppModel)
+	if m.state != StateMaintenance || ops.ran {
+		t.Fatalf("Expected maintenance to run in the background, got state %v", m.state)
+	}
+	if view := m.View(); !strings.Contains(view, "Optimizing Repository") {
+		t.Errorf("Expected the maintenance progress overlay, got:\n%s", view)
+	}
+
+	updated, _ = m.Update(cmd().(tea.BatchMsg)[0]())
+	m = updated.(AppModel)
+	if m.state != StateDashboard || !ops.ran {
+		t.Fatalf("Expected maintenance to finish on the dashboard, got state %v", m.state)
+	}
+	want := "Maintenance: 1.21 MiB in 1 pack, 6900 loose objects (27.43 MiB) → 2.10 MiB in 1 pack, 0 loose objects (0 bytes)"
+	if activity := m.dashboard.Activity(); len(activity) == 0 || activity[len(activity)-1] != want {
+		t.Errorf("Expected %q in activity, got %v", want, activity)
+	}
+}
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
 	"github.com/yourusername/gitman/internal/adapter/git"
 	"github.com/yourusername/gitman/internal/domain"
 	"github.com/yourusername/gitman/internal/ui/layout"
@@ -28,6 +30,7 @@ const (
 	BranchViewRenaming
 	BranchViewSettingUpstream
 	BranchViewManaging
+	BranchViewCherryPicking
 )
 
 // BranchViewModel represents the state of the branch management view.
@@ -48,6 +51,15 @@ type BranchViewModel struct {
 	detailViewport    viewport.Model
 	renameInput       textinput.Model
 	upstreamInput     textinput.Model
+	upstreamRemotes   []string // Known remotes for upstream validation
+	upstreamError     string   // Inline validation error in the upstream modal
+
+	// Cherry-pick (BranchViewCherryPicking)
+	cherryPickSource   string           // Branch the commits come from
+	cherryPickCommits  []git.CommitInfo // Commits on the source branch, newest first
+	cherryPickSelected map[string]bool  // Selected commits by hash
+	cherryPickIndex    int              // Highlighted commit
+	cherryPickLoading  bool             // Loading commits or running the cherry-pick
 
 	// Actions
 	deleteConfirmed     bool
@@ -66,6 +78,7 @@ type BranchViewModel struct {
 
 	// Use cases
 	manageBranchesUC  *usecase.ManageBranchesUseCase
+	refCache          *usecase.RefCache // Shared with manageBranchesUC; nil disables caching
 
 	// Error handling
 	errorMessage      string
@@ -89,7 +102,8 @@ func NewBranchViewModel(
 
 	upstreamInput := textinput.New()
 	upstreamInput.Placeholder = "origin/branch-name"
-	upstreamInput.CharLimit = 50
+	upstreamInput.CharLimit = 100
+	upstreamInput.ShowSuggestions = true
 
 	m := BranchViewModel{
 		branches:          []*domain.BranchInfo{},
@@ -120,6 +134,14 @@ func NewBranchViewModel(
 	return m
 }
 
+// WithRefCache makes the view reuse a branch list loaded moments ago while no
+// ref has moved. R still reloads it from git.
+func (m BranchViewModel) WithRefCache(cache *usecase.RefCache) BranchViewModel {
+	m.refCache = cache
+	m.manageBranchesUC.WithCache(cache)
+	return m
+}
+
 // Init initializes the branch view.
 func (m BranchViewModel) Init() tea.Cmd {
 	return tea.Batch(
@@ -147,6 +169,12 @@ func (m BranchViewModel) loadBranches() tea.Cmd {
 	}
 }
 
+// forceRefresh reloads the branches from git, bypassing the cache.
+func (m BranchViewModel) forceRefresh() tea.Cmd {
+	m.refCache.Invalidate()
+	return m.loadBranches()
+}
+
 // branchesLoadedMsg is sent when branches are loaded successfully.
 type branchesLoadedMsg struct {
 	branches []*domain.BranchInfo
@@ -172,6 +200,29 @@ type upstreamSetMsg struct {
 	response *usecase.SetUpstreamResponse
 }
 
+// upstreamCandidatesMsg carries the remotes and remote branches for the upstream modal.
+type upstreamCandidatesMsg struct {
+	candidates *usecase.UpstreamCandidates
+	err        error
+}
+
+// cherryPickCandidatesMsg carries the source branch's commits for the cherry-pick modal.
+type cherryPickCandidatesMsg struct {
+	commits []git.CommitInfo
+	err     error
+}
+
+// baseBranchSetMsg is sent when the repository's base branch is set.
+type baseBranchSetMsg struct {
+	base string
+}
+
+// cherryPickedMsg is sent when a cherry-pick finishes.
+type cherryPickedMsg struct {
+	response *usecase.CherryPickResponse
+	err      error
+}
+
 // Update handles messages and updates the branch view.
 func (m BranchViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
@@ -256,12 +307,54 @@ func (m BranchViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.state = BranchViewBrowsing
 		return m, m.loadBranches()
 
+	case baseBranchSetMsg:
+		m.successMessage = fmt.Sprintf("Divergence is now measured against '%s'", msg.base)
+		return m, m.loadBranches()
+
 	case upstreamSetMsg:
 		m.successMessage = msg.response.Message
 		m.state = BranchViewBrowsing
 		m.updateViewportContent()
 		return m, nil
 
+	case cherryPickCandidatesMsg:
+		m.cherryPickLoading = false
+		if msg.err != nil {
+			m.state = BranchViewBrowsing
+			m.errorMessage = fmt.Sprintf("Error: %v", msg.err)
+			return m, nil
+		}
+		m.cherryPickCommits = msg.commits
+		return m, nil
+
+	case cherryPickedMsg:
+		m.cherryPickLoading = false
+		m.state = BranchViewBrowsing
+		var conflict *git.CherryPickConflictError
+		switch {
+		case errors.As(msg.err, &conflict):
+			m.errorMessage = fmt.Sprintf("Cherry-pick aborted, nothing was applied: %v", conflict)
+			return m, nil
+		case msg.err != nil:
+			m.errorMessage = fmt.Sprintf("Error: %v", msg.err)
+			return m, nil
+		}
+		short := make([]string, len(msg.response.NewCommits))
+		for i, hash := range msg.response.NewCommits {
+			short[i] = hash[:min(7, len(hash))]
+		}
+		m.successMessage = fmt.Sprintf("%s onto %s: %s", msg.response.Message, m.currentBranch, strings.Join(short, ", "))
+		m.errorMessage = ""
+		return m, m.loadBranches()
+
+	case upstreamCandidatesMsg:
+		// Without candidates, only the format is validated
+		if msg.err == nil && msg.candidates != nil {
+			m.upstreamRemotes = msg.candidates.Remotes
+			m.upstreamInput.SetSuggestions(msg.candidates.RemoteBranches)
+		}
+		return m, nil
+
 	case tea.KeyMsg:
 		// Handle state-specific keys
 		switch m.state {
@@ -277,6 +370,8 @@ func (m BranchViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m.handleRenamingKeys(msg)
 		case BranchViewSettingUpstream:
 			return m.handleUpstreamKeys(msg)
+		case BranchViewCherryPicking:
+			return m.handleCherryPickKeys(msg)
 		case BranchViewManaging:
 			// Allow Esc to cancel during processing
 			if msg.String() == "esc" {
@@ -366,14 +461,43 @@ func (m BranchViewModel) handleBrowsingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 		m.selectedBranch = m.branches[m.selectedIndex]
 		m.upstreamInput.SetValue("")
 		m.upstreamInput.Focus()
+		m.upstreamError = ""
 		m.state = BranchViewSettingUpstream
-		return m, nil
+		return m, m.loadUpstreamCandidates()
+
+	case "c":
+		// Cherry-pick commits from the selected branch onto the current one
+		if len(m.branches) == 0 {
+			return m, nil
+		}
+		source := m.branches[m.selectedIndex].Name()
+		if m.isCurrentBranch(source) {
+			m.errorMessage = "Select another branch to cherry-pick from"
+			return m, nil
+		}
+		m.cherryPickSource = source
+		m.cherryPickCommits = nil
+		m.cherryPickSelected = make(map[string]bool)
+		m.cherryPickIndex = 0
+		m.cherryPickLoading = true
+		m.errorMessage = ""
+		m.successMessage = ""
+		m.state = BranchViewCherryPicking
+		return m, m.loadCherryPickCandidates()
+
+	case "b":
+		// Measure every branch's divergence against the selected one
+		if len(m.branches) == 0 {
+			return m, nil
+		}
+		m.errorMessage = ""
+		return m, m.setBaseBranch(m.branches[m.selectedIndex].Name())
 
 	case "R":
-		// Refresh
+		// Refresh, bypassing the cache
 		m.successMessage = ""
 		m.errorMessage = ""
-		return m, m.loadBranches()
+		return m, m.forceRefresh()
 	}
 
 	return m, nil
@@ -524,7 +648,14 @@ func (m BranchViewModel) handleUpstreamKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 
 	switch msg.String() {
 	case "enter":
-		// Confirm upstream
+		// Validate before handing off to git so mistakes are caught in the modal
+		upstream := strings.TrimSpace(m.upstreamInput.Value())
+		if err := domain.ValidateUpstream(upstream, m.upstreamRemotes); err != nil {
+			m.upstreamError = err.Error()
+			return m, nil
+		}
+		m.upstreamInput.SetValue(upstream)
+		m.upstreamError = ""
 		return m, m.setUpstream()
 
 	case "esc":
@@ -532,20 +663,121 @@ func (m BranchViewModel) handleUpstreamKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 		m.state = BranchViewBrowsing
 		m.selectedBranch = nil
 		m.upstreamInput.SetValue("")
+		m.upstreamError = ""
 		return m, nil
 	}
 
-	// Update text input
+	// Update text input (tab accepts the highlighted suggestion)
 	m.upstreamInput, cmd = m.upstreamInput.Update(msg)
+	m.upstreamError = ""
 	return m, cmd
 }
 
+// handleCherryPickKeys handles keyboard input in the cherry-pick modal.
+func (m BranchViewModel) handleCherryPickKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.cherryPickLoading {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		if m.cherryPickIndex > 0 {
+			m.cherryPickIndex--
+		}
+
+	case "down", "j":
+		if m.cherryPickIndex < len(m.cherryPickCommits)-1 {
+			m.cherryPickIndex++
+		}
+
+	case " ":
+		// Toggle the highlighted commit
+		if m.cherryPickIndex < len(m.cherryPickCommits) {
+			hash := m.cherryPickCommits[m.cherryPickIndex].Hash
+			m.cherryPickSelected[hash] = !m.cherryPickSelected[hash]
+		}
+
+	case "enter":
+		hashes := m.cherryPickHashes()
+		if len(hashes) == 0 {
+			return m, nil
+		}
+		m.cherryPickLoading = true
+		return m, m.cherryPick(hashes)
+
+	case "esc":
+		m.state = BranchViewBrowsing
+		m.cherryPickCommits = nil
+	}
+
+	return m, nil
+}
+
+// cherryPickHashes returns the selected commits oldest first, the order they
+// apply in, or the highlighted commit when none are selected.
+func (m BranchViewModel) cherryPickHashes() []string {
+	var hashes []string
+	for i := len(m.cherryPickCommits) - 1; i >= 0; i-- {
+		if hash := m.cherryPickCommits[i].Hash; m.cherryPickSelected[hash] {
+			hashes = append(hashes, hash)
+		}
+	}
+	if len(hashes) == 0 && m.cherryPickIndex < len(m.cherryPickCommits) {
+		hashes = []string{m.cherryPickCommits[m.cherryPickIndex].Hash}
+	}
+	return hashes
+}
+
+// loadCherryPickCandidates fetches the source branch's commits that the current branch lacks.
+func (m BranchViewModel) loadCherryPickCandidates() tea.Cmd {
+	source := m.cherryPickSource
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		commits, err := m.manageBranchesUC.GetCherryPickCandidates(ctx, m.repoPath, source)
+		return cherryPickCandidatesMsg{commits: commits, err: err}
+	}
+}
+
+// cherryPick applies the commits onto the current branch.
+func (m BranchViewModel) cherryPick(hashes []string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		resp, err := m.manageBranchesUC.CherryPick(ctx, usecase.CherryPickRequest{
+			RepoPath: m.repoPath,
+			Hashes:   hashes,
+		})
+		return cherryPickedMsg{response: resp, err: err}
+	}
+}
+
+// loadUpstreamCandidates fetches remotes and remote branches for validation and autocompletion.
+func (m BranchViewModel) loadUpstreamCandidates() tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		candidates, err := m.manageBranchesUC.GetUpstreamCandidates(ctx, m.repoPath)
+		return upstreamCandidatesMsg{candidates: candidates, err: err}
+	}
+}
+
 // deleteBranch initiates branch deletion.
 func (m BranchViewModel) deleteBranch(alsoDeleteRemote bool) tea.Cmd {
 	branchName := m.selectedBranch.Name()
 
+	// Deleting the remote copy is a push, so it gets the network timeout
+	timeout := 10 * time.Second
+	if alsoDeleteRemote && m.config != nil {
+		timeout = m.config.NetworkTimeout()
+	}
+
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 
 		// Determine remote name (default to "origin")
@@ -611,6 +843,30 @@ func (m BranchViewModel) setUpstream() tea.Cmd {
 	}
 }
 
+// setBaseBranch makes base the repository's base branch.
+func (m BranchViewModel) setBaseBranch(base string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if err := m.manageBranchesUC.SetBaseBranch(ctx, m.repoPath, base); err != nil {
+			return branchLoadErrorMsg{err}
+		}
+		return baseBranchSetMsg{base: base}
+	}
+}
+
+// baseBranch returns the branch the divergence column is measured against,
+// or "" if there is none.
+func (m BranchViewModel) baseBranch() string {
+	for _, branch := range m.branches {
+		if branch.Base() != "" {
+			return branch.Base()
+		}
+	}
+	return ""
+}
+
 // updateViewportContent updates the viewport content based on current state.
 func (m *BranchViewModel) updateViewportContent() {
 	if m.state == BranchViewExpanded {
@@ -639,6 +895,8 @@ func (m BranchViewModel) View() string {
 		return m.renderRenameModal()
 	case BranchViewSettingUpstream:
 		return m.renderUpstreamModal()
+	case BranchViewCherryPicking:
+		return m.renderCherryPickModal()
 	case BranchViewManaging:
 		// Show loading overlay
 		return m.renderLoadingOverlay("Deleting branch...")
@@ -723,8 +981,12 @@ func (m BranchViewModel) renderBranchTable(isCompact bool) string {
 	if isCompact {
 		header = fmt.Sprintf("%-25s %-10s", "Branch", "Status")
 	} else {
-		header = fmt.Sprintf("%-30s %-12s %-15s %-15s %-12s",
-			"Branch", "Type", "Ahead/Behind", "Upstream", "Commits")
+		base := "-"
+		if name := m.baseBranch(); name != "" {
+			base = "vs " + truncate(name, 9)
+		}
+		header = fmt.Sprintf("%-30s %-12s %-12s %-15s %-15s %-12s",
+			"Branch", "Type", base, "Ahead/Behind", "Upstream", "Commits")
 	}
 	lines = append(lines, headerStyle.Render(header))
 
@@ -768,9 +1030,10 @@ func (m BranchViewModel) renderBranchTable(isCompact bool) string {
 			}
 			commits := fmt.Sprintf("%d", branch.CommitCount())
 
-			row = fmt.Sprintf("%-30s %-12s %-15s %-15s %-12s",
+			row = fmt.Sprintf("%-30s %-12s %-12s %-15s %-15s %-12s",
 				truncate(branchName, 28),
 				typeStr,
+				m.getBaseDivergenceString(branch),
 				divergence,
 				truncate(upstream, 13),
 				commits,
@@ -817,6 +1080,15 @@ func (m BranchViewModel) renderDetailPanel() string {
 		lines = append(lines, "")
 	}
 
+	// Divergence from the integration branch
+	if base := branch.Base(); base != "" {
+		ahead, behind := branch.BaseDivergence()
+		lines = append(lines, styles.StatusInfo.Render(fmt.Sprintf("Compared to %s:", base)))
+		lines = append(lines, fmt.Sprintf("  ↑ %d commit(s) not on %s", ahead, base))
+		lines = append(lines, fmt.Sprintf("  ↓ %d commit(s) on %s not here", behind, base))
+		lines = append(lines, "")
+	}
+
 	// Commit count
 	if branch.CommitCount() > 0 {
 		lines = append(lines, styles.StatusInfo.Render(fmt.Sprintf("Commits: %d", branch.CommitCount())))
@@ -936,17 +1208,16 @@ func (m BranchViewModel) renderForceDeletePrompt() string {
 	styles := GetGlobalThemeManager().GetStyles()
 	theme := GetGlobalThemeManager().GetCurrentTheme()
 
+	confirmation := confirmationFor(ConfirmForceDeleteBranch, m.selectedBranch.Name())
+
 	// Title
 	title := lipgloss.NewStyle().
 		Bold(true).
 		Foreground(styles.ColorWarning).
-		Render("⚠ Force Delete Required")
+		Render("⚠ " + confirmation.Title)
 
 	// Message
-	message := fmt.Sprintf("Branch '%s' is not fully merged.\n\n", m.selectedBranch.Name())
-	message += "This branch contains commits that haven't been merged into its parent branch.\n\n"
-	message += "Are you sure you want to force delete it?\n\n"
-	message += "⚠️  This will permanently lose any unmerged changes!"
+	message := confirmation.Message + "\n\n" + confirmation.Consequence
 
 	messageStyle := lipgloss.NewStyle().
 		Foreground(styles.ColorText).
@@ -970,7 +1241,7 @@ func (m BranchViewModel) renderForceDeletePrompt() string {
 
 	// Render buttons
 	noBtn := "Cancel"
-	yesBtn := "Force Delete"
+	yesBtn := confirmation.ConfirmLabel
 
 	if m.confirmSelectedBtn == 0 {
 		noBtn = buttonActiveStyle.Render(noBtn)
@@ -1157,16 +1428,39 @@ func (m BranchViewModel) renderUpstreamModal() string {
 		Foreground(styles.ColorPrimary).
 		Bold(true)
 
-	content := lipgloss.JoinVertical(
-		lipgloss.Left,
+	lines := []string{
 		titleStyle.Render(title),
 		"",
 		message,
 		"",
 		m.upstreamInput.View(),
-		"",
-		"[enter] Confirm    [esc] Cancel",
-	)
+	}
+
+	// Inline validation feedback
+	if m.upstreamError != "" {
+		lines = append(lines, styles.StatusError.Render("✗ "+m.upstreamError))
+	}
+
+	// Matching remote branches
+	if m.upstreamInput.Value() != "" {
+		matches := m.upstreamInput.MatchedSuggestions()
+		const maxMatches = 5
+		for i, match := range matches {
+			if i == maxMatches {
+				lines = append(lines, styles.Metadata.Render(fmt.Sprintf("  … %d more", len(matches)-maxMatches)))
+				break
+			}
+			if i == m.upstreamInput.CurrentSuggestionIndex() {
+				lines = append(lines, styles.SubmenuOptionActive.Render("> "+match))
+			} else {
+				lines = append(lines, styles.SubmenuOption.Render("  "+match))
+			}
+		}
+	}
+
+	lines = append(lines, "", "[enter] Confirm    [tab] Complete    [esc] Cancel")
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
 
 	// Create modal box
 	modalStyle := lipgloss.NewStyle().
@@ -1189,6 +1483,81 @@ func (m BranchViewModel) renderUpstreamModal() string {
 	)
 }
 
+// renderCherryPickModal renders the commit picker for cherry-picking.
+func (m BranchViewModel) renderCherryPickModal() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	theme := GetGlobalThemeManager().GetCurrentTheme()
+
+	titleStyle := lipgloss.NewStyle().
+		Foreground(styles.ColorPrimary).
+		Bold(true)
+
+	lines := []string{
+		titleStyle.Render("Cherry-pick Commits"),
+		"",
+		fmt.Sprintf("From %s onto %s", m.cherryPickSource, m.currentBranch),
+		"",
+	}
+
+	switch {
+	case m.cherryPickLoading && m.cherryPickCommits == nil:
+		lines = append(lines, styles.Metadata.Render("Loading commits..."))
+	case m.cherryPickLoading:
+		lines = append(lines, styles.Metadata.Render("Cherry-picking..."))
+	case len(m.cherryPickCommits) == 0:
+		lines = append(lines, styles.Metadata.Render(fmt.Sprintf("%s has no commits that %s doesn't have", m.cherryPickSource, m.currentBranch)))
+	default:
+		// Keep the highlighted commit in a window of visibleRows
+		const visibleRows = 10
+		start := 0
+		if m.cherryPickIndex >= visibleRows {
+			start = m.cherryPickIndex - visibleRows + 1
+		}
+		end := min(start+visibleRows, len(m.cherryPickCommits))
+
+		for i := start; i < end; i++ {
+			commit := m.cherryPickCommits[i]
+			check := "[ ]"
+			if m.cherryPickSelected[commit.Hash] {
+				check = "[x]"
+			}
+			line := fmt.Sprintf("%s %s %s", check, commit.Hash[:min(7, len(commit.Hash))], truncate(commit.Message, 40))
+			if i == m.cherryPickIndex {
+				lines = append(lines, styles.SubmenuOptionActive.Render("> "+line))
+			} else {
+				lines = append(lines, styles.SubmenuOption.Render("  "+line))
+			}
+		}
+		if end < len(m.cherryPickCommits) {
+			lines = append(lines, styles.Metadata.Render(fmt.Sprintf("  … %d more", len(m.cherryPickCommits)-end)))
+		}
+	}
+
+	lines = append(lines, "", "[space] Select    [enter] Cherry-pick    [esc] Cancel")
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+
+	// Create modal box
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorBorder).
+		Background(lipgloss.Color(theme.Backgrounds.FormInput)).
+		Padding(layout.SpacingMD).
+		Width(layout.ModalWidthLG).
+		Height(layout.ModalHeightLG)
+
+	modal := modalStyle.Render(content)
+
+	// Center modal
+	return lipgloss.Place(
+		m.windowWidth,
+		m.windowHeight,
+		lipgloss.Center,
+		lipgloss.Center,
+		modal,
+	)
+}
+
 // renderFooter renders the footer with keyboard shortcuts.
 func (m BranchViewModel) renderFooter() string {
 	styles := GetGlobalThemeManager().GetStyles()
@@ -1196,9 +1565,9 @@ func (m BranchViewModel) renderFooter() string {
 	var help string
 	switch m.state {
 	case BranchViewBrowsing:
-		help = "↑↓: navigate • enter: expand • d: delete • r: rename • u: set upstream • R: refresh • esc: back"
+		help = "↑↓: navigate • enter: expand • d: delete • r: rename • u: set upstream • c: cherry-pick • b: use as base • R: refresh • esc: back"
 	case BranchViewExpanded:
-		help = "↑↓: navigate • enter: collapse • d: delete • r: rename • u: set upstream • esc: back"
+		help = "↑↓: navigate • enter: collapse • d: delete • r: rename • u: set upstream • c: cherry-pick • b: use as base • esc: back"
 	default:
 		help = "See modal for options"
 	}
@@ -1274,6 +1643,20 @@ func (m BranchViewModel) getDivergenceString(branch *domain.BranchInfo) string {
 	return strings.Join(parts, " ")
 }
 
+// getBaseDivergenceString returns the ahead/behind string for a branch
+// against the base branch, or "-" for the base itself.
+func (m BranchViewModel) getBaseDivergenceString(branch *domain.BranchInfo) string {
+	if branch.Base() == "" {
+		return "-"
+	}
+
+	ahead, behind := branch.BaseDivergence()
+	if ahead == 0 && behind == 0 {
+		return "even"
+	}
+	return fmt.Sprintf("↑%d ↓%d", ahead, behind)
+}
+
 // ShouldReturnToDashboard returns whether the view wants to return to dashboard.
 func (m BranchViewModel) ShouldReturnToDashboard() bool {
 	return m.returnToDashboard
@@ -1340,11 +1723,14 @@ func (m *BranchViewModel) scrollToSelected() {
 }
 
 // Helper functions
+
+// truncate shortens s to maxLen terminal columns, measuring display width so
+// emoji (e.g. gitmoji subjects) and other wide runes don't break alignment.
 func truncate(s string, maxLen int) string {
-	if len(s) <= maxLen {
+	if runewidth.StringWidth(s) <= maxLen {
 		return s
 	}
-	return s[:maxLen-3] + "..."
+	return runewidth.Truncate(s, maxLen, "...")
 }
 
 func getOrDefault(s, def string) string {
@@ -0,0 +1,170 @@
+package ui
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// TestBranchView_MalformedUpstreamStaysInModal tests that a bad upstream is rejected inline
+func TestBranchView_MalformedUpstreamStaysInModal(t *testing.T) {
+	branch, err := domain.NewBranchInfo("feature/login")
+	if err != nil {
+		t.Fatalf("NewBranchInfo() error = %v", err)
+	}
+
+	m := NewBranchViewModel("/tmp/repo", domain.NewDefaultConfig(), nil)
+	m.state = BranchViewSettingUpstream
+	m.selectedBranch = branch
+	m.upstreamRemotes = []string{"origin"}
+	m.upstreamInput.SetValue("main")
+
+	updated, cmd := m.handleUpstreamKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	result := updated.(BranchViewModel)
+
+	if cmd != nil {
+		t.Error("Expected no command for a malformed upstream")
+	}
+	if result.state != BranchViewSettingUpstream {
+		t.Errorf("Expected to stay in upstream modal, got state %v", result.state)
+	}
+	if result.upstreamError == "" {
+		t.Error("Expected an inline validation error")
+	}
+}
+
+// cherryPickGitOps serves a feature branch's commits and records cherry-picks
+type cherryPickGitOps struct {
+	git.Operations
+
+	picked []string
+	err    error
+}
+
+func (f *cherryPickGitOps) GetCurrentBranch(ctx context.Context, repoPath string) (string, error) {
+	return "main", nil
+}
+
+func (f *cherryPickGitOps) GetBranchCommits(ctx context.Context, repoPath, branch, excludeBranch string) ([]git.CommitInfo, error) {
+	return []git.CommitInfo{
+		{Hash: "cccccccccccc", Message: "Add logout"},
+		{Hash: "bbbbbbbbbbbb", Message: "WIP debugging"},
+		{Hash: "aaaaaaaaaaaa", Message: "Fix login redirect"},
+	}, nil
+}
+
+func (f *cherryPickGitOps) CherryPick(ctx context.Context, repoPath string, hashes []string) error {
+	f.picked = hashes
+	return f.err
+}
+
+func (f *cherryPickGitOps) GetLog(ctx context.Context, repoPath string, count int) ([]git.CommitInfo, error) {
+	return []git.CommitInfo{{Hash: "2222222222"}, {Hash: "1111111111"}}[:count], nil
+}
+
+// TestBranchView_CherryPick tests picking commits from another branch onto the current one
+func TestBranchView_CherryPick(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantSuccess string
+		wantError   string
+	}{
+		{name: "applied", wantSuccess: "Cherry-picked 2 commit(s) onto main: 1111111, 2222222"},
+		{
+			name:      "conflict",
+			err:       &git.CherryPickConflictError{Commit: "ccccccc", Files: []string{"auth.go"}},
+			wantError: "nothing was applied: cherry-pick conflict applying ccccccc in auth.go",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mainBranch, _ := domain.NewBranchInfo("main")
+			feature, _ := domain.NewBranchInfo("feature/login")
+			ops := &cherryPickGitOps{err: tt.err}
+			m := NewBranchViewModel("/tmp/repo", domain.NewDefaultConfig(), ops)
+			m.branches = []*domain.BranchInfo{mainBranch, feature}
+			m.currentBranch = "main"
+
+			send := func(msg tea.Msg) tea.Cmd {
+				updated, cmd := m.Update(msg)
+				m = updated.(BranchViewModel)
+				return cmd
+			}
+			key := func(k string) tea.Cmd {
+				if k == "enter" {
+					return send(tea.KeyMsg{Type: tea.KeyEnter})
+				}
+				return send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+			}
+
+			// The current branch can't be a source
+			key("c")
+			if m.state == BranchViewCherryPicking || m.errorMessage == "" {
+				t.Fatalf("Expected an error for the current branch, got state %v", m.state)
+			}
+
+			key("j")
+			send(key("c")())
+			if m.state != BranchViewCherryPicking || len(m.cherryPickCommits) != 3 {
+				t.Fatalf("Expected the picker with 3 commits, got state %v with %d", m.state, len(m.cherryPickCommits))
+			}
+
+			// Select "Add logout" and "Fix login redirect", skipping the WIP commit
+			key(" ")
+			key("j")
+			key("j")
+			key(" ")
+			if view := m.renderCherryPickModal(); !strings.Contains(view, "[x] ccccccc Add logout") || !strings.Contains(view, "[ ] bbbbbbb WIP debugging") {
+				t.Errorf("Expected selections in the picker, got:\n%s", view)
+			}
+
+			send(key("enter")())
+			if strings.Join(ops.picked, ",") != "aaaaaaaaaaaa,cccccccccccc" {
+				t.Errorf("Picked %v, want oldest first without the WIP commit", ops.picked)
+			}
+			if m.state != BranchViewBrowsing {
+				t.Errorf("Expected to return to browsing, got %v", m.state)
+			}
+			if m.successMessage != tt.wantSuccess {
+				t.Errorf("successMessage = %q, want %q", m.successMessage, tt.wantSuccess)
+			}
+			if !strings.Contains(m.errorMessage, tt.wantError) {
+				t.Errorf("errorMessage = %q, want %q", m.errorMessage, tt.wantError)
+			}
+		})
+	}
+}
+
+// TestTruncate_MeasuresDisplayWidth tests that emoji subjects are cut by terminal columns, not bytes
+func TestTruncate_MeasuresDisplayWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		max  int
+		want string
+	}{
+		{"ascii fits", "feat: add login", 20, "feat: add login"},
+		{"ascii cut", "feat: add login form", 10, "feat: a..."},
+		{"emoji fits", "✨ feat: add login", 18, "✨ feat: add login"},
+		{"emoji cut", "✨ feat: add login form", 10, "✨ feat..."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncate(tt.s, tt.max)
+			if got != tt.want {
+				t.Errorf("truncate(%q, %d) = %q, want %q", tt.s, tt.max, got, tt.want)
+			}
+			if w := lipgloss.Width(got); w > tt.max {
+				t.Errorf("truncate(%q, %d) is %d columns wide", tt.s, tt.max, w)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package ui
+
+import (
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// copyToClipboard writes text to the system clipboard (pbcopy, xclip/xsel/wl-copy,
+// or the Windows API). Tests replace it to capture copied text.
+var copyToClipboard = clipboard.WriteAll
+
+// clipboardCopiedMsg reports the result of a clipboard copy
+type clipboardCopiedMsg struct {
+	err error
+}
+
+// copyCmd copies text to the clipboard asynchronously
+func copyCmd(text string) tea.Cmd {
+	return func() tea.Msg {
+		return clipboardCopiedMsg{err: copyToClipboard(text)}
+	}
+}
+
+// ClipboardAvailable reports whether a clipboard tool was found; on Linux
+// this needs xclip, xsel, or wl-clipboard installed.
+func ClipboardAvailable() bool {
+	return !clipboard.Unsupported
+}
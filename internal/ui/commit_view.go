@@ -3,12 +3,17 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/yourusername/gitman/internal/adapter/git"
 	"github.com/yourusername/gitman/internal/domain"
+	"github.com/yourusername/gitman/internal/usecase"
 )
 
 // ViewState represents the current state of the view
@@ -17,6 +22,9 @@ type ViewState int
 const (
 	ViewStateBrowsing ViewState = iota
 	ViewStateConfirm
+	ViewStateBranchName // Reviewing the suggested branch name before browsing options
+	ViewStateDiff       // Reading the diff of the changes being committed
+	ViewStateFiles      // Choosing which changed files the commit includes
 )
 
 // CommitViewModel represents the state of the commit view.
@@ -40,10 +48,67 @@ type CommitViewModel struct {
 	// Input handling
 	state             ViewState
 	msgInput          textinput.Model
+	bodyInput         textarea.Model
 	branchInput       textinput.Model
-	confirmationFocus int // 0: Msg, 1: Branch, 2: Confirm, 3: Cancel
+	confirmationFocus int // One of the focus* constants
 	customMessage     string
+	customBody        string
 	customBranch      string
+	inputErr          string              // Inline validation error shown in the confirmation modal
+	checkConvention   bool                // Re-validate the edited message against the commit convention on confirm
+	hooks             []string            // Commit hooks git will run, noted in the confirmation modal
+	lastCommit        *usecase.LastCommit // Commit the changes can be amended into; nil hides the amend option
+	candidateIndex    int                 // Which of decision.Candidates() the options use
+	suggestedScope    string              // Conventional commit scope computed from the changed paths
+	reasoningExpanded bool                // Details pane shows the full reasoning instead of the first lines
+	issueRef          string              // Description line referencing the branch's issue, e.g. "Refs: #123"; "" if none
+	trailers          []string            // Configured trailers rendered for the branch, added to the description
+	stagedOnly        bool                // Commit the index as-is instead of staging all changes
+
+	// Diff viewer opened with "d"; nil until then or without SetDiffSource
+	gitOps   git.Operations
+	repoPath string
+	diffView *DiffViewModel
+
+	// Files the commit includes, opened with "f"; items follow repo.Changes()
+	// and all start checked
+	files CheckboxGroup
+
+	// Files new or edited since the previous analysis, marked in the file list
+	changedSinceLast []string
+
+	// Branch naming rules; defaults until SetConfig is called
+	cfg *domain.Config
+
+	// Transient status (e.g. "Message copied"), cleared after statusDuration
+	status      string
+	statusError bool
+	statusID    int
+}
+
+// Focus targets in the confirmation modal
+const (
+	focusMessage = iota
+	focusBranch
+	focusConfirm
+	focusCancel
+	focusBody
+)
+
+// Reasoning lines shown in the details pane: collapsedReasoningLines until
+// expanded with "e", then whatever is left after detailsContextLines for the
+// message preview, branch and confidence below it.
+const (
+	collapsedReasoningLines = 6
+	detailsContextLines     = 11
+)
+
+// statusDuration is how long transient status messages stay visible
+const statusDuration = 2 * time.Second
+
+// commitStatusClearMsg clears the transient status if it is still the latest one
+type commitStatusClearMsg struct {
+	id int
 }
 
 // CommitOption represents a user-selectable option.
@@ -72,6 +137,13 @@ func NewCommitViewModel(
 	msgInput.Width = 50
 	msgInput.Placeholder = "Enter commit message"
 
+	bodyInput := textarea.New()
+	bodyInput.ShowLineNumbers = false
+	bodyInput.SetWidth(50)
+	bodyInput.SetHeight(4)
+	bodyInput.CharLimit = 2000
+	bodyInput.Placeholder = "Optional description: what changed and why"
+
 	branchInput := textinput.New()
 	branchInput.CharLimit = 100
 	branchInput.Width = 50
@@ -92,8 +164,11 @@ func NewCommitViewModel(
 		windowHeight:      windowHeight,
 		state:             ViewStateBrowsing,
 		msgInput:          msgInput,
+		bodyInput:         bodyInput,
 		branchInput:       branchInput,
+		cfg:               domain.NewDefaultConfig(),
 	}
+	m.files = m.buildFileSelection()
 
 	// Initialize options
 	m.options = m.buildOptions()
@@ -113,15 +188,507 @@ func NewCommitViewModel(
 	// Set initial viewport content
 	m.viewport.SetContent(m.renderOptionsContent())
 
+	// Without a suggested message (AI disabled), go straight to message input
+	if decision.SuggestedMessage() == nil {
+		m.enterConfirm()
+	} else if decision.Action() == domain.ActionCreateBranch {
+		// The branch name matters as much as the message, so review it first
+		m.enterBranchName()
+	}
+
 	return m
 }
 
+// SetTemplate uses the repository's commit.template as a scaffold: its first
+// non-comment line becomes the placeholder when the message is written by hand.
+func (m *CommitViewModel) SetTemplate(template string) {
+	if m.decision.SuggestedMessage() != nil {
+		return
+	}
+	for _, line := range strings.Split(template, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			m.msgInput.Placeholder = line
+			return
+		}
+	}
+}
+
+// SetConfig sets the configuration whose naming rules the branch name must follow
+func (m *CommitViewModel) SetConfig(cfg *domain.Config) {
+	if cfg != nil {
+		m.cfg = cfg
+	}
+}
+
+// CheckConvention validates the AI's suggested message against the commit
+// convention. If it doesn't comply, the confirmation opens with the message
+// ready to edit and the validation error shown, and the edited message is
+// re-validated before it can be confirmed.
+func (m *CommitViewModel) CheckConvention() {
+	msg := m.decision.SuggestedMessage()
+	if msg == nil {
+		return
+	}
+	m.checkConvention = true
+
+	if err := m.cfg.ValidateCommitSubject(msg.Title()); err != nil {
+		m.selectedIndex = 0
+		m.enterConfirm()
+		m.inputErr = "AI message needs fixing: " + err.Error()
+	}
+}
+
+// enterBranchName switches to the branch name step, pre-filled with the
+// suggested name (or one generated from the message if the AI gave none).
+func (m *CommitViewModel) enterBranchName() {
+	m.state = ViewStateBranchName
+	m.inputErr = ""
+
+	name := m.options[0].BranchName
+	if name == "" {
+		name = m.generateBranchName()
+	}
+	m.branchInput.SetValue(name)
+	m.branchInput.CursorEnd()
+	m.branchInput.Focus()
+}
+
+// isActionable reports whether the option at index can be executed. With
+// cfg.UI.AlternativesActionable off, only the primary (AI) action can be.
+func (m CommitViewModel) isActionable(index int) bool {
+	// Amending is the user's own option, not an AI alternative
+	return index == 0 || m.cfg.UI.AlternativesActionable || m.options[index].Action == domain.ActionAmend
+}
+
+// generateBranchName derives a branch name from the suggested message subject
+func (m CommitViewModel) generateBranchName() string {
+	msg := m.decision.SuggestedMessage()
+	if msg == nil {
+		return ""
+	}
+	return m.cfg.GenerateBranchName(msg.Title())
+}
+
+// acceptBranchName validates the edited branch name and, if it is valid,
+// applies it to the create-branch option and moves on to browsing.
+func (m *CommitViewModel) acceptBranchName() {
+	name := strings.TrimSpace(m.branchInput.Value())
+	if err := m.cfg.ValidateBranchName(name); err != nil {
+		m.inputErr = err.Error()
+		return
+	}
+
+	m.inputErr = ""
+	m.customBranch = name
+	m.options = m.buildOptions()
+	m.viewport.SetContent(m.renderOptionsContent())
+	m.branchInput.Blur()
+	m.state = ViewStateBrowsing
+}
+
+// SetLastCommit offers amending the changes into the previous commit.
+// A nil commit (empty repository) offers nothing.
+func (m *CommitViewModel) SetLastCommit(last *usecase.LastCommit) {
+	m.lastCommit = last
+	m.options = m.buildOptions()
+	m.viewport.SetContent(m.renderOptionsContent())
+}
+
+// SetDiffSource lets the view show the diff of repoPath with "d".
+func (m *CommitViewModel) SetDiffSource(gitOps git.Operations, repoPath string) {
+	m.gitOps = gitOps
+	m.repoPath = repoPath
+}
+
+// ShowingDiff reports whether the diff viewer is open, where Esc closes the
+// viewer rather than leaving the commit view.
+func (m CommitViewModel) ShowingDiff() bool {
+	return m.state == ViewStateDiff
+}
+
+// openDiff shows the diff viewer, loading the unstaged diff in the background
+func (m *CommitViewModel) openDiff() tea.Cmd {
+	diffView := NewDiffViewModel(m.gitOps, m.repoPath, false, m.windowWidth-4, m.windowHeight-4)
+	m.diffView = &diffView
+	m.state = ViewStateDiff
+	return diffView.Init()
+}
+
+// buildFileSelection lists the changed files with their line counts, all checked
+func (m CommitViewModel) buildFileSelection() CheckboxGroup {
+	changes := m.repo.Changes()
+	labels := make([]string, len(changes))
+	checked := make([]bool, len(changes))
+	for i, change := range changes {
+		stats := fmt.Sprintf("+%d -%d", change.Additions, change.Deletions)
+		if change.IsBinary {
+			stats = "binary"
+		}
+		labels[i] = fmt.Sprintf("%s  %s", change.Path, stats)
+		checked[i] = true
+	}
+	return NewCheckboxGroup("", labels, checked)
+}
+
+// ChoosingFiles reports whether the file selection is open, where Esc returns
+// to the options rather than leaving the commit view.
+func (m CommitViewModel) ChoosingFiles() bool {
+	return m.state == ViewStateFiles
+}
+
+// toggleAllFiles checks every file, or unchecks them all when all are checked
+func (m *CommitViewModel) toggleAllFiles() {
+	all := m.selectedFileCount() == len(m.files.Items)
+	for i := range m.files.Items {
+		m.files.Items[i].Checked = !all
+	}
+}
+
+// selectedFileCount returns how many files are checked
+func (m CommitViewModel) selectedFileCount() int {
+	count := 0
+	for _, item := range m.files.Items {
+		if item.Checked {
+			count++
+		}
+	}
+	return count
+}
+
+// SelectedFiles returns the paths of the checked files, both sides of a
+// rename, or nil when every file is checked and the commit should include all
+// changes as usual.
+func (m CommitViewModel) SelectedFiles() []string {
+	if m.selectedFileCount() == len(m.files.Items) {
+		return nil
+	}
+
+	changes := m.repo.Changes()
+	var files []string
+	for i, item := range m.files.Items {
+		if item.Checked && i < len(changes) {
+			files = append(files, git.StatusPaths(changes[i].Path)...)
+		}
+	}
+	return files
+}
+
+// SetStagedOnly records that only the staged changes were analyzed, so the
+// commit leaves the rest unstaged
+func (m *CommitViewModel) SetStagedOnly(stagedOnly bool) {
+	m.stagedOnly = stagedOnly
+}
+
+// checklist lists what confirming will do with the selected option: creating
+// the edited branch, staging, committing and any auto-push.
+func (m CommitViewModel) checklist() []string {
+	option := m.options[m.selectedIndex]
+	req := usecase.ExecuteCommitRequest{
+		Action:     option.Action,
+		BranchName: strings.TrimSpace(m.branchInput.Value()),
+		StageAll:   !m.stagedOnly,
+		Files:      m.SelectedFiles(),
+		Push:       usecase.PushesAfterCommit(m.cfg.Git.AutoPush, option.Action, m.lastCommit),
+		PushMode:   m.cfg.Git.PushMode,
+	}
+
+	target := usecase.CommitTarget{
+		FileCount: m.selectedFileCount(),
+		NoCommits: m.lastCommit == nil,
+	}
+	if m.repo != nil {
+		target.Branch = m.repo.CurrentBranch()
+		if m.repo.HasRemote() {
+			target.Remote = m.repo.RemoteName()
+			if target.Remote == "" {
+				target.Remote = "origin"
+			}
+		}
+		if m.stagedOnly && req.Files == nil {
+			target.FileCount = 0
+			for _, change := range m.repo.Changes() {
+				if change.Staged {
+					target.FileCount++
+				}
+			}
+		}
+	}
+	if m.branchInfo != nil {
+		target.HasUpstream = m.branchInfo.Upstream() != ""
+		// Pushing the current branch follows the remote it tracks, like
+		// ExecOperations.Push; a new branch goes to the primary remote
+		remote, _, ok := strings.Cut(m.branchInfo.Upstream(), "/")
+		if ok && target.Remote != "" && req.Action != domain.ActionCreateBranch {
+			target.Remote = remote
+		}
+	}
+
+	return usecase.CommitChecklist(req, target)
+}
+
+// SetChangedSinceLast marks the files changed since the previous analysis.
+// With deltaOnly, the analysis covered just those files, so only they stay
+// selected for the commit.
+func (m *CommitViewModel) SetChangedSinceLast(paths []string, deltaOnly bool) {
+	m.changedSinceLast = paths
+
+	changed := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		changed[path] = true
+	}
+	for i, change := range m.repo.Changes() {
+		if i >= len(m.files.Items) {
+			break
+		}
+		if changed[change.Path] {
+			m.files.Items[i].Label += "  • changed since last analysis"
+		} else if deltaOnly {
+			m.files.Items[i].Checked = false
+		}
+	}
+}
+
+// SetSuggestedScope shows the conventional commit scope computed from the
+// changed paths alongside the message preview
+func (m *CommitViewModel) SetSuggestedScope(scope string) {
+	m.suggestedScope = scope
+}
+
+// SetHooks records the commit hooks that will run so the confirmation can mention them
+func (m *CommitViewModel) SetHooks(hooks []string) {
+	m.hooks = hooks
+}
+
+// enterConfirm switches to the confirmation modal, pre-filling the inputs
+// from the selected option and focusing the message input.
+func (m *CommitViewModel) enterConfirm() {
+	m.state = ViewStateConfirm
+	m.confirmationFocus = focusMessage
+	m.inputErr = ""
+
+	// Initialize inputs with current values
+	selectedOption := m.options[m.selectedIndex]
+
+	// Message, with the issue the branch is named after and the configured
+	// trailers at the end of the description
+	m.issueRef = m.issueReferenceLine(selectedOption)
+	m.trailers = m.cfg.CommitTrailers(m.commitBranch(selectedOption))
+	if selectedOption.Message != nil {
+		m.msgInput.SetValue(selectedOption.Message.Title())
+		m.bodyInput.SetValue(m.withFooters(selectedOption.Message.Body()))
+	} else {
+		m.msgInput.SetValue("")
+		m.bodyInput.SetValue(m.withFooters(""))
+	}
+
+	// Branch
+	if selectedOption.BranchName != "" {
+		m.branchInput.SetValue(selectedOption.BranchName)
+	} else {
+		m.branchInput.SetValue("")
+	}
+
+	m.applyConfirmFocus()
+}
+
+// commitBranch returns the branch the option commits to: the new branch for
+// options that create one, otherwise the current branch
+func (m CommitViewModel) commitBranch(option CommitOption) string {
+	if option.Action == domain.ActionCreateBranch {
+		return option.BranchName
+	}
+	if m.repo != nil {
+		return m.repo.CurrentBranch()
+	}
+	return ""
+}
+
+// directCommitCaution warns when option commits a large or cross-cutting
+// change straight to a long-lived branch (see domain.DirectCommitCaution)
+func (m CommitViewModel) directCommitCaution(option CommitOption) string {
+	if option.Action != domain.ActionCommitDirect {
+		return ""
+	}
+	return domain.DirectCommitCaution(m.branchInfo, m.repo)
+}
+
+// submoduleCaution explains that committing in a submodule leaves the parent
+// repository unchanged. It is "" outside submodules.
+func (m CommitViewModel) submoduleCaution() string {
+	if m.repo == nil || !m.repo.IsSubmodule() {
+		return ""
+	}
+	return fmt.Sprintf("This commits inside a submodule of %s. The parent repository keeps the old submodule commit until the update is committed there too.",
+		m.repo.Superproject())
+}
+
+// issueReferenceLine returns the line referencing the issue the commit's
+// branch is named after. It is "" when the branch names no issue or issue
+// references are disabled.
+func (m CommitViewModel) issueReferenceLine(option CommitOption) string {
+	rules := m.cfg.Commits.IssueReferences
+	if !rules.Enabled {
+		return ""
+	}
+	return rules.IssueReferenceLine(m.commitBranch(option))
+}
+
+// withFooters adds the issue reference and configured trailers to body
+func (m CommitViewModel) withFooters(body string) string {
+	return domain.AddTrailers(domain.AddIssueReference(body, m.issueRef), m.trailers)
+}
+
+// toggleIssueReference adds the branch's issue reference to the description,
+// or removes it if it is already there
+func (m *CommitViewModel) toggleIssueReference() {
+	body := m.bodyInput.Value()
+	if domain.HasIssueReference(body, m.issueRef) {
+		m.bodyInput.SetValue(domain.RemoveIssueReference(body, m.issueRef))
+	} else {
+		m.bodyInput.SetValue(domain.AddIssueReference(body, m.issueRef))
+	}
+}
+
+// confirmFocusOrder lists the focus targets Tab moves through; the branch
+// name only appears when the selected option creates a branch.
+func (m CommitViewModel) confirmFocusOrder() []int {
+	order := []int{focusMessage, focusBody}
+	if m.options[m.selectedIndex].Action == domain.ActionCreateBranch {
+		order = append(order, focusBranch)
+	}
+	return append(order, focusConfirm, focusCancel)
+}
+
+// moveConfirmFocus moves focus delta steps through confirmFocusOrder. With
+// wrap false it stops at the last target instead of cycling to the first.
+func (m *CommitViewModel) moveConfirmFocus(delta int, wrap bool) {
+	order := m.confirmFocusOrder()
+	pos := 0
+	for i, focus := range order {
+		if focus == m.confirmationFocus {
+			pos = i
+		}
+	}
+
+	pos += delta
+	switch {
+	case wrap:
+		pos = (pos%len(order) + len(order)) % len(order)
+	case pos >= len(order):
+		pos = len(order) - 1
+	case pos < 0:
+		pos = 0
+	}
+
+	m.confirmationFocus = order[pos]
+	m.applyConfirmFocus()
+}
+
+// applyConfirmFocus focuses the input matching confirmationFocus and blurs the others
+func (m *CommitViewModel) applyConfirmFocus() {
+	m.msgInput.Blur()
+	m.bodyInput.Blur()
+	m.branchInput.Blur()
+
+	switch m.confirmationFocus {
+	case focusMessage:
+		m.msgInput.Focus()
+	case focusBody:
+		m.bodyInput.Focus()
+	case focusBranch:
+		m.branchInput.Focus()
+	}
+}
+
+// canCycleCandidates reports whether left/right switch between the AI's
+// phrasings: the message input is focused and neither it nor the description
+// has been edited, so the arrows are not needed to move the cursor.
+func (m CommitViewModel) canCycleCandidates() bool {
+	if m.confirmationFocus != focusMessage || len(m.decision.Candidates()) < 2 {
+		return false
+	}
+	msg := m.options[m.selectedIndex].Message
+	return msg != nil && m.msgInput.Value() == msg.Title() &&
+		strings.TrimSpace(m.bodyInput.Value()) == m.withFooters(msg.Body())
+}
+
+// cycleCandidate switches to the next (delta 1) or previous (delta -1) phrasing
+func (m *CommitViewModel) cycleCandidate(delta int) {
+	candidates := m.decision.Candidates()
+	n := len(candidates)
+	m.candidateIndex = ((m.candidateIndex+delta)%n + n) % n
+	m.customMessage = ""
+	m.customBody = ""
+	m.inputErr = ""
+
+	m.options = m.buildOptions()
+	m.viewport.SetContent(m.renderOptionsContent())
+	m.msgInput.SetValue(candidates[m.candidateIndex].Title())
+	m.msgInput.CursorEnd()
+	m.bodyInput.SetValue(m.withFooters(candidates[m.candidateIndex].Body()))
+}
+
+// effectiveMessage returns the message a commit would use right now: the
+// edited subject and description while the confirmation modal is open,
+// otherwise the selected option's message.
+func (m CommitViewModel) effectiveMessage() string {
+	selectedOption := m.options[m.selectedIndex]
+
+	title := ""
+	body := ""
+	if selectedOption.Message != nil {
+		title = selectedOption.Message.Title()
+		body = selectedOption.Message.Body()
+	}
+	if m.state == ViewStateConfirm {
+		if edited := strings.TrimSpace(m.msgInput.Value()); edited != "" {
+			title = edited
+		}
+		body = strings.TrimSpace(m.bodyInput.Value())
+	}
+
+	if title == "" {
+		return ""
+	}
+	if body == "" {
+		return title
+	}
+	return title + "\n\n" + body
+}
+
+// copyMessage copies the effective message to the clipboard
+func (m *CommitViewModel) copyMessage() tea.Cmd {
+	message := m.effectiveMessage()
+	if message == "" {
+		return m.setStatus("Nothing to copy: write a commit message first", true)
+	}
+	return copyCmd(message)
+}
+
+// setStatus shows a transient status and schedules it to clear
+func (m *CommitViewModel) setStatus(status string, isError bool) tea.Cmd {
+	m.status = status
+	m.statusError = isError
+	m.statusID++
+	id := m.statusID
+	return tea.Tick(statusDuration, func(time.Time) tea.Msg {
+		return commitStatusClearMsg{id: id}
+	})
+}
+
 func (m *CommitViewModel) buildOptions() []CommitOption {
 	options := []CommitOption{}
 
+	// The chosen phrasing, when the AI offered several
+	suggested := m.decision.SuggestedMessage()
+	if candidates := m.decision.Candidates(); m.candidateIndex < len(candidates) {
+		suggested = candidates[m.candidateIndex]
+	}
+
 	// Determine effective message and branch
 	var msg *domain.CommitMessage
-	if m.customMessage != "" {
+	if m.customMessage != "" && (suggested == nil || m.customMessage != suggested.Title() || strings.TrimSpace(m.customBody) != suggested.Body()) {
 		// Create a new message from custom input
 		// We ignore error here as the input is already constrained by the text input model if needed
 		// or we just accept it. NewCommitMessage handles truncation.
@@ -129,10 +696,13 @@ func (m *CommitViewModel) buildOptions() []CommitOption {
 		msg, err = domain.NewCommitMessage(m.customMessage)
 		if err != nil {
 			// If validation fails (e.g. empty), fallback to suggested
-			msg = m.decision.SuggestedMessage()
+			msg = suggested
+		} else {
+			msg.SetBody(m.customBody)
 		}
 	} else {
-		msg = m.decision.SuggestedMessage()
+		// Unedited: keep the suggestion, body included
+		msg = suggested
 	}
 	
 	branchName := m.decision.BranchName()
@@ -163,6 +733,20 @@ func (m *CommitViewModel) buildOptions() []CommitOption {
 		options = append(options, option)
 	}
 
+	// Amend the previous commit instead of creating a new one
+	if m.lastCommit != nil {
+		description := fmt.Sprintf("Add these changes to the previous commit (%s \"%s\") and replace its message.", m.lastCommit.Hash, m.lastCommit.Message)
+		if m.lastCommit.Pushed {
+			description += " ⚠ " + confirmationFor(ConfirmAmendPushed, m.lastCommit.Hash).Consequence
+		}
+		options = append(options, CommitOption{
+			Action:      domain.ActionAmend,
+			Label:       fmt.Sprintf("Amend previous commit (%s)", m.lastCommit.Hash),
+			Description: description,
+			Message:     msg,
+		})
+	}
+
 	return options
 }
 
@@ -229,74 +813,165 @@ func (m CommitViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.viewport.Width = viewportWidth
 		m.viewport.Height = viewportHeight
 
+		if m.diffView != nil {
+			*m.diffView, _ = m.diffView.Update(tea.WindowSizeMsg{Width: msg.Width - 4, Height: msg.Height - 4})
+		}
+
 		return m, nil
 
+	case clipboardCopiedMsg:
+		if msg.err != nil {
+			return m, m.setStatus(fmt.Sprintf("Copy failed: %v", msg.err), true)
+		}
+		return m, m.setStatus("✓ Message copied to clipboard", false)
+
+	case commitStatusClearMsg:
+		if msg.id == m.statusID {
+			m.status = ""
+		}
+		return m, nil
+
+	case diffLoadedMsg:
+		if m.diffView != nil {
+			*m.diffView, cmd = m.diffView.Update(msg)
+		}
+		return m, cmd
+
 	case tea.KeyMsg:
-		// Handle confirmation state
-		if m.state == ViewStateConfirm {
+		// Handle the diff viewer
+		if m.state == ViewStateDiff {
+			if msg.String() == "esc" || msg.String() == "q" {
+				m.state = ViewStateBrowsing
+				return m, nil
+			}
+			*m.diffView, cmd = m.diffView.Update(msg)
+			return m, cmd
+		}
+
+		// Handle the file selection
+		if m.state == ViewStateFiles {
 			switch msg.String() {
-			case "tab":
-				// Cycle focus
-				// 0: Msg, 1: Branch (if visible), 2: Confirm, 3: Cancel
-				m.confirmationFocus++
-				
-				// Skip branch input if not creating branch
-				selectedOption := m.options[m.selectedIndex]
-				if m.confirmationFocus == 1 && selectedOption.Action != domain.ActionCreateBranch {
-					m.confirmationFocus++
+			case "up", "k":
+				if len(m.files.Items) > 0 {
+					m.files.Previous()
 				}
-				
-				if m.confirmationFocus > 3 {
-					m.confirmationFocus = 0
+			case "down", "j":
+				if len(m.files.Items) > 0 {
+					m.files.Next()
 				}
-				
-				// Update focus state of inputs
-				switch m.confirmationFocus {
-				case 0:
-					m.msgInput.Focus()
-					m.branchInput.Blur()
-				case 1:
-					m.msgInput.Blur()
-					m.branchInput.Focus()
-				default:
-					m.msgInput.Blur()
-					m.branchInput.Blur()
-				}
-				return m, textinput.Blink
+			case " ":
+				m.files.Toggle()
+			case "a":
+				m.toggleAllFiles()
+			case "enter", "esc":
+				m.state = ViewStateBrowsing
+			}
+			return m, nil
+		}
 
-			case "shift+tab":
-				m.confirmationFocus--
+		// Handle the branch name step
+		if m.state == ViewStateBranchName {
+			switch msg.String() {
+			case "enter":
+				m.acceptBranchName()
+				return m, nil
 
-				// Skip branch input if not creating branch
-				selectedOption := m.options[m.selectedIndex]
-				if m.confirmationFocus == 1 && selectedOption.Action != domain.ActionCreateBranch {
-					m.confirmationFocus--
-				}
+			case "ctrl+r":
+				// Regenerate from the message, following the naming pattern
+				m.branchInput.SetValue(m.generateBranchName())
+				m.branchInput.CursorEnd()
+				m.inputErr = ""
+				return m, nil
+
+			case "esc":
+				// Keep the suggested name and continue to the options
+				m.branchInput.Blur()
+				m.inputErr = ""
+				m.state = ViewStateBrowsing
+				return m, nil
+			}
+
+			m.branchInput, cmd = m.branchInput.Update(msg)
+			return m, cmd
+		}
+
+		// Handle confirmation state
+		if m.state == ViewStateConfirm {
+			switch msg.String() {
+			case "ctrl+y":
+				// "y" is typed into the inputs here, so copying uses ctrl+y
+				return m, m.copyMessage()
 
-				if m.confirmationFocus < 0 {
-					m.confirmationFocus = 3
+			case "ctrl+r":
+				if m.issueRef != "" {
+					m.toggleIssueReference()
+					return m, nil
 				}
 
-				// Update focus state of inputs
-				switch m.confirmationFocus {
-				case 0:
-					m.msgInput.Focus()
-					m.branchInput.Blur()
-				case 1:
-					m.msgInput.Blur()
-					m.branchInput.Focus()
-				default:
-					m.msgInput.Blur()
-					m.branchInput.Blur()
+			case "left", "right":
+				if m.canCycleCandidates() {
+					delta := 1
+					if msg.String() == "left" {
+						delta = -1
+					}
+					m.cycleCandidate(delta)
+					return m, nil
 				}
+
+			case "tab":
+				m.moveConfirmFocus(1, true)
+				return m, textinput.Blink
+
+			case "shift+tab":
+				m.moveConfirmFocus(-1, true)
 				return m, textinput.Blink
 
 			case "enter":
 				switch m.confirmationFocus {
-				case 2: // Confirm button
+				case focusBody:
+					// The description is multi-line: enter starts a new line
+					m.bodyInput, cmd = m.bodyInput.Update(msg)
+					return m, cmd
+				case focusConfirm:
+					if !m.isActionable(m.selectedIndex) {
+						m.inputErr = "Alternatives are for information only"
+						return m, nil
+					}
+					// A message is required (there is no suggestion to fall back to without AI)
+					if strings.TrimSpace(m.msgInput.Value()) == "" && m.decision.SuggestedMessage() == nil {
+						m.inputErr = "Commit message cannot be empty"
+						m.confirmationFocus = focusMessage
+						m.applyConfirmFocus()
+						return m, textinput.Blink
+					}
+					if m.checkConvention {
+						subject, _, _ := strings.Cut(m.effectiveMessage(), "\n")
+						if err := m.cfg.ValidateCommitSubject(subject); err != nil {
+							m.inputErr = err.Error()
+							m.confirmationFocus = focusMessage
+							m.applyConfirmFocus()
+							return m, textinput.Blink
+						}
+					}
+					selectedOption := m.options[m.selectedIndex]
+					if selectedOption.Action == domain.ActionCreateBranch {
+						if err := m.cfg.ValidateBranchName(strings.TrimSpace(m.branchInput.Value())); err != nil {
+							m.inputErr = err.Error()
+							m.confirmationFocus = focusBranch
+							m.applyConfirmFocus()
+							return m, textinput.Blink
+						}
+					}
+					if len(m.files.Items) > 0 && m.selectedFileCount() == 0 {
+						m.inputErr = "No files selected; press Esc and f to choose files"
+						return m, nil
+					}
+					m.inputErr = ""
+
 					// Save values
 					m.customMessage = m.msgInput.Value()
-					m.customBranch = m.branchInput.Value()
+					m.customBody = m.bodyInput.Value()
+					m.customBranch = strings.TrimSpace(m.branchInput.Value())
 
 					// Rebuild options to reflect changes
 					m.options = m.buildOptions()
@@ -305,45 +980,19 @@ func (m CommitViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.hasDecision = true
 					m.confirmed = true
 					return m, nil
-				case 3: // Cancel button
+				case focusCancel:
 					m.state = ViewStateBrowsing
-					m.msgInput.Blur()
-					m.branchInput.Blur()
+					m.applyConfirmFocus()
 					return m, nil
 				}
-				// If on input, maybe move to next field?
-				// For now, let's just treat enter as confirm if not on cancel
-				// Or better, let enter on input just be enter (newline?) or move focus
-				// Since these are single line inputs, enter usually submits
-				// Let's make Enter on inputs move to next field
-				m.confirmationFocus++
-				selectedOption := m.options[m.selectedIndex]
-				if m.confirmationFocus == 1 && selectedOption.Action != domain.ActionCreateBranch {
-					m.confirmationFocus++
-				}
-				if m.confirmationFocus > 3 {
-					m.confirmationFocus = 0 // Loop back or stop at confirm?
-					// Let's stop at confirm (2)
-					m.confirmationFocus = 2
-				}
-				
-				// Update focus
-				switch m.confirmationFocus {
-				case 0:
-					m.msgInput.Focus()
-					m.branchInput.Blur()
-				case 1:
-					m.msgInput.Blur()
-					m.branchInput.Focus()
-				default:
-					m.msgInput.Blur()
-					m.branchInput.Blur()
-				}
+				// Enter on a single-line input moves to the next field
+				m.moveConfirmFocus(1, false)
 				return m, nil
 
 			case "esc":
 				m.state = ViewStateBrowsing
 				m.msgInput.Blur()
+				m.bodyInput.Blur()
 				m.branchInput.Blur()
 				return m, nil
 			}
@@ -351,10 +1000,13 @@ func (m CommitViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Pass messages to inputs
 			var cmd tea.Cmd
 			switch m.confirmationFocus {
-			case 0:
+			case focusMessage:
 				m.msgInput, cmd = m.msgInput.Update(msg)
 				return m, cmd
-			case 1:
+			case focusBody:
+				m.bodyInput, cmd = m.bodyInput.Update(msg)
+				return m, cmd
+			case focusBranch:
 				m.branchInput, cmd = m.branchInput.Update(msg)
 				return m, cmd
 			}
@@ -378,29 +1030,30 @@ func (m CommitViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 		case "enter":
+			if !m.isActionable(m.selectedIndex) {
+				return m, m.setStatus("Alternatives are for information only; select the primary action to proceed", true)
+			}
 			// Transition to confirmation state
-			m.state = ViewStateConfirm
-			m.confirmationFocus = 0 // Start at message
-			
-			// Initialize inputs with current values
-			selectedOption := m.options[m.selectedIndex]
-			
-			// Message
-			if selectedOption.Message != nil {
-				m.msgInput.SetValue(selectedOption.Message.Title())
-			} else {
-				m.msgInput.SetValue("")
+			m.enterConfirm()
+			return m, textinput.Blink
+
+		case "y":
+			return m, m.copyMessage()
+
+		case "d":
+			if m.gitOps != nil {
+				return m, m.openDiff()
 			}
-			
-			// Branch
-			if selectedOption.BranchName != "" {
-				m.branchInput.SetValue(selectedOption.BranchName)
-			} else {
-				m.branchInput.SetValue("")
+
+		case "e":
+			m.reasoningExpanded = !m.reasoningExpanded
+			return m, nil
+
+		case "f":
+			if len(m.files.Items) > 0 {
+				m.state = ViewStateFiles
 			}
-			
-			m.msgInput.Focus()
-			return m, textinput.Blink
+			return m, nil
 		}
 	}
 
@@ -430,13 +1083,14 @@ func (m CommitViewModel) View() string {
 	if m.state == ViewStateConfirm {
 		return m.renderConfirmationModal()
 	}
-
-	// Layout Dimensions
-	headerHeight := 8 // Logo (6) + Info (1) + Padding (1)
-	footerHeight := 2
-	contentHeight := m.windowHeight - headerHeight - footerHeight
-	if contentHeight < 10 {
-		contentHeight = 10
+	if m.state == ViewStateBranchName {
+		return m.renderBranchNameStep()
+	}
+	if m.state == ViewStateDiff {
+		return m.renderDiff()
+	}
+	if m.state == ViewStateFiles {
+		return m.renderFileSelection()
 	}
 
 	// 1. Header Section (Logo + Repo Info)
@@ -444,6 +1098,15 @@ func (m CommitViewModel) View() string {
 	repoInfo := m.renderRepoInfoCompact()
 	header := lipgloss.JoinVertical(lipgloss.Left, logo, repoInfo)
 
+	// Footer
+	footer := m.renderFooter()
+
+	// Layout Dimensions: the panes get what the header, spacer and footer leave
+	contentHeight := m.windowHeight - lipgloss.Height(header) - 1 - lipgloss.Height(footer)
+	if contentHeight < 10 {
+		contentHeight = 10
+	}
+
 	// 2. Main Content (Split View)
 	// Left: Options Menu (30%)
 	// Right: Details & Context (70%)
@@ -482,9 +1145,6 @@ func (m CommitViewModel) View() string {
 
 	// Wrap main content in a card/box if desired, or just keep it clean
 	// The user wants "compact", so minimal borders is better.
-	
-	// Footer
-	footer := m.renderFooter()
 
 	return lipgloss.JoinVertical(lipgloss.Left,
 		header,
@@ -519,6 +1179,9 @@ func (m CommitViewModel) renderOptionList(width int) string {
 		isSelected := i == m.selectedIndex
 		
 		label := fmt.Sprintf("%d. %s", i+1, option.Label)
+		if !m.isActionable(i) {
+			label += " (info only)"
+		}
 		
 		var style lipgloss.Style
 		if isSelected {
@@ -546,8 +1209,35 @@ func (m CommitViewModel) renderDetailsPane(width, height int) string {
 	title := styles.SectionTitle.Render("DETAILS")
 	sections = append(sections, title)
 	
-	desc := wrapText(selectedOption.Description, width)
-	sections = append(sections, styles.Description.Render(desc))
+	// Long reasoning is capped so the context below stays in view
+	descLines := strings.Split(wrapText(selectedOption.Description, width), "\n")
+	limit := collapsedReasoningLines
+	if m.reasoningExpanded {
+		limit = height - detailsContextLines
+	}
+	if limit < collapsedReasoningLines {
+		limit = collapsedReasoningLines
+	}
+	hint := ""
+	if len(descLines) > limit {
+		descLines = descLines[:limit]
+		hint = "… e to show more"
+		if m.reasoningExpanded {
+			hint = "… e to show less"
+		}
+	} else if m.reasoningExpanded && len(descLines) > collapsedReasoningLines {
+		hint = "e to show less"
+	}
+	sections = append(sections, styles.Description.Render(strings.Join(descLines, "\n")))
+	if hint != "" {
+		sections = append(sections, styles.Metadata.Render(hint))
+	}
+	if caution := m.directCommitCaution(selectedOption); caution != "" {
+		sections = append(sections, styles.StatusWarning.Render(wrapText("⚠ "+caution, width)))
+	}
+	if caution := m.submoduleCaution(); caution != "" {
+		sections = append(sections, styles.StatusWarning.Render(wrapText("⚠ "+caution, width)))
+	}
 	
 	sections = append(sections, "")
 	sections = append(sections, styles.SectionTitle.Render("CONTEXT"))
@@ -557,6 +1247,9 @@ func (m CommitViewModel) renderDetailsPane(width, height int) string {
 		msgBox := styles.CommitBox.Width(width).Render(
 			wrapText(selectedOption.Message.Title(), width-4))
 		sections = append(sections, msgBox)
+		if m.suggestedScope != "" {
+			sections = append(sections, styles.Metadata.Render(fmt.Sprintf("Scope from changed paths: %s", m.suggestedScope)))
+		}
 	}
 	
 	// 3. Branch Info (if applicable)
@@ -569,7 +1262,11 @@ func (m CommitViewModel) renderDetailsPane(width, height int) string {
 	conf := fmt.Sprintf("AI Confidence: %.0f%%", selectedOption.Confidence*100)
 	sections = append(sections, styles.Metadata.Render(conf))
 
-	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+	// Never grow past the pane, whatever the model wrote
+	return lipgloss.NewStyle().
+		MaxWidth(width).
+		MaxHeight(height).
+		Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
 }
 
 func (m CommitViewModel) renderConfirmationModal() string {
@@ -591,7 +1288,7 @@ func (m CommitViewModel) renderConfirmationModal() string {
 	// Message Input
 	msgLabel := styles.FormLabel.Render("Commit Message:")
 	var msgInput string
-	if m.confirmationFocus == 0 {
+	if m.confirmationFocus == focusMessage {
 		// Highlight the input if focused
 		// We can't easily style the internal text of textinput.View() without rebuilding it
 		// But textinput handles its own styling.
@@ -607,12 +1304,62 @@ func (m CommitViewModel) renderConfirmationModal() string {
 		msgInput = styles.FormInput.Render(m.msgInput.View())
 	}
 
+	// Alternative phrasings from the AI
+	if m.canCycleCandidates() {
+		msgInput = lipgloss.JoinVertical(lipgloss.Left,
+			msgInput,
+			styles.Metadata.Render(fmt.Sprintf("Phrasing %d of %d  •  ←/→ to switch", m.candidateIndex+1, len(m.decision.Candidates()))),
+		)
+	}
+
+	// Body Input
+	bodyLabel := styles.FormLabel.Render("Description:")
+	bodyInput := styles.FormInput.Render(m.bodyInput.View())
+	if m.confirmationFocus == focusBody {
+		bodyInput = styles.FormInputFocused.Render(m.bodyInput.View())
+	}
+	bodySection := lipgloss.JoinVertical(lipgloss.Left, "", bodyLabel, bodyInput)
+	if m.issueRef != "" {
+		verb := "remove"
+		if !domain.HasIssueReference(m.bodyInput.Value(), m.issueRef) {
+			verb = "add"
+		}
+		bodySection = lipgloss.JoinVertical(lipgloss.Left,
+			bodySection,
+			styles.Metadata.Render(fmt.Sprintf("%s from the branch name  •  Ctrl+R to %s", m.issueRef, verb)),
+		)
+	}
+
+	// Amending a pushed commit rewrites history
+	if selectedOption.Action == domain.ActionAmend && m.lastCommit != nil && m.lastCommit.Pushed {
+		actionDesc = lipgloss.JoinVertical(lipgloss.Left,
+			actionDesc,
+			styles.StatusWarning.Render(wrapText("⚠ "+confirmationFor(ConfirmAmendPushed, m.lastCommit.Hash).Consequence, 60)),
+		)
+	}
+
+	// A large or cross-cutting commit to a long-lived branch may be a mistake
+	if caution := m.directCommitCaution(selectedOption); caution != "" {
+		actionDesc = lipgloss.JoinVertical(lipgloss.Left,
+			actionDesc,
+			styles.StatusWarning.Render(wrapText("⚠ "+caution, 60)),
+		)
+	}
+
+	// Commits in a submodule don't reach the parent repository on their own
+	if caution := m.submoduleCaution(); caution != "" {
+		actionDesc = lipgloss.JoinVertical(lipgloss.Left,
+			actionDesc,
+			styles.StatusWarning.Render(wrapText("⚠ "+caution, 60)),
+		)
+	}
+
 	// Branch Input (only if creating branch)
 	var branchSection string
 	if selectedOption.Action == domain.ActionCreateBranch {
 		branchLabel := styles.FormLabel.Render("Branch Name:")
 		branchView := m.branchInput.View()
-		if m.confirmationFocus == 1 {
+		if m.confirmationFocus == focusBranch {
 			branchView = styles.FormInputFocused.Render(branchView)
 		} else {
 			branchView = styles.FormInput.Render(branchView)
@@ -641,10 +1388,10 @@ func (m CommitViewModel) renderConfirmationModal() string {
 	cancelBtn := "Cancel"
 
 	switch m.confirmationFocus {
-	case 2:
+	case focusConfirm:
 		confirmBtn = buttonActiveStyle.Render(confirmBtn)
 		cancelBtn = buttonStyle.Render(cancelBtn)
-	case 3:
+	case focusCancel:
 		confirmBtn = buttonStyle.Render(confirmBtn)
 		cancelBtn = buttonActiveStyle.Render(cancelBtn)
 	default:
@@ -654,10 +1401,40 @@ func (m CommitViewModel) renderConfirmationModal() string {
 
 	buttons := lipgloss.JoinHorizontal(lipgloss.Left, confirmBtn, cancelBtn)
 
+	// Inline validation error, or the transient status
+	var errLine string
+	if m.inputErr != "" {
+		errLine = styles.StatusError.Render(m.inputErr)
+	} else if m.status != "" {
+		errLine = m.renderStatus()
+	}
+
+	// Hooks note, so slow or failing hooks aren't a surprise
+	var hooksLine string
+	if len(m.hooks) > 0 {
+		hooksLine = styles.Metadata.Render("Hooks will run: " + strings.Join(m.hooks, ", "))
+	}
+
+	// What confirming will do, so an unexpected branch or push is caught first
+	var checklistSection string
+	if steps := m.checklist(); len(steps) > 0 {
+		lines := []string{"", styles.FormLabel.Render("This will:")}
+		for i, step := range steps {
+			lines = append(lines, styles.Metadata.Render(wrapText(fmt.Sprintf("%d. %s", i+1, step), 60)))
+		}
+		checklistSection = lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
+
+	// Partial selection note; unchecked files stay unstaged
+	var filesLine string
+	if selected := m.selectedFileCount(); selected < len(m.files.Items) {
+		filesLine = styles.StatusWarning.Render(fmt.Sprintf("Committing %d of %d files; the rest stay unstaged", selected, len(m.files.Items)))
+	}
+
 	// Help text
 	helpText := lipgloss.NewStyle().
 		Foreground(styles.ColorMuted).
-		Render("Tab to navigate  •  Enter to confirm/next  •  Esc to cancel")
+		Render("Tab to navigate  •  Enter to confirm/next (new line in description)  •  Ctrl+Y to copy  •  Esc to cancel")
 
 	// Combine all elements
 	content := lipgloss.JoinVertical(
@@ -668,10 +1445,15 @@ func (m CommitViewModel) renderConfirmationModal() string {
 		"",
 		msgLabel,
 		msgInput,
+		bodySection,
 		branchSection,
+		checklistSection,
+		errLine,
 		"",
 		buttons,
 		"",
+		filesLine,
+		hooksLine,
 		helpText,
 	)
 
@@ -691,6 +1473,60 @@ func (m CommitViewModel) renderConfirmationModal() string {
 	)
 }
 
+// renderBranchNameStep renders the branch name review shown right after analysis
+func (m CommitViewModel) renderBranchNameStep() string {
+	styles := GetGlobalThemeManager().GetStyles()
+
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(styles.ColorText).
+		Render("Name the New Branch")
+
+	reasoning := styles.Metadata.Render(wrapText(m.decision.Reasoning(), 60))
+
+	branchLabel := styles.FormLabel.Render("Branch Name:")
+	branchView := styles.FormInputFocused.Render(m.branchInput.View())
+
+	rules := styles.Metadata.Render(wrapText(m.cfg.NamingRules(), 60))
+
+	var errLine string
+	if m.inputErr != "" {
+		errLine = styles.StatusError.Render(m.inputErr)
+	}
+
+	helpText := lipgloss.NewStyle().
+		Foreground(styles.ColorMuted).
+		Render("Enter to accept  •  Ctrl+R to regenerate  •  Esc to keep suggestion")
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		"",
+		reasoning,
+		"",
+		branchLabel,
+		branchView,
+		rules,
+		errLine,
+		"",
+		helpText,
+	)
+
+	theme := GetGlobalThemeManager().GetCurrentTheme()
+	modalStyle := lipgloss.NewStyle().
+		Padding(2, 4).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorPrimary).
+		Background(lipgloss.Color(theme.Backgrounds.Confirmation)).
+		Width(70)
+
+	return lipgloss.Place(
+		m.windowWidth, m.windowHeight,
+		lipgloss.Center, lipgloss.Center,
+		modalStyle.Render(content),
+	)
+}
+
 func (m CommitViewModel) renderRepoInfoCompact() string {
 	styles := GetGlobalThemeManager().GetStyles()
 
@@ -701,10 +1537,14 @@ func (m CommitViewModel) renderRepoInfoCompact() string {
 
 	labelStyle := styles.RepoLabel
 
-	return fmt.Sprintf("%s %s  %s %s  %s %s",
+	info := fmt.Sprintf("%s %s  %s %s  %s %s",
 		labelStyle.Render("Path:"), path,
 		labelStyle.Render("Branch:"), branch,
 		labelStyle.Render("Changes:"), changes)
+	if len(m.changedSinceLast) > 0 {
+		info += "  " + styles.StatusWarning.Render(fmt.Sprintf("%d changed since last analysis (f: files)", len(m.changedSinceLast)))
+	}
+	return info
 }
 
 // renderOptionsContent returns just the options text for viewport
@@ -714,6 +1554,67 @@ func (m CommitViewModel) renderOptionsContent() string {
 
 
 
+// renderDiff renders the diff viewer with its shortcuts
+func (m CommitViewModel) renderDiff() string {
+	styles := GetGlobalThemeManager().GetStyles()
+
+	shortcuts := []string{
+		styles.ShortcutKey.Render("↑/↓") + " " + styles.ShortcutDesc.Render("Scroll"),
+		styles.ShortcutKey.Render("PgUp/PgDn") + " " + styles.ShortcutDesc.Render("Page"),
+		styles.ShortcutKey.Render("Esc") + " " + styles.ShortcutDesc.Render("Back to options"),
+	}
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(lipgloss.JoinVertical(lipgloss.Left,
+		m.diffView.View(),
+		"",
+		styles.Footer.Render(strings.Join(shortcuts, "  ")),
+	))
+}
+
+// renderFileSelection renders the changed files with checkboxes, scrolled to
+// keep the focused file visible
+func (m CommitViewModel) renderFileSelection() string {
+	styles := GetGlobalThemeManager().GetStyles()
+
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(styles.ColorText).
+		Render("Files to Commit")
+	summary := styles.Metadata.Render(fmt.Sprintf("%d of %d files selected", m.selectedFileCount(), len(m.files.Items)))
+
+	lines := strings.Split(m.files.View(), "\n")
+	visible := m.windowHeight - 10 // Title, summary, footer and padding
+	if visible < 5 {
+		visible = 5
+	}
+	if len(lines) > visible {
+		start := m.files.FocusedIdx - visible/2
+		if start < 0 {
+			start = 0
+		}
+		if start > len(lines)-visible {
+			start = len(lines) - visible
+		}
+		lines = lines[start : start+visible]
+	}
+
+	shortcuts := []string{
+		styles.ShortcutKey.Render("↑/↓") + " " + styles.ShortcutDesc.Render("Navigate"),
+		styles.ShortcutKey.Render("Space") + " " + styles.ShortcutDesc.Render("Toggle"),
+		styles.ShortcutKey.Render("a") + " " + styles.ShortcutDesc.Render("Select all/none"),
+		styles.ShortcutKey.Render("Enter/Esc") + " " + styles.ShortcutDesc.Render("Back to options"),
+	}
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(lipgloss.JoinVertical(lipgloss.Left,
+		title,
+		summary,
+		"",
+		strings.Join(lines, "\n"),
+		"",
+		styles.Footer.Render(strings.Join(shortcuts, "  ")),
+	))
+}
+
 func (m CommitViewModel) renderFooter() string {
 	styles := GetGlobalThemeManager().GetStyles()
 	var lines []string
@@ -722,9 +1623,20 @@ func (m CommitViewModel) renderFooter() string {
 	shortcuts := []string{
 		styles.ShortcutKey.Render("↑/↓") + " " + styles.ShortcutDesc.Render("Navigate"),
 		styles.ShortcutKey.Render("Enter") + " " + styles.ShortcutDesc.Render("Confirm"),
-		styles.ShortcutKey.Render("Esc") + " " + styles.ShortcutDesc.Render("Cancel"),
+		styles.ShortcutKey.Render("y") + " " + styles.ShortcutDesc.Render("Copy message"),
+		styles.ShortcutKey.Render("e") + " " + styles.ShortcutDesc.Render("Expand reasoning"),
+	}
+	if m.gitOps != nil {
+		shortcuts = append(shortcuts, styles.ShortcutKey.Render("d")+" "+styles.ShortcutDesc.Render("View diff"))
 	}
+	if len(m.files.Items) > 0 {
+		shortcuts = append(shortcuts, styles.ShortcutKey.Render("f")+" "+styles.ShortcutDesc.Render("Choose files"))
+	}
+	shortcuts = append(shortcuts, styles.ShortcutKey.Render("Esc")+" "+styles.ShortcutDesc.Render("Cancel"))
 	shortcutLine := strings.Join(shortcuts, "  ")
+	if m.status != "" {
+		shortcutLine += "  " + m.renderStatus()
+	}
 	lines = append(lines, shortcutLine)
 
 	// Metadata
@@ -735,6 +1647,15 @@ func (m CommitViewModel) renderFooter() string {
 	return styles.Footer.Render(strings.Join(lines, "\n"))
 }
 
+// renderStatus renders the transient status line
+func (m CommitViewModel) renderStatus() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	if m.statusError {
+		return styles.StatusError.Render(m.status)
+	}
+	return styles.StatusOk.Render(m.status)
+}
+
 // GetSelectedOption returns the currently selected option.
 func (m CommitViewModel) GetSelectedOption() *CommitOption {
 	if m.selectedIndex >= 0 && m.selectedIndex < len(m.options) {
@@ -754,8 +1675,24 @@ func (m CommitViewModel) HasDecision() bool {
 	return m.hasDecision
 }
 
+// WithdrawDecision returns the view to the decision it made, e.g. while
+// rewriting pushed history waits for a confirmation.
+func (m *CommitViewModel) WithdrawDecision() {
+	m.hasDecision = false
+}
+
+// PushedAmendTarget returns the hash of the pushed commit the selected option
+// would amend, or "" when it rewrites no pushed history.
+func (m CommitViewModel) PushedAmendTarget() string {
+	option := m.GetSelectedOption()
+	if option == nil || option.Action != domain.ActionAmend || m.lastCommit == nil || !m.lastCommit.Pushed {
+		return ""
+	}
+	return m.lastCommit.Hash
+}
+
 func wrapText(text string, width int) string {
-	if len(text) <= width {
+	if runewidth.StringWidth(text) <= width {
 		return text
 	}
 
@@ -771,7 +1708,7 @@ func wrapText(text string, width int) string {
 			testLine = word
 		}
 
-		if len(testLine) <= width {
+		if runewidth.StringWidth(testLine) <= width {
 			currentLine = testLine
 		} else {
 			if currentLine != "" {
@@ -0,0 +1,736 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+	"github.com/yourusername/gitman/internal/usecase"
+)
+
+func newTestCommitView(t *testing.T) *CommitViewModel {
+	t.Helper()
+
+	decision, err := domain.NewDecision(domain.ActionCommitDirect, 0.9, "small change")
+	if err != nil {
+		t.Fatalf("NewDecision() error = %v", err)
+	}
+	msg, err := domain.NewCommitMessage("Add login page")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() error = %v", err)
+	}
+	msg.SetBody("Adds the login form and session handling.")
+	decision.SetSuggestedMessage(msg)
+
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+
+	return NewCommitViewModel(repo, nil, decision, 100, "test-model", 120, 40)
+}
+
+// captureClipboard replaces the clipboard writer for the duration of the test
+func captureClipboard(t *testing.T, copyErr error) *string {
+	t.Helper()
+
+	var copied string
+	original := copyToClipboard
+	copyToClipboard = func(text string) error {
+		copied = text
+		return copyErr
+	}
+	t.Cleanup(func() { copyToClipboard = original })
+
+	return &copied
+}
+
+// TestCommitView_CopyUsesEffectiveMessage tests that "y" and ctrl+y copy the message that would be committed
+func TestCommitView_CopyUsesEffectiveMessage(t *testing.T) {
+	copied := captureClipboard(t, nil)
+	m := newTestCommitView(t)
+
+	// Browsing: the suggested message, subject and body
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if cmd == nil {
+		t.Fatal("Expected a copy command")
+	}
+	result := cmd()
+	want := "Add login page\n\nAdds the login form and session handling."
+	if *copied != want {
+		t.Errorf("copied = %q, want %q", *copied, want)
+	}
+
+	view := updated.(CommitViewModel)
+	updated, _ = view.Update(result)
+	view = updated.(CommitViewModel)
+	if !strings.Contains(view.status, "copied") {
+		t.Errorf("Expected copied status, got %q", view.status)
+	}
+
+	// Confirm modal: the edited subject replaces the suggestion
+	view.enterConfirm()
+	view.msgInput.SetValue("Add login page with remember-me")
+	_, cmd = view.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+	if cmd == nil {
+		t.Fatal("Expected a copy command in the confirm modal")
+	}
+	cmd()
+	want = "Add login page with remember-me\n\nAdds the login form and session handling."
+	if *copied != want {
+		t.Errorf("copied = %q, want %q", *copied, want)
+	}
+}
+
+// TestCommitView_CopyFailureShown tests that a clipboard error is surfaced
+func TestCommitView_CopyFailureShown(t *testing.T) {
+	captureClipboard(t, errors.New("no clipboard utility"))
+	m := newTestCommitView(t)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	updated, _ = updated.(CommitViewModel).Update(cmd())
+	view := updated.(CommitViewModel)
+
+	if !view.statusError || !strings.Contains(view.status, "no clipboard utility") {
+		t.Errorf("Expected copy failure status, got %q (error=%v)", view.status, view.statusError)
+	}
+}
+
+func newBranchCommitView(t *testing.T, cfg *domain.Config) *CommitViewModel {
+	t.Helper()
+
+	decision, err := domain.NewDecision(domain.ActionCreateBranch, 0.8, "new feature area")
+	if err != nil {
+		t.Fatalf("NewDecision() error = %v", err)
+	}
+	msg, err := domain.NewCommitMessage("feat(auth): Add OAuth login")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() error = %v", err)
+	}
+	decision.SetSuggestedMessage(msg)
+	decision.SetBranchName("oauth stuff")
+
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+
+	m := NewCommitViewModel(repo, nil, decision, 100, "test-model", 120, 40)
+	m.SetConfig(cfg)
+	return m
+}
+
+// TestCommitView_BranchNameStep tests reviewing, regenerating and accepting the suggested branch name
+func TestCommitView_BranchNameStep(t *testing.T) {
+	cfg := domain.NewDefaultConfig()
+	cfg.Naming.Enforce = true
+	m := newBranchCommitView(t, cfg)
+
+	if m.state != ViewStateBranchName {
+		t.Fatalf("state = %v, want the branch name step after a create-branch analysis", m.state)
+	}
+	if !strings.Contains(m.View(), "Allowed prefixes") {
+		t.Error("Expected the naming rules in the branch name step")
+	}
+
+	// The AI suggestion is not a valid branch name, so Enter is refused
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view := updated.(CommitViewModel)
+	if view.state != ViewStateBranchName || view.inputErr == "" {
+		t.Fatalf("Expected validation error, got state=%v err=%q", view.state, view.inputErr)
+	}
+
+	// Regenerate from the commit subject using the naming pattern
+	updated, _ = view.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	view = updated.(CommitViewModel)
+	if got := view.branchInput.Value(); got != "feature/add-oauth-login" {
+		t.Errorf("regenerated branch = %q, want %q", got, "feature/add-oauth-login")
+	}
+
+	// Edit it and accept
+	view.branchInput.SetValue("feature/oauth-login")
+	updated, _ = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view = updated.(CommitViewModel)
+	if view.state != ViewStateBrowsing {
+		t.Fatalf("state = %v, want browsing after accepting", view.state)
+	}
+	if got := view.GetSelectedOption().BranchName; got != "feature/oauth-login" {
+		t.Errorf("BranchName = %q, want the edited name", got)
+	}
+}
+
+// TestCommitView_InformationalAlternativesCannotBeConfirmed tests that alternatives can't be executed when not actionable
+func TestCommitView_InformationalAlternativesCannotBeConfirmed(t *testing.T) {
+	m := newTestCommitView(t)
+	alt, err := domain.NewAlternative(domain.ActionCreateBranch, "Isolate the change", 0.4)
+	if err != nil {
+		t.Fatalf("NewAlternative() error = %v", err)
+	}
+	m.decision.AddAlternative(*alt)
+	m.options = m.buildOptions()
+
+	cfg := domain.NewDefaultConfig()
+	cfg.UI.AlternativesActionable = false
+	m.SetConfig(cfg)
+
+	// Select the alternative and try to confirm it
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	updated, _ = updated.(CommitViewModel).Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view := updated.(CommitViewModel)
+
+	if view.selectedIndex != 1 {
+		t.Fatalf("selectedIndex = %d, want the alternative to stay browsable", view.selectedIndex)
+	}
+	if view.state != ViewStateBrowsing || view.HasDecision() {
+		t.Errorf("Expected the alternative not to be confirmable, got state=%v decision=%v", view.state, view.HasDecision())
+	}
+	if !view.statusError {
+		t.Error("Expected a status explaining alternatives are informational")
+	}
+	if !strings.Contains(view.renderOptionList(80), "(info only)") {
+		t.Error("Expected alternatives to be marked as information only")
+	}
+
+	// The primary action still works
+	updated, _ = view.Update(tea.KeyMsg{Type: tea.KeyUp})
+	updated, _ = updated.(CommitViewModel).Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if updated.(CommitViewModel).state != ViewStateConfirm {
+		t.Error("Expected the primary action to open the confirmation")
+	}
+}
+
+// TestCommitView_NonConventionalSuggestionRoutesToEdit tests that an AI message failing the
+// commit convention opens the message for editing and is re-validated on confirm
+func TestCommitView_NonConventionalSuggestionRoutesToEdit(t *testing.T) {
+	m := newTestCommitView(t)
+	m.SetConfig(domain.NewDefaultConfig())
+	m.CheckConvention()
+
+	if m.state != ViewStateConfirm {
+		t.Fatalf("state = %v, want the edit step for a non-conventional message", m.state)
+	}
+	if got := m.msgInput.Value(); got != "Add login page" {
+		t.Errorf("message input = %q, want the AI attempt", got)
+	}
+	if !strings.Contains(m.inputErr, "must start with a type") {
+		t.Errorf("inputErr = %q, want the validation error", m.inputErr)
+	}
+
+	// Confirming the unchanged message is refused
+	m.confirmationFocus = 2
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view := updated.(CommitViewModel)
+	if view.HasDecision() || view.confirmationFocus != 0 || view.inputErr == "" {
+		t.Fatalf("Expected re-validation to refuse the message, got decision=%v focus=%d err=%q",
+			view.HasDecision(), view.confirmationFocus, view.inputErr)
+	}
+
+	// The fixed message is accepted
+	view.msgInput.SetValue("feat: add login page")
+	view.confirmationFocus = 2
+	updated, _ = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view = updated.(CommitViewModel)
+	if !view.HasDecision() {
+		t.Fatalf("Expected the fixed message to be confirmed, err=%q", view.inputErr)
+	}
+	if got := view.GetSelectedOption().Message.Title(); got != "feat: add login page" {
+		t.Errorf("Message = %q, want the fixed message", got)
+	}
+}
+
+// TestCommitView_NoConventionKeepsBrowsing tests that no edit step is forced without a convention
+func TestCommitView_NoConventionKeepsBrowsing(t *testing.T) {
+	m := newTestCommitView(t)
+	cfg := domain.NewDefaultConfig()
+	cfg.Commits.Convention = "none"
+	m.SetConfig(cfg)
+	m.CheckConvention()
+
+	if m.state != ViewStateBrowsing || m.inputErr != "" {
+		t.Errorf("Expected browsing without an error, got state=%v err=%q", m.state, m.inputErr)
+	}
+}
+
+// TestCommitView_AmendOption tests that amending is offered only with a previous commit and warns once it is pushed
+func TestCommitView_AmendOption(t *testing.T) {
+	m := newTestCommitView(t)
+	for _, option := range m.options {
+		if option.Action == domain.ActionAmend {
+			t.Fatal("Expected no amend option without a previous commit")
+		}
+	}
+
+	m.SetLastCommit(&usecase.LastCommit{Hash: "abc1234", Message: "Add login form"})
+	amend := m.options[len(m.options)-1]
+	if amend.Action != domain.ActionAmend || !strings.Contains(amend.Label, "abc1234") {
+		t.Fatalf("Last option = %+v, want the amend option", amend)
+	}
+	if strings.Contains(amend.Description, "rewrites") {
+		t.Error("Expected no history warning for an unpushed commit")
+	}
+
+	// Amending is the user's choice, so it stays actionable with informational alternatives
+	cfg := domain.NewDefaultConfig()
+	cfg.UI.AlternativesActionable = false
+	m.SetConfig(cfg)
+	if !m.isActionable(len(m.options) - 1) {
+		t.Error("Expected the amend option to be actionable")
+	}
+
+	m.SetLastCommit(&usecase.LastCommit{Hash: "abc1234", Message: "Add login form", Pushed: true})
+	m.selectedIndex = len(m.options) - 1
+	if !strings.Contains(m.options[m.selectedIndex].Description, "rewrites published history") {
+		t.Errorf("Description = %q, want a history rewrite warning", m.options[m.selectedIndex].Description)
+	}
+	m.enterConfirm()
+	if !strings.Contains(m.View(), "rewrites published history") {
+		t.Error("Expected the confirmation to warn that amending rewrites history")
+	}
+}
+
+// TestCommitView_CycleMessageCandidates tests that left/right switch between the AI's phrasings until the message is edited
+func TestCommitView_CycleMessageCandidates(t *testing.T) {
+	m := newTestCommitView(t)
+	alt, err := domain.NewCommitMessage("Introduce the login screen")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() error = %v", err)
+	}
+	alt.SetBody("With remember-me support.")
+	m.decision.AddCandidate(alt)
+
+	m.enterConfirm()
+	if !strings.Contains(m.View(), "Phrasing 1 of 2") {
+		t.Error("Expected the confirmation to offer the other phrasing")
+	}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	view := updated.(CommitViewModel)
+	if got := view.msgInput.Value(); got != "Introduce the login screen" {
+		t.Fatalf("message input = %q, want the second phrasing", got)
+	}
+
+	// Wraps around to the first
+	updated, _ = view.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if got := updated.(CommitViewModel).msgInput.Value(); got != "Add login page" {
+		t.Errorf("message input = %q, want the first phrasing again", got)
+	}
+
+	// Once edited, the arrows move the cursor instead
+	view.msgInput.SetValue("Introduce the login screen!")
+	updated, _ = view.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	if got := updated.(CommitViewModel).msgInput.Value(); got != "Introduce the login screen!" {
+		t.Errorf("message input = %q, want the edit kept", got)
+	}
+
+	// Confirming an unedited phrasing keeps its body
+	view.msgInput.SetValue("Introduce the login screen")
+	view.confirmationFocus = 2
+	updated, _ = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view = updated.(CommitViewModel)
+	if !view.HasDecision() {
+		t.Fatalf("Expected the phrasing to be confirmed, err=%q", view.inputErr)
+	}
+	if got := view.GetSelectedOption().Message.FullMessage(); got != alt.FullMessage() {
+		t.Errorf("Message = %q, want %q", got, alt.FullMessage())
+	}
+}
+
+// TestCommitView_EditDescription tests that the description is edited separately from the subject
+func TestCommitView_EditDescription(t *testing.T) {
+	m := newTestCommitView(t)
+	m.enterConfirm()
+
+	if got := m.bodyInput.Value(); got != "Adds the login form and session handling." {
+		t.Fatalf("description = %q, want the suggested body", got)
+	}
+
+	// Tab moves from the subject to the description, where enter starts a new line
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	view := updated.(CommitViewModel)
+	if view.confirmationFocus != focusBody {
+		t.Fatalf("confirmationFocus = %d, want the description", view.confirmationFocus)
+	}
+	view.bodyInput.CursorEnd()
+	updated, _ = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view = updated.(CommitViewModel)
+	updated, _ = view.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("- Remember me")})
+	view = updated.(CommitViewModel)
+	if view.HasDecision() {
+		t.Fatal("Expected enter in the description not to confirm")
+	}
+
+	view.confirmationFocus = focusConfirm
+	updated, _ = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view = updated.(CommitViewModel)
+	if !view.HasDecision() {
+		t.Fatalf("Expected the message to be confirmed, err=%q", view.inputErr)
+	}
+
+	msg := view.GetSelectedOption().Message
+	if msg.Title() != "Add login page" {
+		t.Errorf("Title() = %q, want the unchanged subject", msg.Title())
+	}
+	if want := "Adds the login form and session handling.\n- Remember me"; msg.Body() != want {
+		t.Errorf("Body() = %q, want %q", msg.Body(), want)
+	}
+}
+
+// TestCommitView_IssueReference tests that the issue named by the branch is added to the description and can be toggled
+func TestCommitView_IssueReference(t *testing.T) {
+	m := newTestCommitView(t)
+	m.repo.SetCurrentBranch("feature/123-login")
+	m.enterConfirm()
+
+	want := "Adds the login form and session handling.\n\nRefs: #123"
+	if got := m.bodyInput.Value(); got != want {
+		t.Fatalf("description = %q, want %q", got, want)
+	}
+	if view := m.renderConfirmationModal(); !strings.Contains(view, "Refs: #123 from the branch name") || !strings.Contains(view, "Ctrl+R to remove") {
+		t.Errorf("Expected the reference to be offered, got:\n%s", view)
+	}
+
+	ctrlR := tea.KeyMsg{Type: tea.KeyCtrlR}
+	updated, _ := m.Update(ctrlR)
+	view := updated.(CommitViewModel)
+	if got := view.bodyInput.Value(); got != "Adds the login form and session handling." {
+		t.Errorf("Expected ctrl+r to remove the reference, got %q", got)
+	}
+	updated, _ = view.Update(ctrlR)
+	view = updated.(CommitViewModel)
+	if got := view.bodyInput.Value(); got != want {
+		t.Errorf("Expected ctrl+r to add the reference back, got %q", got)
+	}
+
+	// Disabled in the config, nothing is added
+	m = newTestCommitView(t)
+	m.repo.SetCurrentBranch("feature/123-login")
+	cfg := domain.NewDefaultConfig()
+	cfg.Commits.IssueReferences.Enabled = false
+	m.SetConfig(cfg)
+	m.enterConfirm()
+	if got := m.bodyInput.Value(); got != "Adds the login form and session handling." {
+		t.Errorf("Expected no reference when disabled, got %q", got)
+	}
+}
+
+// TestCommitView_Trailers tests that configured trailers follow the issue reference in the description
+func TestCommitView_Trailers(t *testing.T) {
+	m := newTestCommitView(t)
+	m.repo.SetCurrentBranch("feature/123-login")
+	cfg := domain.NewDefaultConfig()
+	cfg.Commits.Trailers = []domain.Trailer{
+		{Key: "Reviewed-by", Value: "Ada <ada@example.com>"},
+		{Key: "Closes", Value: "{issue}"},
+	}
+	m.SetConfig(cfg)
+	m.enterConfirm()
+
+	want := "Adds the login form and session handling.\n\nRefs: #123\nReviewed-by: Ada <ada@example.com>\nCloses: #123"
+	if got := m.bodyInput.Value(); got != want {
+		t.Fatalf("description = %q, want %q", got, want)
+	}
+
+	// Removing the reference keeps the trailers
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	view := updated.(CommitViewModel)
+	if got := view.bodyInput.Value(); got != "Adds the login form and session handling.\n\nReviewed-by: Ada <ada@example.com>\nCloses: #123" {
+		t.Errorf("Expected only the reference removed, got %q", got)
+	}
+}
+
+// TestCommitView_ConfirmChecklist tests that the confirmation lists the branch, commit and auto-push it will do
+func TestCommitView_ConfirmChecklist(t *testing.T) {
+	decision, err := domain.NewDecision(domain.ActionCreateBranch, 0.8, "new feature area")
+	if err != nil {
+		t.Fatalf("NewDecision() error = %v", err)
+	}
+	msg, err := domain.NewCommitMessage("Add login page")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() error = %v", err)
+	}
+	decision.SetSuggestedMessage(msg)
+	decision.SetBranchName("feature/login")
+
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+	repo.SetCurrentBranch("main")
+	repo.SetHasRemote(true)
+	repo.SetRemoteName("origin")
+	repo.SetChanges([]domain.FileChange{
+		{Path: "login.go", Status: domain.StatusAdded},
+		{Path: "auth.go", Status: domain.StatusModified},
+		{Path: "routes.go", Status: domain.StatusModified},
+	})
+
+	cfg := domain.NewDefaultConfig()
+	cfg.Git.AutoPush = true
+	m := NewCommitViewModel(repo, nil, decision, 100, "test-model", 120, 40)
+	m.SetConfig(cfg)
+	m.SetLastCommit(&usecase.LastCommit{Hash: "abc1234", Message: "Initial commit"})
+	m.enterConfirm()
+
+	view := m.renderConfirmationModal()
+	for _, want := range []string{
+		"This will:",
+		"1. Create branch feature/login from main and switch to it",
+		"2. Stage all changes",
+		"3. Commit 3 files to feature/login",
+		"4. Push feature/login to origin with -u",
+	} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected the checklist to contain %q, got:\n%s", want, view)
+		}
+	}
+
+	// Without auto-push the checklist stops at the commit
+	cfg.Git.AutoPush = false
+	if steps := m.checklist(); len(steps) != 3 {
+		t.Errorf("Expected no push step with auto-push off, got %q", steps)
+	}
+}
+
+// TestCommitView_ChecklistTrackedRemote tests that the push step names the remote the branch tracks
+func TestCommitView_ChecklistTrackedRemote(t *testing.T) {
+	decision, err := domain.NewDecision(domain.ActionCommitDirect, 0.9, "small fix")
+	if err != nil {
+		t.Fatalf("NewDecision() error = %v", err)
+	}
+	msg, err := domain.NewCommitMessage("Fix typo")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() error = %v", err)
+	}
+	decision.SetSuggestedMessage(msg)
+
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+	repo.SetCurrentBranch("main")
+	repo.SetHasRemote(true)
+	repo.SetRemoteName("origin")
+	repo.SetChanges([]domain.FileChange{{Path: "README.md", Status: domain.StatusModified}})
+
+	branchInfo, err := domain.NewBranchInfo("main")
+	if err != nil {
+		t.Fatalf("NewBranchInfo() error = %v", err)
+	}
+	branchInfo.SetUpstream("fork/main")
+
+	cfg := domain.NewDefaultConfig()
+	cfg.Git.AutoPush = true
+	m := NewCommitViewModel(repo, branchInfo, decision, 100, "test-model", 120, 40)
+	m.SetConfig(cfg)
+	m.SetLastCommit(&usecase.LastCommit{Hash: "abc1234", Message: "Initial commit"})
+
+	steps := m.checklist()
+	if len(steps) == 0 || steps[len(steps)-1] != "Push main to fork" {
+		t.Errorf("Expected the push to go to the tracked fork, got %q", steps)
+	}
+}
+
+// TestCommitView_DirectCommitCaution tests the warning for a cross-cutting commit straight to main
+func TestCommitView_DirectCommitCaution(t *testing.T) {
+	decision, err := domain.NewDecision(domain.ActionCommitDirect, 0.7, "small fix")
+	if err != nil {
+		t.Fatalf("NewDecision() error = %v", err)
+	}
+	msg, err := domain.NewCommitMessage("Rework configuration loading")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() error = %v", err)
+	}
+	decision.SetSuggestedMessage(msg)
+	decision.AddAlternative(domain.Alternative{Action: domain.ActionCreateBranch, Description: "Isolate the rework", Confidence: 0.3})
+
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+	repo.SetCurrentBranch("main")
+	repo.SetChanges([]domain.FileChange{
+		{Path: "cmd/gm/main.go", Status: domain.StatusModified},
+		{Path: "internal/config/load.go", Status: domain.StatusModified},
+		{Path: "docs/config.md", Status: domain.StatusModified},
+		{Path: "scripts/migrate.sh", Status: domain.StatusAdded},
+	})
+	branchInfo, err := domain.NewBranchInfo("main")
+	if err != nil {
+		t.Fatalf("NewBranchInfo() error = %v", err)
+	}
+
+	m := NewCommitViewModel(repo, branchInfo, decision, 100, "test-model", 120, 40)
+	if caution := m.directCommitCaution(m.options[0]); !strings.Contains(caution, "across 4 areas") {
+		t.Fatalf("Expected a caution for the direct commit, got %q", caution)
+	}
+	if caution := m.directCommitCaution(m.options[1]); caution != "" {
+		t.Errorf("Expected no caution when creating a branch, got %q", caution)
+	}
+
+	// The caution doesn't block confirming the commit
+	m.enterConfirm()
+	if view := m.renderConfirmationModal(); !strings.Contains(view, "⚠ This commits changes across 4") {
+		t.Errorf("Expected the confirmation to warn about the direct commit, got:\n%s", view)
+	}
+}
+
+// diffGitOps returns a fixed working tree diff
+type diffGitOps struct {
+	git.Operations
+
+	diff string
+}
+
+func (f *diffGitOps) GetDiff(ctx context.Context, repoPath string, staged bool) (string, error) {
+	if staged {
+		return "", nil
+	}
+	return f.diff, nil
+}
+
+// TestCommitView_DiffViewer tests that "d" loads the diff in the background and Esc returns to the options
+func TestCommitView_DiffViewer(t *testing.T) {
+	m := newTestCommitView(t)
+	m.SetDiffSource(&diffGitOps{diff: "diff --git a/login.go b/login.go\n+func Login() {}\n-func OldLogin() {}"}, "/tmp/repo")
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	view := updated.(CommitViewModel)
+	if !view.ShowingDiff() || cmd == nil {
+		t.Fatalf("Expected the diff viewer to open and load the diff, showing=%v", view.ShowingDiff())
+	}
+	if !strings.Contains(view.View(), "Loading diff") {
+		t.Error("Expected a loading state until the diff arrives")
+	}
+
+	updated, _ = view.Update(cmd())
+	view = updated.(CommitViewModel)
+	rendered := view.View()
+	if !strings.Contains(rendered, "+func Login() {}") || !strings.Contains(rendered, "-func OldLogin() {}") {
+		t.Errorf("Expected the diff lines in the viewer, got:\n%s", rendered)
+	}
+
+	updated, _ = view.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	view = updated.(CommitViewModel)
+	if view.ShowingDiff() || view.state != ViewStateBrowsing {
+		t.Errorf("Expected Esc to return to the options, state=%v", view.state)
+	}
+}
+
+// TestCommitView_LongReasoningStaysBounded tests that verbose reasoning neither pushes the layout past the window nor hides the context
+func TestCommitView_LongReasoningStaysBounded(t *testing.T) {
+	reasoning := strings.TrimSpace(strings.Repeat("The change touches session handling and needs care. ", 40))
+	if len(reasoning) < 2000 {
+		t.Fatalf("reasoning is %d chars, want at least 2000", len(reasoning))
+	}
+	decision, err := domain.NewDecision(domain.ActionCommitDirect, 0.9, reasoning)
+	if err != nil {
+		t.Fatalf("NewDecision() error = %v", err)
+	}
+	msg, err := domain.NewCommitMessage("Add login page")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() error = %v", err)
+	}
+	decision.SetSuggestedMessage(msg)
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+	m := NewCommitViewModel(repo, nil, decision, 100, "test-model", 120, 40)
+
+	for _, expanded := range []bool{false, true} {
+		m.reasoningExpanded = expanded
+
+		view := m.View()
+		if height := lipgloss.Height(view); height > 40 {
+			t.Errorf("expanded=%v: view is %d lines, want at most the window height 40", expanded, height)
+		}
+		if !strings.Contains(view, "Add login page") {
+			t.Errorf("expanded=%v: expected the message preview to stay visible", expanded)
+		}
+	}
+
+	// Collapsed shows fewer lines than expanded
+	m.reasoningExpanded = false
+	collapsed := lipgloss.Height(m.renderDetailsPane(60, 30))
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	view := updated.(CommitViewModel)
+	if !view.reasoningExpanded {
+		t.Fatal("Expected e to expand the reasoning")
+	}
+	if expanded := lipgloss.Height(view.renderDetailsPane(60, 30)); expanded <= collapsed || expanded > 30 {
+		t.Errorf("details pane = %d lines expanded, %d collapsed, want more when expanded but at most 30", expanded, collapsed)
+	}
+}
+
+// TestCommitView_FileSelection tests that files can be left out of the commit and all reselected
+func TestCommitView_FileSelection(t *testing.T) {
+	decision, err := domain.NewDecision(domain.ActionCommitDirect, 0.9, "small change")
+	if err != nil {
+		t.Fatalf("NewDecision() error = %v", err)
+	}
+	msg, err := domain.NewCommitMessage("Fix login redirect")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() error = %v", err)
+	}
+	decision.SetSuggestedMessage(msg)
+
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+	repo.AddChange(domain.FileChange{Path: "auth/login.go", Status: domain.StatusModified, Additions: 12, Deletions: 3})
+	repo.AddChange(domain.FileChange{Path: "notes.txt", Status: domain.StatusUntracked, Additions: 1})
+	repo.AddChange(domain.FileChange{Path: "auth/old.go -> auth/session.go", Status: domain.StatusRenamed})
+
+	var m tea.Model = *NewCommitViewModel(repo, nil, decision, 100, "test-model", 120, 40)
+	if files := m.(CommitViewModel).SelectedFiles(); files != nil {
+		t.Fatalf("SelectedFiles() = %v, want nil while every file is selected", files)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	view := m.(CommitViewModel)
+	if !view.ChoosingFiles() {
+		t.Fatal("Expected f to open the file selection")
+	}
+	if rendered := view.View(); !strings.Contains(rendered, "auth/login.go  +12 -3") {
+		t.Errorf("Expected the per-file line counts, got:\n%s", rendered)
+	}
+
+	// Leave notes.txt out
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	// A rename commits both its sides, never the "old -> new" status line
+	want := []string{"auth/login.go", "auth/session.go", "auth/old.go"}
+	if files := m.(CommitViewModel).SelectedFiles(); strings.Join(files, " ") != strings.Join(want, " ") {
+		t.Errorf("SelectedFiles() = %v, want %v", files, want)
+	}
+
+	// "a" selects all, then none
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	if files := m.(CommitViewModel).SelectedFiles(); files != nil {
+		t.Errorf("SelectedFiles() = %v, want nil after selecting all", files)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	if count := m.(CommitViewModel).selectedFileCount(); count != 0 {
+		t.Errorf("selected %d files, want none after toggling all off", count)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.(CommitViewModel).ChoosingFiles() {
+		t.Fatal("Expected Esc to return to the options")
+	}
+
+	// Confirming with nothing selected is refused
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	view = m.(CommitViewModel)
+	view.confirmationFocus = focusConfirm
+	m, _ = view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if view = m.(CommitViewModel); view.HasDecision() || view.inputErr == "" {
+		t.Errorf("Expected confirming without files to be refused, err=%q", view.inputErr)
+	}
+}
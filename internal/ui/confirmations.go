@@ -0,0 +1,148 @@
+package ui
+
+import "fmt"
+
+// ConfirmAction identifies an action guarded by a confirmation dialog.
+// Each action has its own wording so destructive prompts spell out what
+// will be lost instead of asking a generic "Are you sure?".
+type ConfirmAction int
+
+const (
+	ConfirmCancelCommitAnalysis ConfirmAction = iota
+	ConfirmLeaveCommit
+	ConfirmCancelMergeAnalysis
+	ConfirmLeaveMerge
+	ConfirmForceDeleteBranch
+	ConfirmPushProtected
+	ConfirmDiscardAll
+	ConfirmAmendPushed
+	ConfirmRebasePushed
+	ConfirmLeaveConflicts
+	ConfirmRememberMergeTarget
+	ConfirmRunMaintenance
+)
+
+// Confirmation is the wording of a confirmation dialog.
+type Confirmation struct {
+	Category     string // Name used in cfg.UI.SkipConfirmations
+	Title        string
+	Message      string
+	Consequence  string // What cannot be undone; empty for non-destructive actions
+	ConfirmLabel string // Label of the button that proceeds
+	Destructive  bool
+}
+
+// confirmationFor returns the dialog wording for action. subject names what
+// the action applies to (a branch or commit) and is ignored where unused.
+func confirmationFor(action ConfirmAction, subject string) Confirmation {
+	switch action {
+	case ConfirmCancelCommitAnalysis:
+		return Confirmation{
+			Category:     "cancel_analysis",
+			Title:        "Cancel Analysis",
+			Message:      "Cancel commit analysis?",
+			ConfirmLabel: "Yes",
+		}
+
+	case ConfirmLeaveCommit:
+		return Confirmation{
+			Category:     "leave_commit",
+			Title:        "Leave Commit",
+			Message:      "Return to dashboard without committing?",
+			ConfirmLabel: "Yes",
+		}
+
+	case ConfirmCancelMergeAnalysis:
+		return Confirmation{
+			Category:     "cancel_analysis",
+			Title:        "Cancel Analysis",
+			Message:      "Cancel merge analysis?",
+			ConfirmLabel: "Yes",
+		}
+
+	case ConfirmLeaveMerge:
+		return Confirmation{
+			Category:     "leave_merge",
+			Title:        "Leave Merge",
+			Message:      "Return to dashboard without merging?",
+			ConfirmLabel: "Yes",
+		}
+
+	case ConfirmLeaveConflicts:
+		return Confirmation{
+			Category:     "leave_conflicts",
+			Title:        "Leave Conflicts",
+			Message:      fmt.Sprintf("The %s still has conflicts. Return to dashboard and leave it in progress?", subject),
+			Consequence:  fmt.Sprintf("The repository stays mid-%s until you finish resolving it or run git %s --abort.", subject, subject),
+			ConfirmLabel: "Leave",
+		}
+
+	case ConfirmRememberMergeTarget:
+		return Confirmation{
+			Category:     "remember_merge_target",
+			Title:        "Remember Merge Target",
+			Message:      fmt.Sprintf("Merged %s, which isn't the branch's recorded parent. Merge this way by default next time?", subject),
+			ConfirmLabel: "Remember",
+		}
+
+	case ConfirmRunMaintenance:
+		return Confirmation{
+			Category:     "run_maintenance",
+			Title:        "Optimize Repository",
+			Message:      fmt.Sprintf("Repack objects and prune garbage (%s)? This can take several minutes on a large repository.", subject),
+			ConfirmLabel: "Optimize",
+		}
+
+	case ConfirmForceDeleteBranch:
+		return Confirmation{
+			Category:     "force_delete_branch",
+			Title:        "Force Delete Branch",
+			Message:      fmt.Sprintf("Branch '%s' has commits that are not merged into its parent branch.", subject),
+			Consequence:  "Force deleting it permanently loses those commits. This cannot be undone.",
+			ConfirmLabel: "Force Delete",
+			Destructive:  true,
+		}
+
+	case ConfirmPushProtected:
+		return Confirmation{
+			Category:     "push_protected",
+			Title:        "Push to Protected Branch",
+			Message:      fmt.Sprintf("'%s' is a protected branch. Push your commits directly to it?", subject),
+			Consequence:  "Everyone sharing the branch receives these commits. Once pushed they can only be reverted, not removed.",
+			ConfirmLabel: "Push",
+			Destructive:  true,
+		}
+
+	case ConfirmDiscardAll:
+		return Confirmation{
+			Category:     "discard_all",
+			Title:        "Discard All Changes",
+			Message:      "Discard every uncommitted change in this repository?",
+			Consequence:  "Modified files are reset and untracked files are deleted. This cannot be undone.",
+			ConfirmLabel: "Discard",
+			Destructive:  true,
+		}
+
+	case ConfirmAmendPushed:
+		return Confirmation{
+			Category:     "amend_pushed",
+			Title:        "Amend Pushed Commit",
+			Message:      fmt.Sprintf("Commit %s has already been pushed. Amend it anyway?", subject),
+			Consequence:  "Amending rewrites published history. You will need to force push, and anyone who pulled the commit must recover by hand.",
+			ConfirmLabel: "Amend",
+			Destructive:  true,
+		}
+
+	case ConfirmRebasePushed:
+		return Confirmation{
+			Category:     "rebase_pushed",
+			Title:        "Rebase Pushed Branch",
+			Message:      fmt.Sprintf("'%s' has already been pushed. Rebase it onto its parent anyway?", subject),
+			Consequence:  "Rebasing rewrites the pushed commits. You will need to force push, and anyone sharing the branch must reset to the new history.",
+			ConfirmLabel: "Rebase",
+			Destructive:  true,
+		}
+	}
+
+	return Confirmation{Title: "Confirmation", Message: "Continue?", ConfirmLabel: "Yes"}
+}
@@ -0,0 +1,83 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestConfirmationFor_DestructiveActions tests that each destructive action gets specific wording and consequences
+func TestConfirmationFor_DestructiveActions(t *testing.T) {
+	tests := []struct {
+		action      ConfirmAction
+		subject     string
+		title       string
+		message     string
+		consequence string
+		label       string
+	}{
+		{
+			action:      ConfirmForceDeleteBranch,
+			subject:     "feature/login",
+			title:       "Force Delete Branch",
+			message:     "Branch 'feature/login' has commits that are not merged into its parent branch.",
+			consequence: "Force deleting it permanently loses those commits. This cannot be undone.",
+			label:       "Force Delete",
+		},
+		{
+			action:      ConfirmPushProtected,
+			subject:     "main",
+			title:       "Push to Protected Branch",
+			message:     "'main' is a protected branch. Push your commits directly to it?",
+			consequence: "Everyone sharing the branch receives these commits. Once pushed they can only be reverted, not removed.",
+			label:       "Push",
+		},
+		{
+			action:      ConfirmDiscardAll,
+			title:       "Discard All Changes",
+			message:     "Discard every uncommitted change in this repository?",
+			consequence: "Modified files are reset and untracked files are deleted. This cannot be undone.",
+			label:       "Discard",
+		},
+		{
+			action:      ConfirmAmendPushed,
+			subject:     "abc1234",
+			title:       "Amend Pushed Commit",
+			message:     "Commit abc1234 has already been pushed. Amend it anyway?",
+			consequence: "Amending rewrites published history. You will need to force push, and anyone who pulled the commit must recover by hand.",
+			label:       "Amend",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			got := confirmationFor(tt.action, tt.subject)
+			if !got.Destructive {
+				t.Error("Expected action to be marked destructive")
+			}
+			if got.Title != tt.title || got.Message != tt.message || got.Consequence != tt.consequence || got.ConfirmLabel != tt.label {
+				t.Errorf("confirmationFor() = %+v\nwant title %q, message %q, consequence %q, label %q",
+					got, tt.title, tt.message, tt.consequence, tt.label)
+			}
+		})
+	}
+
+	// Leaving a view loses nothing, so it stays a plain yes/no question
+	if got := confirmationFor(ConfirmLeaveCommit, ""); got.Destructive || got.Consequence != "" {
+		t.Errorf("Expected non-destructive wording for leaving the commit view, got %+v", got)
+	}
+}
+
+// TestAppModel_ConfirmationDialogShowsConsequence tests that the dialog renders the action's own wording
+func TestAppModel_ConfirmationDialogShowsConsequence(t *testing.T) {
+	m := newTestAppModel()
+	m.windowWidth, m.windowHeight = 100, 30
+	m.showingConfirmation = true
+	m.confirmation = confirmationFor(ConfirmPushProtected, "main")
+
+	view := m.renderConfirmationDialog()
+	for _, want := range []string{"⚠ Push to Protected Branch", "'main' is a protected branch", "only be reverted", "Push"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected dialog to contain %q\nGot:\n%s", want, view)
+		}
+	}
+}
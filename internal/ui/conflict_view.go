@@ -0,0 +1,312 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/gitman/internal/adapter/git"
+)
+
+// ConflictKind is the git operation that stopped on conflicts.
+type ConflictKind int
+
+const (
+	ConflictMerge ConflictKind = iota
+	ConflictRebase
+)
+
+func (k ConflictKind) String() string {
+	if k == ConflictRebase {
+		return "rebase"
+	}
+	return "merge"
+}
+
+// ConflictViewModel walks through resolving the conflicts a merge or rebase
+// stopped on: edit each file, mark it resolved, then continue or abort.
+type ConflictViewModel struct {
+	gitOps          git.Operations
+	repoPath        string
+	kind            ConflictKind
+	description     string // What stopped, e.g. "feature/login → main"
+	continueMessage string // Commit message completing a merge; empty keeps git's
+
+	conflicts []string // Files still conflicted, re-polled after each action
+	index     int
+	working   bool   // A git command or the editor is running
+	status    string // Outcome of the last action
+	err       string
+	outcome   string // How the operation ended, once continued or aborted
+	done      bool
+
+	windowWidth  int
+	windowHeight int
+}
+
+// conflictsPolledMsg carries the files still conflicted
+type conflictsPolledMsg struct {
+	files []string
+	err   error
+}
+
+// conflictActionMsg reports an action taken on the conflicted operation
+type conflictActionMsg struct {
+	action string // "edit", "resolve", "continue" or "abort"
+	file   string
+	err    error
+}
+
+// NewConflictViewModel creates a conflict view for the files a merge or
+// rebase stopped on.
+func NewConflictViewModel(gitOps git.Operations, repoPath string, kind ConflictKind, description string, files []string) ConflictViewModel {
+	return ConflictViewModel{
+		gitOps:       gitOps,
+		repoPath:     repoPath,
+		kind:         kind,
+		description:  description,
+		conflicts:    files,
+		working:      true, // Until the first poll
+		windowWidth:  120,
+		windowHeight: 30,
+	}
+}
+
+// WithContinueMessage sets the commit message that completes a merge, e.g.
+// a squash merge's message. Without one git's prepared message is kept.
+func (m ConflictViewModel) WithContinueMessage(message string) ConflictViewModel {
+	m.continueMessage = message
+	return m
+}
+
+// Init polls git for the files that are still conflicted.
+func (m ConflictViewModel) Init() tea.Cmd {
+	return pollConflicts(m.gitOps, m.repoPath)
+}
+
+// Update handles messages.
+func (m ConflictViewModel) Update(msg tea.Msg) (ConflictViewModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.windowWidth = msg.Width
+		m.windowHeight = msg.Height
+
+	case conflictsPolledMsg:
+		m.working = false
+		if msg.err != nil {
+			m.err = msg.err.Error()
+			return m, nil
+		}
+		m.conflicts = msg.files
+		if m.index >= len(m.conflicts) {
+			m.index = max(len(m.conflicts)-1, 0)
+		}
+
+	case conflictActionMsg:
+		return m.handleAction(msg)
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+
+	return m, nil
+}
+
+// handleKey handles the conflict list: e edits the selected file, a marks it
+// resolved, c continues once nothing is conflicted and x aborts
+func (m ConflictViewModel) handleKey(msg tea.KeyMsg) (ConflictViewModel, tea.Cmd) {
+	if m.working {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		if m.index > 0 {
+			m.index--
+		}
+	case "down", "j":
+		if m.index < len(m.conflicts)-1 {
+			m.index++
+		}
+	case "e":
+		if m.index < len(m.conflicts) {
+			m.err = ""
+			m.working = true
+			return m, editConflictedFile(m.repoPath, m.conflicts[m.index])
+		}
+	case "a", "enter":
+		if m.index < len(m.conflicts) {
+			m.err = ""
+			m.working = true
+			return m, markConflictResolved(m.gitOps, m.repoPath, m.conflicts[m.index])
+		}
+	case "c":
+		if len(m.conflicts) > 0 {
+			m.err = fmt.Sprintf("%d files are still conflicted; resolve them before continuing", len(m.conflicts))
+			return m, nil
+		}
+		m.err = ""
+		m.working = true
+		return m, continueConflicted(m.gitOps, m.repoPath, m.kind, m.continueMessage)
+	case "x":
+		m.err = ""
+		m.working = true
+		return m, abortConflicted(m.gitOps, m.repoPath, m.kind)
+	}
+	return m, nil
+}
+
+// handleAction reports an action. Edits and resolutions re-poll the
+// conflicts; continuing or aborting ends the operation, unless a rebase
+// stops on the next commit's conflicts.
+func (m ConflictViewModel) handleAction(msg conflictActionMsg) (ConflictViewModel, tea.Cmd) {
+	m.working = false
+
+	var rebaseConflict *git.RebaseConflictError
+	if msg.action == "continue" && errors.As(msg.err, &rebaseConflict) {
+		m.status = "Continued; the next commit conflicts too"
+		m.working = true
+		return m, pollConflicts(m.gitOps, m.repoPath)
+	}
+	if msg.err != nil {
+		m.err = msg.err.Error()
+		return m, nil
+	}
+
+	switch msg.action {
+	case "edit":
+		m.status = "Edited " + msg.file
+	case "resolve":
+		m.status = "Marked " + msg.file + " as resolved"
+	case "continue":
+		m.outcome = fmt.Sprintf("Completed the %s (%s) after resolving conflicts", m.kind, m.description)
+		m.done = true
+		return m, nil
+	case "abort":
+		m.outcome = fmt.Sprintf("Aborted the %s (%s)", m.kind, m.description)
+		m.done = true
+		return m, nil
+	}
+
+	m.working = true
+	return m, pollConflicts(m.gitOps, m.repoPath)
+}
+
+// Done returns true once the operation was continued or aborted.
+func (m ConflictViewModel) Done() bool {
+	return m.done
+}
+
+// Kind returns the operation that stopped on conflicts.
+func (m ConflictViewModel) Kind() ConflictKind {
+	return m.kind
+}
+
+// Outcome describes how the operation ended, or "" if it hasn't.
+func (m ConflictViewModel) Outcome() string {
+	return m.outcome
+}
+
+// View renders the conflicted files with the actions to resolve them.
+func (m ConflictViewModel) View() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.ColorMuted)
+
+	lines := []string{
+		styles.SectionTitle.Render(fmt.Sprintf("RESOLVE %s CONFLICTS", strings.ToUpper(m.kind.String()))),
+		mutedStyle.Render(m.description),
+		"",
+	}
+
+	if len(m.conflicts) == 0 {
+		lines = append(lines, lipgloss.NewStyle().Foreground(styles.ColorSuccess).Render("✓ All conflicts resolved"))
+		lines = append(lines, fmt.Sprintf("Press c to continue the %s.", m.kind))
+	} else {
+		lines = append(lines, styles.Warning.Render(fmt.Sprintf("%d conflicted files:", len(m.conflicts))))
+		for i, file := range m.conflicts {
+			if i == m.index {
+				lines = append(lines, styles.TabActive.Render("> "+file))
+			} else {
+				lines = append(lines, "  "+file)
+			}
+		}
+		lines = append(lines, "", mutedStyle.Render("Edit each file to remove the conflict markers, then mark it resolved."))
+	}
+
+	lines = append(lines, "")
+	switch {
+	case m.working:
+		lines = append(lines, mutedStyle.Render("Working..."))
+	case m.err != "":
+		lines = append(lines, lipgloss.NewStyle().Foreground(styles.ColorError).Render(m.err))
+	case m.status != "":
+		lines = append(lines, lipgloss.NewStyle().Foreground(styles.ColorSuccess).Render("✓ "+m.status))
+	}
+
+	help := fmt.Sprintf("↑/↓: Select • e: Edit • a: Mark resolved • c: Continue %s • x: Abort %s • Esc: Leave", m.kind, m.kind)
+	box := styles.CommitBox.Width(70).Render(strings.Join(lines, "\n"))
+	return lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.Place(m.windowWidth, m.windowHeight-2, lipgloss.Center, lipgloss.Center, box),
+		styles.Footer.Render(help),
+	)
+}
+
+// pollConflicts lists the files still conflicted
+func pollConflicts(gitOps git.Operations, repoPath string) tea.Cmd {
+	return func() tea.Msg {
+		files, err := gitOps.GetConflictedFiles(context.Background(), repoPath)
+		return conflictsPolledMsg{files: files, err: err}
+	}
+}
+
+// editConflictedFile opens file in $EDITOR (vi if unset), handing it the terminal
+func editConflictedFile(repoPath, file string) tea.Cmd {
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{"vi"}
+	}
+	cmd := exec.Command(editor[0], append(editor[1:], filepath.Join(repoPath, file))...)
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return conflictActionMsg{action: "edit", file: file, err: err}
+	})
+}
+
+// markConflictResolved stages file, which tells git its conflict is resolved
+func markConflictResolved(gitOps git.Operations, repoPath, file string) tea.Cmd {
+	return func() tea.Msg {
+		err := gitOps.Add(context.Background(), repoPath, []string{file})
+		return conflictActionMsg{action: "resolve", file: file, err: err}
+	}
+}
+
+// continueConflicted commits the resolved merge or continues the rebase
+func continueConflicted(gitOps git.Operations, repoPath string, kind ConflictKind, message string) tea.Cmd {
+	return func() tea.Msg {
+		var err error
+		if kind == ConflictRebase {
+			err = gitOps.ContinueRebase(context.Background(), repoPath)
+		} else {
+			err = gitOps.ContinueMerge(context.Background(), repoPath, message)
+		}
+		return conflictActionMsg{action: "continue", err: err}
+	}
+}
+
+// abortConflicted abandons the merge or rebase, restoring the branch as it was
+func abortConflicted(gitOps git.Operations, repoPath string, kind ConflictKind) tea.Cmd {
+	return func() tea.Msg {
+		var err error
+		if kind == ConflictRebase {
+			err = gitOps.AbortRebase(context.Background(), repoPath)
+		} else {
+			err = gitOps.AbortMerge(context.Background(), repoPath)
+		}
+		return conflictActionMsg{action: "abort", err: err}
+	}
+}
@@ -0,0 +1,131 @@
+package ui
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/gitman/internal/adapter/git"
+)
+
+// conflictGitOps serves a merge or rebase left with conflicts; staging a file resolves it
+type conflictGitOps struct {
+	git.Operations
+
+	conflicts       []string
+	nextConflicts   []string // Conflicts the next commit of a rebase stops on
+	continueMessage string
+	continued       string // "merge" or "rebase"
+	aborted         string
+}
+
+func (f *conflictGitOps) GetConflictedFiles(ctx context.Context, repoPath string) ([]string, error) {
+	return f.conflicts, nil
+}
+
+func (f *conflictGitOps) Add(ctx context.Context, repoPath string, files []string) error {
+	var remaining []string
+	for _, c := range f.conflicts {
+		if c != files[0] {
+			remaining = append(remaining, c)
+		}
+	}
+	f.conflicts = remaining
+	return nil
+}
+
+func (f *conflictGitOps) ContinueMerge(ctx context.Context, repoPath, message string) error {
+	f.continued = "merge"
+	f.continueMessage = message
+	return nil
+}
+
+func (f *conflictGitOps) ContinueRebase(ctx context.Context, repoPath string) error {
+	f.continued = "rebase"
+	if len(f.nextConflicts) > 0 {
+		f.conflicts, f.nextConflicts = f.nextConflicts, nil
+		return &git.RebaseConflictError{Files: f.conflicts}
+	}
+	return nil
+}
+
+func (f *conflictGitOps) AbortMerge(ctx context.Context, repoPath string) error {
+	f.aborted = "merge"
+	return nil
+}
+
+func (f *conflictGitOps) AbortRebase(ctx context.Context, repoPath string) error {
+	f.aborted = "rebase"
+	return nil
+}
+
+// runConflictView feeds key presses to m, running the commands they return
+// until the view settles
+func runConflictView(m ConflictViewModel, cmd tea.Cmd, keys ...string) ConflictViewModel {
+	for cmd != nil {
+		m, cmd = m.Update(cmd())
+	}
+	for _, key := range keys {
+		m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		for cmd != nil {
+			m, cmd = m.Update(cmd())
+		}
+	}
+	return m
+}
+
+// TestConflictView_ResolvesMerge tests marking conflicted files resolved and continuing the merge
+func TestConflictView_ResolvesMerge(t *testing.T) {
+	ops := &conflictGitOps{conflicts: []string{"auth.go", "login.go"}}
+	m := NewConflictViewModel(ops, "/tmp/repo", ConflictMerge, "feature/login → main", ops.conflicts)
+	m = runConflictView(m, m.Init())
+
+	if view := m.View(); !strings.Contains(view, "RESOLVE MERGE CONFLICTS") || !strings.Contains(view, "2 conflicted files") {
+		t.Fatalf("Expected the conflicted files to be listed, got:\n%s", view)
+	}
+
+	// Continuing is refused while files are conflicted
+	m = runConflictView(m, nil, "c")
+	if ops.continued != "" || m.err == "" {
+		t.Fatal("Expected continue to be refused with conflicts left")
+	}
+
+	// Each resolution re-polls the conflicts
+	m = runConflictView(m, nil, "a")
+	if len(m.conflicts) != 1 || m.conflicts[0] != "login.go" {
+		t.Fatalf("Expected login.go left after resolving auth.go, got %v", m.conflicts)
+	}
+	m = runConflictView(m, nil, "a")
+	if view := m.View(); !strings.Contains(view, "All conflicts resolved") {
+		t.Errorf("Expected all conflicts resolved, got:\n%s", view)
+	}
+
+	m = runConflictView(m, nil, "c")
+	if ops.continued != "merge" || ops.continueMessage != "" {
+		t.Errorf("Expected the merge to continue with git's message, got %q with %q", ops.continued, ops.continueMessage)
+	}
+	if !m.Done() || !strings.Contains(m.Outcome(), "Completed the merge (feature/login → main)") {
+		t.Errorf("Expected the merge to be done, got %q", m.Outcome())
+	}
+}
+
+// TestConflictView_Rebase tests continuing a rebase through a later conflict, and aborting it
+func TestConflictView_Rebase(t *testing.T) {
+	ops := &conflictGitOps{conflicts: []string{"auth.go"}, nextConflicts: []string{"login.go"}}
+	m := NewConflictViewModel(ops, "/tmp/repo", ConflictRebase, "pulling main with rebase", ops.conflicts)
+	m = runConflictView(m, m.Init(), "a", "c")
+
+	// The next commit conflicts, so the view stays on the new conflicts
+	if ops.continued != "rebase" || m.Done() {
+		t.Fatalf("Expected the rebase to continue and stop again, got continued=%q done=%v", ops.continued, m.Done())
+	}
+	if len(m.conflicts) != 1 || m.conflicts[0] != "login.go" {
+		t.Errorf("Expected the next commit's conflicts, got %v", m.conflicts)
+	}
+
+	m = runConflictView(m, nil, "x")
+	if ops.aborted != "rebase" || !m.Done() || !strings.Contains(m.Outcome(), "Aborted the rebase") {
+		t.Errorf("Expected the rebase to be aborted, got aborted=%q outcome=%q", ops.aborted, m.Outcome())
+	}
+}
@@ -2,13 +2,18 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/adapter/github"
 	"github.com/yourusername/gitman/internal/domain"
 )
 
@@ -24,8 +29,39 @@ const (
 	QuickStatusMenu
 	HelpMenu
 	RepositoryDetailsMenu
+	CommitDetailMenu
+	TagListMenu
+	CreateTagMenu
+	PathScopeMenu
+	ReflogMenu
+	RecoverBranchMenu
+	MergeTargetMenu
+	RemoteListMenu
+	RemoteFormMenu
+	PushRemoteMenu
+	BlameMenu
+	CommitDiffMenu
 )
 
+// submenuReadOnly lists submenus that only display information. Enter closes
+// them (returning to the parent list for CommitDetailMenu and BlameMenu, and
+// to the commit for CommitDiffMenu). Every other
+// submenu is actionable: Enter performs or opens something for the
+// highlighted row, e.g. the commit list opens the commit's detail and the
+// branch list switches to the branch.
+var submenuReadOnly = map[ActiveSubmenu]bool{
+	QuickStatusMenu:  true,
+	HelpMenu:         true,
+	CommitDetailMenu: true,
+	BlameMenu:        true,
+	CommitDiffMenu:   true,
+}
+
+// IsReadOnly returns true if Enter only closes the submenu
+func (s ActiveSubmenu) IsReadOnly() bool {
+	return submenuReadOnly[s]
+}
+
 // Dashboard actions that can be returned
 type DashboardAction int
 
@@ -44,8 +80,35 @@ const (
 	ActionListPRs
 	ActionCreatePR
 	ActionManageBranches
+	ActionRebase
+	ActionLoginGH
+	ActionUnshallow
+	ActionMaintenance
+	ActionSummarizeBranch
 )
 
+// pathScoper is implemented by git operations that can limit status, diffs,
+// and staging to a subtree (git.ExecOperations)
+type pathScoper interface {
+	SetPathScope(path string)
+	PathScope() string
+}
+
+// checkGHAuth reports whether gh is logged in. Tests replace it to avoid running gh.
+var checkGHAuth = github.IsAuthenticated
+
+// ghAuthStatusMsg carries the result of checking gh authentication
+type ghAuthStatusMsg struct {
+	authenticated bool
+	err           error
+}
+
+// objectStatsMsg carries git count-objects statistics for the repository details
+type objectStatsMsg struct {
+	stats *git.ObjectStats
+	err   error
+}
+
 // DashboardModel represents the state of the dashboard view
 type DashboardModel struct {
 	gitOps              git.Operations
@@ -59,6 +122,67 @@ type DashboardModel struct {
 	activeSubmenu       ActiveSubmenu
 	submenuIndex        int
 	submenuScrollOffset int
+	detailCommitIndex   int    // Commit shown in CommitDetailMenu and CommitDiffMenu (index into recentCommits)
+	commitTimeFormat    string // Commit time format toggled with "t"; empty uses cfg.UI.TimeFormat
+
+	// Commit diff (shown in CommitDiffMenu)
+	commitDiff     *DiffViewModel
+	commitDiffFrom ActiveSubmenu // Submenu the diff was opened from
+
+	// Tags (loaded when TagListMenu opens)
+	tags             []string
+	tagsLoaded       bool
+	tagVerifications map[string]*domain.TagVerification // Verification results by tag name
+	verifyingTag     string                             // Tag whose verification is in flight
+
+	// Tag creation (CreateTagMenu)
+	tagNameInput    textinput.Model
+	tagMessageInput textinput.Model
+	tagError        string // Validation or git error shown in the form
+	creatingTag     bool   // git tag is running
+
+	// Path scope (PathScopeMenu), limiting analysis and staging to a subtree
+	pathScopeInput textinput.Model
+	pathScopeError string
+
+	// Reflog recovery: ReflogMenu lists where HEAD has been, RecoverBranchMenu
+	// names a branch at the chosen entry
+	reflog             []git.ReflogEntry
+	reflogLoaded       bool
+	reflogIndex        int             // Entry in ReflogMenu the recovery form was opened from
+	recoverEntry       git.ReflogEntry // Entry the recovered branch will point at
+	recoverBranchInput textinput.Model
+	recoverError       string
+	recovering         bool // git branch is running
+
+	// Branches MergeTargetMenu offers to merge the current branch into
+	mergeTargets []string
+
+	// Blame of a changed file, opened from QuickStatusMenu
+	blameFile      string
+	blameFileIndex int // Row of the file in QuickStatusMenu, restored on close
+	blame          []git.BlameLine
+	blameLoaded    bool
+	blameError     string
+
+	// Remotes PushRemoteMenu offers to push to, when there is more than one
+	pushRemotes []string
+
+	// Remote management: RemoteListMenu lists the remotes, RemoteFormMenu adds
+	// one or renames the highlighted one
+	remotes         []git.Remote
+	remotesLoaded   bool
+	removingRemote  bool   // Asking to confirm removal of the highlighted remote
+	renamingRemote  string // Remote the form renames; empty when adding one
+	remoteNameInput textinput.Model
+	remoteURLInput  textinput.Model
+	remoteError     string // Validation or git error shown in the list or form
+	remoteBusy      bool   // git remote is running
+
+	// Reverting a commit from CommitListMenu
+	revertConfirm bool   // Asking to confirm the revert of the highlighted commit
+	reverting     bool   // git revert is running
+	revertError   string // Conflict or git error from the last revert
 
 	// Submenu options
 	sourceBranch string
@@ -73,8 +197,32 @@ type DashboardModel struct {
 	action       DashboardAction
 	actionParams map[string]interface{}
 
+	// Recent background activity (hook output, etc.), newest last
+	activity []string
+
+	// Commits, branch switches and pushes, for the summary printed on exit
+	session []sessionEvent
+
+	// gh authentication, checked when the repository details open on a GitHub remote
+	ghAuthChecked   bool
+	ghAuthenticated bool
+	ghAuthErr       error
+
+	// Object database size, loaded when the repository details open
+	objectStats *git.ObjectStats
+
+	// Background fetch on open (cfg.Git.AutoFetchOnOpen), cleared once it reports back
+	autoFetchPending bool
+
+	// An earlier analysis was left without committing, so the commit options
+	// offer to analyze only what changed since
+	hasPriorAnalysis bool
+
 	// App info
-	version string
+	version     string
+	activeModel string // Resolved AI model (global default or per-repo override)
+	aiDisabled  bool   // Skip AI analysis (--no-ai or toggled with "i")
+	aiMissing   bool   // No AI provider configured, so AI cannot be turned on
 
 	// Dimensions
 	width  int
@@ -90,6 +238,51 @@ type repoStatusMsg struct {
 type branchesMsg []string
 type commitsMsg []git.CommitInfo
 type errorMsg struct{ err error }
+type tagsMsg []string
+type autoFetchMsg struct {
+	fetched bool // False when there is no remote to fetch from
+	err     error
+}
+type tagCreatedMsg struct {
+	name string
+	err  error
+}
+type reflogMsg struct {
+	entries []git.ReflogEntry
+	err     error
+}
+type blameMsg struct {
+	file  string
+	lines []git.BlameLine
+	err   error
+}
+type remotesMsg struct {
+	remotes []git.Remote
+	err     error
+}
+type pushRemotesMsg struct {
+	remotes []string
+	err     error
+}
+type remoteChangedMsg struct {
+	activity string // What changed, for the activity log
+	err      error
+}
+type branchRecoveredMsg struct {
+	branch string
+	entry  git.ReflogEntry
+	err    error
+}
+type commitRevertedMsg struct {
+	commit   git.CommitInfo
+	noCommit bool
+	err      error
+}
+type tagVerifiedMsg struct {
+	tag    string
+	result *domain.TagVerification
+	err    error
+}
 
 // NewDashboardModel creates a new dashboard model
 func NewDashboardModel(gitOps git.Operations, repoPath string, config *domain.Config) DashboardModel {
@@ -100,8 +293,12 @@ func NewDashboardModel(gitOps git.Operations, repoPath string, config *domain.Co
 		selectedCard:  0,
 		activeSubmenu: NoSubmenu,
 		loading:       true,
+
+		tagVerifications: make(map[string]*domain.TagVerification),
 		actionParams:  make(map[string]interface{}),
 		version:       "0.1.0", // Default version
+
+		autoFetchPending: config != nil && config.Git.AutoFetchOnOpen,
 	}
 }
 
@@ -110,13 +307,59 @@ func (m *DashboardModel) SetVersion(version string) {
 	m.version = version
 }
 
+// maxActivityEntries bounds the dashboard activity log
+const maxActivityEntries = 20
+
+// AddActivity appends an entry to the dashboard activity log
+func (m *DashboardModel) AddActivity(entry string) {
+	m.activity = append(m.activity, entry)
+	if len(m.activity) > maxActivityEntries {
+		m.activity = m.activity[len(m.activity)-maxActivityEntries:]
+	}
+}
+
+// Activity returns the activity log, oldest first
+func (m DashboardModel) Activity() []string {
+	return m.activity
+}
+
+// SetActiveModel sets the AI model shown in the footer
+func (m *DashboardModel) SetActiveModel(model string) {
+	m.activeModel = model
+}
+
+// SetAIDisabled turns AI analysis off (manual messages, default merge strategy)
+func (m *DashboardModel) SetAIDisabled(disabled bool) {
+	m.aiDisabled = disabled
+}
+
+// SetHasPriorAnalysis offers analyzing only the changes since the last analysis
+func (m *DashboardModel) SetHasPriorAnalysis(has bool) {
+	m.hasPriorAnalysis = has
+}
+
+// SetAIUnavailable marks AI as unavailable (no provider), which also disables it
+func (m *DashboardModel) SetAIUnavailable() {
+	m.aiMissing = true
+	m.aiDisabled = true
+}
+
+// AIDisabled returns true if AI analysis is turned off
+func (m DashboardModel) AIDisabled() bool {
+	return m.aiDisabled
+}
+
 // Init initializes the model and starts data fetching
 func (m DashboardModel) Init() tea.Cmd {
-	return tea.Batch(
+	cmds := []tea.Cmd{
 		fetchRepoStatus(m.gitOps, m.repoPath),
 		fetchBranches(m.gitOps, m.repoPath),
 		fetchRecentCommits(m.gitOps, m.repoPath),
-	)
+	}
+	if m.autoFetchPending {
+		cmds = append(cmds, autoFetch(m.gitOps, m.repoPath))
+	}
+	return tea.Batch(cmds...)
 }
 
 // Update handles messages
@@ -138,6 +381,155 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.checkLoading()
 		return m, nil
 
+	case autoFetchMsg:
+		m.autoFetchPending = false
+		if msg.err != nil {
+			// Offline or unreachable remote: keep the last known sync status
+			m.AddActivity(fmt.Sprintf("Auto-fetch failed: %v", msg.err))
+			return m, nil
+		}
+		if msg.fetched {
+			// Refresh ahead/behind against the updated remote refs
+			return m, fetchRepoStatus(m.gitOps, m.repoPath)
+		}
+		return m, nil
+
+	case ghAuthStatusMsg:
+		m.ghAuthChecked = true
+		m.ghAuthenticated = msg.authenticated
+		m.ghAuthErr = msg.err
+		return m, nil
+
+	case objectStatsMsg:
+		if msg.err == nil {
+			m.objectStats = msg.stats
+		}
+		return m, nil
+
+	case tagsMsg:
+		m.tags = msg
+		m.tagsLoaded = true
+		return m, nil
+
+	case tagCreatedMsg:
+		m.creatingTag = false
+		if msg.err != nil {
+			m.tagError = msg.err.Error()
+			return m, nil
+		}
+		m.AddActivity(fmt.Sprintf("Created tag %s", msg.name))
+		m.activeSubmenu = NoSubmenu
+		m.submenuIndex = 0
+		m.tagsLoaded = false
+		// Reload commits so the graph shows the new tag
+		return m, fetchRecentCommits(m.gitOps, m.repoPath)
+
+	case commitRevertedMsg:
+		return m.handleCommitReverted(msg)
+
+	case diffLoadedMsg:
+		if m.commitDiff != nil {
+			diff, cmd := m.commitDiff.Update(msg)
+			m.commitDiff = &diff
+			return m, cmd
+		}
+		return m, nil
+
+	case blameMsg:
+		if msg.file != m.blameFile {
+			return m, nil // Another file was opened since
+		}
+		m.blameLoaded = true
+		m.blame = msg.lines
+		m.blameError = ""
+		if msg.err != nil {
+			m.blameError = msg.err.Error()
+		}
+		return m, nil
+
+	case reflogMsg:
+		m.reflogLoaded = true
+		if msg.err != nil {
+			m.reflog = nil
+			m.AddActivity(fmt.Sprintf("Failed to read the reflog: %v", msg.err))
+			return m, nil
+		}
+		m.reflog = msg.entries
+		return m, nil
+
+	case branchRecoveredMsg:
+		m.recovering = false
+		if msg.err != nil {
+			m.recoverError = msg.err.Error()
+			return m, nil
+		}
+		m.AddActivity(fmt.Sprintf("Recovered %s %s as branch %s", msg.entry.Hash[:7], msg.entry.Message, msg.branch))
+		m.activeSubmenu = NoSubmenu
+		m.submenuIndex = 0
+		return m, fetchBranches(m.gitOps, m.repoPath)
+
+	case remotesMsg:
+		m.remotesLoaded = true
+		if msg.err != nil {
+			m.remotes = nil
+			m.remoteError = msg.err.Error()
+			return m, nil
+		}
+		m.remotes = msg.remotes
+		return m, nil
+
+	case pushRemotesMsg:
+		if m.activeSubmenu != RepositoryDetailsMenu {
+			return m, nil // Closed while listing
+		}
+		if msg.err != nil || len(msg.remotes) < 2 {
+			// Nothing to choose; push to the primary remote
+			m.action = ActionPush
+			m.activeSubmenu = NoSubmenu
+			return m, nil
+		}
+		m.pushRemotes = msg.remotes
+		m.activeSubmenu = PushRemoteMenu
+		m.submenuIndex = 0
+		m.submenuScrollOffset = 0
+		for i, remote := range m.pushRemotes {
+			if remote == "origin" {
+				m.submenuIndex = i
+			}
+		}
+		return m, nil
+
+	case remoteChangedMsg:
+		m.remoteBusy = false
+		if msg.err != nil {
+			m.remoteError = msg.err.Error()
+			return m, nil
+		}
+		m.AddActivity(msg.activity)
+		m.remoteError = ""
+		m.activeSubmenu = RemoteListMenu
+		m.submenuIndex = 0
+		m.remotesLoaded = false
+		// The remote card follows the remotes
+		return m, tea.Batch(fetchRemotes(m.gitOps, m.repoPath), fetchRepoStatus(m.gitOps, m.repoPath))
+
+	case tagVerifiedMsg:
+		if msg.tag == m.verifyingTag {
+			m.verifyingTag = ""
+		}
+		result := msg.result
+		if msg.err != nil {
+			result = &domain.TagVerification{Tag: msg.tag, Status: domain.TagSignatureError, Detail: msg.err.Error()}
+		}
+		if m.tagVerifications == nil {
+			m.tagVerifications = make(map[string]*domain.TagVerification)
+		}
+		m.tagVerifications[msg.tag] = result
+		if !result.Verified() {
+			m.AddActivity(fmt.Sprintf("Tag %s: %s", msg.tag, result.Summary()))
+		}
+		return m, nil
+
 	case errorMsg:
 		m.err = msg.err
 		m.loading = false
@@ -149,6 +541,35 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
+		// The tag form takes text, so keys go to its inputs
+		if m.activeSubmenu == CreateTagMenu {
+			return m.handleCreateTagKey(msg)
+		}
+		if m.activeSubmenu == PathScopeMenu {
+			return m.handlePathScopeKey(msg)
+		}
+		if m.activeSubmenu == RecoverBranchMenu {
+			return m.handleRecoverBranchKey(msg)
+		}
+		if m.activeSubmenu == RemoteFormMenu {
+			return m.handleRemoteFormKey(msg)
+		}
+
+		// The remove prompt waits for a yes or no
+		if m.activeSubmenu == RemoteListMenu && (m.removingRemote || m.remoteBusy) {
+			return m.handleRemoveRemoteKey(msg)
+		}
+
+		// The revert prompt waits for a yes or no
+		if m.activeSubmenu == CommitListMenu && (m.revertConfirm || m.reverting) {
+			return m.handleRevertConfirmKey(msg)
+		}
+
+		// The commit diff scrolls with the viewer's keys
+		if m.activeSubmenu == CommitDiffMenu {
+			return m.handleCommitDiffKey(msg)
+		}
+
 		// Submenu navigation
 		if m.activeSubmenu != NoSubmenu {
 			return m.handleSubmenuKey(msg)
@@ -198,6 +619,23 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				fetchRecentCommits(m.gitOps, m.repoPath),
 			)
 
+		case "u":
+			// Update the branch: rebase onto its parent
+			m.action = ActionRebase
+
+		case "i":
+			// Toggle AI analysis for this session
+			if m.aiMissing {
+				m.AddActivity("AI unavailable: configure an API key and restart without --no-ai")
+				return m, nil
+			}
+			m.aiDisabled = !m.aiDisabled
+			if m.aiDisabled {
+				m.AddActivity("AI analysis off: commit messages will be written manually")
+			} else {
+				m.AddActivity("AI analysis on")
+			}
+
 		case "enter":
 			return m.handleCardActivation()
 		}
@@ -210,10 +648,7 @@ func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m DashboardModel) handleSubmenuKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "esc", "q":
-		m.activeSubmenu = NoSubmenu
-		m.submenuIndex = 0
-		m.submenuScrollOffset = 0
-		return m, nil
+		return m.closeSubmenu(), nil
 
 	case "up", "k":
 		if m.submenuIndex > 0 {
@@ -235,99 +670,640 @@ func (m DashboardModel) handleSubmenuKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	case "enter", " ":
 		return m.handleSubmenuSelection()
+
+	case "p":
+		// Limit the analysis to a subdirectory
+		if m.activeSubmenu == CommitOptionsMenu {
+			if _, ok := m.gitOps.(pathScoper); ok {
+				return m.openPathScope(), textinput.Blink
+			}
+		}
+
+	case "t":
+		// Cycle how commit times are shown, for this session
+		if m.activeSubmenu == CommitListMenu {
+			m.commitTimeFormat = nextTimeFormat(m.timeFormat())
+		}
+
+	case "d":
+		// Show the diff of the highlighted or detailed commit
+		if m.activeSubmenu == CommitListMenu && m.submenuIndex < len(m.recentCommits) {
+			m.detailCommitIndex = m.submenuIndex
+			return m.openCommitDiff()
+		}
+		if m.activeSubmenu == CommitDetailMenu && m.detailCommitIndex < len(m.recentCommits) {
+			return m.openCommitDiff()
+		}
+		// Ask before removing the highlighted remote
+		if m.activeSubmenu == RemoteListMenu && m.submenuIndex < len(m.remotes) {
+			m.removingRemote = true
+			m.remoteError = ""
+		}
+
+	case "v":
+		// Ask before reverting the highlighted commit
+		if m.activeSubmenu == CommitListMenu && m.submenuIndex < len(m.recentCommits) {
+			m.revertConfirm = true
+			m.revertError = ""
+		}
+
+	case "a":
+		if m.activeSubmenu == RemoteListMenu {
+			return m.openRemoteForm(""), textinput.Blink
+		}
+
+	case "b":
+		// Show who last changed each line of the highlighted file
+		if m.activeSubmenu == QuickStatusMenu && m.repo != nil && m.submenuIndex < len(m.repo.Changes()) {
+			return m.openBlame(m.repo.Changes()[m.submenuIndex].Path)
+		}
 	}
 
 	return m, nil
 }
 
-// handleCardActivation opens submenu or performs action when card is selected
-func (m DashboardModel) handleCardActivation() (tea.Model, tea.Cmd) {
-	m.submenuIndex = 0
-	m.submenuScrollOffset = 0
+// handleRevertConfirmKey answers the revert prompt: y reverts and commits,
+// s only stages the revert, anything else cancels
+func (m DashboardModel) handleRevertConfirmKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.reverting {
+		return m, nil
+	}
 
-	switch m.selectedCard {
-	case 0: // Repository Status - show repository details menu
-		m.activeSubmenu = RepositoryDetailsMenu
+	m.revertConfirm = false
+	if m.submenuIndex >= len(m.recentCommits) {
+		return m, nil
+	}
+	commit := m.recentCommits[m.submenuIndex]
 
-	case 1: // AI Commit - show commit options
-		m.activeSubmenu = CommitOptionsMenu
+	switch msg.String() {
+	case "y":
+		m.reverting = true
+		return m, revertCommit(m.gitOps, m.repoPath, commit, false)
+	case "s":
+		m.reverting = true
+		return m, revertCommit(m.gitOps, m.repoPath, commit, true)
+	}
+	return m, nil
+}
 
-	case 2: // AI Merge - show merge options
-		m.activeSubmenu = MergeOptionsMenu
+// handleCommitReverted reports a revert and refreshes the dashboard so the
+// revert commit (or the conflict) shows up
+func (m DashboardModel) handleCommitReverted(msg commitRevertedMsg) (tea.Model, tea.Cmd) {
+	m.reverting = false
+	refresh := tea.Batch(
+		fetchRepoStatus(m.gitOps, m.repoPath),
+		fetchRecentCommits(m.gitOps, m.repoPath),
+	)
 
-	case 3: // Recent Commits - show commit list
+	short := msg.commit.Hash[:7]
+	if msg.err != nil {
+		var conflict *git.RevertConflictError
+		if errors.As(msg.err, &conflict) {
+			m.revertError = fmt.Sprintf("Reverting %s conflicts in %s. Resolve and run git revert --continue, or git revert --abort",
+				short, strings.Join(conflict.Files, ", "))
+			m.AddActivity(fmt.Sprintf("Revert of %s stopped on conflicts", short))
+			return m, refresh
+		}
+		m.revertError = msg.err.Error()
+		return m, nil
+	}
+
+	subject := truncate(msg.commit.Message, 50)
+	if msg.noCommit {
+		m.AddActivity(fmt.Sprintf("Staged the revert of %s %s", short, subject))
+	} else {
+		m.recordSessionEvent(sessionCommit, fmt.Sprintf("Reverted %s %s", short, subject))
+	}
+	m.revertError = ""
+	m.activeSubmenu = NoSubmenu
+	m.submenuIndex = 0
+	m.submenuScrollOffset = 0
+	return m, refresh
+}
+
+// closeSubmenu closes the active submenu. The commit detail returns to the
+// commit list with the same commit highlighted, the commit diff to where it
+// was opened from, and the blame to the status with the same file highlighted.
+func (m DashboardModel) closeSubmenu() DashboardModel {
+	if m.activeSubmenu == CommitDiffMenu {
+		m.commitDiff = nil
+		m.activeSubmenu = m.commitDiffFrom
+		if m.activeSubmenu == CommitListMenu {
+			m.submenuIndex = m.detailCommitIndex
+		}
+		return m
+	}
+	if m.activeSubmenu == CommitDetailMenu {
 		m.activeSubmenu = CommitListMenu
+		m.submenuIndex = m.detailCommitIndex
+		return m
+	}
+	if m.activeSubmenu == BlameMenu {
+		m.activeSubmenu = QuickStatusMenu
+		m.submenuIndex = m.blameFileIndex
+		m.submenuScrollOffset = 0
+		return m
+	}
 
-	case 4: // Branch Management - open full branch view
-		m.action = ActionManageBranches
-		m.activeSubmenu = NoSubmenu
+	m.activeSubmenu = NoSubmenu
+	m.submenuIndex = 0
+	m.submenuScrollOffset = 0
+	m.revertError = ""
+	m.remoteError = ""
+	return m
+}
 
-	case 5: // Quick Actions - show help
-		m.activeSubmenu = HelpMenu
+// openCommitDiff shows the diff of the commit at detailCommitIndex against
+// its parent, loading it in the background
+func (m DashboardModel) openCommitDiff() (tea.Model, tea.Cmd) {
+	width, height := 100, 18
+	if m.width > 0 {
+		width = max(m.width-8, 60)
+	}
+	if m.height > 0 {
+		height = max(m.height/2, height)
 	}
 
-	return m, nil
+	diff := NewCommitDiffViewModel(m.gitOps, m.repoPath, m.recentCommits[m.detailCommitIndex].Hash, width, height)
+	m.commitDiff = &diff
+	m.commitDiffFrom = m.activeSubmenu
+	m.activeSubmenu = CommitDiffMenu
+	return m, diff.Init()
 }
 
-// handleSubmenuSelection handles Enter key in submenus
-func (m DashboardModel) handleSubmenuSelection() (tea.Model, tea.Cmd) {
-	switch m.activeSubmenu {
-	case CommitOptionsMenu:
-		if m.submenuIndex == 0 {
-			// Execute commit
-			m.action = ActionCommit
-			m.actionParams["conventional"] = m.config.Commits.Convention == "conventional"
-			m.activeSubmenu = NoSubmenu
-			m.submenuIndex = 0
-			return m, nil
+// handleCommitDiffKey scrolls the commit diff; Enter, Esc or q closes it
+func (m DashboardModel) handleCommitDiffKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter", "esc", "q":
+		return m.closeSubmenu(), nil
+	}
+	if m.commitDiff == nil {
+		return m, nil
+	}
+	diff, cmd := m.commitDiff.Update(msg)
+	m.commitDiff = &diff
+	return m, cmd
+}
+
+// openBlame opens the blame of file, loading it in the background
+func (m DashboardModel) openBlame(file string) (tea.Model, tea.Cmd) {
+	m.blameFile = file
+	m.blameFileIndex = m.submenuIndex
+	m.blame = nil
+	m.blameLoaded = false
+	m.blameError = ""
+	m.activeSubmenu = BlameMenu
+	m.submenuIndex = 0
+	m.submenuScrollOffset = 0
+	return m, fetchBlame(m.gitOps, m.repoPath, file)
+}
+
+// openCreateTag opens the tag form with empty inputs and the name focused
+func (m DashboardModel) openCreateTag() DashboardModel {
+	m.tagNameInput = textinput.New()
+	m.tagNameInput.Placeholder = "v1.0.0"
+	m.tagNameInput.CharLimit = 100
+	m.tagNameInput.Focus()
+
+	m.tagMessageInput = textinput.New()
+	m.tagMessageInput.Placeholder = "Leave empty for a lightweight tag"
+	m.tagMessageInput.CharLimit = 200
+
+	m.tagError = ""
+	m.creatingTag = false
+	m.activeSubmenu = CreateTagMenu
+	m.submenuIndex = 0
+	return m
+}
+
+// handleCreateTagKey handles keyboard input in the tag form
+func (m DashboardModel) handleCreateTagKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.creatingTag {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		// Back to the repository details the form was opened from
+		m.activeSubmenu = RepositoryDetailsMenu
+		m.submenuIndex = 0
+		m.tagError = ""
+		return m, nil
+
+	case "tab", "shift+tab", "up", "down":
+		if m.tagNameInput.Focused() {
+			m.tagNameInput.Blur()
+			m.tagMessageInput.Focus()
+		} else {
+			m.tagMessageInput.Blur()
+			m.tagNameInput.Focus()
 		}
+		return m, textinput.Blink
 
-	case MergeOptionsMenu:
-		switch m.submenuIndex {
-		case 0:
-			// Execute merge
-			m.action = ActionMerge
-			m.actionParams["source"] = m.sourceBranch
-			m.actionParams["target"] = m.targetBranch
-			m.activeSubmenu = NoSubmenu
-			m.submenuIndex = 0
-			return m, nil
-		case 1:
-			// List pull requests
-			m.action = ActionListPRs
-			m.activeSubmenu = NoSubmenu
-			m.submenuIndex = 0
-			return m, nil
-		case 2:
-			// Create pull request
-			m.action = ActionCreatePR
-			m.activeSubmenu = NoSubmenu
-			m.submenuIndex = 0
+	case "enter":
+		name := strings.TrimSpace(m.tagNameInput.Value())
+		if name == "" {
+			m.tagError = "Tag name cannot be empty"
 			return m, nil
 		}
-
-	case BranchListMenu:
-		if m.submenuIndex < len(m.branches) {
-			// Switch to selected branch
-			m.action = ActionSwitchBranch
-			m.actionParams["branch"] = m.branches[m.submenuIndex]
-			m.activeSubmenu = NoSubmenu
-			m.submenuIndex = 0
+		if strings.ContainsAny(name, " \t") {
+			m.tagError = "Tag name cannot contain spaces"
 			return m, nil
 		}
+		m.tagError = ""
+		m.creatingTag = true
+		message := strings.TrimSpace(m.tagMessageInput.Value())
+		return m, createTag(m.gitOps, m.repoPath, name, message, m.config.Git.SignTags, m.config.Git.SigningKey)
+	}
 
-	case RepositoryDetailsMenu:
-		// Build the action list dynamically to match rendering
-		actionIndex := 0
-		if m.repo != nil && m.repo.HasRemote() {
-			// Fetch is always first
-			if actionIndex == m.submenuIndex {
-				m.action = ActionFetch
+	var cmd tea.Cmd
+	if m.tagNameInput.Focused() {
+		m.tagNameInput, cmd = m.tagNameInput.Update(msg)
+	} else {
+		m.tagMessageInput, cmd = m.tagMessageInput.Update(msg)
+	}
+	m.tagError = ""
+	return m, cmd
+}
+
+// openPathScope opens the path scope form with the current scope filled in
+func (m DashboardModel) openPathScope() DashboardModel {
+	m.pathScopeInput = textinput.New()
+	m.pathScopeInput.Placeholder = "services/api (empty for the whole repository)"
+	m.pathScopeInput.CharLimit = 200
+	m.pathScopeInput.SetValue(m.PathScope())
+	m.pathScopeInput.Focus()
+
+	m.pathScopeError = ""
+	m.activeSubmenu = PathScopeMenu
+	m.submenuIndex = 0
+	return m
+}
+
+// handlePathScopeKey handles keyboard input in the path scope form
+func (m DashboardModel) handlePathScopeKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		// Back to the commit options the form was opened from
+		m.activeSubmenu = CommitOptionsMenu
+		m.pathScopeError = ""
+		return m, nil
+
+	case "enter":
+		scoper, ok := m.gitOps.(pathScoper)
+		if !ok {
+			return m, nil
+		}
+		path := strings.TrimSpace(m.pathScopeInput.Value())
+		if path != "" {
+			if _, err := os.Stat(filepath.Join(m.repoPath, path)); err != nil {
+				m.pathScopeError = fmt.Sprintf("%s does not exist in the repository", path)
+				return m, nil
+			}
+		}
+
+		scoper.SetPathScope(path)
+		if scope := scoper.PathScope(); scope != "" {
+			m.AddActivity(fmt.Sprintf("Analysis limited to %s", scope))
+		} else {
+			m.AddActivity("Analysis covers the whole repository")
+		}
+		m.activeSubmenu = CommitOptionsMenu
+		m.pathScopeError = ""
+		// The status card shows only the changes in scope
+		return m, fetchRepoStatus(m.gitOps, m.repoPath)
+	}
+
+	var cmd tea.Cmd
+	m.pathScopeInput, cmd = m.pathScopeInput.Update(msg)
+	m.pathScopeError = ""
+	return m, cmd
+}
+
+// PathScope returns the subtree analysis is limited to, or "" for the whole repository
+func (m DashboardModel) PathScope() string {
+	if scoper, ok := m.gitOps.(pathScoper); ok {
+		return scoper.PathScope()
+	}
+	return ""
+}
+
+// openRecoverBranch opens the form naming a branch at the highlighted reflog entry
+func (m DashboardModel) openRecoverBranch() DashboardModel {
+	entry := m.reflog[m.submenuIndex]
+	m.reflogIndex = m.submenuIndex
+	m.recoverEntry = entry
+
+	m.recoverBranchInput = textinput.New()
+	m.recoverBranchInput.Placeholder = "recovered/" + entry.Hash[:7]
+	m.recoverBranchInput.CharLimit = 100
+	m.recoverBranchInput.Focus()
+
+	m.recoverError = ""
+	m.recovering = false
+	m.activeSubmenu = RecoverBranchMenu
+	m.submenuIndex = 0
+	return m
+}
+
+// handleRecoverBranchKey handles keyboard input in the recovery branch form.
+// An empty name takes the suggested one.
+func (m DashboardModel) handleRecoverBranchKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.recovering {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		// Back to the reflog with the same entry highlighted
+		m.activeSubmenu = ReflogMenu
+		m.submenuIndex = m.reflogIndex
+		m.recoverError = ""
+		return m, nil
+
+	case "enter":
+		name := strings.TrimSpace(m.recoverBranchInput.Value())
+		if name == "" {
+			name = m.recoverBranchInput.Placeholder
+		}
+		if strings.ContainsAny(name, " \t") {
+			m.recoverError = "Branch name cannot contain spaces"
+			return m, nil
+		}
+		m.recoverError = ""
+		m.recovering = true
+		return m, recoverBranch(m.gitOps, m.repoPath, name, m.recoverEntry)
+	}
+
+	var cmd tea.Cmd
+	m.recoverBranchInput, cmd = m.recoverBranchInput.Update(msg)
+	m.recoverError = ""
+	return m, cmd
+}
+
+// handleRemoveRemoteKey answers the remove prompt: y removes the highlighted
+// remote, anything else cancels
+func (m DashboardModel) handleRemoveRemoteKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.remoteBusy {
+		return m, nil
+	}
+
+	m.removingRemote = false
+	if msg.String() == "y" && m.submenuIndex < len(m.remotes) {
+		m.remoteBusy = true
+		return m, removeRemote(m.gitOps, m.repoPath, m.remotes[m.submenuIndex].Name)
+	}
+	return m, nil
+}
+
+// openRemoteForm opens the form adding a remote, or renaming the remote
+// called rename when set
+func (m DashboardModel) openRemoteForm(rename string) DashboardModel {
+	m.remoteNameInput = textinput.New()
+	m.remoteNameInput.Placeholder = "upstream"
+	m.remoteNameInput.CharLimit = 100
+	m.remoteNameInput.SetValue(rename)
+	m.remoteNameInput.Focus()
+
+	m.remoteURLInput = textinput.New()
+	m.remoteURLInput.Placeholder = "git@github.com:owner/repo.git"
+	m.remoteURLInput.CharLimit = 300
+
+	m.renamingRemote = rename
+	m.remoteError = ""
+	m.remoteBusy = false
+	m.activeSubmenu = RemoteFormMenu
+	return m
+}
+
+// handleRemoteFormKey handles keyboard input in the add or rename remote form
+func (m DashboardModel) handleRemoteFormKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.remoteBusy {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		// Back to the remote list
+		m.activeSubmenu = RemoteListMenu
+		m.remoteError = ""
+		return m, nil
+
+	case "tab", "shift+tab", "up", "down":
+		// Renaming only takes the new name
+		if m.renamingRemote != "" {
+			return m, nil
+		}
+		if m.remoteNameInput.Focused() {
+			m.remoteNameInput.Blur()
+			m.remoteURLInput.Focus()
+		} else {
+			m.remoteURLInput.Blur()
+			m.remoteNameInput.Focus()
+		}
+		return m, textinput.Blink
+
+	case "enter":
+		name := strings.TrimSpace(m.remoteNameInput.Value())
+		if name == "" {
+			m.remoteError = "Remote name cannot be empty"
+			return m, nil
+		}
+		if m.renamingRemote != "" {
+			if name == m.renamingRemote {
+				m.activeSubmenu = RemoteListMenu
+				return m, nil
+			}
+			m.remoteError = ""
+			m.remoteBusy = true
+			return m, renameRemote(m.gitOps, m.repoPath, m.renamingRemote, name)
+		}
+		url := strings.TrimSpace(m.remoteURLInput.Value())
+		if url == "" {
+			m.remoteError = "Remote URL cannot be empty"
+			return m, nil
+		}
+		m.remoteError = ""
+		m.remoteBusy = true
+		return m, addRemote(m.gitOps, m.repoPath, name, url)
+	}
+
+	var cmd tea.Cmd
+	if m.remoteNameInput.Focused() {
+		m.remoteNameInput, cmd = m.remoteNameInput.Update(msg)
+	} else {
+		m.remoteURLInput, cmd = m.remoteURLInput.Update(msg)
+	}
+	m.remoteError = ""
+	return m, cmd
+}
+
+// CapturingInput reports whether a submenu is taking text, so global
+// shortcuts should leave keys alone
+func (m DashboardModel) CapturingInput() bool {
+	return m.activeSubmenu == CreateTagMenu || m.activeSubmenu == PathScopeMenu || m.activeSubmenu == RecoverBranchMenu ||
+		m.activeSubmenu == RemoteFormMenu
+}
+
+// handleCardActivation opens submenu or performs action when card is selected
+func (m DashboardModel) handleCardActivation() (tea.Model, tea.Cmd) {
+	m.submenuIndex = 0
+	m.submenuScrollOffset = 0
+
+	switch m.selectedCard {
+	case 0: // Repository Status - show repository details menu
+		m.activeSubmenu = RepositoryDetailsMenu
+		cmds := []tea.Cmd{fetchObjectStats(m.gitOps, m.repoPath)}
+		if m.repo != nil && m.repo.IsGitHubRemote() && !m.ghAuthChecked {
+			cmds = append(cmds, fetchGHAuthStatus())
+		}
+		return m, tea.Batch(cmds...)
+
+	case 1: // AI Commit - show commit options
+		m.activeSubmenu = CommitOptionsMenu
+		// Preselect the last analysis mode
+		if m.config.Commits.AnalyzeStaged {
+			m.submenuIndex = 1
+		}
+
+	case 2: // AI Merge - show merge options
+		m.activeSubmenu = MergeOptionsMenu
+
+	case 3: // Recent Commits - show commit list
+		m.activeSubmenu = CommitListMenu
+
+	case 4: // Branch Management - open full branch view
+		m.action = ActionManageBranches
+		m.activeSubmenu = NoSubmenu
+
+	case 5: // Quick Actions - show help
+		m.activeSubmenu = HelpMenu
+	}
+
+	return m, nil
+}
+
+// handleSubmenuSelection handles Enter key in submenus
+func (m DashboardModel) handleSubmenuSelection() (tea.Model, tea.Cmd) {
+	if m.activeSubmenu.IsReadOnly() {
+		return m.closeSubmenu(), nil
+	}
+
+	switch m.activeSubmenu {
+	case CommitOptionsMenu:
+		// 0: analyze all changes (stages everything), 1: analyze staged only,
+		// 2: analyze what changed since the last analysis
+		m.action = ActionCommit
+		m.actionParams["conventional"] = m.config.UsesCommitTypes()
+		m.actionParams["staged_only"] = m.submenuIndex == 1
+		m.actionParams["delta_only"] = m.submenuIndex == 2
+		m.activeSubmenu = NoSubmenu
+		m.submenuIndex = 0
+		return m, nil
+
+	case MergeOptionsMenu:
+		switch m.submenuIndex {
+		case 0:
+			// Execute merge
+			m.action = ActionMerge
+			m.actionParams["source"] = m.sourceBranch
+			m.actionParams["target"] = m.targetBranch
+			m.activeSubmenu = NoSubmenu
+			m.submenuIndex = 0
+			return m, nil
+		case 1:
+			// List pull requests
+			m.action = ActionListPRs
+			m.activeSubmenu = NoSubmenu
+			m.submenuIndex = 0
+			return m, nil
+		case 2:
+			// Create pull request, once the branch can be proposed
+			if m.pullRequestBlocker() != "" {
+				return m, nil
+			}
+			m.action = ActionCreatePR
+			m.activeSubmenu = NoSubmenu
+			m.submenuIndex = 0
+			return m, nil
+		case 3:
+			// Rebase onto parent
+			m.action = ActionRebase
+			m.activeSubmenu = NoSubmenu
+			m.submenuIndex = 0
+			return m, nil
+		case 4:
+			// Pick the branch to merge into
+			var targets []string
+			for _, branch := range m.branches {
+				if m.repo == nil || branch != m.repo.CurrentBranch() {
+					targets = append(targets, branch)
+				}
+			}
+			m.mergeTargets = targets
+			m.activeSubmenu = MergeTargetMenu
+			m.submenuIndex = 0
+			m.submenuScrollOffset = 0
+			return m, nil
+		case 5:
+			// Summarize the whole branch as a pull request
+			m.action = ActionSummarizeBranch
+			m.activeSubmenu = NoSubmenu
+			m.submenuIndex = 0
+			return m, nil
+		}
+
+	case MergeTargetMenu:
+		if m.submenuIndex < len(m.mergeTargets) {
+			// Merge the current branch into the selected one
+			m.action = ActionMerge
+			m.actionParams["target"] = m.mergeTargets[m.submenuIndex]
+			m.activeSubmenu = NoSubmenu
+			m.submenuIndex = 0
+			return m, nil
+		}
+
+	case PushRemoteMenu:
+		if m.submenuIndex < len(m.pushRemotes) {
+			m.action = ActionPush
+			m.actionParams["remote"] = m.pushRemotes[m.submenuIndex]
+			m.activeSubmenu = NoSubmenu
+			m.submenuIndex = 0
+			return m, nil
+		}
+
+	case BranchListMenu:
+		if m.submenuIndex < len(m.branches) {
+			// Switch to selected branch
+			m.action = ActionSwitchBranch
+			m.actionParams["branch"] = m.branches[m.submenuIndex]
+			m.activeSubmenu = NoSubmenu
+			m.submenuIndex = 0
+			return m, nil
+		}
+
+	case RepositoryDetailsMenu:
+		// Build the action list dynamically to match rendering
+		actionIndex := 0
+		if m.repo != nil && m.repo.HasRemote() {
+			// Fetch is always first
+			if actionIndex == m.submenuIndex {
+				m.action = ActionFetch
 				m.activeSubmenu = NoSubmenu
 				return m, nil
 			}
 			actionIndex++
 
+			// Fetch full history if shallow
+			if m.repo.IsShallow() {
+				if actionIndex == m.submenuIndex {
+					m.action = ActionUnshallow
+					m.activeSubmenu = NoSubmenu
+					return m, nil
+				}
+				actionIndex++
+			}
+
 			// Pull if behind
 			if m.repo.CommitsBehind() > 0 {
 				if actionIndex == m.submenuIndex {
@@ -338,18 +1314,26 @@ func (m DashboardModel) handleSubmenuSelection() (tea.Model, tea.Cmd) {
 				actionIndex++
 			}
 
-			// Push if ahead
+			// Push if ahead, asking which remote when there are several
 			if m.repo.CommitsAhead() > 0 {
 				if actionIndex == m.submenuIndex {
-					m.action = ActionPush
-					m.activeSubmenu = NoSubmenu
-					return m, nil
+					return m, fetchPushRemotes(m.gitOps, m.repoPath)
 				}
 				actionIndex++
 			}
 
 			// GitHub actions if GitHub remote
 			if m.repo.IsGitHubRemote() {
+				// Login with gh if it isn't authenticated
+				if m.needsGHLogin() {
+					if actionIndex == m.submenuIndex {
+						m.action = ActionLoginGH
+						m.activeSubmenu = NoSubmenu
+						return m, nil
+					}
+					actionIndex++
+				}
+
 				// View on GitHub (web)
 				if actionIndex == m.submenuIndex {
 					m.action = ActionViewGitHub
@@ -376,6 +1360,51 @@ func (m DashboardModel) handleSubmenuSelection() (tea.Model, tea.Cmd) {
 			actionIndex++
 		}
 
+		// Manage remotes
+		if actionIndex == m.submenuIndex {
+			m.activeSubmenu = RemoteListMenu
+			m.submenuIndex = 0
+			m.submenuScrollOffset = 0
+			m.remotesLoaded = false
+			m.remoteError = ""
+			return m, fetchRemotes(m.gitOps, m.repoPath)
+		}
+		actionIndex++
+
+		// View tags
+		if actionIndex == m.submenuIndex {
+			m.activeSubmenu = TagListMenu
+			m.submenuIndex = 0
+			m.submenuScrollOffset = 0
+			m.tagsLoaded = false
+			return m, fetchTags(m.gitOps, m.repoPath)
+		}
+		actionIndex++
+
+		// Create tag
+		if actionIndex == m.submenuIndex {
+			return m.openCreateTag(), textinput.Blink
+		}
+		actionIndex++
+
+		// Recover lost commits from the reflog
+		if actionIndex == m.submenuIndex {
+			m.activeSubmenu = ReflogMenu
+			m.submenuIndex = 0
+			m.submenuScrollOffset = 0
+			m.reflogLoaded = false
+			return m, fetchReflog(m.gitOps, m.repoPath)
+		}
+		actionIndex++
+
+		// Optimize the object database
+		if actionIndex == m.submenuIndex {
+			m.action = ActionMaintenance
+			m.activeSubmenu = NoSubmenu
+			return m, nil
+		}
+		actionIndex++
+
 		// Refresh is always last
 		if actionIndex == m.submenuIndex {
 			m.action = ActionRefresh
@@ -383,10 +1412,32 @@ func (m DashboardModel) handleSubmenuSelection() (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
-	case QuickStatusMenu, CommitListMenu, HelpMenu:
-		// These are read-only, just close on enter
-		m.activeSubmenu = NoSubmenu
-		m.submenuIndex = 0
+	case CommitListMenu:
+		// Open the highlighted commit's detail
+		if m.submenuIndex < len(m.recentCommits) {
+			m.detailCommitIndex = m.submenuIndex
+			m.activeSubmenu = CommitDetailMenu
+		}
+
+	case TagListMenu:
+		// Verify the highlighted tag's signature
+		if m.submenuIndex < len(m.tags) && m.verifyingTag == "" {
+			tag := m.tags[m.submenuIndex]
+			m.verifyingTag = tag
+			return m, verifyTag(m.gitOps, m.repoPath, tag)
+		}
+
+	case ReflogMenu:
+		// Name a branch at the highlighted entry
+		if m.submenuIndex < len(m.reflog) {
+			return m.openRecoverBranch(), textinput.Blink
+		}
+
+	case RemoteListMenu:
+		// Rename the highlighted remote
+		if m.submenuIndex < len(m.remotes) {
+			return m.openRemoteForm(m.remotes[m.submenuIndex].Name), textinput.Blink
+		}
 	}
 
 	return m, nil
@@ -396,22 +1447,46 @@ func (m DashboardModel) handleSubmenuSelection() (tea.Model, tea.Cmd) {
 func (m DashboardModel) getSubmenuMaxIndex() int {
 	switch m.activeSubmenu {
 	case CommitOptionsMenu:
-		return 0 // 1 option: execute
+		if m.hasPriorAnalysis {
+			return 2 // Also: analyze changes since last analysis
+		}
+		return 1 // 2 options: analyze all changes, analyze staged only
 	case MergeOptionsMenu:
-		return 2 // 3 options: merge, list PRs, create PR
+		return 5 // 6 options: merge, list PRs, create PR, rebase onto parent, merge into..., summarize branch
 	case CommitListMenu:
 		return len(m.recentCommits) - 1
 	case BranchListMenu:
 		return len(m.branches) - 1
 	case QuickStatusMenu:
-		return 0 // Read-only
+		// Read-only, but a listed file can be highlighted to blame it
+		if m.repo == nil {
+			return 0
+		}
+		return min(len(m.repo.Changes()), quickStatusMaxFiles) - 1
+	case BlameMenu:
+		return len(m.blame) - 1
 	case HelpMenu:
 		return 0 // Read-only
+	case CommitDetailMenu:
+		return 0 // Read-only
+	case TagListMenu:
+		return len(m.tags) - 1
+	case ReflogMenu:
+		return len(m.reflog) - 1
+	case MergeTargetMenu:
+		return len(m.mergeTargets) - 1
+	case PushRemoteMenu:
+		return len(m.pushRemotes) - 1
+	case RemoteListMenu:
+		return len(m.remotes) - 1
 	case RepositoryDetailsMenu:
 		// Count available actions dynamically
 		count := 0
 		if m.repo != nil && m.repo.HasRemote() {
 			count++ // Fetch
+			if m.repo.IsShallow() {
+				count++ // Fetch full history
+			}
 			if m.repo.CommitsBehind() > 0 {
 				count++ // Pull
 			}
@@ -420,10 +1495,18 @@ func (m DashboardModel) getSubmenuMaxIndex() int {
 			}
 			if m.repo.IsGitHubRemote() {
 				count += 2 // View on GitHub + Show GitHub info
+				if m.needsGHLogin() {
+					count++ // Login with gh
+				}
 			}
 		} else {
 			count++ // Setup remote
 		}
+		count++          // Manage remotes
+		count++          // View tags
+		count++          // Create tag
+		count++          // Recover from reflog
+		count++          // Optimize repository
 		count++          // Refresh
 		return count - 1 // Return max index (count - 1)
 	}
@@ -499,6 +1582,15 @@ func (m DashboardModel) renderHeader() string {
 			lipgloss.NewStyle().Foreground(statusColor).Render(statusText))
 
 		infoLines = append(infoLines, branchLine)
+
+		// Line 4: The parent repository, when this is a submodule
+		if m.repo.IsSubmodule() {
+			superproject := m.repo.Superproject()
+			if len(superproject) > 37 {
+				superproject = "..." + superproject[len(superproject)-34:]
+			}
+			infoLines = append(infoLines, styles.StatusWarning.Render("Submodule of "+superproject))
+		}
 	} else {
 		infoLines = append(infoLines, "")
 	}
@@ -522,14 +1614,65 @@ func (m DashboardModel) renderHeader() string {
 	return header
 }
 
-// relativeTime returns a human-readable relative time string
-func relativeTime(tStr string) string {
-	t, err := time.Parse(time.RFC3339, tStr)
-	if err != nil {
-		return tStr
+// commitTimeLayouts are the date formats git prints: strict ISO 8601 (%aI)
+// and --date=iso
+var commitTimeLayouts = []string{time.RFC3339, "2006-01-02 15:04:05 -0700"}
+
+// formatCommitTime renders a commit date from git in the given
+// domain.TimeFormat* format, relative to now: "2h ago", "2024-05-01 14:03"
+// in the commit's own time zone, or "2h ago (2024-05-01 14:03)". A date that
+// can't be parsed has no relative form and is shown as git gave it.
+func formatCommitTime(date, format string, now time.Time) string {
+	date = strings.TrimSpace(date)
+	var t time.Time
+	parsed := false
+	for _, layout := range commitTimeLayouts {
+		if parsedTime, err := time.Parse(layout, date); err == nil {
+			t, parsed = parsedTime, true
+			break
+		}
+	}
+	if !parsed {
+		return date
+	}
+
+	absolute := t.Format("2006-01-02 15:04")
+	switch format {
+	case domain.TimeFormatAbsolute:
+		return absolute
+	case domain.TimeFormatBoth:
+		return fmt.Sprintf("%s (%s)", relativeTime(t, now), absolute)
+	default:
+		return relativeTime(t, now)
+	}
+}
+
+// nextTimeFormat cycles relative → absolute → both
+func nextTimeFormat(format string) string {
+	switch format {
+	case domain.TimeFormatAbsolute:
+		return domain.TimeFormatBoth
+	case domain.TimeFormatBoth:
+		return domain.TimeFormatRelative
+	default:
+		return domain.TimeFormatAbsolute
 	}
+}
+
+// timeFormat returns how commit times are shown: as toggled with "t", or as configured
+func (m DashboardModel) timeFormat() string {
+	if m.commitTimeFormat != "" {
+		return m.commitTimeFormat
+	}
+	if m.config != nil {
+		return m.config.UI.TimeFormat
+	}
+	return domain.TimeFormatRelative
+}
 
-	diff := time.Since(t)
+// relativeTime returns a human-readable relative time string
+func relativeTime(t, now time.Time) string {
+	diff := now.Sub(t)
 
 	if diff < time.Minute {
 		return "just now"
@@ -686,6 +1829,11 @@ func (m DashboardModel) renderRepoStatusCard() string {
 		lines = append(lines, fmt.Sprintf("%s %s",
 			lipgloss.NewStyle().Foreground(styles.ColorPrimary).Render(icon),
 			lipgloss.NewStyle().Foreground(statusColor).Render(syncStatus)))
+		if m.repo.IsShallow() {
+			lines = append(lines, fmt.Sprintf("%s %s",
+				styles.StatusWarning.Render("!"),
+				"Shallow clone, history is limited"))
+		}
 	} else {
 		lines = append(lines, fmt.Sprintf("%s %s",
 			lipgloss.NewStyle().Foreground(styles.ColorMuted).Render("∅"),
@@ -772,12 +1920,9 @@ func (m DashboardModel) renderCommitsCard() string {
 	for i := 0; i < maxCommits; i++ {
 		commit := m.recentCommits[i]
 		hash := styles.StatusInfo.Render(commit.Hash[:7])
-		msg := commit.Message
-		if len(msg) > 20 {
-			msg = msg[:17] + "..."
-		}
+		msg := truncate(commit.Message, 20)
 
-		timeStr := relativeTime(commit.Date)
+		timeStr := formatCommitTime(commit.Date, m.timeFormat(), time.Now())
 
 		lines = append(lines, fmt.Sprintf("%s %s", hash, msg))
 		lines = append(lines, lipgloss.NewStyle().Foreground(styles.ColorMuted).Render("  "+timeStr))
@@ -854,6 +1999,30 @@ func (m DashboardModel) renderSubmenu() string {
 		content = m.renderHelpMenu()
 	case RepositoryDetailsMenu:
 		content = m.renderRepositoryDetailsMenu()
+	case CommitDetailMenu:
+		content = m.renderCommitDetailMenu()
+	case TagListMenu:
+		content = m.renderTagListMenu()
+	case CreateTagMenu:
+		content = m.renderCreateTagMenu()
+	case PathScopeMenu:
+		content = m.renderPathScopeMenu()
+	case ReflogMenu:
+		content = m.renderReflogMenu()
+	case RecoverBranchMenu:
+		content = m.renderRecoverBranchMenu()
+	case MergeTargetMenu:
+		content = m.renderMergeTargetMenu()
+	case PushRemoteMenu:
+		content = m.renderPushRemoteMenu()
+	case BlameMenu:
+		content = m.renderBlameMenu()
+	case CommitDiffMenu:
+		content = m.renderCommitDiffMenu()
+	case RemoteListMenu:
+		content = m.renderRemoteListMenu()
+	case RemoteFormMenu:
+		content = m.renderRemoteFormMenu()
 	}
 
 	styles := GetGlobalThemeManager().GetStyles()
@@ -869,28 +2038,80 @@ func (m DashboardModel) renderCommitOptionsMenu() string {
 
 	// Show current mode (informational)
 	mode := "Standard"
-	if m.config.Commits.Convention == "conventional" {
+	switch m.config.Commits.Convention {
+	case "conventional":
 		mode = "Conventional"
+	case "gitmoji":
+		mode = "Gitmoji"
 	}
 	info := fmt.Sprintf("Format: %s (configured in settings)", mode)
 	lines = append(lines, styles.Description.Render(info))
+	if scope := m.PathScope(); scope != "" {
+		lines = append(lines, styles.Description.Render("Path: only changes under "+scope))
+	}
 	lines = append(lines, "")
 
-	// Option 0: Execute
-	opt0 := "  Analyze and commit"
+	// Option 0: Analyze everything (stages all changes on commit)
+	opt0 := "  Analyze all changes"
 	if m.submenuIndex == 0 {
-		opt0 = styles.SubmenuOptionActive.Render("> " + styles.StatusInfo.Render("Analyze and commit"))
+		opt0 = styles.SubmenuOptionActive.Render("> " + styles.StatusInfo.Render("Analyze all changes"))
 	} else {
 		opt0 = styles.SubmenuOption.Render(opt0)
 	}
 	lines = append(lines, opt0)
+	lines = append(lines, styles.Metadata.Render("    Stages and commits every change in the working tree"))
+
+	// Option 1: Analyze only what is already staged
+	opt1 := "  Analyze staged only"
+	if m.submenuIndex == 1 {
+		opt1 = styles.SubmenuOptionActive.Render("> " + styles.StatusInfo.Render("Analyze staged only"))
+	} else {
+		opt1 = styles.SubmenuOption.Render(opt1)
+	}
+	lines = append(lines, opt1)
+	lines = append(lines, styles.Metadata.Render("    Commits the index as-is; unstaged changes stay put"))
+
+	// Option 2: Re-analyze only what changed since the last analysis
+	if m.hasPriorAnalysis {
+		opt2 := "  Analyze changes since last analysis"
+		if m.submenuIndex == 2 {
+			opt2 = styles.SubmenuOptionActive.Render("> " + styles.StatusInfo.Render("Analyze changes since last analysis"))
+		} else {
+			opt2 = styles.SubmenuOption.Render(opt2)
+		}
+		lines = append(lines, opt2)
+		lines = append(lines, styles.Metadata.Render("    Describes and selects only the files edited since then"))
+	}
 
 	lines = append(lines, "")
-	lines = append(lines, styles.ShortcutDesc.Render("Enter: select  •  Esc: cancel"))
+	if _, ok := m.gitOps.(pathScoper); ok {
+		lines = append(lines, styles.ShortcutDesc.Render("Enter: select  •  p: limit to a path  •  Esc: cancel"))
+	} else {
+		lines = append(lines, styles.ShortcutDesc.Render("Enter: select  •  Esc: cancel"))
+	}
 
 	return strings.Join(lines, "\n")
 }
 
+// pullRequestBlocker says why the current branch can't be proposed in a pull
+// request, or returns "" if it can: gh needs a GitHub remote and a pushed,
+// non-protected branch
+func (m DashboardModel) pullRequestBlocker() string {
+	if m.repo != nil && !m.repo.IsGitHubRemote() {
+		return "needs a GitHub remote"
+	}
+	if m.branchInfo == nil {
+		return ""
+	}
+	if m.branchInfo.IsProtected() || m.config.IsProtectedBranch(m.branchInfo.Name()) {
+		return "protected branch"
+	}
+	if m.branchInfo.Upstream() == "" {
+		return "push the branch first"
+	}
+	return ""
+}
+
 // renderMergeOptionsMenu renders merge options submenu
 func (m DashboardModel) renderMergeOptionsMenu() string {
 	styles := GetGlobalThemeManager().GetStyles()
@@ -916,21 +2137,121 @@ func (m DashboardModel) renderMergeOptionsMenu() string {
 	}
 	lines = append(lines, opt1)
 
-	// Option 2: Create pull request
+	// Option 2: Create pull request, with why it's unavailable if it is
 	opt2 := "  Create pull request"
 	if m.submenuIndex == 2 {
 		opt2 = styles.SubmenuOptionActive.Render("> " + styles.StatusInfo.Render("Create pull request"))
 	} else {
 		opt2 = styles.SubmenuOption.Render(opt2)
 	}
+	if blocker := m.pullRequestBlocker(); blocker != "" {
+		opt2 += " " + lipgloss.NewStyle().Foreground(styles.ColorMuted).Render("("+blocker+")")
+	}
 	lines = append(lines, opt2)
 
+	// Option 3: Rebase onto parent
+	rebaseLabel := "Rebase onto parent"
+	if m.branchInfo != nil && m.branchInfo.Parent() != "" {
+		rebaseLabel = "Rebase onto " + m.branchInfo.Parent()
+	}
+	opt3 := "  " + rebaseLabel
+	if m.submenuIndex == 3 {
+		opt3 = styles.SubmenuOptionActive.Render("> " + styles.StatusInfo.Render(rebaseLabel))
+	} else {
+		opt3 = styles.SubmenuOption.Render(opt3)
+	}
+	lines = append(lines, opt3)
+
+	// Option 4: Merge into a branch other than the detected target
+	opt4 := "  Merge into..."
+	if m.submenuIndex == 4 {
+		opt4 = styles.SubmenuOptionActive.Render("> " + styles.StatusInfo.Render("Merge into..."))
+	} else {
+		opt4 = styles.SubmenuOption.Render(opt4)
+	}
+	lines = append(lines, opt4)
+
+	// Option 5: Summarize the branch for a pull request
+	opt5 := "  Summarize branch for PR"
+	if m.submenuIndex == 5 {
+		opt5 = styles.SubmenuOptionActive.Render("> " + styles.StatusInfo.Render("Summarize branch for PR"))
+	} else {
+		opt5 = styles.SubmenuOption.Render(opt5)
+	}
+	lines = append(lines, opt5)
+
 	lines = append(lines, "")
 	lines = append(lines, styles.ShortcutDesc.Render("Enter: select  •  Esc: cancel"))
 
 	return strings.Join(lines, "\n")
 }
 
+// renderPushRemoteMenu renders the remotes the current branch can be pushed to
+func (m DashboardModel) renderPushRemoteMenu() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	var lines []string
+	lines = append(lines, styles.CardTitle.Render("Push To"))
+	lines = append(lines, "")
+
+	for i, remote := range m.pushRemotes {
+		if i == m.submenuIndex {
+			lines = append(lines, styles.SubmenuOptionActive.Render("> "+remote))
+		} else {
+			lines = append(lines, styles.SubmenuOption.Render("  "+remote))
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, styles.ShortcutDesc.Render("↑/↓: navigate  •  Enter: push  •  Esc: cancel"))
+
+	return strings.Join(lines, "\n")
+}
+
+// renderMergeTargetMenu renders the branches the current branch can be merged into
+func (m DashboardModel) renderMergeTargetMenu() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	var lines []string
+	lines = append(lines, styles.CardTitle.Render("Merge Into"))
+	lines = append(lines, "")
+
+	if len(m.mergeTargets) == 0 {
+		lines = append(lines, styles.SubmenuOption.Render("No other branches"))
+	} else {
+		visibleHeight := 10
+		start := m.submenuScrollOffset
+		end := start + visibleHeight
+		if end > len(m.mergeTargets) {
+			end = len(m.mergeTargets)
+		}
+
+		if start > 0 {
+			lines = append(lines, styles.SubmenuOption.Render(fmt.Sprintf("  ... %d more above", start)))
+		}
+
+		for i := start; i < end; i++ {
+			line := m.mergeTargets[i]
+			if m.branchInfo != nil && line == m.branchInfo.Parent() {
+				line += styles.ShortcutDesc.Render("  (parent)")
+			}
+			if i == m.submenuIndex {
+				line = styles.SubmenuOptionActive.Render("> " + line)
+			} else {
+				line = styles.SubmenuOption.Render("  " + line)
+			}
+			lines = append(lines, line)
+		}
+
+		if end < len(m.mergeTargets) {
+			lines = append(lines, styles.SubmenuOption.Render(fmt.Sprintf("  ... %d more below", len(m.mergeTargets)-end)))
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, styles.ShortcutDesc.Render("↑/↓: navigate  •  Enter: merge  •  Esc: cancel"))
+
+	return strings.Join(lines, "\n")
+}
+
 // renderCommitListMenu renders scrollable commit list
 func (m DashboardModel) renderCommitListMenu() string {
 	styles := GetGlobalThemeManager().GetStyles()
@@ -955,10 +2276,7 @@ func (m DashboardModel) renderCommitListMenu() string {
 		for i := start; i < end; i++ {
 			commit := m.recentCommits[i]
 			hash := styles.StatusInfo.Render(commit.Hash[:7])
-			msg := commit.Message
-			if len(msg) > 50 {
-				msg = msg[:47] + "..."
-			}
+			msg := truncate(commit.Message, 50)
 
 			line := fmt.Sprintf("%s  %s", hash, msg)
 			if i == m.submenuIndex {
@@ -966,7 +2284,7 @@ func (m DashboardModel) renderCommitListMenu() string {
 			} else {
 				line = styles.SubmenuOption.Render("  " + line)
 			}
-			lines = append(lines, line)
+			lines = append(lines, line+styles.Metadata.Render("  "+formatCommitTime(commit.Date, m.timeFormat(), time.Now())))
 		}
 
 		if end < len(m.recentCommits) {
@@ -975,11 +2293,63 @@ func (m DashboardModel) renderCommitListMenu() string {
 	}
 
 	lines = append(lines, "")
-	lines = append(lines, styles.ShortcutDesc.Render("↑/↓: navigate  •  Esc: close"))
+	switch {
+	case m.reverting:
+		lines = append(lines, styles.Metadata.Render("Reverting..."))
+	case m.revertConfirm && m.submenuIndex < len(m.recentCommits):
+		commit := m.recentCommits[m.submenuIndex]
+		lines = append(lines, styles.StatusWarning.Render(fmt.Sprintf("Revert %s %s?", commit.Hash[:7], truncate(commit.Message, 40))))
+		lines = append(lines, styles.ShortcutDesc.Render("y: revert and commit  •  s: stage the revert only  •  n: cancel"))
+		return strings.Join(lines, "\n")
+	case m.revertError != "":
+		lines = append(lines, styles.StatusError.Render(m.revertError))
+		lines = append(lines, "")
+	}
+	lines = append(lines, styles.ShortcutDesc.Render("↑/↓: navigate  •  Enter: details  •  d: diff  •  v: revert  •  t: time format  •  Esc: close"))
 
 	return strings.Join(lines, "\n")
 }
 
+// renderCommitDetailMenu renders the details of the selected commit
+func (m DashboardModel) renderCommitDetailMenu() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	var lines []string
+	lines = append(lines, styles.CardTitle.Render("Commit Details"))
+	lines = append(lines, "")
+
+	if m.detailCommitIndex >= len(m.recentCommits) {
+		lines = append(lines, styles.SubmenuOption.Render("Commit no longer available"))
+	} else {
+		commit := m.recentCommits[m.detailCommitIndex]
+		lines = append(lines, fmt.Sprintf("  Hash:    %s", styles.StatusInfo.Render(commit.Hash)))
+		lines = append(lines, fmt.Sprintf("  Author:  %s", commit.Author))
+		// Details always include the exact time
+		format := domain.TimeFormatBoth
+		if m.timeFormat() == domain.TimeFormatAbsolute {
+			format = domain.TimeFormatAbsolute
+		}
+		lines = append(lines, fmt.Sprintf("  Date:    %s", formatCommitTime(commit.Date, format, time.Now())))
+		lines = append(lines, "")
+		for _, line := range strings.Split(commit.Message, "\n") {
+			lines = append(lines, "  "+line)
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, styles.ShortcutDesc.Render("d: diff  •  Enter/Esc: back to commits"))
+
+	return strings.Join(lines, "\n")
+}
+
+// renderCommitDiffMenu renders the scrollable diff of the selected commit
+func (m DashboardModel) renderCommitDiffMenu() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	if m.commitDiff == nil {
+		return styles.SubmenuOption.Render("Commit no longer available")
+	}
+	return m.commitDiff.View() + "\n\n" + styles.ShortcutDesc.Render("Enter/Esc: back")
+}
+
 // renderBranchListMenu renders scrollable branch list
 func (m DashboardModel) renderBranchListMenu() string {
 	styles := GetGlobalThemeManager().GetStyles()
@@ -1031,6 +2401,29 @@ func (m DashboardModel) renderBranchListMenu() string {
 }
 
 // renderQuickStatusMenu renders detailed status
+// stagingMarker marks whether a file's changes are staged (●), unstaged (○)
+// or partly both (◐)
+func stagingMarker(change domain.FileChange) string {
+	styles := GetGlobalThemeManager().GetStyles()
+	switch {
+	case change.Staged && change.Unstaged:
+		return styles.StatusInfo.Render("◐")
+	case change.Staged:
+		return styles.StatusOk.Render("●")
+	default:
+		return styles.StatusWarning.Render("○")
+	}
+}
+
+// stagingLegend explains the staging markers
+func stagingLegend() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	return styles.ShortcutDesc.Render("● staged  ○ unstaged  ◐ both")
+}
+
+// quickStatusMaxFiles is how many changed files the status lists
+const quickStatusMaxFiles = 5
+
 func (m DashboardModel) renderQuickStatusMenu() string {
 	styles := GetGlobalThemeManager().GetStyles()
 	var lines []string
@@ -1047,6 +2440,9 @@ func (m DashboardModel) renderQuickStatusMenu() string {
 			lines = append(lines, styles.RepoLabel.Render("Type:")+" "+styles.RepoValue.Render(string(m.branchInfo.Type())))
 			if m.branchInfo.Parent() != "" {
 				lines = append(lines, styles.RepoLabel.Render("Parent:")+" "+styles.RepoValue.Render(m.branchInfo.Parent()))
+			} else if lost := m.branchInfo.LostParent(); lost != "" {
+				lines = append(lines, styles.RepoLabel.Render("Parent:")+" "+
+					lipgloss.NewStyle().Foreground(styles.ColorMuted).Render(lost+" (no longer exists)"))
 			}
 		}
 
@@ -1057,22 +2453,80 @@ func (m DashboardModel) renderQuickStatusMenu() string {
 			lines = append(lines, "")
 			lines = append(lines, styles.SubmenuOption.Render("Modified files:"))
 			changes := m.repo.Changes()
-			maxFiles := 5
-			if len(changes) < maxFiles {
-				maxFiles = len(changes)
-			}
+			maxFiles := min(len(changes), quickStatusMaxFiles)
 			for i := 0; i < maxFiles; i++ {
 				change := changes[i]
-				lines = append(lines, styles.SubmenuOption.Render(fmt.Sprintf("  %s (+%d -%d)", change.Path, change.Additions, change.Deletions)))
+				file := fmt.Sprintf("%s (+%d -%d)", change.Path, change.Additions, change.Deletions)
+				if i == m.submenuIndex {
+					lines = append(lines, "> "+stagingMarker(change)+" "+styles.SubmenuOptionActive.Render(file))
+				} else {
+					lines = append(lines, "  "+stagingMarker(change)+" "+styles.SubmenuOption.Render(file))
+				}
 			}
 			if len(changes) > maxFiles {
 				lines = append(lines, styles.SubmenuOption.Render(fmt.Sprintf("  ... and %d more files", len(changes)-maxFiles)))
 			}
+			lines = append(lines, stagingLegend())
 		}
 	}
 
 	lines = append(lines, "")
-	lines = append(lines, styles.ShortcutDesc.Render("Esc: close"))
+	if m.repo != nil && m.repo.HasChanges() {
+		lines = append(lines, styles.ShortcutDesc.Render("↑/↓: select file  •  b: blame  •  Esc: close"))
+	} else {
+		lines = append(lines, styles.ShortcutDesc.Render("Esc: close"))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderBlameMenu renders who last changed each line of the blamed file
+func (m DashboardModel) renderBlameMenu() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	var lines []string
+	lines = append(lines, styles.CardTitle.Render("Blame: "+m.blameFile))
+	lines = append(lines, "")
+
+	switch {
+	case !m.blameLoaded:
+		lines = append(lines, styles.SubmenuOption.Render("Loading blame..."))
+	case m.blameError != "":
+		lines = append(lines, styles.StatusError.Render(m.blameError))
+	case len(m.blame) == 0:
+		lines = append(lines, styles.SubmenuOption.Render("The file is empty"))
+	default:
+		visibleHeight := 10
+		start := m.submenuScrollOffset
+		end := start + visibleHeight
+		if end > len(m.blame) {
+			end = len(m.blame)
+		}
+
+		if start > 0 {
+			lines = append(lines, styles.SubmenuOption.Render(fmt.Sprintf("  ... %d more above", start)))
+		}
+
+		for i := start; i < end; i++ {
+			line := m.blame[i]
+			origin := fmt.Sprintf("%s %-14s %s", line.Hash[:7], truncate(line.Author, 14), line.Time.Format("2006-01-02"))
+			if line.Uncommitted() {
+				origin = fmt.Sprintf("%-33s", "not committed yet")
+			}
+			text := fmt.Sprintf("%4d │ %s", line.Line, truncate(strings.ReplaceAll(line.Content, "\t", "    "), 60))
+			if i == m.submenuIndex {
+				lines = append(lines, styles.SubmenuOptionActive.Render("> ")+styles.Metadata.Render(origin)+" "+styles.SubmenuOptionActive.Render(text))
+			} else {
+				lines = append(lines, "  "+styles.Metadata.Render(origin)+" "+styles.SubmenuOption.Render(text))
+			}
+		}
+
+		if end < len(m.blame) {
+			lines = append(lines, styles.SubmenuOption.Render(fmt.Sprintf("  ... %d more below", len(m.blame)-end)))
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, styles.ShortcutDesc.Render("↑/↓: scroll  •  Enter/Esc: back to status"))
 
 	return strings.Join(lines, "\n")
 }
@@ -1092,6 +2546,7 @@ func (m DashboardModel) renderHelpMenu() string {
 
 	lines = append(lines, styles.StatusInfo.Render("Actions:"))
 	lines = append(lines, styles.SubmenuOption.Render("  r             Refresh dashboard"))
+	lines = append(lines, styles.SubmenuOption.Render("  u             Rebase onto parent branch"))
 	lines = append(lines, styles.SubmenuOption.Render("  q / Esc       Quit"))
 	lines = append(lines, "")
 
@@ -1099,7 +2554,7 @@ func (m DashboardModel) renderHelpMenu() string {
 	lines = append(lines, styles.SubmenuOption.Render("  Repository       View current status"))
 	lines = append(lines, styles.SubmenuOption.Render("  Commit           Analyze & commit changes"))
 	lines = append(lines, styles.SubmenuOption.Render("  Merge/PR         Merge branches or create PRs"))
-	lines = append(lines, styles.SubmenuOption.Render("  Recent Commits   Browse commit history"))
+	lines = append(lines, styles.SubmenuOption.Render("  Recent Commits   Browse, diff and revert commits"))
 	lines = append(lines, styles.SubmenuOption.Render("  Branches         Switch branches"))
 	lines = append(lines, styles.SubmenuOption.Render("  Quick Actions    This help menu"))
 
@@ -1168,6 +2623,10 @@ func (m DashboardModel) renderRepositoryDetailsMenu() string {
 			}
 		}
 		lines = append(lines, statusLine)
+
+		if m.repo.IsGitHubRemote() {
+			lines = append(lines, m.renderGHAuthStatus())
+		}
 		lines = append(lines, "")
 	} else {
 		lines = append(lines, styles.StatusWarning.Render("Remote:"))
@@ -1175,6 +2634,32 @@ func (m DashboardModel) renderRepositoryDetailsMenu() string {
 		lines = append(lines, "")
 	}
 
+	// Shallow clones only have part of the history
+	if m.repo.IsShallow() {
+		lines = append(lines, styles.StatusWarning.Render("History:"))
+		lines = append(lines, styles.StatusWarning.Render("  Shallow clone: the commit graph and ahead/behind counts"))
+		lines = append(lines, styles.StatusWarning.Render("  against older history may be incomplete"))
+		lines = append(lines, "")
+	}
+
+	// Commits in a submodule are separate from its parent's
+	if m.repo.IsSubmodule() {
+		lines = append(lines, styles.StatusWarning.Render("Submodule:"))
+		lines = append(lines, styles.StatusWarning.Render("  Part of "+m.repo.Superproject()+"; commits here stay in the"))
+		lines = append(lines, styles.StatusWarning.Render("  submodule until the parent commits the new submodule pointer"))
+		lines = append(lines, "")
+	}
+
+	// Object database size, and whether maintenance is warranted
+	if m.objectStats != nil {
+		lines = append(lines, styles.StatusInfo.Render("Storage:"))
+		lines = append(lines, "  "+lipgloss.NewStyle().Foreground(styles.ColorMuted).Render(objectStatsSummary(m.objectStats)))
+		if reason := m.objectStats.MaintenanceReason(); reason != "" {
+			lines = append(lines, styles.StatusWarning.Render("  Optimizing is recommended: "+reason))
+		}
+		lines = append(lines, "")
+	}
+
 	// Changes summary
 	lines = append(lines, styles.StatusInfo.Render("Changes:"))
 	if m.repo.HasChanges() {
@@ -1192,16 +2677,17 @@ func (m DashboardModel) renderRepositoryDetailsMenu() string {
 		}
 		for i := 0; i < displayCount; i++ {
 			change := changes[i]
-			changeLine := fmt.Sprintf("    • %s (+%d -%d)",
+			changeLine := fmt.Sprintf("%s (+%d -%d)",
 				change.Path,
 				change.Additions,
 				change.Deletions)
-			lines = append(lines, lipgloss.NewStyle().Foreground(styles.ColorMuted).Render(changeLine))
+			lines = append(lines, "    "+stagingMarker(change)+" "+lipgloss.NewStyle().Foreground(styles.ColorMuted).Render(changeLine))
 		}
 		if len(changes) > 3 {
 			lines = append(lines, lipgloss.NewStyle().Foreground(styles.ColorMuted).Render(
 				fmt.Sprintf("    ... and %d more", len(changes)-3)))
 		}
+		lines = append(lines, "  "+stagingLegend())
 	} else {
 		lines = append(lines, "  "+styles.StatusOk.Render("Clean"))
 	}
@@ -1228,6 +2714,18 @@ func (m DashboardModel) renderRepositoryDetailsMenu() string {
 		lines = append(lines, fetchLine)
 		actionIndex++
 
+		// Fetch full history if shallow
+		if m.repo.IsShallow() {
+			unshallowLine := "Fetch full history (unshallow)"
+			if actionIndex == m.submenuIndex {
+				unshallowLine = styles.SubmenuOptionActive.Render("> " + unshallowLine)
+			} else {
+				unshallowLine = styles.SubmenuOption.Render("  " + unshallowLine)
+			}
+			lines = append(lines, unshallowLine)
+			actionIndex++
+		}
+
 		// Pull if behind
 		if m.repo.CommitsBehind() > 0 {
 			pullLine := fmt.Sprintf("Pull from remote (↓%d available)", m.repo.CommitsBehind())
@@ -1254,6 +2752,18 @@ func (m DashboardModel) renderRepositoryDetailsMenu() string {
 
 		// GitHub actions
 		if m.repo.IsGitHubRemote() {
+			// Login with gh
+			if m.needsGHLogin() {
+				loginLine := "Login with gh"
+				if actionIndex == m.submenuIndex {
+					loginLine = styles.SubmenuOptionActive.Render("> " + loginLine)
+				} else {
+					loginLine = styles.SubmenuOption.Render("  " + loginLine)
+				}
+				lines = append(lines, loginLine)
+				actionIndex++
+			}
+
 			// View on GitHub (web)
 			githubLine := "View on GitHub (web)"
 			if actionIndex == m.submenuIndex {
@@ -1286,6 +2796,59 @@ func (m DashboardModel) renderRepositoryDetailsMenu() string {
 		actionIndex++
 	}
 
+	// Manage remotes
+	remotesLine := "Manage remotes"
+	if actionIndex == m.submenuIndex {
+		remotesLine = styles.SubmenuOptionActive.Render("> " + remotesLine)
+	} else {
+		remotesLine = styles.SubmenuOption.Render("  " + remotesLine)
+	}
+	lines = append(lines, remotesLine)
+	actionIndex++
+
+	// View tags
+	tagsLine := "View tags"
+	if actionIndex == m.submenuIndex {
+		tagsLine = styles.SubmenuOptionActive.Render("> " + tagsLine)
+	} else {
+		tagsLine = styles.SubmenuOption.Render("  " + tagsLine)
+	}
+	lines = append(lines, tagsLine)
+	actionIndex++
+
+	// Create tag
+	createTagLine := "Create tag"
+	if actionIndex == m.submenuIndex {
+		createTagLine = styles.SubmenuOptionActive.Render("> " + createTagLine)
+	} else {
+		createTagLine = styles.SubmenuOption.Render("  " + createTagLine)
+	}
+	lines = append(lines, createTagLine)
+	actionIndex++
+
+	// Recover from reflog
+	reflogLine := "Recover lost commits (reflog)"
+	if actionIndex == m.submenuIndex {
+		reflogLine = styles.SubmenuOptionActive.Render("> " + reflogLine)
+	} else {
+		reflogLine = styles.SubmenuOption.Render("  " + reflogLine)
+	}
+	lines = append(lines, reflogLine)
+	actionIndex++
+
+	// Optimize repository, flagged when the object database needs it
+	maintenanceLine := "Optimize repository (git maintenance)"
+	if m.objectStats != nil && m.objectStats.MaintenanceReason() != "" {
+		maintenanceLine += " • recommended"
+	}
+	if actionIndex == m.submenuIndex {
+		maintenanceLine = styles.SubmenuOptionActive.Render("> " + maintenanceLine)
+	} else {
+		maintenanceLine = styles.SubmenuOption.Render("  " + maintenanceLine)
+	}
+	lines = append(lines, maintenanceLine)
+	actionIndex++
+
 	// Refresh (always last)
 	refreshLine := "Refresh status"
 	if actionIndex == m.submenuIndex {
@@ -1301,18 +2864,324 @@ func (m DashboardModel) renderRepositoryDetailsMenu() string {
 	return strings.Join(lines, "\n")
 }
 
+// renderTagListMenu renders the tag list with signature verification results
+func (m DashboardModel) renderTagListMenu() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	var lines []string
+	lines = append(lines, styles.CardTitle.Render("Tags"))
+	lines = append(lines, "")
+
+	switch {
+	case !m.tagsLoaded:
+		lines = append(lines, styles.SubmenuOption.Render("Loading tags..."))
+	case len(m.tags) == 0:
+		lines = append(lines, styles.SubmenuOption.Render("No tags"))
+	default:
+		visibleHeight := 10
+		start := m.submenuScrollOffset
+		end := start + visibleHeight
+		if end > len(m.tags) {
+			end = len(m.tags)
+		}
+
+		if start > 0 {
+			lines = append(lines, styles.SubmenuOption.Render(fmt.Sprintf("  ... %d more above", start)))
+		}
+
+		for i := start; i < end; i++ {
+			tag := m.tags[i]
+
+			indicator := "  "
+			status := ""
+			if tag == m.verifyingTag {
+				status = styles.Metadata.Render("  verifying...")
+			} else if result, ok := m.tagVerifications[tag]; ok {
+				switch result.Status {
+				case domain.TagSignatureGood:
+					indicator = styles.StatusOk.Render("✓ ")
+					status = styles.StatusOk.Render("  " + result.Summary())
+				case domain.TagSignatureUnsigned:
+					indicator = styles.Metadata.Render("- ")
+					status = styles.Metadata.Render("  " + result.Summary())
+				case domain.TagSignatureUnknownKey:
+					indicator = styles.StatusWarning.Render("? ")
+					status = styles.StatusWarning.Render("  " + result.Summary())
+				default:
+					indicator = styles.StatusError.Render("✗ ")
+					status = styles.StatusError.Render("  " + truncate(result.Summary(), 60))
+				}
+			}
+
+			line := indicator + tag
+			if i == m.submenuIndex {
+				line = styles.SubmenuOptionActive.Render("> " + line)
+			} else {
+				line = styles.SubmenuOption.Render("  " + line)
+			}
+			lines = append(lines, line+status)
+		}
+
+		if end < len(m.tags) {
+			lines = append(lines, styles.SubmenuOption.Render(fmt.Sprintf("  ... %d more below", len(m.tags)-end)))
+		}
+	}
+
+	lines = append(lines, "")
+	signing := "off"
+	if m.config.Git.SignTags {
+		signing = "on"
+		if m.config.Git.SigningKey != "" {
+			signing += " (key " + m.config.Git.SigningKey + ")"
+		}
+	}
+	lines = append(lines, styles.Description.Render("Tag signing: "+signing+" (configured in settings)"))
+	lines = append(lines, styles.ShortcutDesc.Render("↑/↓: navigate  •  Enter: verify signature  •  Esc: close"))
+
+	return strings.Join(lines, "\n")
+}
+
+// renderCreateTagMenu renders the tag name and message form
+func (m DashboardModel) renderCreateTagMenu() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	var lines []string
+	lines = append(lines, styles.CardTitle.Render("Create Tag"))
+	lines = append(lines, "")
+
+	target := "HEAD"
+	if m.repo != nil && m.repo.CurrentBranch() != "" {
+		target = m.repo.CurrentBranch()
+	}
+	lines = append(lines, styles.Description.Render("Tags the latest commit on "+target))
+	lines = append(lines, "")
+
+	lines = append(lines, styles.SubmenuOption.Render("Name"))
+	lines = append(lines, m.tagNameInput.View())
+	lines = append(lines, "")
+	lines = append(lines, styles.SubmenuOption.Render("Message (optional, makes an annotated tag)"))
+	lines = append(lines, m.tagMessageInput.View())
+
+	if m.creatingTag {
+		lines = append(lines, "")
+		lines = append(lines, styles.Metadata.Render("Creating tag..."))
+	} else if m.tagError != "" {
+		lines = append(lines, "")
+		lines = append(lines, styles.StatusError.Render(m.tagError))
+	}
+
+	lines = append(lines, "")
+	if m.config.Git.SignTags {
+		lines = append(lines, styles.Description.Render("Tag signing is on: the tag will be signed and annotated"))
+	}
+	lines = append(lines, styles.ShortcutDesc.Render("Tab: next field  •  Enter: create  •  Esc: cancel"))
+
+	return strings.Join(lines, "\n")
+}
+
+// renderReflogMenu renders where HEAD has been, newest first
+func (m DashboardModel) renderReflogMenu() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	var lines []string
+	lines = append(lines, styles.CardTitle.Render("Recover Lost Commits"))
+	lines = append(lines, "")
+
+	switch {
+	case !m.reflogLoaded:
+		lines = append(lines, styles.SubmenuOption.Render("Loading reflog..."))
+	case len(m.reflog) == 0:
+		lines = append(lines, styles.SubmenuOption.Render("The reflog is empty"))
+	default:
+		lines = append(lines, styles.Description.Render("Every commit HEAD has pointed at, including ones on deleted branches"))
+		lines = append(lines, "")
+
+		visibleHeight := 10
+		start := m.submenuScrollOffset
+		end := start + visibleHeight
+		if end > len(m.reflog) {
+			end = len(m.reflog)
+		}
+
+		if start > 0 {
+			lines = append(lines, styles.SubmenuOption.Render(fmt.Sprintf("  ... %d more above", start)))
+		}
+
+		for i := start; i < end; i++ {
+			entry := m.reflog[i]
+			line := fmt.Sprintf("%s %-16s %s", entry.Hash[:7], truncate(entry.Action, 16), truncate(entry.Message, 50))
+			if i == m.submenuIndex {
+				line = styles.SubmenuOptionActive.Render("> " + line)
+			} else {
+				line = styles.SubmenuOption.Render("  " + line)
+			}
+			lines = append(lines, line+styles.Metadata.Render("  "+entry.Date))
+		}
+
+		if end < len(m.reflog) {
+			lines = append(lines, styles.SubmenuOption.Render(fmt.Sprintf("  ... %d more below", len(m.reflog)-end)))
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, styles.ShortcutDesc.Render("↑/↓: navigate  •  Enter: create branch here  •  Esc: close"))
+
+	return strings.Join(lines, "\n")
+}
+
+// renderRecoverBranchMenu renders the form naming the recovered branch
+func (m DashboardModel) renderRecoverBranchMenu() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	var lines []string
+	lines = append(lines, styles.CardTitle.Render("Recover as Branch"))
+	lines = append(lines, "")
+
+	entry := m.recoverEntry
+	lines = append(lines, styles.Description.Render(fmt.Sprintf("Creates a branch at %s (%s: %s)", entry.Hash[:7], entry.Action, truncate(entry.Message, 50))))
+	lines = append(lines, "")
+
+	lines = append(lines, styles.SubmenuOption.Render("Branch name"))
+	lines = append(lines, m.recoverBranchInput.View())
+
+	if m.recovering {
+		lines = append(lines, "")
+		lines = append(lines, styles.Metadata.Render("Creating branch..."))
+	} else if m.recoverError != "" {
+		lines = append(lines, "")
+		lines = append(lines, styles.StatusError.Render(m.recoverError))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, styles.ShortcutDesc.Render("Enter: create  •  Esc: back to the reflog"))
+
+	return strings.Join(lines, "\n")
+}
+
+// renderRemoteListMenu renders the remotes with their fetch URLs
+func (m DashboardModel) renderRemoteListMenu() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	var lines []string
+	lines = append(lines, styles.CardTitle.Render("Remotes"))
+	lines = append(lines, "")
+
+	switch {
+	case !m.remotesLoaded:
+		lines = append(lines, styles.SubmenuOption.Render("Loading remotes..."))
+	case len(m.remotes) == 0:
+		lines = append(lines, styles.SubmenuOption.Render("No remotes"))
+	default:
+		for i, remote := range m.remotes {
+			line := fmt.Sprintf("%-12s %s", remote.Name, styles.Metadata.Render(truncate(remote.URL, 60)))
+			if i == m.submenuIndex {
+				line = styles.SubmenuOptionActive.Render("> " + line)
+			} else {
+				line = styles.SubmenuOption.Render("  " + line)
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	lines = append(lines, "")
+	switch {
+	case m.remoteBusy:
+		lines = append(lines, styles.Metadata.Render("Removing remote..."))
+	case m.removingRemote && m.submenuIndex < len(m.remotes):
+		lines = append(lines, styles.StatusWarning.Render(fmt.Sprintf("Remove remote %s and its remote-tracking branches?", m.remotes[m.submenuIndex].Name)))
+		lines = append(lines, styles.ShortcutDesc.Render("y: remove  •  n: cancel"))
+		return strings.Join(lines, "\n")
+	case m.remoteError != "":
+		lines = append(lines, styles.StatusError.Render(m.remoteError))
+		lines = append(lines, "")
+	}
+	lines = append(lines, styles.ShortcutDesc.Render("↑/↓: navigate  •  Enter: rename  •  a: add  •  d: remove  •  Esc: close"))
+
+	return strings.Join(lines, "\n")
+}
+
+// renderRemoteFormMenu renders the form adding a remote or renaming one
+func (m DashboardModel) renderRemoteFormMenu() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	var lines []string
+
+	if m.renamingRemote != "" {
+		lines = append(lines, styles.CardTitle.Render("Rename Remote"))
+		lines = append(lines, "")
+		lines = append(lines, styles.Description.Render(fmt.Sprintf("Renames %s and its remote-tracking branches", m.renamingRemote)))
+		lines = append(lines, "")
+		lines = append(lines, styles.SubmenuOption.Render("New name"))
+		lines = append(lines, m.remoteNameInput.View())
+	} else {
+		lines = append(lines, styles.CardTitle.Render("Add Remote"))
+		lines = append(lines, "")
+		lines = append(lines, styles.SubmenuOption.Render("Name"))
+		lines = append(lines, m.remoteNameInput.View())
+		lines = append(lines, "")
+		lines = append(lines, styles.SubmenuOption.Render("URL"))
+		lines = append(lines, m.remoteURLInput.View())
+	}
+
+	if m.remoteBusy {
+		lines = append(lines, "")
+		lines = append(lines, styles.Metadata.Render("Saving remote..."))
+	} else if m.remoteError != "" {
+		lines = append(lines, "")
+		lines = append(lines, styles.StatusError.Render(m.remoteError))
+	}
+
+	lines = append(lines, "")
+	if m.renamingRemote != "" {
+		lines = append(lines, styles.ShortcutDesc.Render("Enter: rename  •  Esc: back to remotes"))
+	} else {
+		lines = append(lines, styles.ShortcutDesc.Render("Tab: next field  •  Enter: add  •  Esc: back to remotes"))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderPathScopeMenu renders the form limiting analysis to a subdirectory
+func (m DashboardModel) renderPathScopeMenu() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	var lines []string
+	lines = append(lines, styles.CardTitle.Render("Limit to Path"))
+	lines = append(lines, "")
+	lines = append(lines, styles.Description.Render("Only changes under this path are analyzed and staged"))
+	lines = append(lines, "")
+	lines = append(lines, m.pathScopeInput.View())
+
+	if m.pathScopeError != "" {
+		lines = append(lines, "")
+		lines = append(lines, styles.StatusError.Render(m.pathScopeError))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, styles.ShortcutDesc.Render("Enter: apply  •  Esc: cancel"))
+
+	return strings.Join(lines, "\n")
+}
+
 // renderFooter renders dashboard footer
 func (m DashboardModel) renderFooter() string {
 	styles := GetGlobalThemeManager().GetStyles()
 
 	// Minimal footer
-	return styles.Footer.Render(
-		fmt.Sprintf("%s navigate  •  %s select  •  %s quit",
-			styles.ShortcutKey.Render("arrows"),
-			styles.ShortcutKey.Render("enter"),
-			styles.ShortcutKey.Render("q"),
-		),
+	footer := fmt.Sprintf("%s navigate  •  %s select  •  %s quit",
+		styles.ShortcutKey.Render("arrows"),
+		styles.ShortcutKey.Render("enter"),
+		styles.ShortcutKey.Render("q"),
 	)
+	if m.aiDisabled {
+		footer += "  •  " + styles.ShortcutKey.Render("i") + " " + styles.StatusWarning.Render("AI: off")
+	} else {
+		footer += "  •  " + styles.ShortcutKey.Render("i") + " " + styles.ShortcutDesc.Render("AI: on")
+		if m.activeModel != "" {
+			footer += "  •  " + styles.ShortcutDesc.Render("model: "+m.activeModel)
+		}
+	}
+
+	// Latest background activity, if any
+	if len(m.activity) > 0 {
+		latest := m.activity[len(m.activity)-1]
+		return styles.Metadata.Render(truncate(latest, 100)) + "\n" + styles.Footer.Render(footer)
+	}
+
+	return styles.Footer.Render(footer)
 }
 
 // Getters for action results
@@ -1349,6 +3218,28 @@ func fetchRepoStatus(gitOps git.Operations, repoPath string) tea.Cmd {
 	}
 }
 
+// autoFetch fetches from the remote in the background, skipping repositories
+// without one. Failures are reported rather than treated as dashboard errors.
+func autoFetch(gitOps git.Operations, repoPath string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		hasRemote, err := gitOps.HasRemote(ctx, repoPath)
+		if err != nil {
+			return autoFetchMsg{err: err}
+		}
+		if !hasRemote {
+			return autoFetchMsg{}
+		}
+
+		if err := gitOps.Fetch(ctx, repoPath); err != nil {
+			return autoFetchMsg{err: err}
+		}
+		return autoFetchMsg{fetched: true}
+	}
+}
+
 func fetchBranches(gitOps git.Operations, repoPath string) tea.Cmd {
 	return func() tea.Msg {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -1376,3 +3267,200 @@ func fetchRecentCommits(gitOps git.Operations, repoPath string) tea.Cmd {
 		return commitsMsg(commits)
 	}
 }
+
+// needsGHLogin reports whether gh was found to be installed but logged out
+func (m DashboardModel) needsGHLogin() bool {
+	return m.ghAuthChecked && !m.ghAuthenticated && m.ghAuthErr == nil
+}
+
+// renderGHAuthStatus renders the gh authentication line of the repository details
+func (m DashboardModel) renderGHAuthStatus() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	line := "  GitHub CLI: "
+	switch {
+	case !m.ghAuthChecked:
+		line += lipgloss.NewStyle().Foreground(styles.ColorMuted).Render("checking...")
+	case m.ghAuthErr != nil:
+		line += styles.StatusWarning.Render("unavailable (" + m.ghAuthErr.Error() + ")")
+	case m.ghAuthenticated:
+		line += styles.StatusOk.Render("✓ authenticated")
+	default:
+		line += styles.StatusWarning.Render("✗ not authenticated, GitHub actions will fail")
+	}
+	return line
+}
+
+// RecheckGHAuth checks gh authentication again, e.g. after logging in
+func (m *DashboardModel) RecheckGHAuth() tea.Cmd {
+	m.ghAuthChecked = false
+	return fetchGHAuthStatus()
+}
+
+func fetchGHAuthStatus() tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		authenticated, err := checkGHAuth(ctx)
+		return ghAuthStatusMsg{authenticated: authenticated, err: err}
+	}
+}
+
+// objectStatsSummary describes the object database size, e.g. "1.21 MiB in
+// 1 pack, 12 loose objects (48.00 KiB)"
+func objectStatsSummary(stats *git.ObjectStats) string {
+	packs := "packs"
+	if stats.Packs == 1 {
+		packs = "pack"
+	}
+	return fmt.Sprintf("%s in %d %s, %d loose objects (%s)", stats.PackSize, stats.Packs, packs, stats.Loose, stats.LooseSize)
+}
+
+func fetchObjectStats(gitOps git.Operations, repoPath string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		stats, err := gitOps.CountObjects(ctx, repoPath)
+		return objectStatsMsg{stats: stats, err: err}
+	}
+}
+
+func fetchTags(gitOps git.Operations, repoPath string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		tags, err := gitOps.ListTags(ctx, repoPath)
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		return tagsMsg(tags)
+	}
+}
+
+func fetchReflog(gitOps git.Operations, repoPath string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		entries, err := gitOps.GetReflog(ctx, repoPath, 100)
+		return reflogMsg{entries: entries, err: err}
+	}
+}
+
+// fetchBlame blames file, reporting who last changed each line
+func fetchBlame(gitOps git.Operations, repoPath, file string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		lines, err := gitOps.Blame(ctx, repoPath, file)
+		return blameMsg{file: file, lines: lines, err: err}
+	}
+}
+
+// fetchPushRemotes lists the remote names the push action can choose from
+func fetchPushRemotes(gitOps git.Operations, repoPath string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		remotes, err := gitOps.ListRemotes(ctx, repoPath)
+		return pushRemotesMsg{remotes: remotes, err: err}
+	}
+}
+
+// fetchRemotes lists the remotes with their URLs
+func fetchRemotes(gitOps git.Operations, repoPath string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		remotes, err := gitOps.ListRemotesWithURLs(ctx, repoPath)
+		return remotesMsg{remotes: remotes, err: err}
+	}
+}
+
+// addRemote adds a remote called name at url
+func addRemote(gitOps git.Operations, repoPath, name, url string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		err := gitOps.AddRemote(ctx, repoPath, name, url)
+		return remoteChangedMsg{activity: fmt.Sprintf("Added remote %s (%s)", name, url), err: err}
+	}
+}
+
+// renameRemote renames the remote oldName to newName
+func renameRemote(gitOps git.Operations, repoPath, oldName, newName string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		err := gitOps.RenameRemote(ctx, repoPath, oldName, newName)
+		return remoteChangedMsg{activity: fmt.Sprintf("Renamed remote %s to %s", oldName, newName), err: err}
+	}
+}
+
+// removeRemote removes the remote called name
+func removeRemote(gitOps git.Operations, repoPath, name string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		err := gitOps.RemoveRemote(ctx, repoPath, name)
+		return remoteChangedMsg{activity: fmt.Sprintf("Removed remote %s", name), err: err}
+	}
+}
+
+// recoverBranch creates branch at the reflog entry's commit
+func recoverBranch(gitOps git.Operations, repoPath, branch string, entry git.ReflogEntry) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		err := gitOps.CreateBranchAt(ctx, repoPath, branch, entry.Hash)
+		return branchRecoveredMsg{branch: branch, entry: entry, err: err}
+	}
+}
+
+// createTag tags HEAD. Tags are signed when tag signing is configured;
+// otherwise a message makes the tag annotated and no message keeps it lightweight.
+func createTag(gitOps git.Operations, repoPath, name, message string, sign bool, signingKey string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		var err error
+		if sign {
+			err = gitOps.CreateSignedTag(ctx, repoPath, name, message, signingKey)
+		} else {
+			err = gitOps.CreateTag(ctx, repoPath, name, message, message != "")
+		}
+		return tagCreatedMsg{name: name, err: err}
+	}
+}
+
+func revertCommit(gitOps git.Operations, repoPath string, commit git.CommitInfo, noCommit bool) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		err := gitOps.RevertCommit(ctx, repoPath, commit.Hash, noCommit)
+		return commitRevertedMsg{commit: commit, noCommit: noCommit, err: err}
+	}
+}
+
+func verifyTag(gitOps git.Operations, repoPath, tag string) tea.Cmd {
+	return func() tea.Msg {
+		// gpg may need to consult a keyserver or agent, so allow more time
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		result, err := gitOps.VerifyTag(ctx, repoPath, tag)
+		return tagVerifiedMsg{tag: tag, result: result, err: err}
+	}
+}
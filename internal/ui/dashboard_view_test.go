@@ -0,0 +1,906 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// TestDashboard_EnterOnCommitOpensDetail tests that Enter on the commit list opens the commit's detail
+func TestDashboard_EnterOnCommitOpensDetail(t *testing.T) {
+	m := NewDashboardModel(nil, "/tmp/repo", domain.NewDefaultConfig())
+	m.recentCommits = []git.CommitInfo{
+		{Hash: "aaaaaaaaaaaa", Author: "Ada", Date: "2024-01-01", Message: "First"},
+		{Hash: "bbbbbbbbbbbb", Author: "Linus", Date: "2024-01-02", Message: "Second"},
+	}
+	m.activeSubmenu = CommitListMenu
+	m.submenuIndex = 1
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	dash := updated.(DashboardModel)
+
+	if dash.activeSubmenu != CommitDetailMenu {
+		t.Fatalf("Expected CommitDetailMenu, got %v", dash.activeSubmenu)
+	}
+	if dash.detailCommitIndex != 1 {
+		t.Errorf("Expected detail for commit 1, got %d", dash.detailCommitIndex)
+	}
+
+	// Esc returns to the list with the same commit highlighted
+	updated, _ = dash.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	dash = updated.(DashboardModel)
+	if dash.activeSubmenu != CommitListMenu || dash.submenuIndex != 1 {
+		t.Errorf("Expected CommitListMenu at index 1, got %v at %d", dash.activeSubmenu, dash.submenuIndex)
+	}
+}
+
+// TestFormatCommitTime tests the commit time formats and the fallback for dates that can't be parsed
+func TestFormatCommitTime(t *testing.T) {
+	now := time.Date(2024, 5, 1, 16, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		date   string
+		format string
+		want   string
+	}{
+		{"relative", "2024-05-01T14:03:00Z", domain.TimeFormatRelative, "1h ago"},
+		{"unset is relative", "2024-04-28T16:00:00Z", "", "3d ago"},
+		{"absolute keeps the commit's zone", "2024-05-01T14:03:00+02:00", domain.TimeFormatAbsolute, "2024-05-01 14:03"},
+		{"both", "2024-05-01T15:59:30Z", domain.TimeFormatBoth, "just now (2024-05-01 15:59)"},
+		{"git iso date", "2024-04-30 09:15:00 +0000", domain.TimeFormatBoth, "yesterday (2024-04-30 09:15)"},
+		{"unparseable date shown as given", " 2024-01-01 ", domain.TimeFormatRelative, "2024-01-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatCommitTime(tt.date, tt.format, now); got != tt.want {
+				t.Errorf("formatCommitTime(%q, %q) = %q, want %q", tt.date, tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDashboard_ToggleTimeFormat tests that t cycles the commit list's time format without changing the config
+func TestDashboard_ToggleTimeFormat(t *testing.T) {
+	cfg := domain.NewDefaultConfig()
+	m := NewDashboardModel(nil, "/tmp/repo", cfg)
+	m.recentCommits = []git.CommitInfo{
+		{Hash: "aaaaaaaaaaaa", Author: "Ada", Date: "2024-01-01T10:30:00Z", Message: "First"},
+	}
+	m.activeSubmenu = CommitListMenu
+
+	var formats []string
+	for i := 0; i < 3; i++ {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+		m = updated.(DashboardModel)
+		formats = append(formats, m.timeFormat())
+	}
+
+	want := []string{domain.TimeFormatAbsolute, domain.TimeFormatBoth, domain.TimeFormatRelative}
+	if strings.Join(formats, ",") != strings.Join(want, ",") {
+		t.Errorf("t cycled through %v, want %v", formats, want)
+	}
+	if cfg.UI.TimeFormat != domain.TimeFormatRelative {
+		t.Errorf("cfg.UI.TimeFormat = %q, want the configured format left alone", cfg.UI.TimeFormat)
+	}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	m = updated.(DashboardModel)
+	if view := m.renderCommitListMenu(); !strings.Contains(view, "2024-01-01 10:30") {
+		t.Errorf("Expected the absolute time in the commit list, got:\n%s", view)
+	}
+}
+
+// TestActiveSubmenu_IsReadOnly tests which submenus treat Enter as close
+func TestActiveSubmenu_IsReadOnly(t *testing.T) {
+	readOnly := []ActiveSubmenu{QuickStatusMenu, HelpMenu, CommitDetailMenu, BlameMenu}
+	actionable := []ActiveSubmenu{CommitOptionsMenu, MergeOptionsMenu, CommitListMenu, BranchListMenu, RepositoryDetailsMenu, TagListMenu, CreateTagMenu, PathScopeMenu, ReflogMenu, RecoverBranchMenu, MergeTargetMenu, RemoteListMenu, RemoteFormMenu, PushRemoteMenu}
+
+	for _, menu := range readOnly {
+		if !menu.IsReadOnly() {
+			t.Errorf("Expected submenu %v to be read-only", menu)
+		}
+	}
+	for _, menu := range actionable {
+		if menu.IsReadOnly() {
+			t.Errorf("Expected submenu %v to be actionable", menu)
+		}
+	}
+}
+
+// TestDashboard_TagVerificationFailureShown tests that a failed tag verification is reported clearly
+func TestDashboard_TagVerificationFailureShown(t *testing.T) {
+	m := NewDashboardModel(nil, "/tmp/repo", domain.NewDefaultConfig())
+	m.activeSubmenu = TagListMenu
+	m.tags = []string{"v1.1.0", "v1.0.0"}
+	m.tagsLoaded = true
+	m.verifyingTag = "v1.1.0"
+
+	updated, _ := m.Update(tagVerifiedMsg{
+		tag:    "v1.1.0",
+		result: &domain.TagVerification{Tag: "v1.1.0", Status: domain.TagSignatureBad},
+	})
+	dash := updated.(DashboardModel)
+
+	if dash.verifyingTag != "" {
+		t.Errorf("Expected verification to finish, still verifying %q", dash.verifyingTag)
+	}
+	view := dash.renderTagListMenu()
+	if !strings.Contains(view, "BAD signature") {
+		t.Errorf("Expected bad signature in tag list, got:\n%s", view)
+	}
+	activity := dash.Activity()
+	if len(activity) == 0 || !strings.Contains(activity[len(activity)-1], "v1.1.0") {
+		t.Errorf("Expected failure in activity log, got %v", activity)
+	}
+}
+
+// tagRecordingGitOps records tags created from the dashboard
+type tagRecordingGitOps struct {
+	git.Operations
+
+	name      string
+	message   string
+	annotated bool
+}
+
+func (f *tagRecordingGitOps) CreateTag(ctx context.Context, repoPath, name, message string, annotated bool) error {
+	f.name, f.message, f.annotated = name, message, annotated
+	return nil
+}
+
+func (f *tagRecordingGitOps) GetLog(ctx context.Context, repoPath string, count int) ([]git.CommitInfo, error) {
+	return nil, nil
+}
+
+// TestDashboard_CreateTag tests that the tag form creates a lightweight or annotated tag
+func TestDashboard_CreateTag(t *testing.T) {
+	tests := []struct {
+		name          string
+		message       string
+		wantAnnotated bool
+	}{
+		{"lightweight", "", false},
+		{"annotated", "Release 1.2.0", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &tagRecordingGitOps{}
+			m := NewDashboardModel(ops, "/tmp/repo", domain.NewDefaultConfig()).openCreateTag()
+
+			updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v1.2.0")})
+			m = updated.(DashboardModel)
+			if tt.message != "" {
+				updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+				m = updated.(DashboardModel)
+				updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tt.message)})
+				m = updated.(DashboardModel)
+			}
+
+			updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+			m = updated.(DashboardModel)
+			if cmd == nil {
+				t.Fatal("Expected a command to create the tag")
+			}
+			updated, _ = m.Update(cmd())
+			m = updated.(DashboardModel)
+
+			if ops.name != "v1.2.0" || ops.message != tt.message || ops.annotated != tt.wantAnnotated {
+				t.Errorf("CreateTag(%q, %q, %v), want (%q, %q, %v)", ops.name, ops.message, ops.annotated, "v1.2.0", tt.message, tt.wantAnnotated)
+			}
+			if m.activeSubmenu != NoSubmenu {
+				t.Errorf("Expected the form to close after creating the tag, got %v", m.activeSubmenu)
+			}
+		})
+	}
+}
+
+// TestDashboard_CreateTagRequiresName tests that an empty name is rejected in the form
+func TestDashboard_CreateTagRequiresName(t *testing.T) {
+	m := NewDashboardModel(nil, "/tmp/repo", domain.NewDefaultConfig()).openCreateTag()
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(DashboardModel)
+
+	if cmd != nil {
+		t.Error("Expected no command for an empty tag name")
+	}
+	if m.tagError == "" || m.activeSubmenu != CreateTagMenu {
+		t.Errorf("Expected the form to stay open with an error, got %v %q", m.activeSubmenu, m.tagError)
+	}
+}
+
+// revertRecordingGitOps records reverts and fails them with err
+type revertRecordingGitOps struct {
+	git.Operations
+
+	hash     string
+	noCommit bool
+	calls    int
+	err      error
+}
+
+func (f *revertRecordingGitOps) RevertCommit(ctx context.Context, repoPath, hash string, noCommit bool) error {
+	f.hash, f.noCommit = hash, noCommit
+	f.calls++
+	return f.err
+}
+
+// TestDashboard_RevertCommit tests reverting the highlighted commit from the commit list
+func TestDashboard_RevertCommit(t *testing.T) {
+	tests := []struct {
+		name         string
+		answer       string
+		err          error
+		wantCalls    int
+		wantNoCommit bool
+		wantSubmenu  ActiveSubmenu
+		wantError    string
+	}{
+		{name: "revert and commit", answer: "y", wantCalls: 1, wantSubmenu: NoSubmenu},
+		{name: "stage only", answer: "s", wantCalls: 1, wantNoCommit: true, wantSubmenu: NoSubmenu},
+		{name: "cancelled", answer: "n", wantSubmenu: CommitListMenu},
+		{
+			name:        "conflict",
+			answer:      "y",
+			err:         &git.RevertConflictError{Hash: "bbbbbbbbbbbb", Files: []string{"auth/login.go"}},
+			wantCalls:   1,
+			wantSubmenu: CommitListMenu,
+			wantError:   "conflicts in auth/login.go",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &revertRecordingGitOps{err: tt.err}
+			m := NewDashboardModel(ops, "/tmp/repo", domain.NewDefaultConfig())
+			m.recentCommits = []git.CommitInfo{
+				{Hash: "aaaaaaaaaaaa", Message: "Add login"},
+				{Hash: "bbbbbbbbbbbb", Message: "Break login"},
+			}
+			m.activeSubmenu = CommitListMenu
+			m.submenuIndex = 1
+
+			updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+			m = updated.(DashboardModel)
+			if !strings.Contains(m.renderCommitListMenu(), "Revert bbbbbbb Break login?") {
+				t.Fatalf("Expected a revert prompt, got:\n%s", m.renderCommitListMenu())
+			}
+
+			updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tt.answer)})
+			m = updated.(DashboardModel)
+			if cmd != nil {
+				updated, _ = m.Update(cmd())
+				m = updated.(DashboardModel)
+			}
+
+			if ops.calls != tt.wantCalls {
+				t.Fatalf("RevertCommit called %d times, want %d", ops.calls, tt.wantCalls)
+			}
+			if tt.wantCalls > 0 && (ops.hash != "bbbbbbbbbbbb" || ops.noCommit != tt.wantNoCommit) {
+				t.Errorf("RevertCommit(%q, %v), want (%q, %v)", ops.hash, ops.noCommit, "bbbbbbbbbbbb", tt.wantNoCommit)
+			}
+			if m.activeSubmenu != tt.wantSubmenu {
+				t.Errorf("Expected submenu %v, got %v", tt.wantSubmenu, m.activeSubmenu)
+			}
+			if tt.wantError != "" && !strings.Contains(m.revertError, tt.wantError) {
+				t.Errorf("Expected revert error containing %q, got %q", tt.wantError, m.revertError)
+			}
+			if tt.answer == "y" && tt.err == nil && len(m.session) != 1 {
+				t.Errorf("Expected the revert commit in the session summary, got %v", m.session)
+			}
+		})
+	}
+}
+
+// scopedGitOps records the path scope set from the dashboard
+type scopedGitOps struct {
+	git.Operations
+
+	scope string
+}
+
+func (f *scopedGitOps) SetPathScope(path string) { f.scope = path }
+func (f *scopedGitOps) PathScope() string        { return f.scope }
+
+// TestDashboard_PathScope tests limiting the analysis to a subdirectory from the commit options
+func TestDashboard_PathScope(t *testing.T) {
+	repo := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(repo, "services", "api"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	ops := &scopedGitOps{}
+	m := NewDashboardModel(ops, repo, domain.NewDefaultConfig())
+	m.activeSubmenu = CommitOptionsMenu
+	send := func(msg tea.KeyMsg) tea.Cmd {
+		updated, cmd := m.Update(msg)
+		m = updated.(DashboardModel)
+		return cmd
+	}
+
+	send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if m.activeSubmenu != PathScopeMenu || !m.CapturingInput() {
+		t.Fatalf("Expected the path form to capture input, got %v", m.activeSubmenu)
+	}
+
+	// A path outside the repository is rejected in the form
+	send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("services/web")})
+	if cmd := send(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil || m.pathScopeError == "" {
+		t.Fatalf("Expected an error for a missing path, got %q", m.pathScopeError)
+	}
+	if ops.scope != "" {
+		t.Errorf("Expected no scope after a rejected path, got %q", ops.scope)
+	}
+
+	m.pathScopeInput.SetValue("services/api")
+	if cmd := send(tea.KeyMsg{Type: tea.KeyEnter}); cmd == nil {
+		t.Error("Expected the status to refresh for the new scope")
+	}
+	if ops.scope != "services/api" || m.activeSubmenu != CommitOptionsMenu {
+		t.Errorf("Expected scope services/api back in the commit options, got %q in %v", ops.scope, m.activeSubmenu)
+	}
+	if !strings.Contains(m.renderCommitOptionsMenu(), "only changes under services/api") {
+		t.Errorf("Expected the commit options to show the scope, got:\n%s", m.renderCommitOptionsMenu())
+	}
+}
+
+// TestDashboard_StagingMarkers tests that the status views tell staged, unstaged and partly staged files apart
+func TestDashboard_StagingMarkers(t *testing.T) {
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo.SetChanges([]domain.FileChange{
+		{Path: "staged.go", Status: domain.StatusModified, Staged: true},
+		{Path: "unstaged.go", Status: domain.StatusModified, Unstaged: true},
+		{Path: "both.go", Status: domain.StatusModified, Staged: true, Unstaged: true},
+	})
+	m := NewDashboardModel(nil, "/tmp/repo", domain.NewDefaultConfig())
+	m.repo = repo
+
+	for name, view := range map[string]string{
+		"quick status":       m.renderQuickStatusMenu(),
+		"repository details": m.renderRepositoryDetailsMenu(),
+	} {
+		for _, want := range []string{"● staged.go", "○ unstaged.go", "◐ both.go", "● staged  ○ unstaged  ◐ both"} {
+			if !strings.Contains(view, want) {
+				t.Errorf("Expected %s to contain %q, got:\n%s", name, want, view)
+			}
+		}
+	}
+}
+
+// TestDashboard_MaintenanceIndicator tests that the repository details show the object database size and flag when optimizing is warranted
+func TestDashboard_MaintenanceIndicator(t *testing.T) {
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewDashboardModel(nil, "/tmp/repo", domain.NewDefaultConfig())
+	m.repo = repo
+	m.activeSubmenu = RepositoryDetailsMenu
+	send := func(msg tea.Msg) {
+		updated, _ := m.Update(msg)
+		m = updated.(DashboardModel)
+	}
+
+	send(objectStatsMsg{stats: &git.ObjectStats{Loose: 12, LooseSize: "48.00 KiB", Packs: 1, PackSize: "1.21 MiB"}})
+	view := m.renderRepositoryDetailsMenu()
+	if !strings.Contains(view, "1.21 MiB in 1 pack, 12 loose objects (48.00 KiB)") || strings.Contains(view, "recommended") {
+		t.Errorf("Expected the size without a recommendation, got:\n%s", view)
+	}
+
+	send(objectStatsMsg{stats: &git.ObjectStats{Loose: 6900, LooseSize: "27.43 MiB", Packs: 1, PackSize: "1.21 MiB"}})
+	view = m.renderRepositoryDetailsMenu()
+	for _, want := range []string{"Optimizing is recommended: 6900 loose objects", "Optimize repository (git maintenance) • recommended"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q, got:\n%s", want, view)
+		}
+	}
+
+	// Without a remote: Set up remote, Manage remotes, View tags, Create tag, reflog, then optimize
+	m.submenuIndex = 5
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.GetAction() != ActionMaintenance {
+		t.Errorf("Expected ActionMaintenance, got %v", m.GetAction())
+	}
+}
+
+// pushRemoteGitOps lists remote names for the push chooser
+type pushRemoteGitOps struct {
+	git.Operations
+
+	remotes []string
+}
+
+func (f *pushRemoteGitOps) ListRemotes(ctx context.Context, repoPath string) ([]string, error) {
+	return f.remotes, nil
+}
+
+// TestDashboard_PushChoosesRemote tests that pushing asks for the remote only when there is more than one
+func TestDashboard_PushChoosesRemote(t *testing.T) {
+	tests := []struct {
+		name       string
+		remotes    []string
+		wantMenu   bool
+		wantRemote string
+	}{
+		{name: "single remote pushes to the primary", remotes: []string{"origin"}},
+		{name: "several remotes ask, starting at origin", remotes: []string{"fork", "origin"}, wantMenu: true, wantRemote: "origin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, err := domain.NewRepository("/tmp/repo")
+			if err != nil {
+				t.Fatal(err)
+			}
+			repo.SetHasRemote(true)
+			repo.SetCommitsAhead(2)
+			m := NewDashboardModel(&pushRemoteGitOps{remotes: tt.remotes}, "/tmp/repo", domain.NewDefaultConfig())
+			m.repo = repo
+			m.activeSubmenu = RepositoryDetailsMenu
+			send := func(msg tea.Msg) tea.Cmd {
+				updated, cmd := m.Update(msg)
+				m = updated.(DashboardModel)
+				return cmd
+			}
+
+			// Fetch, then Push
+			m.submenuIndex = 1
+			send(send(tea.KeyMsg{Type: tea.KeyEnter})())
+
+			if (m.activeSubmenu == PushRemoteMenu) != tt.wantMenu {
+				t.Fatalf("Expected the remote chooser open = %v, got %v", tt.wantMenu, m.activeSubmenu)
+			}
+			if tt.wantMenu {
+				if view := m.renderPushRemoteMenu(); !strings.Contains(view, "> origin") || !strings.Contains(view, "fork") {
+					t.Errorf("Expected the remotes listed with origin highlighted, got:\n%s", view)
+				}
+				send(tea.KeyMsg{Type: tea.KeyEnter})
+			}
+			if remote, _ := m.GetActionParams()["remote"].(string); m.GetAction() != ActionPush || remote != tt.wantRemote {
+				t.Errorf("Expected ActionPush to %q, got %v to %q", tt.wantRemote, m.GetAction(), remote)
+			}
+		})
+	}
+}
+
+// blameGitOps blames every file the same way, recording which was asked for
+type blameGitOps struct {
+	git.Operations
+
+	blamed string
+}
+
+func (f *blameGitOps) Blame(ctx context.Context, repoPath, filePath string) ([]git.BlameLine, error) {
+	f.blamed = filePath
+	return []git.BlameLine{
+		{Hash: "3f2a9c1e8b7d6a5f4e3d2c1b0a9f8e7d6c5b4a39", Author: "Ada Lovelace", Time: time.Date(2024, 3, 1, 9, 0, 0, 0, time.Local), Line: 1, Content: "package auth"},
+		{Hash: "0000000000000000000000000000000000000000", Author: "Not Committed Yet", Line: 2, Content: "func Login() {}"},
+	}, nil
+}
+
+// TestDashboard_BlameChangedFile tests blaming the file highlighted in the status and returning to it
+func TestDashboard_BlameChangedFile(t *testing.T) {
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo.SetChanges([]domain.FileChange{
+		{Path: "main.go", Status: domain.StatusModified, Unstaged: true},
+		{Path: "auth.go", Status: domain.StatusModified, Unstaged: true},
+	})
+	ops := &blameGitOps{}
+	m := NewDashboardModel(ops, "/tmp/repo", domain.NewDefaultConfig())
+	m.repo = repo
+	m.activeSubmenu = QuickStatusMenu
+	send := func(msg tea.Msg) tea.Cmd {
+		updated, cmd := m.Update(msg)
+		m = updated.(DashboardModel)
+		return cmd
+	}
+
+	// The highlight stops at the last listed file
+	send(tea.KeyMsg{Type: tea.KeyDown})
+	send(tea.KeyMsg{Type: tea.KeyDown})
+	if m.submenuIndex != 1 {
+		t.Fatalf("Expected auth.go highlighted, got index %d", m.submenuIndex)
+	}
+
+	send(send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})())
+	if m.activeSubmenu != BlameMenu || ops.blamed != "auth.go" {
+		t.Fatalf("Expected the blame of auth.go, got submenu %v blaming %q", m.activeSubmenu, ops.blamed)
+	}
+	view := m.renderBlameMenu()
+	for _, want := range []string{"Blame: auth.go", "3f2a9c1 Ada Lovelace   2024-03-01", "1 │ package auth", "not committed yet", "2 │ func Login() {}"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected the blame to contain %q, got:\n%s", want, view)
+		}
+	}
+
+	send(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.activeSubmenu != QuickStatusMenu || m.submenuIndex != 1 {
+		t.Errorf("Expected Esc to return to the status on auth.go, got submenu %v index %d", m.activeSubmenu, m.submenuIndex)
+	}
+}
+
+// commitDiffGitOps shows every commit the same way, recording which was asked for
+type commitDiffGitOps struct {
+	git.Operations
+
+	shown string
+}
+
+func (f *commitDiffGitOps) GetCommitDiff(ctx context.Context, repoPath, hash string) (string, error) {
+	f.shown = hash
+	return "commit " + hash + "\n\n    Break login\ndiff --git a/auth.go b/auth.go\n-func Login() {}\n", nil
+}
+
+// TestDashboard_CommitDiff tests showing a commit's diff from the list and its detail
+func TestDashboard_CommitDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		from ActiveSubmenu
+	}{
+		{"from the commit list", CommitListMenu},
+		{"from the commit detail", CommitDetailMenu},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &commitDiffGitOps{}
+			m := NewDashboardModel(ops, "/tmp/repo", domain.NewDefaultConfig())
+			m.recentCommits = []git.CommitInfo{
+				{Hash: "aaaaaaaaaaaa", Message: "Add login"},
+				{Hash: "bbbbbbbbbbbb", Message: "Break login"},
+			}
+			m.activeSubmenu = tt.from
+			m.submenuIndex = 1
+			m.detailCommitIndex = 1
+			send := func(msg tea.Msg) tea.Cmd {
+				updated, cmd := m.Update(msg)
+				m = updated.(DashboardModel)
+				return cmd
+			}
+
+			send(send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})())
+			if m.activeSubmenu != CommitDiffMenu || ops.shown != "bbbbbbbbbbbb" {
+				t.Fatalf("Expected the diff of bbbbbbb, got submenu %v showing %q", m.activeSubmenu, ops.shown)
+			}
+			view := m.renderCommitDiffMenu()
+			for _, want := range []string{"bbbbbbb", "Break login", "-func Login() {}"} {
+				if !strings.Contains(view, want) {
+					t.Errorf("Expected the diff to contain %q, got:\n%s", want, view)
+				}
+			}
+
+			send(tea.KeyMsg{Type: tea.KeyEsc})
+			if m.activeSubmenu != tt.from || m.commitDiff != nil {
+				t.Errorf("Expected Esc to return to %v, got %v", tt.from, m.activeSubmenu)
+			}
+			if tt.from == CommitListMenu && m.submenuIndex != 1 {
+				t.Errorf("Expected the commit still highlighted, got index %d", m.submenuIndex)
+			}
+		})
+	}
+}
+
+// reflogGitOps serves a reflog and records the branch created from it
+type reflogGitOps struct {
+	git.Operations
+
+	entries    []git.ReflogEntry
+	branch     string
+	startPoint string
+}
+
+func (f *reflogGitOps) GetReflog(ctx context.Context, repoPath string, count int) ([]git.ReflogEntry, error) {
+	return f.entries, nil
+}
+
+func (f *reflogGitOps) CreateBranchAt(ctx context.Context, repoPath, branchName, startPoint string) error {
+	f.branch, f.startPoint = branchName, startPoint
+	return nil
+}
+
+func (f *reflogGitOps) ListBranches(ctx context.Context, repoPath string, includeRemote bool) ([]string, error) {
+	return []string{"main", f.branch}, nil
+}
+
+// TestDashboard_RecoverFromReflog tests creating a branch at a reflog entry from the repository details
+func TestDashboard_RecoverFromReflog(t *testing.T) {
+	ops := &reflogGitOps{entries: []git.ReflogEntry{
+		{Hash: "aaaa1111aaaa", Date: "2024-03-02 10:00:00 +0100", Action: "checkout", Message: "moving from feature to main"},
+		{Hash: "bbbb2222bbbb", Date: "2024-03-02 09:00:00 +0100", Action: "commit", Message: "Unmerged login work"},
+	}}
+	m := NewDashboardModel(ops, "/tmp/repo", domain.NewDefaultConfig())
+	m.activeSubmenu = RepositoryDetailsMenu
+	send := func(msg tea.Msg) tea.Cmd {
+		updated, cmd := m.Update(msg)
+		m = updated.(DashboardModel)
+		return cmd
+	}
+	run := func(cmd tea.Cmd) {
+		if cmd != nil {
+			send(cmd())
+		}
+	}
+
+	// Without a remote: Set up remote, Manage remotes, View tags, Create tag, then the reflog
+	m.submenuIndex = 4
+	run(send(tea.KeyMsg{Type: tea.KeyEnter}))
+	if m.activeSubmenu != ReflogMenu || len(m.reflog) != 2 {
+		t.Fatalf("Expected the reflog to load, got %v with %d entries", m.activeSubmenu, len(m.reflog))
+	}
+	if view := m.renderReflogMenu(); !strings.Contains(view, "bbbb222 commit") || !strings.Contains(view, "Unmerged login work") {
+		t.Errorf("Expected the reflog entries listed, got:\n%s", view)
+	}
+
+	send(tea.KeyMsg{Type: tea.KeyDown})
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.activeSubmenu != RecoverBranchMenu || !m.CapturingInput() {
+		t.Fatalf("Expected the branch form to capture input, got %v", m.activeSubmenu)
+	}
+
+	// Esc returns to the same entry
+	send(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.activeSubmenu != ReflogMenu || m.submenuIndex != 1 {
+		t.Fatalf("Expected the reflog at entry 1, got %v at %d", m.activeSubmenu, m.submenuIndex)
+	}
+
+	// An empty name takes the suggestion
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	run(send(tea.KeyMsg{Type: tea.KeyEnter}))
+	if ops.branch != "recovered/bbbb222" || ops.startPoint != "bbbb2222bbbb" {
+		t.Errorf("Expected recovered/bbbb222 at bbbb2222bbbb, got %q at %q", ops.branch, ops.startPoint)
+	}
+	if m.activeSubmenu != NoSubmenu || !strings.Contains(strings.Join(m.Activity(), "\n"), "as branch recovered/bbbb222") {
+		t.Errorf("Expected the recovery to be logged and the menu closed, got %v: %v", m.activeSubmenu, m.Activity())
+	}
+}
+
+// fetchCountingGitOps serves the dashboard's initial loads and counts fetches
+type fetchCountingGitOps struct {
+	git.Operations
+
+	hasRemote  bool
+	fetchCalls int
+}
+
+func (f *fetchCountingGitOps) GetStatus(ctx context.Context, repoPath string) (*domain.Repository, error) {
+	return domain.NewRepository(repoPath)
+}
+
+func (f *fetchCountingGitOps) GetBranchInfo(ctx context.Context, repoPath string, protectedBranches []string) (*domain.BranchInfo, error) {
+	return domain.NewBranchInfo("main")
+}
+
+func (f *fetchCountingGitOps) ListBranches(ctx context.Context, repoPath string, includeRemote bool) ([]string, error) {
+	return []string{"main"}, nil
+}
+
+func (f *fetchCountingGitOps) GetLog(ctx context.Context, repoPath string, count int) ([]git.CommitInfo, error) {
+	return nil, nil
+}
+
+func (f *fetchCountingGitOps) HasRemote(ctx context.Context, repoPath string) (bool, error) {
+	return f.hasRemote, nil
+}
+
+func (f *fetchCountingGitOps) Fetch(ctx context.Context, repoPath string) error {
+	f.fetchCalls++
+	return nil
+}
+
+// TestDashboard_AutoFetchOnOpen tests that Init fetches in the background only when enabled and a remote exists
+func TestDashboard_AutoFetchOnOpen(t *testing.T) {
+	tests := []struct {
+		name           string
+		autoFetch      bool
+		hasRemote      bool
+		wantFetchCalls int
+	}{
+		{"enabled with remote", true, true, 1},
+		{"enabled without remote", true, false, 0},
+		{"disabled", false, true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := domain.NewDefaultConfig()
+			cfg.Git.AutoFetchOnOpen = tt.autoFetch
+			ops := &fetchCountingGitOps{hasRemote: tt.hasRemote}
+			m := NewDashboardModel(ops, "/tmp/repo", cfg)
+
+			batch, ok := m.Init()().(tea.BatchMsg)
+			if !ok {
+				t.Fatal("Expected Init to batch its commands")
+			}
+
+			var fetched *autoFetchMsg
+			for _, cmd := range batch {
+				if msg, ok := cmd().(autoFetchMsg); ok {
+					fetched = &msg
+				}
+			}
+
+			if ops.fetchCalls != tt.wantFetchCalls {
+				t.Errorf("Fetch called %d times, want %d", ops.fetchCalls, tt.wantFetchCalls)
+			}
+			if !tt.autoFetch {
+				if fetched != nil {
+					t.Error("Expected no auto-fetch command when disabled")
+				}
+				return
+			}
+			if fetched == nil {
+				t.Fatal("Expected an auto-fetch command on init")
+			}
+
+			// Once it reports back, a successful fetch refreshes sync status and isn't repeated
+			updated, cmd := m.Update(*fetched)
+			dash := updated.(DashboardModel)
+			if dash.autoFetchPending {
+				t.Error("Expected auto-fetch to be done after its result")
+			}
+			if tt.hasRemote && cmd == nil {
+				t.Error("Expected a sync status refresh after fetching")
+			}
+		})
+	}
+}
+
+// TestDashboard_MergeIntoChosenTarget tests picking the branch to merge into from the merge options
+func TestDashboard_MergeIntoChosenTarget(t *testing.T) {
+	m := NewDashboardModel(nil, "/tmp/repo", domain.NewDefaultConfig())
+	m.branches = []string{"develop", "main", "release"}
+	m.activeSubmenu = MergeOptionsMenu
+	m.submenuIndex = 4
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(DashboardModel)
+	if m.activeSubmenu != MergeTargetMenu || len(m.mergeTargets) != 3 {
+		t.Fatalf("Expected the merge targets listed, got %v with %v", m.activeSubmenu, m.mergeTargets)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = updated.(DashboardModel)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(DashboardModel)
+	if m.action != ActionMerge || m.actionParams["target"] != "main" {
+		t.Errorf("Expected a merge into main, got action %v with %v", m.action, m.actionParams)
+	}
+}
+
+// remoteGitOps keeps remotes in memory, refusing taken names like ExecOperations
+type remoteGitOps struct {
+	git.Operations
+
+	remotes []git.Remote
+}
+
+func (f *remoteGitOps) ListRemotesWithURLs(ctx context.Context, repoPath string) ([]git.Remote, error) {
+	return f.remotes, nil
+}
+
+func (f *remoteGitOps) AddRemote(ctx context.Context, repoPath, name, url string) error {
+	for _, remote := range f.remotes {
+		if remote.Name == name {
+			return fmt.Errorf("a remote named %q already exists", name)
+		}
+	}
+	f.remotes = append(f.remotes, git.Remote{Name: name, URL: url})
+	return nil
+}
+
+func (f *remoteGitOps) RenameRemote(ctx context.Context, repoPath, oldName, newName string) error {
+	for i, remote := range f.remotes {
+		if remote.Name == oldName {
+			f.remotes[i].Name = newName
+		}
+	}
+	return nil
+}
+
+func (f *remoteGitOps) RemoveRemote(ctx context.Context, repoPath, name string) error {
+	var kept []git.Remote
+	for _, remote := range f.remotes {
+		if remote.Name != name {
+			kept = append(kept, remote)
+		}
+	}
+	f.remotes = kept
+	return nil
+}
+
+// TestDashboard_ManageRemotes tests adding, renaming and removing remotes from the repository details
+func TestDashboard_ManageRemotes(t *testing.T) {
+	ops := &remoteGitOps{remotes: []git.Remote{{Name: "origin", URL: "git@github.com:acme/app.git"}}}
+	m := NewDashboardModel(ops, "/tmp/repo", domain.NewDefaultConfig())
+	m.activeSubmenu = RepositoryDetailsMenu
+	send := func(msg tea.Msg) tea.Cmd {
+		updated, cmd := m.Update(msg)
+		m = updated.(DashboardModel)
+		return cmd
+	}
+	// run delivers a command's message and then its follow-ups, leaving out
+	// the status refresh batched after reloading the remotes
+	var run func(cmd tea.Cmd)
+	run = func(cmd tea.Cmd) {
+		if cmd == nil {
+			return
+		}
+		msg := cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			msg = batch[0]()
+		}
+		run(send(msg))
+	}
+	typeText := func(text string) {
+		send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+	}
+
+	// Without a remote: Set up remote, then Manage remotes
+	m.submenuIndex = 1
+	run(send(tea.KeyMsg{Type: tea.KeyEnter}))
+	if m.activeSubmenu != RemoteListMenu || !strings.Contains(m.renderRemoteListMenu(), "git@github.com:acme/app.git") {
+		t.Fatalf("Expected the remotes listed, got %v:\n%s", m.activeSubmenu, m.renderRemoteListMenu())
+	}
+
+	// A taken name is refused with a friendly error
+	send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if !m.CapturingInput() {
+		t.Fatal("Expected the add form to capture input")
+	}
+	typeText("origin")
+	send(tea.KeyMsg{Type: tea.KeyTab})
+	typeText("https://example.com/fork.git")
+	run(send(tea.KeyMsg{Type: tea.KeyEnter}))
+	if m.activeSubmenu != RemoteFormMenu || !strings.Contains(m.remoteError, `a remote named "origin" already exists`) {
+		t.Fatalf("Expected the duplicate name refused, got %v with %q", m.activeSubmenu, m.remoteError)
+	}
+
+	send(tea.KeyMsg{Type: tea.KeyShiftTab})
+	m.remoteNameInput.SetValue("fork")
+	run(send(tea.KeyMsg{Type: tea.KeyEnter}))
+	if m.activeSubmenu != RemoteListMenu || len(m.remotes) != 2 || m.remotes[1].URL != "https://example.com/fork.git" {
+		t.Fatalf("Expected fork added, got %v with %+v", m.activeSubmenu, m.remotes)
+	}
+
+	// Enter renames the highlighted remote
+	send(tea.KeyMsg{Type: tea.KeyDown})
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	m.remoteNameInput.SetValue("mine")
+	run(send(tea.KeyMsg{Type: tea.KeyEnter}))
+	if len(m.remotes) != 2 || m.remotes[1].Name != "mine" {
+		t.Fatalf("Expected fork renamed to mine, got %+v", m.remotes)
+	}
+
+	// Removal asks first
+	send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	if !strings.Contains(m.renderRemoteListMenu(), "Remove remote origin") {
+		t.Fatalf("Expected a removal prompt, got:\n%s", m.renderRemoteListMenu())
+	}
+	run(send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")}))
+	if len(m.remotes) != 1 || m.remotes[0].Name != "mine" {
+		t.Errorf("Expected origin removed, got %+v", m.remotes)
+	}
+	activity := strings.Join(m.Activity(), "\n")
+	for _, want := range []string{"Added remote fork", "Renamed remote fork to mine", "Removed remote origin"} {
+		if !strings.Contains(activity, want) {
+			t.Errorf("Expected %q in activity, got:\n%s", want, activity)
+		}
+	}
+}
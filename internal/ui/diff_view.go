@@ -0,0 +1,262 @@
+package ui
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/gitman/internal/adapter/git"
+)
+
+// DiffViewModel is a scrollable diff viewer shared by every view that shows a diff.
+// It standardizes the staged/unstaged toggle (tab or s) so users can flip
+// between what is staged and what is still in the working tree. Showing a
+// commit instead, it has no toggle.
+type DiffViewModel struct {
+	gitOps   git.Operations
+	repoPath string
+
+	commit   string // Hash of the commit shown; "" for the working tree
+	staged   bool
+	content  string
+	loading  bool
+	err      error
+	viewport viewport.Model
+
+	width  int
+	height int
+}
+
+// diffLoadedMsg carries a fetched diff back to the DiffViewModel
+type diffLoadedMsg struct {
+	commit  string
+	staged  bool
+	content string
+	err     error
+}
+
+// NewDiffViewModel creates a diff viewer starting in the given staged mode.
+func NewDiffViewModel(gitOps git.Operations, repoPath string, staged bool, width, height int) DiffViewModel {
+	m := DiffViewModel{
+		gitOps:   gitOps,
+		repoPath: repoPath,
+		staged:   staged,
+		loading:  true,
+		viewport: viewport.New(width, height),
+		width:    width,
+		height:   height,
+	}
+	m.resizeViewport()
+	m.viewport.SetContent(m.renderContent())
+	return m
+}
+
+// NewCommitDiffViewModel creates a diff viewer showing the commit with hash
+// against its parent.
+func NewCommitDiffViewModel(gitOps git.Operations, repoPath, hash string, width, height int) DiffViewModel {
+	m := NewDiffViewModel(gitOps, repoPath, false, width, height)
+	m.commit = hash
+	m.viewport.SetContent(m.renderContent())
+	return m
+}
+
+// Init fetches the diff for the initial mode
+func (m DiffViewModel) Init() tea.Cmd {
+	return m.fetchDiff()
+}
+
+// Update handles messages for the diff viewer
+func (m DiffViewModel) Update(msg tea.Msg) (DiffViewModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.resizeViewport()
+		return m, nil
+
+	case diffLoadedMsg:
+		// Ignore results for a mode the user already toggled away from,
+		// or for another commit
+		if msg.staged != m.staged || msg.commit != m.commit {
+			return m, nil
+		}
+		m.loading = false
+		m.err = msg.err
+		m.content = msg.content
+		m.viewport.SetContent(m.renderContent())
+		m.viewport.GotoTop()
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "tab", "s":
+			if m.commit == "" {
+				return m.ToggleStaged()
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// ToggleStaged switches between the staged and unstaged diff and re-fetches it
+func (m DiffViewModel) ToggleStaged() (DiffViewModel, tea.Cmd) {
+	m.staged = !m.staged
+	m.loading = true
+	m.err = nil
+	m.content = ""
+	m.viewport.SetContent(m.renderContent())
+	return m, m.fetchDiff()
+}
+
+// IsStaged returns true if the viewer is showing the staged diff
+func (m DiffViewModel) IsStaged() bool {
+	return m.staged
+}
+
+// fetchDiff loads the diff for the current mode asynchronously
+func (m DiffViewModel) fetchDiff() tea.Cmd {
+	gitOps := m.gitOps
+	repoPath := m.repoPath
+	staged := m.staged
+	commit := m.commit
+
+	return func() tea.Msg {
+		if gitOps == nil {
+			return diffLoadedMsg{commit: commit, staged: staged}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if commit != "" {
+			content, err := gitOps.GetCommitDiff(ctx, repoPath, commit)
+			return diffLoadedMsg{commit: commit, content: content, err: err}
+		}
+		content, err := gitOps.GetDiff(ctx, repoPath, staged)
+		return diffLoadedMsg{staged: staged, content: content, err: err}
+	}
+}
+
+// resizeViewport sizes the viewport to the space left below the header
+func (m *DiffViewModel) resizeViewport() {
+	m.viewport.Width = m.width
+	m.viewport.Height = m.height - 2 // header + spacer
+	if m.viewport.Height < 3 {
+		m.viewport.Height = 3
+	}
+}
+
+// View renders the mode header followed by the scrollable diff
+func (m DiffViewModel) View() string {
+	return m.renderHeader() + "\n\n" + m.viewport.View()
+}
+
+// renderHeader renders the staged/unstaged mode indicator, or the commit shown
+func (m DiffViewModel) renderHeader() string {
+	styles := GetGlobalThemeManager().GetStyles()
+
+	if m.commit != "" {
+		hash := m.commit
+		if len(hash) > 7 {
+			hash = hash[:7]
+		}
+		return styles.SectionTitle.Render("Commit") + "  " + styles.StatusInfo.Render(hash) + "  " +
+			styles.ShortcutKey.Render("↑/↓ pgup/pgdn") + " " + styles.ShortcutDesc.Render("scroll")
+	}
+
+	var mode string
+	if m.staged {
+		mode = styles.StatusOk.Bold(true).Render("STAGED")
+	} else {
+		mode = styles.StatusWarning.Bold(true).Render("UNSTAGED")
+	}
+
+	return styles.SectionTitle.Render("Diff") + "  " + mode + "  " +
+		styles.ShortcutKey.Render("tab/s") + " " + styles.ShortcutDesc.Render("toggle staged/unstaged")
+}
+
+// renderContent renders the viewport body for the current state
+func (m DiffViewModel) renderContent() string {
+	styles := GetGlobalThemeManager().GetStyles()
+
+	switch {
+	case m.loading:
+		return styles.Metadata.Render("Loading diff...")
+	case m.err != nil:
+		return styles.StatusError.Render("Failed to load diff: " + m.err.Error())
+	case strings.TrimSpace(m.content) == "":
+		if m.commit != "" {
+			return styles.Metadata.Render("The commit changes no files")
+		}
+		if m.staged {
+			return styles.Metadata.Render("No staged changes")
+		}
+		return styles.Metadata.Render("No unstaged changes")
+	}
+
+	return RenderDiff(m.content)
+}
+
+// diffLineKind classifies a line of diff output for coloring
+type diffLineKind int
+
+const (
+	diffLineContext diffLineKind = iota
+	diffLineCommit               // git show's commit header: commit, Author, Date
+	diffLineFile
+	diffLineHunk
+	diffLineAdded
+	diffLineRemoved
+)
+
+// commitHeaderPrefixes start the header lines git show prints before the message
+var commitHeaderPrefixes = []string{"commit ", "Author:", "AuthorDate:", "Commit:", "CommitDate:", "Date:", "Merge:"}
+
+// classifyDiffLine returns the kind of a line of git diff or git show output.
+// Diff lines start with a space, + or -, so the commit header can't be confused with them.
+func classifyDiffLine(line string) diffLineKind {
+	switch {
+	case strings.HasPrefix(line, "diff --git"), strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+		return diffLineFile
+	case strings.HasPrefix(line, "@@"):
+		return diffLineHunk
+	case strings.HasPrefix(line, "+"):
+		return diffLineAdded
+	case strings.HasPrefix(line, "-"):
+		return diffLineRemoved
+	}
+	for _, prefix := range commitHeaderPrefixes {
+		if strings.HasPrefix(line, prefix) {
+			return diffLineCommit
+		}
+	}
+	return diffLineContext
+}
+
+// RenderDiff colorizes unified diff output: additions, deletions, hunk headers and file headers,
+// and for git show output the commit header.
+func RenderDiff(diff string) string {
+	styles := GetGlobalThemeManager().GetStyles()
+	kindStyles := map[diffLineKind]lipgloss.Style{
+		diffLineCommit:  lipgloss.NewStyle().Foreground(styles.ColorWarning),
+		diffLineFile:    lipgloss.NewStyle().Foreground(styles.ColorPrimary).Bold(true),
+		diffLineHunk:    lipgloss.NewStyle().Foreground(styles.ColorSecondary),
+		diffLineAdded:   lipgloss.NewStyle().Foreground(styles.ColorSuccess),
+		diffLineRemoved: lipgloss.NewStyle().Foreground(styles.ColorError),
+	}
+
+	lines := strings.Split(strings.TrimRight(diff, "\n"), "\n")
+	for i, line := range lines {
+		if style, ok := kindStyles[classifyDiffLine(line)]; ok {
+			lines[i] = style.Render(line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
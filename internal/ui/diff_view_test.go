@@ -0,0 +1,128 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestDiffView_HeaderReflectsToggle tests that the header shows the current staged/unstaged mode
+func TestDiffView_HeaderReflectsToggle(t *testing.T) {
+	tests := []struct {
+		name       string
+		startStage bool
+		key        tea.KeyMsg
+		wantStaged bool
+		wantHeader string
+	}{
+		{"unstaged to staged with s", false, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}}, true, "STAGED"},
+		{"staged to unstaged with s", true, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}}, false, "UNSTAGED"},
+		{"unstaged to staged with tab", false, tea.KeyMsg{Type: tea.KeyTab}, true, "STAGED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewDiffViewModel(nil, "/tmp/repo", tt.startStage, 80, 20)
+
+			m, cmd := m.Update(tt.key)
+			if cmd == nil {
+				t.Error("Expected toggle to re-fetch the diff")
+			}
+			if m.IsStaged() != tt.wantStaged {
+				t.Errorf("IsStaged() = %v, want %v", m.IsStaged(), tt.wantStaged)
+			}
+
+			header := m.renderHeader()
+			if !strings.Contains(header, tt.wantHeader) {
+				t.Errorf("Header %q does not contain %q", header, tt.wantHeader)
+			}
+			if tt.wantHeader == "STAGED" && strings.Contains(header, "UNSTAGED") {
+				t.Errorf("Header %q should not show UNSTAGED", header)
+			}
+		})
+	}
+}
+
+// TestDiffView_IgnoresStaleDiff tests that a diff for the previous mode is discarded
+func TestDiffView_IgnoresStaleDiff(t *testing.T) {
+	m := NewDiffViewModel(nil, "/tmp/repo", false, 80, 20)
+	m, _ = m.ToggleStaged()
+
+	m, _ = m.Update(diffLoadedMsg{staged: false, content: "+stale line"})
+	if strings.Contains(m.View(), "stale line") {
+		t.Error("Expected stale unstaged diff to be ignored while in staged mode")
+	}
+
+	m, _ = m.Update(diffLoadedMsg{staged: true, content: "+fresh line"})
+	if !strings.Contains(m.View(), "fresh line") {
+		t.Error("Expected staged diff to be rendered")
+	}
+}
+
+// sampleCommitShow is git show output for a commit changing one file
+const sampleCommitShow = `commit 3f2a9c1e8b7d6a5f4e3d2c1b0a9f8e7d6c5b4a39
+Author: Ada Lovelace <ada@example.com>
+Date:   Fri Mar 1 09:00:00 2024 +0000
+
+    Add login handler
+
+    - validates the session
+diff --git a/auth.go b/auth.go
+index 1b2c3d4..5e6f7a8 100644
+--- a/auth.go
++++ b/auth.go
+@@ -1,3 +1,4 @@
+ package auth
+-func Login() {}
++func Login() error {
++    return nil
++}
+`
+
+// TestClassifyDiffLine tests classifying the lines of git show output
+func TestClassifyDiffLine(t *testing.T) {
+	want := []diffLineKind{
+		diffLineCommit, diffLineCommit, diffLineCommit, diffLineContext,
+		diffLineContext, diffLineContext, diffLineContext, // The message, even a "- " bullet, is indented
+		diffLineFile, diffLineContext, diffLineFile, diffLineFile, diffLineHunk,
+		diffLineContext, diffLineRemoved, diffLineAdded, diffLineAdded, diffLineAdded,
+	}
+
+	lines := strings.Split(strings.TrimRight(sampleCommitShow, "\n"), "\n")
+	if len(lines) != len(want) {
+		t.Fatalf("sample has %d lines, want %d", len(lines), len(want))
+	}
+	for i, line := range lines {
+		if got := classifyDiffLine(line); got != want[i] {
+			t.Errorf("classifyDiffLine(%q) = %d, want %d", line, got, want[i])
+		}
+	}
+
+	rendered := RenderDiff(sampleCommitShow)
+	for _, line := range lines {
+		if !strings.Contains(rendered, line) {
+			t.Errorf("RenderDiff() lost line %q", line)
+		}
+	}
+}
+
+// TestDiffView_CommitHasNoStagedToggle tests that a commit's diff ignores the staged toggle
+func TestDiffView_CommitHasNoStagedToggle(t *testing.T) {
+	m := NewCommitDiffViewModel(nil, "/tmp/repo", "3f2a9c1e8b7d6a5f4e3d2c1b0a9f8e7d6c5b4a39", 80, 20)
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	if cmd != nil || m.IsStaged() {
+		t.Error("Expected s not to toggle a commit's diff")
+	}
+	if header := m.renderHeader(); !strings.Contains(header, "Commit") || !strings.Contains(header, "3f2a9c1") || strings.Contains(header, "STAGED") {
+		t.Errorf("Header %q should name the commit, not a staged mode", header)
+	}
+
+	m, _ = m.Update(diffLoadedMsg{commit: "0000000", content: "+other commit"})
+	m, _ = m.Update(diffLoadedMsg{commit: "3f2a9c1e8b7d6a5f4e3d2c1b0a9f8e7d6c5b4a39", content: sampleCommitShow})
+	view := m.View()
+	if strings.Contains(view, "other commit") || !strings.Contains(view, "Add login handler") {
+		t.Errorf("Expected only the commit's own diff, got:\n%s", view)
+	}
+}
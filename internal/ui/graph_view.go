@@ -0,0 +1,465 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+	"github.com/yourusername/gitman/internal/ui/layout"
+	"github.com/yourusername/gitman/internal/usecase"
+)
+
+// GraphViewModel represents the state of the commit graph view.
+type GraphViewModel struct {
+	// Data
+	graph    *domain.CommitGraph
+	messages map[string]string // Full commit messages by hash, loaded on selection
+	repoPath string
+	config   *domain.Config
+	gitOps   git.Operations
+	refCache *usecase.RefCache // Reuses a graph loaded moments ago; nil always loads
+
+	// State
+	selectedIndex int
+	expanded      bool // Detail pane open for the selected commit
+	loading       bool
+
+	// UI Components
+	viewport       viewport.Model
+	detailViewport viewport.Model
+
+	// Dimensions
+	windowWidth  int
+	windowHeight int
+
+	// Error handling
+	errorMessage string
+}
+
+// graphLoadedMsg is sent when the commit graph has loaded.
+type graphLoadedMsg struct {
+	graph *domain.CommitGraph
+	err   error
+}
+
+// commitMessageLoadedMsg carries the full message of a commit in the graph.
+type commitMessageLoadedMsg struct {
+	hash    string
+	message string
+	err     error
+}
+
+// NewGraphViewModel creates a new commit graph view model.
+func NewGraphViewModel(repoPath string, config *domain.Config, gitOps git.Operations) GraphViewModel {
+	m := GraphViewModel{
+		messages:       make(map[string]string),
+		repoPath:       repoPath,
+		config:         config,
+		gitOps:         gitOps,
+		loading:        true,
+		viewport:       viewport.New(50, 20),
+		detailViewport: viewport.New(50, 20),
+		windowWidth:    120,
+		windowHeight:   30,
+	}
+
+	m.viewport.SetContent("Loading commit graph...")
+
+	return m
+}
+
+// WithRefCache makes the view reuse a graph loaded moments ago while no ref
+// has moved. R still reloads it from git.
+func (m GraphViewModel) WithRefCache(cache *usecase.RefCache) GraphViewModel {
+	m.refCache = cache
+	return m
+}
+
+// Init loads the commit graph.
+func (m GraphViewModel) Init() tea.Cmd {
+	return m.loadGraph()
+}
+
+// loadGraph loads the graph for every ref, or only the current, parent and
+// main branches unless cfg.UI.GraphAllRefs is set.
+func (m GraphViewModel) loadGraph() tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		var refs []string
+		if !m.config.UI.GraphAllRefs {
+			info, err := m.gitOps.GetBranchInfo(ctx, m.repoPath, m.config.Git.ProtectedBranches)
+			if err != nil {
+				return graphLoadedMsg{err: err}
+			}
+			refs = domain.RelevantGraphRefs(info.Name(), info.Parent(), m.config.Git.MainBranch)
+		}
+
+		graph, err := usecase.CachedLoad(ctx, m.refCache, m.gitOps, m.repoPath, "graph:"+strings.Join(refs, ","), func() (*domain.CommitGraph, error) {
+			return m.gitOps.GetCommitGraph(ctx, m.repoPath, refs, 0)
+		})
+		return graphLoadedMsg{graph: graph, err: err}
+	}
+}
+
+// forceRefresh reloads the graph from git, bypassing the cache
+func (m GraphViewModel) forceRefresh() tea.Cmd {
+	m.refCache.Invalidate()
+	return m.loadGraph()
+}
+
+// loadCommitMessage loads the full message of a commit for the detail pane.
+func (m GraphViewModel) loadCommitMessage(hash string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		message, err := m.gitOps.GetCommitMessage(ctx, m.repoPath, hash)
+		return commitMessageLoadedMsg{hash: hash, message: message, err: err}
+	}
+}
+
+// Update handles messages and updates the graph view.
+func (m GraphViewModel) Update(msg tea.Msg) (GraphViewModel, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.windowWidth = msg.Width
+		m.windowHeight = msg.Height
+		m.resize()
+		m.updateViewportContent()
+		return m, nil
+
+	case graphLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.errorMessage = fmt.Sprintf("Error: %v", msg.err)
+			m.viewport.SetContent(fmt.Sprintf("Error loading commit graph:\n\n%v", msg.err))
+			return m, nil
+		}
+		m.errorMessage = ""
+		m.graph = msg.graph
+		if m.selectedIndex >= len(m.graph.Nodes) {
+			m.selectedIndex = 0
+		}
+		m.updateViewportContent()
+		if m.expanded {
+			return m, m.selectCommit()
+		}
+		return m, nil
+
+	case commitMessageLoadedMsg:
+		if msg.err != nil {
+			m.errorMessage = fmt.Sprintf("Error: %v", msg.err)
+			return m, nil
+		}
+		m.messages[msg.hash] = msg.message
+		m.updateViewportContent()
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.handleKeys(msg)
+	}
+
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// handleKeys handles keyboard input.
+func (m GraphViewModel) handleKeys(msg tea.KeyMsg) (GraphViewModel, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.selectedIndex > 0 {
+			m.selectedIndex--
+			m.updateViewportContent()
+			m.scrollToSelected()
+			return m, m.selectCommit()
+		}
+		return m, nil
+
+	case "down", "j":
+		if m.graph != nil && m.selectedIndex < len(m.graph.Nodes)-1 {
+			m.selectedIndex++
+			m.updateViewportContent()
+			m.scrollToSelected()
+			return m, m.selectCommit()
+		}
+		return m, nil
+
+	case "enter":
+		// Toggle the detail pane
+		m.expanded = !m.expanded
+		m.resize()
+		m.updateViewportContent()
+		m.scrollToSelected()
+		return m, m.selectCommit()
+
+	case "esc":
+		if m.expanded {
+			m.expanded = false
+			m.resize()
+			m.updateViewportContent()
+		}
+		return m, nil
+
+	case "R":
+		m.loading = true
+		m.errorMessage = ""
+		return m, m.forceRefresh()
+	}
+
+	return m, nil
+}
+
+// selectCommit loads the selected commit's full message if the detail pane
+// shows it and it isn't loaded yet.
+func (m GraphViewModel) selectCommit() tea.Cmd {
+	node := m.selectedNode()
+	if !m.expanded || node == nil {
+		return nil
+	}
+	if _, ok := m.messages[node.Hash]; ok {
+		return nil
+	}
+	return m.loadCommitMessage(node.Hash)
+}
+
+// selectedNode returns the highlighted commit, or nil if there is none.
+func (m GraphViewModel) selectedNode() *domain.CommitNode {
+	if m.graph == nil || m.selectedIndex < 0 || m.selectedIndex >= len(m.graph.Nodes) {
+		return nil
+	}
+	return &m.graph.Nodes[m.selectedIndex]
+}
+
+// resize sizes the viewports for the full-width or split layout.
+func (m *GraphViewModel) resize() {
+	headerHeight := 6
+	footerHeight := 3
+	contentHeight := m.windowHeight - headerHeight - footerHeight
+	if contentHeight < 5 {
+		contentHeight = 5
+	}
+
+	if m.expanded {
+		// Split view (50/50): the graph needs room to stay readable
+		leftWidth, rightWidth := layout.CalculateSplitWidths(m.windowWidth, layout.SplitRatio50_50)
+		m.viewport.Width = leftWidth - 4
+		m.detailViewport.Width = rightWidth - 4
+		m.detailViewport.Height = contentHeight
+	} else {
+		m.viewport.Width = m.windowWidth - 4
+	}
+	m.viewport.Height = contentHeight
+}
+
+// updateViewportContent updates the viewport content based on current state.
+func (m *GraphViewModel) updateViewportContent() {
+	if m.graph == nil {
+		return
+	}
+	m.viewport.SetContent(m.renderGraph())
+	if m.expanded {
+		m.detailViewport.SetContent(m.renderDetailPanel())
+	}
+}
+
+// scrollToSelected ensures the selected commit is visible in the viewport.
+func (m *GraphViewModel) scrollToSelected() {
+	if m.selectedIndex < m.viewport.YOffset {
+		m.viewport.YOffset = m.selectedIndex
+	}
+	if m.selectedIndex >= m.viewport.YOffset+m.viewport.Height {
+		m.viewport.YOffset = m.selectedIndex - m.viewport.Height + 1
+	}
+}
+
+// View renders the graph view.
+func (m GraphViewModel) View() string {
+	styles := GetGlobalThemeManager().GetStyles()
+
+	header := lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.Header.Render("COMMIT GRAPH"),
+		styles.RepoLabel.Render("Repository: ")+styles.RepoValue.Render(m.repoPath),
+	)
+
+	var content string
+	if m.expanded {
+		divider := lipgloss.NewStyle().
+			Foreground(styles.ColorBorder).
+			Render(strings.Repeat("│\n", m.viewport.Height))
+
+		content = lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			styles.ViewportStyle.Render(m.viewport.View()),
+			divider,
+			styles.ViewportStyle.Render(m.detailViewport.View()),
+		)
+	} else {
+		content = styles.ViewportStyle.Render(m.viewport.View())
+	}
+
+	var message string
+	if m.errorMessage != "" {
+		message = styles.StatusError.Render("✗ " + m.errorMessage)
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		header,
+		message,
+		"",
+		content,
+		"",
+		m.renderFooter(),
+	)
+}
+
+// renderGraph renders one line per commit: graph, short hash, subject and refs.
+// HEAD and merge commits are colored so they stand out.
+func (m GraphViewModel) renderGraph() string {
+	if len(m.graph.Nodes) == 0 {
+		return "\n\n      No commits yet"
+	}
+
+	styles := GetGlobalThemeManager().GetStyles()
+	headStyle := lipgloss.NewStyle().Foreground(styles.ColorSuccess).Bold(true)
+	mergeStyle := lipgloss.NewStyle().Foreground(styles.ColorWarning)
+
+	var lines []string
+	for i, node := range m.graph.Nodes {
+		// Leave room for the graph, hash and refs
+		refs := graphRefLabel(node)
+		width := m.viewport.Width - len(node.GraphLine) - len(node.ShortHash) - 2
+		if refs != "" {
+			width -= len(refs) + 1
+		}
+		if width < 20 {
+			width = 20
+		}
+		row := fmt.Sprintf("%s %s %s", node.GraphLine, node.ShortHash, truncate(node.Message, width))
+		if refs != "" {
+			row += " " + refs
+		}
+
+		switch {
+		case i == m.selectedIndex:
+			row = styles.ListItemSelected.Render(row)
+		case node.IsHead:
+			row = headStyle.Render(row)
+		case node.IsMerge():
+			row = mergeStyle.Render(row)
+		default:
+			row = styles.ListItemNormal.Render(row)
+		}
+		lines = append(lines, row)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// graphRefLabel returns the refs pointing at a commit, as git log decorates them.
+func graphRefLabel(node domain.CommitNode) string {
+	if !node.HasRefs() {
+		return ""
+	}
+
+	var refs []string
+	switch {
+	case node.HeadBranch != "":
+		refs = append(refs, "HEAD -> "+node.HeadBranch)
+	case node.IsHead:
+		refs = append(refs, "HEAD")
+	}
+	for _, branch := range node.LocalBranches {
+		if branch != node.HeadBranch {
+			refs = append(refs, branch)
+		}
+	}
+	refs = append(refs, node.RemoteBranches...)
+	for _, tag := range node.Tags {
+		refs = append(refs, "tag: "+tag)
+	}
+	return "(" + strings.Join(refs, ", ") + ")"
+}
+
+// renderDetailPanel renders the detail pane for the selected commit.
+func (m GraphViewModel) renderDetailPanel() string {
+	node := m.selectedNode()
+	if node == nil {
+		return "No commit selected"
+	}
+
+	styles := GetGlobalThemeManager().GetStyles()
+	var lines []string
+
+	lines = append(lines, styles.Header.Bold(true).Render(node.ShortHash))
+	lines = append(lines, "")
+
+	lines = append(lines, styles.StatusInfo.Render("Commit Information:"))
+	lines = append(lines, fmt.Sprintf("  Hash: %s", node.Hash))
+	lines = append(lines, fmt.Sprintf("  Author: %s", node.Author))
+	lines = append(lines, fmt.Sprintf("  Date: %s", node.Date))
+	if len(node.Parents) == 0 {
+		lines = append(lines, "  Parents: - (root commit)")
+	} else {
+		lines = append(lines, fmt.Sprintf("  Parents: %s", strings.Join(node.Parents, ", ")))
+	}
+	if node.IsMerge() {
+		lines = append(lines, styles.StatusWarning.Render(fmt.Sprintf("  Merge commit (%d parents)", len(node.Parents))))
+	}
+	if refs := graphRefLabel(*node); refs != "" {
+		lines = append(lines, fmt.Sprintf("  Refs: %s", strings.Trim(refs, "()")))
+	}
+	lines = append(lines, "")
+
+	lines = append(lines, styles.StatusInfo.Render("Message:"))
+	message, ok := m.messages[node.Hash]
+	if !ok {
+		// The subject is known from the graph; the body loads on selection
+		message = node.Message + "\n\n" + styles.Metadata.Render("Loading full message...")
+	}
+	for _, line := range strings.Split(message, "\n") {
+		lines = append(lines, "  "+line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderFooter renders the footer with keyboard shortcuts.
+func (m GraphViewModel) renderFooter() string {
+	styles := GetGlobalThemeManager().GetStyles()
+
+	help := "↑↓: navigate • enter: details • R: refresh"
+	if m.expanded {
+		help = "↑↓: navigate • enter/esc: close details • R: refresh"
+	}
+
+	var metadata string
+	switch {
+	case m.loading:
+		metadata = "Loading..."
+	case m.graph != nil:
+		scope := "current, parent and main branches"
+		if m.config.UI.GraphAllRefs {
+			scope = "all refs"
+		}
+		metadata = fmt.Sprintf("%d commit(s) • %s", len(m.graph.Nodes), scope)
+	}
+
+	footer := styles.Footer.Render(help)
+	if metadata != "" {
+		footer = footer + " " + styles.Metadata.Render(metadata)
+	}
+
+	return footer
+}
@@ -0,0 +1,128 @@
+package ui
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// graphGitOps serves a fixed commit graph and full messages
+type graphGitOps struct {
+	git.Operations
+
+	graph    *domain.CommitGraph
+	messages map[string]string
+	refs     []string
+}
+
+func (f *graphGitOps) GetBranchInfo(ctx context.Context, repoPath string, protectedBranches []string) (*domain.BranchInfo, error) {
+	info, err := domain.NewBranchInfo("feature/login")
+	if err == nil {
+		info.SetParent("develop")
+	}
+	return info, err
+}
+
+func (f *graphGitOps) GetCommitGraph(ctx context.Context, repoPath string, refs []string, limit int) (*domain.CommitGraph, error) {
+	f.refs = refs
+	return f.graph, nil
+}
+
+func (f *graphGitOps) GetCommitMessage(ctx context.Context, repoPath, hash string) (string, error) {
+	return f.messages[hash], nil
+}
+
+func testCommitGraph() *domain.CommitGraph {
+	return &domain.CommitGraph{Nodes: []domain.CommitNode{
+		{
+			Hash: "aaaa1111", ShortHash: "aaaa111", Parents: []string{"bbbb2222", "cccc3333"},
+			Author: "Ada", Date: "2024-03-02", Message: "Merge branch 'fix'", GraphLine: "*",
+			IsHead: true, HeadBranch: "main", LocalBranches: []string{"main"}, Tags: []string{"v1.0.0"},
+		},
+		{
+			Hash: "cccc3333", ShortHash: "cccc333", Parents: []string{"bbbb2222"},
+			Author: "Linus", Date: "2024-03-01", Message: "Fix login redirect", GraphLine: "| *",
+		},
+		{
+			Hash: "bbbb2222", ShortHash: "bbbb222",
+			Author: "Ada", Date: "2024-02-28", Message: "Initial commit", GraphLine: "*",
+		},
+	}}
+}
+
+// TestGraphView_LoadsFocusedRefs tests that the graph is seeded from the current, parent and main branches by default
+func TestGraphView_LoadsFocusedRefs(t *testing.T) {
+	tests := []struct {
+		name     string
+		allRefs  bool
+		wantRefs []string
+	}{
+		{"focused", false, []string{"feature/login", "develop", "main"}},
+		{"all refs", true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := domain.NewDefaultConfig()
+			cfg.UI.GraphAllRefs = tt.allRefs
+			ops := &graphGitOps{graph: testCommitGraph()}
+			m := NewGraphViewModel("/tmp/repo", cfg, ops)
+
+			m, _ = m.Update(m.Init()())
+
+			if strings.Join(ops.refs, ",") != strings.Join(tt.wantRefs, ",") {
+				t.Errorf("GetCommitGraph refs = %v, want %v", ops.refs, tt.wantRefs)
+			}
+			if len(m.graph.Nodes) != 3 {
+				t.Errorf("Expected 3 commits, got %d", len(m.graph.Nodes))
+			}
+		})
+	}
+}
+
+// TestGraphView_RendersRowsAndDetail tests the graph rows and the selected commit's detail pane
+func TestGraphView_RendersRowsAndDetail(t *testing.T) {
+	ops := &graphGitOps{
+		graph:    testCommitGraph(),
+		messages: map[string]string{"cccc3333": "Fix login redirect\n\nKeep the return URL after signing in."},
+	}
+	m := NewGraphViewModel("/tmp/repo", domain.NewDefaultConfig(), ops)
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m, _ = m.Update(m.Init()())
+
+	rows := strings.Split(m.renderGraph(), "\n")
+	if len(rows) != 3 {
+		t.Fatalf("Expected one row per commit, got %d", len(rows))
+	}
+	if !strings.Contains(rows[0], "aaaa111") || !strings.Contains(rows[0], "(HEAD -> main, tag: v1.0.0)") {
+		t.Errorf("Expected HEAD row with hash and refs, got %q", rows[0])
+	}
+	if !strings.Contains(rows[1], "| * cccc333 Fix login redirect") {
+		t.Errorf("Expected graph line, hash and subject, got %q", rows[1])
+	}
+
+	// Open the detail pane on the second commit
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected the full message to load when details open")
+	}
+	m, _ = m.Update(cmd())
+
+	detail := m.renderDetailPanel()
+	for _, want := range []string{"Author: Linus", "Date: 2024-03-01", "Parents: bbbb2222", "Keep the return URL after signing in."} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("Expected detail to contain %q, got:\n%s", want, detail)
+		}
+	}
+
+	// The merge commit says so
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if detail := m.renderDetailPanel(); !strings.Contains(detail, "Merge commit (2 parents)") {
+		t.Errorf("Expected merge commit in detail, got:\n%s", detail)
+	}
+}
@@ -8,9 +8,13 @@ import (
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/gitman/internal/adapter/git"
 	"github.com/yourusername/gitman/internal/usecase"
 )
 
+// maxSquashFilesShown caps the pre-squash file list; the rest are summarized
+const maxSquashFilesShown = 12
+
 // MergeViewModel represents the state of the merge view.
 type MergeViewModel struct {
 	analysis          *usecase.AnalyzeMergeResponse
@@ -435,8 +439,13 @@ func (m MergeViewModel) renderDetailsPane(width, height int) string {
 	}
 	
 	sections = append(sections, "")
+
+	// 3. Files collapsed by a squash, so it isn't a black box
+	if selectedStrategy.Strategy == "squash" && len(m.analysis.Files) > 0 {
+		sections = append(sections, renderSquashFileSummary(m.analysis.Files, width), "")
+	}
 	
-	// 3. Merge Message Preview
+	// 4. Merge Message Preview
 	if m.analysis.MergeMessage != nil {
 		msgBox := styles.CommitBox.Width(width).Render(
 			wrapTextMerge(m.analysis.MergeMessage.FullMessage(), width-4))
@@ -446,6 +455,49 @@ func (m MergeViewModel) renderDetailsPane(width, height int) string {
 	return lipgloss.JoinVertical(lipgloss.Left, sections...)
 }
 
+// renderSquashFileSummary lists the files a squash merge will fold into one
+// commit, with per-file and total line stats.
+func renderSquashFileSummary(files []git.FileStat, width int) string {
+	styles := GetGlobalThemeManager().GetStyles()
+	addStyle := lipgloss.NewStyle().Foreground(styles.ColorSuccess)
+	delStyle := lipgloss.NewStyle().Foreground(styles.ColorError)
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.ColorMuted)
+
+	additions, deletions := 0, 0
+	for _, f := range files {
+		additions += f.Additions
+		deletions += f.Deletions
+	}
+
+	lines := []string{
+		styles.SectionTitle.Render("FILES IN SQUASH"),
+		fmt.Sprintf("%d files changed  %s %s", len(files),
+			addStyle.Render(fmt.Sprintf("+%d", additions)),
+			delStyle.Render(fmt.Sprintf("-%d", deletions))),
+	}
+
+	for i, f := range files {
+		if i >= maxSquashFilesShown {
+			lines = append(lines, mutedStyle.Render(fmt.Sprintf("  … and %d more", len(files)-maxSquashFilesShown)))
+			break
+		}
+
+		stats := mutedStyle.Render("binary")
+		if !f.Binary {
+			stats = addStyle.Render(fmt.Sprintf("+%d", f.Additions)) + " " + delStyle.Render(fmt.Sprintf("-%d", f.Deletions))
+		}
+
+		// Keep the stats visible by truncating long paths from the left
+		path := f.Path
+		if maxPath := width - 16; maxPath > 10 && len(path) > maxPath {
+			path = "…" + path[len(path)-maxPath+1:]
+		}
+		lines = append(lines, fmt.Sprintf("  %s  %s", path, stats))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 func (m MergeViewModel) renderStrategiesContent() string {
 	return m.renderStrategyList(m.viewport.Width)
 }
@@ -483,9 +535,22 @@ func (m MergeViewModel) renderConfirmationModal() string {
 	
 	buttons := lipgloss.JoinHorizontal(lipgloss.Center, confirmBtn, "  ", cancelBtn)
 	
+	// Remind what the squash collapses before it's confirmed
+	scope := ""
+	if m.GetSelectedStrategy() == "squash" && len(m.analysis.Files) > 0 {
+		additions, deletions := 0, 0
+		for _, f := range m.analysis.Files {
+			additions += f.Additions
+			deletions += f.Deletions
+		}
+		scope = lipgloss.NewStyle().Foreground(styles.ColorMuted).Render(
+			fmt.Sprintf("Squashes %d commits, %d files (+%d -%d)", len(m.analysis.Commits), len(m.analysis.Files), additions, deletions))
+	}
+
 	// Content
 	content := lipgloss.JoinVertical(lipgloss.Center,
 		title,
+		scope,
 		"",
 		"Enter merge message:",
 		inputView,
@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+	"github.com/yourusername/gitman/internal/usecase"
+)
+
+// TestMergeView_SquashShowsFileSummary tests that selecting squash lists the files it will collapse
+func TestMergeView_SquashShowsFileSummary(t *testing.T) {
+	branchInfo, err := domain.NewBranchInfo("feature/login")
+	if err != nil {
+		t.Fatalf("NewBranchInfo() error = %v", err)
+	}
+
+	files := []git.FileStat{
+		{Path: "internal/auth/login.go", Additions: 40, Deletions: 2},
+		{Path: "internal/auth/login_test.go", Additions: 25},
+		{Path: "assets/logo.png", Binary: true},
+	}
+	for i := 0; i < maxSquashFilesShown; i++ {
+		files = append(files, git.FileStat{Path: fmt.Sprintf("docs/page%d.md", i), Additions: 1})
+	}
+
+	m := NewMergeViewModel(&usecase.AnalyzeMergeResponse{
+		SourceBranchInfo:  branchInfo,
+		TargetBranch:      "main",
+		Commits:           []git.CommitInfo{{Hash: "abc123", Message: "Add login"}},
+		Files:             files,
+		CanMerge:          true,
+		SuggestedStrategy: "squash",
+	})
+
+	view := m.View()
+	for _, want := range []string{"FILES IN SQUASH", "15 files changed", "+77", "login.go", "+40 -2", "binary", "… and 3 more"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected squash view to contain %q\nGot:\n%s", want, view)
+		}
+	}
+
+	// Regular merges keep their history, so the summary is squash-only
+	m.selectedIndex = 1
+	if view := m.View(); strings.Contains(view, "FILES IN SQUASH") {
+		t.Errorf("Expected no file summary for a regular merge\nGot:\n%s", view)
+	}
+}
@@ -36,7 +36,7 @@ type OnboardingAIScreen struct {
 // NewOnboardingAIScreen creates a new AI screen
 func NewOnboardingAIScreen(step, totalSteps int, config *domain.Config) OnboardingAIScreen {
 	// Provider options
-	providers := []string{"cerebras"}
+	providers := providerOptions(config.AI.Provider)
 	providerIdx := 0
 
 	// Model options
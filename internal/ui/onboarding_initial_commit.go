@@ -0,0 +1,233 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/gitman/internal/adapter/git"
+)
+
+// defaultInitialCommitMessage is used when the message field is left empty
+const defaultInitialCommitMessage = "Initial commit"
+
+// maxListedInitialFiles caps the file list shown on the initial commit screen
+const maxListedInitialFiles = 8
+
+// OnboardingInitialCommitScreen offers to commit the files in a repository
+// that has no commits yet, so onboarding ends with something to push
+type OnboardingInitialCommitScreen struct {
+	step       int
+	totalSteps int
+	gitOps     git.Operations
+	repoPath   string
+
+	needsCommit bool     // Repository has no commits but has files to commit
+	files       []string // Files the initial commit will include
+	message     TextInput
+
+	committed      bool
+	shouldContinue bool
+	shouldGoBack   bool
+	error          string
+
+	width  int
+	height int
+}
+
+// NewOnboardingInitialCommitScreen creates a new initial commit screen
+func NewOnboardingInitialCommitScreen(step, totalSteps int, gitOps git.Operations, repoPath string) OnboardingInitialCommitScreen {
+	screen := OnboardingInitialCommitScreen{
+		step:       step,
+		totalSteps: totalSteps,
+		gitOps:     gitOps,
+		repoPath:   repoPath,
+		message:    NewTextInput("Message", defaultInitialCommitMessage),
+		width:      100,
+		height:     40,
+	}
+	screen.message.Focused = true
+
+	ctx := context.Background()
+	if isRepo, err := gitOps.IsGitRepo(ctx, repoPath); err != nil || !isRepo {
+		return screen
+	}
+
+	// Only a repository without commits needs an initial one
+	if log, err := gitOps.GetLog(ctx, repoPath, 1); err == nil && len(log) > 0 {
+		return screen
+	}
+
+	repo, err := gitOps.GetStatus(ctx, repoPath)
+	if err != nil {
+		return screen
+	}
+	for _, change := range repo.Changes() {
+		screen.files = append(screen.files, change.Path)
+	}
+	screen.needsCommit = len(screen.files) > 0
+
+	return screen
+}
+
+// Init initializes the screen
+func (m OnboardingInitialCommitScreen) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages
+func (m OnboardingInitialCommitScreen) Update(msg tea.Msg) (OnboardingInitialCommitScreen, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "enter":
+			if !m.needsCommit || m.committed {
+				m.shouldContinue = true
+				return m, nil
+			}
+			m.commit()
+			return m, nil
+		case "esc":
+			m.shouldGoBack = true
+			return m, nil
+		case "ctrl+s":
+			// Skip the initial commit
+			m.shouldContinue = true
+			return m, nil
+		}
+
+		// The message is editable until the commit is made
+		if m.needsCommit && !m.committed {
+			m.message.Update(msg)
+		}
+	}
+
+	return m, nil
+}
+
+// commit stages every file and makes the initial commit
+func (m *OnboardingInitialCommitScreen) commit() {
+	message := strings.TrimSpace(m.message.Value)
+	if message == "" {
+		message = defaultInitialCommitMessage
+	}
+
+	ctx := context.Background()
+	if err := m.gitOps.Add(ctx, m.repoPath, nil); err != nil {
+		m.error = err.Error()
+		return
+	}
+	if err := m.gitOps.Commit(ctx, m.repoPath, message, nil); err != nil {
+		m.error = err.Error()
+		return
+	}
+
+	m.error = ""
+	m.committed = true
+	m.message.Focused = false
+}
+
+// View renders the initial commit screen
+func (m OnboardingInitialCommitScreen) View() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	var sections []string
+
+	// Header
+	header := styles.Header.Render("Initial Commit")
+	sections = append(sections, header)
+
+	// Progress
+	progress := fmt.Sprintf("Step %d of %d", m.step, m.totalSteps)
+	sections = append(sections, styles.Metadata.Render(progress))
+
+	sections = append(sections, "")
+
+	textStyle := lipgloss.NewStyle().Foreground(styles.ColorText)
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.ColorMuted)
+
+	// Status
+	switch {
+	case m.committed:
+		sections = append(sections, styles.StatusOk.Render("✓")+" "+
+			textStyle.Render(fmt.Sprintf("Committed %d files", len(m.files))))
+		sections = append(sections, "")
+		sections = append(sections, mutedStyle.Render("Your repository is ready to push."))
+	case !m.needsCommit:
+		sections = append(sections, styles.StatusOk.Render("✓")+" "+
+			textStyle.Render("Nothing to commit"))
+		sections = append(sections, "")
+		sections = append(sections, mutedStyle.Render(
+			"The repository already has commits, or there are no files to commit yet."))
+	default:
+		sections = append(sections, styles.StatusWarning.Render("!")+" "+
+			textStyle.Render("The repository has no commits yet"))
+		sections = append(sections, "")
+		sections = append(sections, mutedStyle.Render(
+			"Commit these files so the repository can be pushed:"))
+
+		listed := m.files
+		if len(listed) > maxListedInitialFiles {
+			listed = listed[:maxListedInitialFiles]
+		}
+		for _, file := range listed {
+			sections = append(sections, textStyle.Render("  "+file))
+		}
+		if more := len(m.files) - len(listed); more > 0 {
+			sections = append(sections, mutedStyle.Render(fmt.Sprintf("  ...and %d more", more)))
+		}
+
+		sections = append(sections, "")
+		sections = append(sections, m.message.View())
+	}
+
+	if m.error != "" {
+		sections = append(sections, "")
+		sections = append(sections, styles.StatusError.Render("Error: "+m.error))
+	}
+
+	sections = append(sections, "")
+	sections = append(sections, renderSeparator(70))
+
+	// Footer
+	footerText := ""
+	if m.needsCommit && !m.committed {
+		footerText = styles.ShortcutKey.Render("Enter") + " " + styles.ShortcutDesc.Render("Commit") + "  " +
+			styles.ShortcutKey.Render("Ctrl+S") + " " + styles.ShortcutDesc.Render("Skip")
+	} else {
+		footerText = styles.ShortcutKey.Render("Enter") + " " + styles.ShortcutDesc.Render("Continue")
+	}
+	footerText += "  " + styles.ShortcutKey.Render("Esc") + " " + styles.ShortcutDesc.Render("Back")
+
+	footer := styles.Footer.Render(footerText)
+	sections = append(sections, footer)
+
+	// Wrap in card
+	content := lipgloss.JoinVertical(lipgloss.Left, sections...)
+	cardStyle := styles.DashboardCard.Padding(1, 2)
+
+	// Center the card
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		cardStyle.Render(content),
+	)
+}
+
+// ShouldContinue returns true if user wants to continue
+func (m OnboardingInitialCommitScreen) ShouldContinue() bool {
+	return m.shouldContinue
+}
+
+// ShouldGoBack returns true if user wants to go back
+func (m OnboardingInitialCommitScreen) ShouldGoBack() bool {
+	return m.shouldGoBack
+}
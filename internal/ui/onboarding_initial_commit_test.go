@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// initialCommitGitOps is a repository with created files and no commits yet
+type initialCommitGitOps struct {
+	git.Operations
+
+	files     []string
+	hasCommit bool
+	staged    []string
+	message   string
+}
+
+func (f *initialCommitGitOps) IsGitRepo(ctx context.Context, repoPath string) (bool, error) {
+	return true, nil
+}
+
+func (f *initialCommitGitOps) GetLog(ctx context.Context, repoPath string, count int) ([]git.CommitInfo, error) {
+	if !f.hasCommit {
+		return nil, errors.New("failed to get log: fatal: your current branch 'main' does not have any commits yet")
+	}
+	return []git.CommitInfo{{Hash: "abc1234", Message: f.message}}, nil
+}
+
+func (f *initialCommitGitOps) GetStatus(ctx context.Context, repoPath string) (*domain.Repository, error) {
+	repo, err := domain.NewRepository(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range f.files {
+		repo.AddChange(domain.FileChange{Path: file, Status: domain.StatusUntracked})
+	}
+	return repo, nil
+}
+
+func (f *initialCommitGitOps) Add(ctx context.Context, repoPath string, files []string) error {
+	// nil stages everything
+	f.staged = append([]string(nil), f.files...)
+	return nil
+}
+
+func (f *initialCommitGitOps) Commit(ctx context.Context, repoPath, message string, files []string) error {
+	if len(f.staged) == 0 {
+		return errors.New("no changes to commit")
+	}
+	f.message = message
+	f.hasCommit = true
+	return nil
+}
+
+// TestOnboardingInitialCommitScreen_CommitsCreatedFiles tests that the step stages and commits the created files
+func TestOnboardingInitialCommitScreen_CommitsCreatedFiles(t *testing.T) {
+	ops := &initialCommitGitOps{files: []string{"README.md", "LICENSE", ".gitignore"}}
+	screen := NewOnboardingInitialCommitScreen(4, 9, ops, "/tmp/repo")
+
+	if !screen.needsCommit {
+		t.Fatal("Expected a repository without commits to offer an initial commit")
+	}
+	if !strings.Contains(screen.View(), "README.md") {
+		t.Error("Expected the files to be listed")
+	}
+
+	updated, _ := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !updated.committed || updated.error != "" {
+		t.Fatalf("Expected the commit to succeed, committed=%v err=%q", updated.committed, updated.error)
+	}
+	if strings.Join(ops.staged, ",") != "README.md,LICENSE,.gitignore" {
+		t.Errorf("staged = %v, want the created files", ops.staged)
+	}
+	if ops.message != defaultInitialCommitMessage {
+		t.Errorf("message = %q, want %q", ops.message, defaultInitialCommitMessage)
+	}
+	if updated.ShouldContinue() {
+		t.Error("Expected the result to be shown before continuing")
+	}
+
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !updated.ShouldContinue() {
+		t.Error("Expected Enter to continue after committing")
+	}
+}
+
+// TestOnboardingInitialCommitScreen_CustomMessage tests that a typed message replaces the default
+func TestOnboardingInitialCommitScreen_CustomMessage(t *testing.T) {
+	ops := &initialCommitGitOps{files: []string{"README.md"}}
+	screen := NewOnboardingInitialCommitScreen(4, 9, ops, "/tmp/repo")
+
+	for _, r := range "Start" {
+		screen, _ = screen.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	screen, _ = screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if ops.message != "Start" {
+		t.Errorf("message = %q, want the typed message", ops.message)
+	}
+}
+
+// TestOnboardingInitialCommitScreen_NothingToCommit tests that a repository with commits just continues
+func TestOnboardingInitialCommitScreen_NothingToCommit(t *testing.T) {
+	ops := &initialCommitGitOps{files: []string{"main.go"}, hasCommit: true}
+	screen := NewOnboardingInitialCommitScreen(4, 9, ops, "/tmp/repo")
+
+	if screen.needsCommit {
+		t.Fatal("Expected no initial commit for a repository with history")
+	}
+
+	updated, _ := screen.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !updated.ShouldContinue() || ops.staged != nil {
+		t.Errorf("Expected Enter to continue without committing, staged=%v", ops.staged)
+	}
+}
@@ -14,6 +14,7 @@ const (
 	OnboardingWelcome OnboardingState = iota
 	OnboardingGitInit
 	OnboardingGitHub
+	OnboardingInitialCommit
 	OnboardingBranches
 	OnboardingCommits
 	OnboardingNaming
@@ -41,6 +42,7 @@ type OnboardingModel struct {
 	welcomeScreen   *OnboardingWelcomeScreen
 	gitInitScreen   *OnboardingGitInitScreen
 	githubScreen    *OnboardingGitHubScreen
+	initialCommitScreen *OnboardingInitialCommitScreen
 	branchesScreen  *OnboardingBranchesScreen
 	commitsScreen   *OnboardingCommitsScreen
 	namingScreen    *OnboardingNamingScreen
@@ -59,7 +61,7 @@ type OnboardingModel struct {
 // NewOnboardingModel creates a new onboarding model
 func NewOnboardingModel(cfg *domain.Config, cfgManager *config.Manager, gitOps git.Operations, repoPath string) OnboardingModel {
 	// Initialize the welcome screen
-	welcomeScreen := NewOnboardingWelcomeScreen(1, 8)
+	welcomeScreen := NewOnboardingWelcomeScreen(1, 9)
 
 	return OnboardingModel{
 		state:         OnboardingWelcome,
@@ -68,7 +70,7 @@ func NewOnboardingModel(cfg *domain.Config, cfgManager *config.Manager, gitOps g
 		gitOps:        gitOps,
 		repoPath:      repoPath,
 		currentStep:   1,
-		totalSteps:    8,
+		totalSteps:    9,
 		skipAll:       false,
 		completed:     false,
 		cancelled:     false,
@@ -112,6 +114,8 @@ func (m OnboardingModel) Update(msg tea.Msg) (OnboardingModel, tea.Cmd) {
 		return m.updateGitInitScreen(msg)
 	case OnboardingGitHub:
 		return m.updateGitHubScreen(msg)
+	case OnboardingInitialCommit:
+		return m.updateInitialCommitScreen(msg)
 	case OnboardingBranches:
 		return m.updateBranchesScreen(msg)
 	case OnboardingCommits:
@@ -145,6 +149,10 @@ func (m OnboardingModel) View() string {
 		if m.githubScreen != nil {
 			return m.githubScreen.View()
 		}
+	case OnboardingInitialCommit:
+		if m.initialCommitScreen != nil {
+			return m.initialCommitScreen.View()
+		}
 	case OnboardingBranches:
 		if m.branchesScreen != nil {
 			return m.branchesScreen.View()
@@ -236,12 +244,13 @@ func (m OnboardingModel) updateGitHubScreen(msg tea.Msg) (OnboardingModel, tea.C
 	m.githubScreen = &updated
 
 	if m.githubScreen.ShouldContinue() {
-		m.state = OnboardingBranches
+		m.state = OnboardingInitialCommit
 		m.currentStep++
-		screen := NewOnboardingBranchesScreen(m.currentStep, m.totalSteps, m.config)
+		// Created fresh so it sees files added since the last visit
+		screen := NewOnboardingInitialCommitScreen(m.currentStep, m.totalSteps, m.gitOps, m.repoPath)
 		screen.width = m.windowWidth
 		screen.height = m.windowHeight
-		m.branchesScreen = &screen
+		m.initialCommitScreen = &screen
 		return m, screen.Init()
 	}
 
@@ -255,6 +264,33 @@ func (m OnboardingModel) updateGitHubScreen(msg tea.Msg) (OnboardingModel, tea.C
 	return m, cmd
 }
 
+func (m OnboardingModel) updateInitialCommitScreen(msg tea.Msg) (OnboardingModel, tea.Cmd) {
+	if m.initialCommitScreen == nil {
+		return m, nil
+	}
+
+	updated, cmd := m.initialCommitScreen.Update(msg)
+	m.initialCommitScreen = &updated
+
+	if m.initialCommitScreen.ShouldContinue() {
+		m.state = OnboardingBranches
+		m.currentStep++
+		screen := NewOnboardingBranchesScreen(m.currentStep, m.totalSteps, m.config)
+		screen.width = m.windowWidth
+		screen.height = m.windowHeight
+		m.branchesScreen = &screen
+		return m, screen.Init()
+	}
+
+	if m.initialCommitScreen.ShouldGoBack() {
+		m.state = OnboardingGitHub
+		m.currentStep--
+		return m, nil
+	}
+
+	return m, cmd
+}
+
 func (m OnboardingModel) updateBranchesScreen(msg tea.Msg) (OnboardingModel, tea.Cmd) {
 	if m.branchesScreen == nil {
 		return m, nil
@@ -274,7 +310,7 @@ func (m OnboardingModel) updateBranchesScreen(msg tea.Msg) (OnboardingModel, tea
 	}
 
 	if m.branchesScreen.ShouldGoBack() {
-		m.state = OnboardingGitHub
+		m.state = OnboardingInitialCommit
 		m.currentStep--
 		return m, nil
 	}
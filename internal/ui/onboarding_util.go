@@ -12,7 +12,7 @@ import (
 // ShouldRunOnboarding determines if onboarding should run
 func ShouldRunOnboarding(cfg *domain.Config, gitOps git.Operations, repoPath string) bool {
 	// Check if API key is configured
-	if cfg.AI.APIKey == "" {
+	if cfg.AI.APIKey == "" && domain.ProviderRequiresAPIKey(cfg.AI.Provider) {
 		return true
 	}
 
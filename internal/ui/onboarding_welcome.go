@@ -148,7 +148,7 @@ func (m OnboardingWelcomeScreen) View() string {
 
 // renderProgressBar creates a visual progress indicator
 func (m OnboardingWelcomeScreen) renderProgressBar() string {
-	totalDots := 8
+	totalDots := m.totalSteps
 	currentDot := m.step
 
 	styles := GetGlobalThemeManager().GetStyles()
@@ -0,0 +1,310 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/gitman/internal/domain"
+	"github.com/yourusername/gitman/internal/usecase"
+)
+
+// PRCreateViewModel opens a pull request for the current branch: it drafts
+// the title and description from the branch's commits, lets them be edited,
+// then creates the pull request and shows its URL. As a branch summary it
+// describes the branch from its net diff as well, and the title and
+// description can be copied even when the pull request can't be opened yet.
+type PRCreateViewModel struct {
+	createPR  *usecase.CreatePullRequestUseCase
+	repoPath  string
+	request   usecase.DraftPullRequestRequest
+	summarize bool
+
+	draft      *usecase.PullRequestDraft
+	titleInput textinput.Model
+	bodyInput  textarea.Model
+	focusBody  bool
+
+	working bool // Drafting or creating is in flight
+	err     string
+	status  string // Result of the last copy
+	created *domain.PRInfo
+	done    bool
+
+	windowWidth  int
+	windowHeight int
+}
+
+// prDraftedMsg carries the drafted pull request
+type prDraftedMsg struct {
+	draft *usecase.PullRequestDraft
+	err   error
+}
+
+// prCreatedMsg carries the pull request gh opened
+type prCreatedMsg struct {
+	prInfo *domain.PRInfo
+	err    error
+}
+
+// NewPRCreateViewModel creates a view drafting a pull request with request.
+func NewPRCreateViewModel(createPR *usecase.CreatePullRequestUseCase, repoPath string, request usecase.DraftPullRequestRequest) PRCreateViewModel {
+	titleInput := textinput.New()
+	titleInput.CharLimit = 256
+	titleInput.Width = 60
+	titleInput.Placeholder = "Pull request title"
+
+	bodyInput := textarea.New()
+	bodyInput.ShowLineNumbers = false
+	bodyInput.SetWidth(60)
+	bodyInput.SetHeight(8)
+	bodyInput.CharLimit = 10000
+	bodyInput.Placeholder = "Describe the changes for reviewers"
+
+	return PRCreateViewModel{
+		createPR:     createPR,
+		repoPath:     repoPath,
+		request:      request,
+		titleInput:   titleInput,
+		bodyInput:    bodyInput,
+		working:      true, // Until the draft arrives
+		windowWidth:  120,
+		windowHeight: 30,
+	}
+}
+
+// NewPRSummaryViewModel creates a view summarizing the current branch for a
+// pull request with request.
+func NewPRSummaryViewModel(createPR *usecase.CreatePullRequestUseCase, repoPath string, request usecase.DraftPullRequestRequest) PRCreateViewModel {
+	m := NewPRCreateViewModel(createPR, repoPath, request)
+	m.summarize = true
+	return m
+}
+
+// Init drafts the pull request.
+func (m PRCreateViewModel) Init() tea.Cmd {
+	return draftPullRequest(m.createPR, m.request, m.summarize)
+}
+
+// Update handles messages.
+func (m PRCreateViewModel) Update(msg tea.Msg) (PRCreateViewModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.windowWidth = msg.Width
+		m.windowHeight = msg.Height
+
+	case prDraftedMsg:
+		m.working = false
+		if msg.err != nil {
+			m.err = msg.err.Error()
+			return m, nil
+		}
+		m.draft = msg.draft
+		m.titleInput.SetValue(msg.draft.Title)
+		m.bodyInput.SetValue(msg.draft.Body)
+		m.titleInput.Focus()
+		return m, textinput.Blink
+
+	case prCreatedMsg:
+		m.working = false
+		if msg.err != nil {
+			m.err = msg.err.Error()
+			return m, nil
+		}
+		m.created = msg.prInfo
+
+	case clipboardCopiedMsg:
+		if msg.err != nil {
+			m.status = fmt.Sprintf("Copy failed: %v", msg.err)
+		} else {
+			m.status = "✓ Title and description copied to clipboard"
+		}
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+
+	return m, nil
+}
+
+// handleKey edits the draft: Tab switches between title and description,
+// Enter on the title or Ctrl+S creates the pull request, Ctrl+Y copies it.
+// Once created, or if drafting failed, Enter closes the view.
+func (m PRCreateViewModel) handleKey(msg tea.KeyMsg) (PRCreateViewModel, tea.Cmd) {
+	if m.working {
+		return m, nil
+	}
+	if m.created != nil || m.draft == nil {
+		if msg.String() == "enter" {
+			m.done = true
+		}
+		return m, nil
+	}
+
+	m.status = ""
+	switch msg.String() {
+	case "ctrl+y":
+		// "y" is typed into the inputs, so copying uses ctrl+y
+		return m, copyCmd(strings.TrimSpace(m.titleInput.Value()) + "\n\n" + strings.TrimSpace(m.bodyInput.Value()))
+
+	case "tab", "shift+tab":
+		m.focusBody = !m.focusBody
+		if m.focusBody {
+			m.titleInput.Blur()
+			return m, m.bodyInput.Focus()
+		}
+		m.bodyInput.Blur()
+		m.titleInput.Focus()
+		return m, textinput.Blink
+
+	case "ctrl+s":
+		return m.create()
+
+	case "enter":
+		if !m.focusBody {
+			return m.create()
+		}
+	}
+
+	var cmd tea.Cmd
+	if m.focusBody {
+		m.bodyInput, cmd = m.bodyInput.Update(msg)
+	} else {
+		m.titleInput, cmd = m.titleInput.Update(msg)
+	}
+	return m, cmd
+}
+
+// create opens the pull request with the edited title and description
+func (m PRCreateViewModel) create() (PRCreateViewModel, tea.Cmd) {
+	if m.draft.Blocker != "" {
+		m.err = "Can't open a pull request: " + m.draft.Blocker
+		return m, nil
+	}
+	if strings.TrimSpace(m.titleInput.Value()) == "" {
+		m.err = "The pull request needs a title"
+		return m, nil
+	}
+	m.err = ""
+	m.working = true
+	return m, createPullRequest(m.createPR, m.repoPath, m.draft, m.titleInput.Value(), m.bodyInput.Value())
+}
+
+// Done returns true once the view was closed.
+func (m PRCreateViewModel) Done() bool {
+	return m.done
+}
+
+// Created returns the opened pull request, or nil if none was.
+func (m PRCreateViewModel) Created() *domain.PRInfo {
+	return m.created
+}
+
+// View renders the draft being edited, or the created pull request.
+func (m PRCreateViewModel) View() string {
+	styles := GetGlobalThemeManager().GetStyles()
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.ColorMuted)
+
+	heading := "CREATE PULL REQUEST"
+	if m.summarize {
+		heading = "SUMMARIZE BRANCH FOR PR"
+	}
+	lines := []string{styles.SectionTitle.Render(heading)}
+	help := "Esc: Cancel"
+
+	switch {
+	case m.created != nil:
+		lines = append(lines, "",
+			lipgloss.NewStyle().Foreground(styles.ColorSuccess).Render(fmt.Sprintf("✓ Opened pull request #%d", m.created.Number())),
+			m.created.HTMLURL())
+		help = "Enter: Back to dashboard"
+
+	case m.draft == nil:
+		lines = append(lines, "")
+		if m.working {
+			drafting := "Drafting the description from the branch's commits..."
+			if m.summarize {
+				drafting = "Summarizing the branch's commits and changes..."
+			}
+			lines = append(lines, mutedStyle.Render(drafting))
+		} else {
+			help = "Enter: Back to dashboard"
+		}
+
+	default:
+		commits := fmt.Sprintf("%d commits", len(m.draft.Commits))
+		if len(m.draft.Commits) == 1 {
+			commits = "1 commit"
+		}
+		source := "listed from the commits"
+		if m.draft.Model != "manual" {
+			source = fmt.Sprintf("drafted by %s (%d tokens)", m.draft.Model, m.draft.TokensUsed)
+		}
+		lines = append(lines,
+			mutedStyle.Render(fmt.Sprintf("%s → %s • %s • %s", m.draft.HeadBranch, m.draft.BaseBranch, commits, source)),
+			"")
+
+		titleInput := styles.FormInput.Render(m.titleInput.View())
+		bodyInput := styles.FormInput.Render(m.bodyInput.View())
+		if m.focusBody {
+			bodyInput = styles.FormInputFocused.Render(m.bodyInput.View())
+		} else {
+			titleInput = styles.FormInputFocused.Render(m.titleInput.View())
+		}
+		lines = append(lines, "Title", titleInput, "", "Description", bodyInput)
+
+		if m.working {
+			lines = append(lines, "", mutedStyle.Render("Creating pull request..."))
+		}
+		help = "Tab: Switch field • Enter/Ctrl+S: Create • Ctrl+Y: Copy • Esc: Cancel"
+		if m.draft.Blocker != "" {
+			lines = append(lines, "", mutedStyle.Render("Can't open a pull request yet: "+m.draft.Blocker))
+			help = "Tab: Switch field • Ctrl+Y: Copy • Esc: Cancel"
+		}
+		if m.status != "" {
+			lines = append(lines, "", m.status)
+		}
+	}
+
+	if m.err != "" {
+		lines = append(lines, "", lipgloss.NewStyle().Foreground(styles.ColorError).Render(m.err))
+	}
+
+	box := styles.CommitBox.Width(70).Render(strings.Join(lines, "\n"))
+	return lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.Place(m.windowWidth, m.windowHeight-2, lipgloss.Center, lipgloss.Center, box),
+		styles.Footer.Render(help),
+	)
+}
+
+// draftPullRequest describes the current branch's commits, or with summarize
+// the whole branch
+func draftPullRequest(createPR *usecase.CreatePullRequestUseCase, request usecase.DraftPullRequestRequest, summarize bool) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		draft := createPR.Draft
+		if summarize {
+			draft = createPR.Summarize
+		}
+		prDraft, err := draft(ctx, request)
+		return prDraftedMsg{draft: prDraft, err: err}
+	}
+}
+
+// createPullRequest opens draft on GitHub with the edited title and body
+func createPullRequest(createPR *usecase.CreatePullRequestUseCase, repoPath string, draft *usecase.PullRequestDraft, title, body string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		prInfo, err := createPR.Create(ctx, repoPath, draft, title, body)
+		return prCreatedMsg{prInfo: prInfo, err: err}
+	}
+}
@@ -0,0 +1,184 @@
+package ui
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+	"github.com/yourusername/gitman/internal/usecase"
+)
+
+// prBranchGitOps serves a pushed feature branch with two commits over main
+type prBranchGitOps struct {
+	git.Operations
+
+	branchInfo *domain.BranchInfo
+}
+
+func (f *prBranchGitOps) GetBranchInfo(ctx context.Context, repoPath string, protectedBranches []string) (*domain.BranchInfo, error) {
+	return f.branchInfo, nil
+}
+
+func (f *prBranchGitOps) GetMergeTarget(ctx context.Context, repoPath, branch string) (string, error) {
+	return "", nil
+}
+
+func (f *prBranchGitOps) GetBranchCommits(ctx context.Context, repoPath, branch, excludeBranch string) ([]git.CommitInfo, error) {
+	return []git.CommitInfo{{Hash: "b2", Message: "Add OAuth callback"}, {Hash: "a1", Message: "Add login page"}}, nil
+}
+
+// prCreatingGitHubOps records the pull request gh was asked to open
+type prCreatingGitHubOps struct {
+	usecase.GitHubOperations
+
+	title, body string
+}
+
+func (f *prCreatingGitHubOps) CreatePullRequest(ctx context.Context, repoPath, base, head, title, body string) (*domain.PRInfo, error) {
+	f.title, f.body = title, body
+	prInfo, err := domain.NewPRInfo(7, title, "octocat", base, head)
+	if err != nil {
+		return nil, err
+	}
+	prInfo.SetHTMLURL("https://github.com/octo/repo/pull/7")
+	return prInfo, nil
+}
+
+// TestPRCreateView_DraftsAndCreates tests editing the drafted pull request and showing the created URL
+func TestPRCreateView_DraftsAndCreates(t *testing.T) {
+	branchInfo, err := domain.NewBranchInfo("feature/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	branchInfo.SetParent("main")
+	branchInfo.SetUpstream("origin/feature/login")
+
+	createPR := usecase.NewCreatePullRequestUseCase(&prBranchGitOps{branchInfo: branchInfo}, nil)
+	ghOps := &prCreatingGitHubOps{}
+	createPR.SetGitHubOps(ghOps)
+
+	m := NewPRCreateViewModel(createPR, "/tmp/repo", usecase.DraftPullRequestRequest{RepoPath: "/tmp/repo", SkipAI: true})
+	m, _ = m.Update(m.Init()())
+
+	view := m.View()
+	for _, want := range []string{"feature/login → main • 2 commits", "- Add login page"} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("Expected the draft to show %q, got:\n%s", want, view)
+		}
+	}
+
+	// The title is prefilled and editable; Enter on it creates the pull request
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Add OAuth login")})
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m, _ = m.Update(cmd())
+
+	if ghOps.title != "Add OAuth login" || !strings.Contains(ghOps.body, "- Add OAuth callback") {
+		t.Errorf("Expected the edited title and drafted body, got %q / %q", ghOps.title, ghOps.body)
+	}
+	if m.Created() == nil || !strings.Contains(m.View(), "https://github.com/octo/repo/pull/7") {
+		t.Fatalf("Expected the created pull request's URL, got:\n%s", m.View())
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.Done() {
+		t.Error("Expected Enter to close the view once the pull request is open")
+	}
+}
+
+// TestPRCreateView_RefusesUnpushedBranch tests that drafting reports a branch without an upstream
+func TestPRCreateView_RefusesUnpushedBranch(t *testing.T) {
+	branchInfo, err := domain.NewBranchInfo("feature/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	createPR := usecase.NewCreatePullRequestUseCase(&prBranchGitOps{branchInfo: branchInfo}, nil)
+	m := NewPRCreateViewModel(createPR, "/tmp/repo", usecase.DraftPullRequestRequest{RepoPath: "/tmp/repo", SkipAI: true})
+	m, _ = m.Update(m.Init()())
+
+	if view := m.View(); !strings.Contains(view, "push it before opening a pull request") {
+		t.Errorf("Expected the missing upstream to be reported, got:\n%s", view)
+	}
+}
+
+// TestPRSummaryView_CopiesUnpushedBranch tests that a branch summary can be copied but not opened before the branch is pushed
+func TestPRSummaryView_CopiesUnpushedBranch(t *testing.T) {
+	copied := captureClipboard(t, nil)
+
+	branchInfo, err := domain.NewBranchInfo("feature/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	branchInfo.SetParent("main")
+
+	createPR := usecase.NewCreatePullRequestUseCase(&prBranchGitOps{branchInfo: branchInfo}, nil)
+	ghOps := &prCreatingGitHubOps{}
+	createPR.SetGitHubOps(ghOps)
+
+	m := NewPRSummaryViewModel(createPR, "/tmp/repo", usecase.DraftPullRequestRequest{RepoPath: "/tmp/repo", SkipAI: true})
+	m, _ = m.Update(m.Init()())
+
+	view := m.View()
+	for _, want := range []string{"SUMMARIZE BRANCH FOR PR", "feature/login → main • 2 commits", "Can't open a pull request yet", "has no upstream"} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("Expected the summary to show %q, got:\n%s", want, view)
+		}
+	}
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+	if cmd == nil {
+		t.Fatal("Expected ctrl+y to copy the summary")
+	}
+	m, _ = m.Update(cmd())
+	if !strings.HasPrefix(*copied, "feature/login\n\n## Commits\n- Add OAuth callback") {
+		t.Errorf("Expected the title and description copied, got %q", *copied)
+	}
+	if !strings.Contains(m.View(), "copied to clipboard") {
+		t.Errorf("Expected the copy to be confirmed, got:\n%s", m.View())
+	}
+
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if cmd != nil || ghOps.title != "" || !strings.Contains(m.View(), "Can't open a pull request:") {
+		t.Errorf("Expected creating to be refused until the branch is pushed, got:\n%s", m.View())
+	}
+}
+
+// TestDashboard_PullRequestBlocker tests that Create pull request needs a pushed, unprotected branch
+func TestDashboard_PullRequestBlocker(t *testing.T) {
+	tests := []struct {
+		name     string
+		branch   string
+		upstream string
+		github   bool
+		want     string
+	}{
+		{"pushed feature branch", "feature/login", "origin/feature/login", true, ""},
+		{"not pushed", "feature/login", "", true, "push the branch first"},
+		{"protected branch", "main", "origin/main", true, "protected branch"},
+		{"not on GitHub", "feature/login", "origin/feature/login", false, "needs a GitHub remote"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, err := domain.NewRepository("/tmp/repo")
+			if err != nil {
+				t.Fatal(err)
+			}
+			repo.SetIsGitHubRemote(tt.github)
+			branchInfo, err := domain.NewBranchInfo(tt.branch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			branchInfo.SetUpstream(tt.upstream)
+
+			m := DashboardModel{config: domain.NewDefaultConfig(), repo: repo, branchInfo: branchInfo}
+			if got := m.pullRequestBlocker(); got != tt.want {
+				t.Errorf("pullRequestBlocker() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
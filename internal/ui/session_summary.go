@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sessionEventKind classifies the actions listed in the exit summary
+type sessionEventKind int
+
+const (
+	sessionCommit sessionEventKind = iota
+	sessionBranchSwitch
+	sessionPush
+)
+
+// sessionEvent is one action taken during the session
+type sessionEvent struct {
+	kind   sessionEventKind
+	detail string
+}
+
+// recordSessionEvent adds an action to the activity log and keeps it for the
+// exit summary. Unlike the activity log, session events are never trimmed.
+func (m *DashboardModel) recordSessionEvent(kind sessionEventKind, detail string) {
+	m.session = append(m.session, sessionEvent{kind: kind, detail: detail})
+	m.AddActivity(detail)
+}
+
+// SessionSummary returns a plain-text record of the commits, branch switches
+// and pushes made during the session, for printing after the TUI exits.
+// It returns "" when nothing was done.
+func (m AppModel) SessionSummary() string {
+	if m.dashboard == nil || len(m.dashboard.session) == 0 {
+		return ""
+	}
+
+	sections := []struct {
+		kind  sessionEventKind
+		title string
+	}{
+		{sessionCommit, "Commits"},
+		{sessionBranchSwitch, "Branch switches"},
+		{sessionPush, "Pushes"},
+	}
+
+	var sb strings.Builder
+	sb.WriteString("GitMind session summary\n")
+	for _, section := range sections {
+		var details []string
+		for _, event := range m.dashboard.session {
+			if event.kind == section.kind {
+				details = append(details, event.detail)
+			}
+		}
+		if len(details) == 0 {
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf("  %s: %d\n", section.title, len(details)))
+		for _, detail := range details {
+			sb.WriteString(fmt.Sprintf("    %s\n", detail))
+		}
+	}
+
+	return sb.String()
+}
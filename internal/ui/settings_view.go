@@ -6,6 +6,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/gitman/internal/adapter/ai"
 	"github.com/yourusername/gitman/internal/adapter/config"
 	"github.com/yourusername/gitman/internal/adapter/github"
 	"github.com/yourusername/gitman/internal/domain"
@@ -37,6 +38,10 @@ type SettingsView struct {
 	gitCustomProtected  TextInput
 	gitAutoPush         Checkbox
 	gitAutoPull         Checkbox
+	gitPullRebase       Checkbox
+	gitPostCommitCmd    TextInput
+	gitSignCommits      Checkbox
+	gitSigningKey       TextInput
 
 	// GitHub settings fields
 	ghEnabled           Checkbox
@@ -57,6 +62,11 @@ type SettingsView struct {
 	commitRequireScope    Checkbox
 	commitRequireBreaking Checkbox
 	commitCustomTemplate  TextInput
+	commitNormCapitalize  Checkbox
+	commitNormStripPeriod Checkbox
+	commitNormImperative  Checkbox
+	commitBranchScope     Checkbox
+	commitIssueRefs       Checkbox
 
 	// Naming settings fields
 	namingEnforce        Checkbox
@@ -86,6 +96,21 @@ type SettingsView struct {
 	height int
 }
 
+// providerOptions lists registered AI providers for the provider dropdowns.
+// A configured provider that is not registered is kept so saving does not change it.
+func providerOptions(current string) []string {
+	providers := ai.RegisteredProviders()
+	if current == "" {
+		return providers
+	}
+	for _, p := range providers {
+		if p == current {
+			return providers
+		}
+	}
+	return append(providers, current)
+}
+
 // NewSettingsView creates a new settings view
 func NewSettingsView(cfg *domain.Config, cfgManager *config.Manager) *SettingsView {
 	// Initialize Git fields
@@ -103,7 +128,7 @@ func NewSettingsView(cfg *domain.Config, cfgManager *config.Manager) *SettingsVi
 	// Initialize Commits fields
 	commitTypes := []string{"feat", "fix", "docs", "style", "refactor", "test", "chore"}
 	commitTypesChecked := make([]bool, len(commitTypes))
-	if cfg.Commits.Convention == "conventional" {
+	if cfg.UsesCommitTypes() {
 		for i, cType := range commitTypes {
 			for _, enabled := range cfg.Commits.Types {
 				if cType == enabled {
@@ -120,10 +145,12 @@ func NewSettingsView(cfg *domain.Config, cfgManager *config.Manager) *SettingsVi
 
 	conventionIdx := 0
 	switch cfg.Commits.Convention {
-	case "custom":
+	case "gitmoji":
 		conventionIdx = 1
-	case "none":
+	case "custom":
 		conventionIdx = 2
+	case "none":
+		conventionIdx = 3
 	}
 
 	// Initialize Naming fields
@@ -139,7 +166,7 @@ func NewSettingsView(cfg *domain.Config, cfgManager *config.Manager) *SettingsVi
 	}
 
 	// Initialize AI fields
-	providers := []string{"cerebras", "openai", "anthropic", "ollama"}
+	providers := providerOptions(cfg.AI.Provider)
 	providerIdx := 0
 	for i, p := range providers {
 		if p == cfg.AI.Provider {
@@ -148,7 +175,7 @@ func NewSettingsView(cfg *domain.Config, cfgManager *config.Manager) *SettingsVi
 		}
 	}
 
-	models := []string{"llama-3.3-70b", "llama-3.1-8b", "gpt-4", "claude-3-sonnet"}
+	models := []string{"llama-3.3-70b", "llama-3.1-8b", "gpt-4", "gpt-4o-mini", "claude-3-sonnet"}
 	defaultModelIdx := 0
 	fallbackModelIdx := 0
 	for i, m := range models {
@@ -182,9 +209,18 @@ func NewSettingsView(cfg *domain.Config, cfgManager *config.Manager) *SettingsVi
 		namingPatternInput.Value = cfg.Naming.Pattern
 	}
 
+	gitPostCommitInput := NewTextInput("Post-commit Command", "./scripts/notify.sh")
+	if cfg.Git.PostCommitCommand != "" {
+		gitPostCommitInput.Value = cfg.Git.PostCommitCommand
+	}
+
+	gitSigningKeyInput := NewTextInput("Signing Key", "user.signingkey")
+	gitSigningKeyInput.Value = cfg.Git.SigningKey
+
+	// The saved key is never shown, only that there is one; typing replaces it
 	aiAPIKeyInput := NewTextInput("API Key", "Enter API key")
 	if cfg.AI.APIKey != "" {
-		aiAPIKeyInput.Value = cfg.AI.APIKey
+		aiAPIKeyInput.Placeholder = "•••••••• saved (type to replace)"
 	}
 
 	aiMaxDiffSizeInput := NewTextInput("Max Diff Size (KB)", "50")
@@ -203,6 +239,10 @@ func NewSettingsView(cfg *domain.Config, cfgManager *config.Manager) *SettingsVi
 		gitCustomProtected:   NewTextInput("Custom Protected Branch", "staging"),
 		gitAutoPush:          NewCheckbox("Auto-push commits", cfg.Git.AutoPush),
 		gitAutoPull:          NewCheckbox("Auto-pull on checkout", cfg.Git.AutoPull),
+		gitPullRebase:        NewCheckbox("Pull with rebase", cfg.Git.PullRebase),
+		gitPostCommitCmd:     gitPostCommitInput,
+		gitSignCommits:       NewCheckbox("Sign commits (git commit -S)", cfg.Git.SignCommits),
+		gitSigningKey:        gitSigningKeyInput,
 
 		// GitHub
 		ghEnabled:           NewCheckbox("Enable GitHub integration", cfg.GitHub.Enabled),
@@ -220,6 +260,7 @@ func NewSettingsView(cfg *domain.Config, cfgManager *config.Manager) *SettingsVi
 		// Commits
 		commitConvention: NewRadioGroup("Convention", []string{
 			"Conventional Commits",
+			"Gitmoji (conventional with emoji)",
 			"Custom Template",
 			"None (freeform)",
 		}, conventionIdx),
@@ -227,6 +268,11 @@ func NewSettingsView(cfg *domain.Config, cfgManager *config.Manager) *SettingsVi
 		commitRequireScope:    NewCheckbox("Require scope", cfg.Commits.RequireScope),
 		commitRequireBreaking: NewCheckbox("Require breaking change marker", cfg.Commits.RequireBreaking),
 		commitCustomTemplate:  commitCustomTemplateInput,
+		commitNormCapitalize:  NewCheckbox("Capitalize subject", cfg.Commits.Normalize.Capitalize),
+		commitNormStripPeriod: NewCheckbox("Strip trailing period", cfg.Commits.Normalize.StripTrailingPeriod),
+		commitNormImperative:  NewCheckbox("Imperative mood", cfg.Commits.Normalize.Imperative),
+		commitBranchScope:     NewCheckbox("Analyze the whole branch since its parent (not just uncommitted changes)", cfg.Commits.AnalysisScope == domain.AnalysisScopeBranch),
+		commitIssueRefs:       NewCheckbox("Add the issue named by the branch (e.g. feature/123-login) to the description", cfg.Commits.IssueReferences.Enabled),
 
 		// Naming
 		namingEnforce:         NewCheckbox("Enforce naming patterns", cfg.Naming.Enforce),
@@ -355,11 +401,11 @@ func (m SettingsView) Update(msg tea.Msg) (SettingsView, tea.Cmd) {
 func (m SettingsView) getMaxFields() int {
 	switch m.currentTab {
 	case SettingsGit:
-		return 6 // 5 fields + save button
+		return 10 // 9 fields + save button
 	case SettingsGitHub:
 		return 11
 	case SettingsCommits:
-		return 6
+		return 11
 	case SettingsNaming:
 		return 5
 	case SettingsAI:
@@ -386,6 +432,10 @@ func (m *SettingsView) handleFieldInteraction() {
 		case 4:
 			m.gitAutoPull.Checked = !m.gitAutoPull.Checked
 		case 5:
+			m.gitPullRebase.Checked = !m.gitPullRebase.Checked
+		case 7:
+			m.gitSignCommits.Checked = !m.gitSignCommits.Checked
+		case 9:
 			// Save button - handled by saveSettings()
 		}
 
@@ -422,6 +472,16 @@ func (m *SettingsView) handleFieldInteraction() {
 			m.commitRequireScope.Checked = !m.commitRequireScope.Checked
 		case 3:
 			m.commitRequireBreaking.Checked = !m.commitRequireBreaking.Checked
+		case 5:
+			m.commitNormCapitalize.Checked = !m.commitNormCapitalize.Checked
+		case 6:
+			m.commitNormStripPeriod.Checked = !m.commitNormStripPeriod.Checked
+		case 7:
+			m.commitNormImperative.Checked = !m.commitNormImperative.Checked
+		case 8:
+			m.commitBranchScope.Checked = !m.commitBranchScope.Checked
+		case 9:
+			m.commitIssueRefs.Checked = !m.commitIssueRefs.Checked
 		}
 
 	case SettingsNaming:
@@ -588,12 +648,16 @@ func (m *SettingsView) handleTextInput(msg tea.KeyMsg) {
 			m.gitMainBranch.Update(msg)
 		case 2:
 			m.gitCustomProtected.Update(msg)
+		case 6:
+			m.gitPostCommitCmd.Update(msg)
+		case 8:
+			m.gitSigningKey.Update(msg)
 		}
 
 	case SettingsCommits:
 		switch m.focusedField {
 		case 4:
-			if m.commitConvention.Selected == 1 {
+			if m.commitConvention.Selected == 2 {
 				m.commitCustomTemplate.Update(msg)
 			}
 		}
@@ -629,6 +693,9 @@ func (m *SettingsView) saveSettings() tea.Cmd {
 		}
 
 		m.saveStatus = "Settings saved successfully"
+		if repoConfig := m.cfgManager.RepoConfigPath(); repoConfig != "" {
+			m.saveStatus = "Settings saved to " + repoConfig
+		}
 		m.hasChanges = false
 		return nil
 	}
@@ -644,6 +711,10 @@ func (m *SettingsView) updateConfigFromFields() {
 	}
 	m.cfg.Git.AutoPush = m.gitAutoPush.Checked
 	m.cfg.Git.AutoPull = m.gitAutoPull.Checked
+	m.cfg.Git.PullRebase = m.gitPullRebase.Checked
+	m.cfg.Git.PostCommitCommand = strings.TrimSpace(m.gitPostCommitCmd.Value)
+	m.cfg.Git.SignCommits = m.gitSignCommits.Checked
+	m.cfg.Git.SigningKey = strings.TrimSpace(m.gitSigningKey.Value)
 
 	// GitHub
 	m.cfg.GitHub.Enabled = m.ghEnabled.Checked
@@ -660,17 +731,30 @@ func (m *SettingsView) updateConfigFromFields() {
 
 	// Commits
 	switch m.commitConvention.Selected {
-	case 0:
+	case 0, 1:
 		m.cfg.Commits.Convention = "conventional"
+		if m.commitConvention.Selected == 1 {
+			m.cfg.Commits.Convention = "gitmoji"
+		}
 		m.cfg.Commits.Types = m.commitTypes.GetChecked()
 		m.cfg.Commits.RequireScope = m.commitRequireScope.Checked
 		m.cfg.Commits.RequireBreaking = m.commitRequireBreaking.Checked
-	case 1:
+	case 2:
 		m.cfg.Commits.Convention = "custom"
 		m.cfg.Commits.CustomTemplate = m.commitCustomTemplate.Value
 	default:
 		m.cfg.Commits.Convention = "none"
 	}
+	m.cfg.Commits.Normalize = domain.NormalizeRules{
+		Capitalize:          m.commitNormCapitalize.Checked,
+		StripTrailingPeriod: m.commitNormStripPeriod.Checked,
+		Imperative:          m.commitNormImperative.Checked,
+	}
+	m.cfg.Commits.AnalysisScope = domain.AnalysisScopeChanges
+	if m.commitBranchScope.Checked {
+		m.cfg.Commits.AnalysisScope = domain.AnalysisScopeBranch
+	}
+	m.cfg.Commits.IssueReferences.Enabled = m.commitIssueRefs.Checked
 
 	// Naming
 	m.cfg.Naming.Enforce = m.namingEnforce.Checked
@@ -855,21 +939,41 @@ func (m SettingsView) renderGitSettings() string {
 	lines = append(lines, m.gitProtectedBranches.View())
 	lines = append(lines, "")
 
-	// Auto Push & Auto Pull
+	// Auto Push, Auto Pull & Pull with rebase
 	m.gitAutoPush.Focused = (m.focusedField == 3)
 	m.gitAutoPull.Focused = (m.focusedField == 4)
+	m.gitPullRebase.Focused = (m.focusedField == 5)
 	
 	row := lipgloss.JoinHorizontal(lipgloss.Top,
 		m.gitAutoPush.View(),
 		"    ",
 		m.gitAutoPull.View(),
+		"    ",
+		m.gitPullRebase.View(),
 	)
 	lines = append(lines, row)
+	lines = append(lines, HelpText{Text: "Pull with rebase replays local commits on top of the remote instead of a merge commit"}.View())
+	lines = append(lines, "")
+
+	// Post-commit command
+	m.gitPostCommitCmd.Focused = (m.focusedField == 6)
+	m.gitPostCommitCmd.Width = inputWidth
+	lines = append(lines, m.gitPostCommitCmd.View())
+	lines = append(lines, HelpText{Text: "Runs after each commit with GITMIND_COMMIT_SHA and GITMIND_BRANCH set"}.View())
+	lines = append(lines, "")
+
+	// Commit signing
+	m.gitSignCommits.Focused = (m.focusedField == 7)
+	lines = append(lines, m.gitSignCommits.View())
+	m.gitSigningKey.Focused = (m.focusedField == 8)
+	m.gitSigningKey.Width = inputWidth
+	lines = append(lines, m.gitSigningKey.View())
+	lines = append(lines, HelpText{Text: "Also used for signed tags; empty uses git's user.signingkey. Unchecked leaves commit.gpgsign in charge"}.View())
 	lines = append(lines, "")
 
 	// Save button
 	saveBtn := NewButton("Save Changes")
-	saveBtn.Focused = (m.focusedField == 5)
+	saveBtn.Focused = (m.focusedField == 9)
 	lines = append(lines, saveBtn.View())
 
 	return strings.Join(lines, "\n")
@@ -945,6 +1049,20 @@ func (m SettingsView) renderGitHubSettings() string {
 	return strings.Join(lines, "\n")
 }
 
+// gitmojiExamples shows the gitmoji for the first few allowed types, e.g. "✨ feat  🐛 fix"
+func gitmojiExamples(types []string) string {
+	var examples []string
+	for _, commitType := range types {
+		if emoji := domain.Gitmoji(commitType); emoji != "" {
+			examples = append(examples, emoji+" "+commitType)
+		}
+		if len(examples) == 4 {
+			break
+		}
+	}
+	return strings.Join(examples, "  ")
+}
+
 // renderCommitsSettings renders commit convention settings
 func (m SettingsView) renderCommitsSettings() string {
 	styles := GetGlobalThemeManager().GetStyles()
@@ -965,7 +1083,7 @@ func (m SettingsView) renderCommitsSettings() string {
 
 	// Show fields based on convention
 	switch m.commitConvention.Selected {
-	case 0: // Conventional
+	case 0, 1: // Conventional, Gitmoji
 		// Types
 		lines = append(lines, m.commitTypes.View())
 		lines = append(lines, "")
@@ -981,7 +1099,11 @@ func (m SettingsView) renderCommitsSettings() string {
 		)
 		lines = append(lines, row)
 
-	case 1: // Custom
+		if m.commitConvention.Selected == 1 {
+			lines = append(lines, HelpText{Text: "Subjects get their type's emoji, e.g. " + gitmojiExamples(m.commitTypes.GetChecked())}.View())
+		}
+
+	case 2: // Custom
 		m.commitCustomTemplate.Focused = (m.focusedField == 4)
 		m.commitCustomTemplate.Width = inputWidth
 		lines = append(lines, m.commitCustomTemplate.View())
@@ -990,9 +1112,39 @@ func (m SettingsView) renderCommitsSettings() string {
 
 	lines = append(lines, "")
 
+	// Subject normalization
+	lines = append(lines, styles.FormLabel.Render("Normalize AI Subjects:"))
+	m.commitNormCapitalize.Focused = (m.focusedField == 5)
+	m.commitNormStripPeriod.Focused = (m.focusedField == 6)
+	m.commitNormImperative.Focused = (m.focusedField == 7)
+
+	normRow := lipgloss.JoinHorizontal(lipgloss.Top,
+		m.commitNormCapitalize.View(),
+		"    ",
+		m.commitNormStripPeriod.View(),
+		"    ",
+		m.commitNormImperative.View(),
+	)
+	lines = append(lines, normRow)
+	lines = append(lines, "")
+
+	// Analysis scope
+	lines = append(lines, styles.FormLabel.Render("Analysis Scope:"))
+	m.commitBranchScope.Focused = (m.focusedField == 8)
+	lines = append(lines, m.commitBranchScope.View())
+	lines = append(lines, HelpText{Text: "Off: messages describe only the uncommitted changes"}.View())
+	lines = append(lines, "")
+
+	// Issue references
+	lines = append(lines, styles.FormLabel.Render("Issue References:"))
+	m.commitIssueRefs.Focused = (m.focusedField == 9)
+	lines = append(lines, m.commitIssueRefs.View())
+	lines = append(lines, HelpText{Text: "Adds e.g. \"Refs: #123\" or \"Refs: PROJ-456\"; the pattern and format are set in the config file"}.View())
+	lines = append(lines, "")
+
 	// Save button
 	saveBtn := NewButton("Save Changes")
-	saveBtn.Focused = (m.focusedField == 5)
+	saveBtn.Focused = (m.focusedField == 10)
 	lines = append(lines, saveBtn.View())
 
 	return strings.Join(lines, "\n")
@@ -18,6 +18,12 @@ func SetGlobalTheme(theme string) {
 	defaultThemeManager.SetTheme(selectedTheme)
 }
 
+// SetGlobalColorOverrides applies cfg.UI.ColorOverrides on top of the global theme.
+// They stay in effect when the theme is changed.
+func SetGlobalColorOverrides(overrides map[string]string) error {
+	return defaultThemeManager.SetColorOverrides(overrides)
+}
+
 // GetGlobalThemeManager returns the global theme manager instance.
 // UI components should call GetGlobalThemeManager().GetStyles() to access
 // theme styles that will automatically update when the theme changes.
@@ -0,0 +1,160 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/gitman/internal/domain"
+	"github.com/yourusername/gitman/internal/usecase"
+)
+
+// SuccessStep is an actionable next step offered after a commit or merge
+type SuccessStep struct {
+	Key    string
+	Label  string
+	Action DashboardAction
+	Params map[string]interface{}
+}
+
+// SuccessSummary describes a completed commit or merge for the success overlay
+type SuccessSummary struct {
+	Title   string
+	Details [][2]string // label/value pairs
+	Warning string
+	Steps   []SuccessStep
+}
+
+// StepForKey returns the next step bound to key, if any
+func (s *SuccessSummary) StepForKey(key string) (SuccessStep, bool) {
+	for _, step := range s.Steps {
+		if step.Key == key {
+			return step, true
+		}
+	}
+	return SuccessStep{}, false
+}
+
+// newCommitSuccessSummary builds the overlay for a finished commit.
+// repo and branchInfo describe the repository before the commit and may be nil.
+func newCommitSuccessSummary(resp *usecase.ExecuteCommitResponse, repo *domain.Repository, branchInfo *domain.BranchInfo) *SuccessSummary {
+	summary := &SuccessSummary{Title: "Commit created"}
+	if resp.Amended {
+		summary.Title = "Commit amended"
+	}
+
+	if resp.CommitHash != "" {
+		summary.Details = append(summary.Details, [2]string{"Commit", resp.CommitHash})
+	}
+	if resp.Branch != "" {
+		branch := resp.Branch
+		if resp.BranchCreated != "" {
+			branch += " (new)"
+		}
+		summary.Details = append(summary.Details, [2]string{"Branch", branch})
+	}
+
+	var pushStatus string
+	switch {
+	case resp.Pushed:
+		pushStatus = "pushed to remote"
+	case resp.LocalOnly:
+		pushStatus = "local only (no remote configured)"
+	case resp.PushError != nil:
+		pushStatus = "push failed"
+		summary.Warning = fmt.Sprintf("Push failed: %v", resp.PushError)
+	default:
+		pushStatus = "not pushed"
+	}
+	summary.Details = append(summary.Details, [2]string{"Remote", pushStatus})
+
+	// Push now: auto-push was off or failed
+	if !resp.Pushed && !resp.LocalOnly {
+		summary.Steps = append(summary.Steps, SuccessStep{Key: "p", Label: "Push now", Action: ActionPush})
+	}
+
+	// Merge to parent: a new branch merges back into where it started,
+	// an existing branch into its recorded parent
+	parent := ""
+	if branchInfo != nil {
+		if resp.BranchCreated != "" {
+			parent = branchInfo.Name()
+		} else {
+			parent = branchInfo.Parent()
+		}
+	}
+	if parent != "" && parent != resp.Branch {
+		summary.Steps = append(summary.Steps, SuccessStep{
+			Key:    "m",
+			Label:  fmt.Sprintf("Merge to parent (%s)", parent),
+			Action: ActionMerge,
+			Params: map[string]interface{}{"source": resp.Branch, "target": parent},
+		})
+	}
+
+	// Create a PR: only meaningful once the branch is on GitHub
+	if resp.Pushed && repo != nil && repo.IsGitHubRemote() && parent != "" {
+		summary.Steps = append(summary.Steps, SuccessStep{Key: "c", Label: "Create a PR", Action: ActionCreatePR})
+	}
+
+	return summary
+}
+
+// newMergeSuccessSummary builds the overlay for a finished merge.
+func newMergeSuccessSummary(resp *usecase.ExecuteMergeResponse, sourceBranch, targetBranch string, hasRemote bool) *SuccessSummary {
+	summary := &SuccessSummary{Title: "Merge complete"}
+
+	summary.Details = append(summary.Details, [2]string{"Merged", fmt.Sprintf("%s → %s", sourceBranch, targetBranch)})
+	if resp.Strategy != "" {
+		summary.Details = append(summary.Details, [2]string{"Strategy", resp.Strategy})
+	}
+	if resp.MergeCommit != "" {
+		summary.Details = append(summary.Details, [2]string{"Commit", resp.MergeCommit})
+	}
+
+	if hasRemote {
+		summary.Steps = append(summary.Steps, SuccessStep{Key: "p", Label: fmt.Sprintf("Push %s", targetBranch), Action: ActionPush})
+	}
+	summary.Steps = append(summary.Steps, SuccessStep{
+		Key:    "b",
+		Label:  fmt.Sprintf("Clean up branches (delete %s?)", sourceBranch),
+		Action: ActionManageBranches,
+	})
+
+	return summary
+}
+
+// renderSuccessOverlay renders the success summary with its next steps
+func renderSuccessOverlay(summary *SuccessSummary) string {
+	styles := GetGlobalThemeManager().GetStyles()
+
+	var b strings.Builder
+
+	b.WriteString(lipgloss.NewStyle().
+		Foreground(styles.ColorSuccess).
+		Bold(true).
+		Render("✓ " + strings.ToUpper(summary.Title)))
+	b.WriteString("\n\n")
+
+	for _, detail := range summary.Details {
+		b.WriteString(styles.FormLabel.Render(fmt.Sprintf("%-8s", detail[0])) + " " +
+			lipgloss.NewStyle().Foreground(styles.ColorText).Render(detail[1]) + "\n")
+	}
+
+	if summary.Warning != "" {
+		b.WriteString("\n" + styles.StatusWarning.Render(summary.Warning) + "\n")
+	}
+
+	if len(summary.Steps) > 0 {
+		b.WriteString("\n" + styles.Metadata.Render("Next steps") + "\n")
+		for _, step := range summary.Steps {
+			b.WriteString(styles.ShortcutKey.Render(step.Key) + " " + styles.ShortcutDesc.Render(step.Label) + "\n")
+		}
+	}
+
+	b.WriteString("\n" + styles.ShortcutKey.Render("enter") + " " + styles.ShortcutDesc.Render("back to dashboard"))
+
+	return styles.CommitBox.
+		BorderForeground(styles.ColorSuccess).
+		Render(b.String())
+}
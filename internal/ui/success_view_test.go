@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/domain"
+	"github.com/yourusername/gitman/internal/usecase"
+)
+
+// TestSuccessOverlay_CommitContent tests that the commit overlay shows what happened and what to do next
+func TestSuccessOverlay_CommitContent(t *testing.T) {
+	branchInfo, err := domain.NewBranchInfo("main")
+	if err != nil {
+		t.Fatalf("NewBranchInfo() error = %v", err)
+	}
+
+	resp := &usecase.ExecuteCommitResponse{
+		Success:       true,
+		BranchCreated: "feature/login",
+		Branch:        "feature/login",
+		CommitHash:    "abc1234",
+	}
+
+	summary := newCommitSuccessSummary(resp, nil, branchInfo)
+	view := renderSuccessOverlay(summary)
+
+	for _, want := range []string{"COMMIT CREATED", "abc1234", "feature/login (new)", "not pushed", "Push now", "Merge to parent (main)"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected overlay to contain %q\nGot:\n%s", want, view)
+		}
+	}
+
+	step, ok := summary.StepForKey("m")
+	if !ok || step.Action != ActionMerge {
+		t.Fatalf("Expected 'm' to map to ActionMerge, got %+v (ok=%v)", step, ok)
+	}
+	if step.Params["target"] != "main" || step.Params["source"] != "feature/login" {
+		t.Errorf("Unexpected merge params: %v", step.Params)
+	}
+}
+
+// TestSuccessOverlay_PushFailure tests that a failed push is reported and offered again
+func TestSuccessOverlay_PushFailure(t *testing.T) {
+	resp := &usecase.ExecuteCommitResponse{
+		Success:    true,
+		Branch:     "main",
+		CommitHash: "abc1234",
+		PushError:  errors.New("rejected"),
+	}
+
+	view := renderSuccessOverlay(newCommitSuccessSummary(resp, nil, nil))
+
+	for _, want := range []string{"push failed", "Push failed: rejected", "Push now"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected overlay to contain %q\nGot:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "Merge to parent") {
+		t.Error("Did not expect a merge step without a parent branch")
+	}
+}
+
+// TestSuccessOverlay_MergeContent tests the merge overlay content
+func TestSuccessOverlay_MergeContent(t *testing.T) {
+	resp := &usecase.ExecuteMergeResponse{
+		Success:     true,
+		MergeCommit: "def5678",
+		Strategy:    "squash",
+	}
+
+	view := renderSuccessOverlay(newMergeSuccessSummary(resp, "feature/login", "main", true))
+
+	for _, want := range []string{"MERGE COMPLETE", "feature/login → main", "squash", "def5678", "Push main"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected overlay to contain %q\nGot:\n%s", want, view)
+		}
+	}
+}
@@ -10,8 +10,9 @@ import (
 
 // ThemeManager manages the current theme and provides styled components.
 type ThemeManager struct {
-	currentTheme domain.Theme
-	styles       *ThemeStyles
+	currentTheme   domain.Theme
+	colorOverrides map[string]string // Semantic colors replaced on top of currentTheme
+	styles         *ThemeStyles
 }
 
 // ThemeStyles contains all lipgloss styles for the TUI.
@@ -126,6 +127,18 @@ func (tm *ThemeManager) SetTheme(theme domain.Theme) {
 	tm.regenerateStyles()
 }
 
+// SetColorOverrides replaces individual semantic colors (see domain.ValidateColorOverrides)
+// of the current and any later theme and regenerates all styles.
+// Invalid overrides are rejected and the previous ones kept.
+func (tm *ThemeManager) SetColorOverrides(overrides map[string]string) error {
+	if err := domain.ValidateColorOverrides(overrides); err != nil {
+		return err
+	}
+	tm.colorOverrides = overrides
+	tm.regenerateStyles()
+	return nil
+}
+
 // GetStyles returns the current theme styles.
 func (tm *ThemeManager) GetStyles() *ThemeStyles {
 	return tm.styles
@@ -133,8 +146,12 @@ func (tm *ThemeManager) GetStyles() *ThemeStyles {
 
 // regenerateStyles rebuilds all lipgloss styles based on the current theme.
 func (tm *ThemeManager) regenerateStyles() {
-	c := tm.currentTheme.Colors
-	bg := tm.currentTheme.Backgrounds
+	theme := tm.currentTheme
+	if overridden, err := theme.WithColorOverrides(tm.colorOverrides); err == nil {
+		theme = overridden
+	}
+	c := theme.Colors
+	bg := theme.Backgrounds
 
 	// Convert theme colors to lipgloss.Color
 	colorPrimary := lipgloss.Color(c.Primary)
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestThemeManager_ColorOverrideChangesOnlyThatColor(t *testing.T) {
+	tm := NewThemeManager(ThemeClaudeWarm)
+	before := *tm.GetStyles()
+
+	if err := tm.SetColorOverrides(map[string]string{"primary": "#ff0000"}); err != nil {
+		t.Fatalf("SetColorOverrides() error = %v", err)
+	}
+	after := tm.GetStyles()
+
+	if after.ColorPrimary != lipgloss.Color("#ff0000") {
+		t.Errorf("ColorPrimary = %v, want the override", after.ColorPrimary)
+	}
+	unchanged := map[string][2]lipgloss.Color{
+		"secondary": {before.ColorSecondary, after.ColorSecondary},
+		"success":   {before.ColorSuccess, after.ColorSuccess},
+		"warning":   {before.ColorWarning, after.ColorWarning},
+		"error":     {before.ColorError, after.ColorError},
+		"muted":     {before.ColorMuted, after.ColorMuted},
+		"border":    {before.ColorBorder, after.ColorBorder},
+		"selected":  {before.ColorSelected, after.ColorSelected},
+		"text":      {before.ColorText, after.ColorText},
+	}
+	for name, colors := range unchanged {
+		if colors[0] != colors[1] {
+			t.Errorf("%s changed from %v to %v", name, colors[0], colors[1])
+		}
+	}
+
+	// Overrides survive a theme change
+	tm.SetTheme(ThemeOceanBlue)
+	if tm.GetStyles().ColorPrimary != lipgloss.Color("#ff0000") {
+		t.Errorf("ColorPrimary = %v after SetTheme, want the override kept", tm.GetStyles().ColorPrimary)
+	}
+}
+
+func TestThemeManager_InvalidColorOverrideRejected(t *testing.T) {
+	tm := NewThemeManager(ThemeClaudeWarm)
+	want := tm.GetStyles().ColorPrimary
+
+	for _, overrides := range []map[string]string{
+		{"primary": "red"},
+		{"accent": "#ff0000"},
+	} {
+		if err := tm.SetColorOverrides(overrides); err == nil {
+			t.Errorf("SetColorOverrides(%v) error = nil, want an error", overrides)
+		}
+	}
+	if got := tm.GetStyles().ColorPrimary; got != want {
+		t.Errorf("ColorPrimary = %v, want the theme's color after rejected overrides", got)
+	}
+}
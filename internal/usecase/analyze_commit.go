@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/yourusername/gitman/internal/adapter/ai"
 	"github.com/yourusername/gitman/internal/adapter/git"
@@ -30,18 +31,64 @@ type AnalyzeCommitRequest struct {
 	UseConventionalCommits bool
 	APIKey                 *domain.APIKey
 	ProtectedBranches      []string
+	Normalize              domain.NormalizeRules // House-style rules applied to the suggested subject
+	AnalyzeStaged          bool                  // Analyze only the index; otherwise all working tree changes
+	SkipAI                 bool                  // Skip the AI provider and let the user write the message
+	Scope                  string                // domain.AnalysisScopeChanges (default) or domain.AnalysisScopeBranch
+	MaxContextCommits      int                   // Recent commits the AI may be given (cfg.AI.MaxContextCommits)
+	GeneratedPaths         []string              // Lockfile and generated-code patterns (cfg.Commits.GeneratedPaths)
+	RequireScope           bool                  // Add SuggestedScope to conventional subjects without a scope (cfg.Commits.RequireScope)
+	Gitmoji                bool                  // Prefix conventional subjects with their type's gitmoji (cfg.Commits.Convention "gitmoji")
+	PriorSnapshot          domain.ChangeSnapshot // Fingerprints from the previous analysis, to find what changed since
+	DeltaOnly              bool                  // Send the AI only the files changed since PriorSnapshot
+	Tokens                 chan<- string         // Receives the AI's response as it streams in; nil waits for the whole response
 }
 
+// defaultContextCommits is how many recent commits are fetched for context
+// unless the request asks for more
+const defaultContextCommits = 5
+
 // AnalyzeCommitResponse contains the result of commit analysis.
 type AnalyzeCommitResponse struct {
 	Repository *domain.Repository
 	BranchInfo *domain.BranchInfo
 	Decision   *domain.Decision
 	Diff       string
+	Hooks      []string // Commit hooks git will run (pre-commit, commit-msg, ...)
+	Template   string   // Content of commit.template, a scaffold for manual messages
 	TokensUsed int
 	Model      string
+
+	// SuggestedScope is the conventional commit scope computed from the
+	// changed paths; empty when they share no meaningful directory.
+	SuggestedScope string
+
+	// WhitespaceOnly is set when every change is whitespace or line-ending churn;
+	// AI analysis was skipped and the decision carries a canned message.
+	WhitespaceOnly bool
+
+	// LastCommit is the commit the changes could be amended into; nil when
+	// the repository has no commits yet.
+	LastCommit *LastCommit
+
+	// Snapshot fingerprints the analyzed changes for the next analysis.
+	// ChangedSinceLast lists the files that are new or changed since
+	// PriorSnapshot, and DeltaOnly is set when only those were analyzed.
+	Snapshot         domain.ChangeSnapshot
+	ChangedSinceLast []string
+	DeltaOnly        bool
 }
 
+// LastCommit describes HEAD for offering to amend it.
+type LastCommit struct {
+	Hash    string // Short hash
+	Message string
+	Pushed  bool // Already on the remote, so amending rewrites published history
+}
+
+// commitHookNames are the hooks that run during git commit, in execution order
+var commitHookNames = []string{"pre-commit", "prepare-commit-msg", "commit-msg", "post-commit"}
+
 // Execute performs the commit analysis.
 func (uc *AnalyzeCommitUseCase) Execute(ctx context.Context, req AnalyzeCommitRequest) (*AnalyzeCommitResponse, error) {
 	// Validate repository
@@ -88,6 +135,49 @@ func (uc *AnalyzeCommitUseCase) Execute(ctx context.Context, req AnalyzeCommitRe
 		}
 	}
 
+	// Without AI, go straight to a manual message: no diff or history needed
+	if req.SkipAI {
+		if hasMergeOpportunity {
+			return nil, fmt.Errorf("no changes to commit")
+		}
+		decision, err := manualDecision(branchInfo)
+		if err != nil {
+			return nil, err
+		}
+		return &AnalyzeCommitResponse{
+			Repository: repo,
+			BranchInfo: branchInfo,
+			Decision:   decision,
+			Hooks:      uc.commitHooks(ctx, req.RepoPath),
+			Template:   uc.commitTemplate(ctx, req.RepoPath),
+			LastCommit: uc.lastCommit(ctx, req.RepoPath),
+			Model:      "manual",
+		}, nil
+	}
+
+	// Whitespace and line-ending churn confuses the AI and wastes tokens, so
+	// offer a canned message instead (the user can still edit it)
+	if !req.AnalyzeStaged && !hasMergeOpportunity {
+		if whitespaceOnly, err := uc.gitOps.IsWhitespaceOnlyChange(ctx, req.RepoPath); err == nil && whitespaceOnly {
+			decision, err := whitespaceDecision(branchInfo, req.UseConventionalCommits)
+			if err != nil {
+				return nil, err
+			}
+			if req.Gitmoji {
+				decision.SuggestedMessage().AddGitmoji()
+			}
+			return &AnalyzeCommitResponse{
+				Repository:     repo,
+				BranchInfo:     branchInfo,
+				Decision:       decision,
+				Hooks:          uc.commitHooks(ctx, req.RepoPath),
+				WhitespaceOnly: true,
+				LastCommit:     uc.lastCommit(ctx, req.RepoPath),
+				Model:          "none",
+			}, nil
+		}
+	}
+
 	// Get diff (check both staged and unstaged)
 	stagedDiff, err := uc.gitOps.GetDiff(ctx, req.RepoPath, true)
 	if err != nil {
@@ -101,15 +191,36 @@ func (uc *AnalyzeCommitUseCase) Execute(ctx context.Context, req AnalyzeCommitRe
 
 	// Combine diffs
 	diff := stagedDiff
-	if diff == "" {
+	if req.AnalyzeStaged {
+		if diff == "" && !hasMergeOpportunity {
+			return nil, fmt.Errorf("no staged changes to analyze")
+		}
+	} else if diff == "" {
 		diff = unstagedDiff
 	}
 
+	// Fingerprint every file so the next analysis can tell what changed since
+	snapshot := domain.NewChangeSnapshot(repo.Changes(), stagedDiff+"\n"+unstagedDiff)
+	var changedSinceLast []string
+	if req.PriorSnapshot != nil {
+		changedSinceLast = snapshot.ChangedSince(req.PriorSnapshot)
+	}
+
+	// Re-analysis can be limited to what changed since the last one. Files the
+	// diff doesn't cover (untracked) can't be separated out, so those keep the full diff.
+	deltaOnly := false
+	if req.DeltaOnly && len(changedSinceLast) > 0 {
+		if delta := domain.FilterDiff(diff, changedSinceLast); delta != "" {
+			diff = delta
+			deltaOnly = true
+		}
+	}
+
 	// If no diff available, we likely have untracked files
 	// Read them directly from filesystem WITHOUT staging (to preserve clean state for branching)
-	if diff == "" && repo.HasChanges() {
+	if diff == "" && repo.HasChanges() && !req.AnalyzeStaged {
 		// Build a synthetic diff from file contents
-		fileDiff, err := uc.buildUntrackedFilesDiff(req.RepoPath, repo)
+		fileDiff, err := buildUntrackedFilesDiff(req.RepoPath, repo)
 		if err != nil {
 			// Fallback to simple file listing if we can't read files
 			diff = fmt.Sprintf("New files to be added:\n%s", repo.ChangeSummary())
@@ -118,28 +229,52 @@ func (uc *AnalyzeCommitUseCase) Execute(ctx context.Context, req AnalyzeCommitRe
 		}
 	}
 
+	// Branch scope also sends everything committed since the parent; the default
+	// keeps the analysis to the uncommitted delta so long branches stay small
+	var branchDiff string
+	if req.Scope == domain.AnalysisScopeBranch && branchInfo.Parent() != "" {
+		branchDiff, err = uc.gitOps.GetBranchDiff(ctx, req.RepoPath, branchInfo.Parent(), "HEAD")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get branch diff: %w", err)
+		}
+	}
+
 	// Get recent commit log for context
 	// If we have a parent branch, get only commits on this branch (scoped)
 	// Otherwise, get recent commits from the branch
+	contextCommits := defaultContextCommits
+	if req.MaxContextCommits > contextCommits {
+		contextCommits = req.MaxContextCommits
+	}
+
 	var recentCommits []git.CommitInfo
 	if branchInfo.Parent() != "" {
 		// Get commits unique to this branch (not in parent)
 		scopedCommits, err := uc.gitOps.GetBranchCommits(ctx, req.RepoPath, branchInfo.Name(), branchInfo.Parent())
 		if err == nil && len(scopedCommits) > 0 {
 			recentCommits = scopedCommits
-			// Limit to last 5 if more
-			if len(recentCommits) > 5 {
-				recentCommits = recentCommits[:5]
+			// Limit to the most recent ones
+			if len(recentCommits) > contextCommits {
+				recentCommits = recentCommits[:contextCommits]
 			}
 		} else {
 			// Fallback to regular log if scoped commits fail
-			recentCommits, _ = uc.gitOps.GetLog(ctx, req.RepoPath, 5)
+			recentCommits, _ = uc.gitOps.GetLog(ctx, req.RepoPath, contextCommits)
 		}
 	} else {
 		// No parent, use regular log
-		recentCommits, _ = uc.gitOps.GetLog(ctx, req.RepoPath, 5)
+		recentCommits, _ = uc.gitOps.GetLog(ctx, req.RepoPath, contextCommits)
 	}
 
+	// Submodule pointer updates (non-fatal: analysis works without them)
+	submoduleUpdates, _ := uc.gitOps.GetSubmoduleUpdates(ctx, req.RepoPath)
+
+	// Team conventions from commit.template (non-fatal as well)
+	template := uc.commitTemplate(ctx, req.RepoPath)
+
+	// Scope hint from the directory the changed files share
+	scope := suggestedScope(repo)
+
 	recentLog := make([]string, len(recentCommits))
 	for i, commit := range recentCommits {
 		recentLog[i] = commit.Message
@@ -154,9 +289,19 @@ func (uc *AnalyzeCommitUseCase) Execute(ctx context.Context, req AnalyzeCommitRe
 		UserPrompt:             req.UserPrompt,
 		APIKey:                 req.APIKey,
 		UseConventionalCommits: req.UseConventionalCommits,
+		Gitmoji:                req.Gitmoji,
 		MergeOpportunity:       hasMergeOpportunity,
 		MergeTargetBranch:      mergeTargetBranch,
 		MergeCommitCount:       mergeCommitCount,
+		SubmoduleUpdates:       submoduleUpdates,
+		Scope:                  req.Scope,
+		BranchDiff:             branchDiff,
+		CommitTemplate:         template,
+		GeneratedFiles:         repo.GeneratedFiles(req.GeneratedPaths),
+		Tokens:                 req.Tokens,
+	}
+	if req.UseConventionalCommits {
+		aiReq.ScopeHint = scope
 	}
 
 	// Analyze with AI
@@ -165,12 +310,155 @@ func (uc *AnalyzeCommitUseCase) Execute(ctx context.Context, req AnalyzeCommitRe
 		return nil, fmt.Errorf("AI analysis failed: %w", err)
 	}
 
+	// Enforce house style on the suggested subjects before they are presented
+	if aiResp.Decision != nil {
+		for _, candidate := range aiResp.Decision.Candidates() {
+			candidate.Normalize(req.Normalize)
+			if req.UseConventionalCommits && req.RequireScope {
+				candidate.AddScope(scope)
+			}
+			if req.Gitmoji {
+				candidate.AddGitmoji()
+			}
+		}
+	}
+
+	// Record submodule old→new SHAs and their commits in the message body
+	if aiResp.Decision != nil && len(submoduleUpdates) > 0 {
+		if msg := aiResp.Decision.SuggestedMessage(); msg != nil {
+			section := domain.FormatSubmoduleUpdates(submoduleUpdates)
+			if msg.Body() == "" {
+				msg.SetBody(section)
+			} else if !strings.Contains(msg.Body(), section) {
+				msg.SetBody(msg.Body() + "\n\n" + section)
+			}
+		}
+	}
+
 	return &AnalyzeCommitResponse{
 		Repository: repo,
 		BranchInfo: branchInfo,
 		Decision:   aiResp.Decision,
 		Diff:       diff,
+		Hooks:      uc.commitHooks(ctx, req.RepoPath),
+		Template:   template,
+		LastCommit: uc.lastCommit(ctx, req.RepoPath),
 		TokensUsed: aiResp.TokensUsed,
 		Model:      aiResp.Model,
+
+		SuggestedScope: scope,
+
+		Snapshot:         snapshot,
+		ChangedSinceLast: changedSinceLast,
+		DeltaOnly:        deltaOnly,
 	}, nil
 }
+
+// suggestedScope computes a conventional commit scope from the changed paths
+func suggestedScope(repo *domain.Repository) string {
+	changes := repo.Changes()
+	paths := make([]string, len(changes))
+	for i, change := range changes {
+		paths[i] = change.Path
+	}
+	return domain.ScopeFromPaths(paths)
+}
+
+// lastCommit returns HEAD and whether it has been pushed, or nil when there
+// is no commit to amend. If the remote state can't be determined the commit
+// is treated as pushed, so amending it comes with a warning.
+func (uc *AnalyzeCommitUseCase) lastCommit(ctx context.Context, repoPath string) *LastCommit {
+	log, err := uc.gitOps.GetLog(ctx, repoPath, 1)
+	if err != nil || len(log) == 0 {
+		return nil
+	}
+
+	last := &LastCommit{Hash: log[0].Hash, Message: log[0].Message}
+	if len(last.Hash) > 7 {
+		last.Hash = last.Hash[:7]
+	}
+
+	last.Pushed = RewritesPushedHistory(ctx, uc.gitOps, repoPath, "", 1)
+	return last
+}
+
+// commitHooks returns the configured hooks that will run on commit. Failures
+// are ignored: the list is informational and must not block the commit.
+func (uc *AnalyzeCommitUseCase) commitHooks(ctx context.Context, repoPath string) []string {
+	installed, err := uc.gitOps.ListHooks(ctx, repoPath)
+	if err != nil {
+		return nil
+	}
+
+	var hooks []string
+	for _, name := range commitHookNames {
+		for _, hook := range installed {
+			if hook == name {
+				hooks = append(hooks, name)
+				break
+			}
+		}
+	}
+	return hooks
+}
+
+// commitTemplate returns the repository's commit.template content. An
+// unreadable template is ignored rather than failing the analysis.
+func (uc *AnalyzeCommitUseCase) commitTemplate(ctx context.Context, repoPath string) string {
+	template, err := uc.gitOps.GetCommitTemplate(ctx, repoPath)
+	if err != nil {
+		return ""
+	}
+	return template
+}
+
+// manualDecision builds a decision without AI input. The suggested message is
+// left empty so the user is prompted to write one.
+func manualDecision(branchInfo *domain.BranchInfo) (*domain.Decision, error) {
+	action := domain.ActionCommitDirect
+	alternative := domain.ActionCreateBranch
+	if branchInfo != nil && branchInfo.IsProtected() {
+		action, alternative = alternative, action
+	}
+
+	decision, err := domain.NewDecision(action, 1.0, "AI analysis disabled - write the commit message yourself")
+	if err != nil {
+		return nil, fmt.Errorf("failed to build manual decision: %w", err)
+	}
+
+	alt, err := domain.NewAlternative(alternative, "Write the commit message yourself", 1.0)
+	if err == nil {
+		decision.AddAlternative(*alt)
+	}
+
+	return decision, nil
+}
+
+// whitespaceDecision builds the decision for whitespace-only changes without
+// asking the AI: a canned "normalize whitespace" message.
+func whitespaceDecision(branchInfo *domain.BranchInfo, useConventional bool) (*domain.Decision, error) {
+	action := domain.ActionCommitDirect
+	if branchInfo != nil && branchInfo.IsProtected() {
+		action = domain.ActionCreateBranch
+	}
+
+	decision, err := domain.NewDecision(action, 1.0, "Only whitespace or line-ending changes detected - skipped AI analysis. Review the diff if you expected content changes.")
+	if err != nil {
+		return nil, fmt.Errorf("failed to build whitespace decision: %w", err)
+	}
+
+	title := "Normalize whitespace"
+	if useConventional {
+		title = "chore: normalize whitespace"
+	}
+	msg, err := domain.NewCommitMessage(title)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build whitespace message: %w", err)
+	}
+	decision.SetSuggestedMessage(msg)
+	if action == domain.ActionCreateBranch {
+		decision.SetBranchName("chore/normalize-whitespace")
+	}
+
+	return decision, nil
+}
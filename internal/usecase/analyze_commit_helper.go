@@ -12,7 +12,7 @@ import (
 // buildUntrackedFilesDiff creates a diff-like representation of untracked files
 // by reading their content directly from the filesystem.
 // This avoids staging files before the user makes a decision.
-func (uc *AnalyzeCommitUseCase) buildUntrackedFilesDiff(repoPath string, repo *domain.Repository) (string, error) {
+func buildUntrackedFilesDiff(repoPath string, repo *domain.Repository) (string, error) {
 	var sb strings.Builder
 
 	sb.WriteString("New files to be added:\n\n")
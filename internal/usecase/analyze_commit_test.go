@@ -0,0 +1,310 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/adapter/ai"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+const (
+	workingTreeDiff = "diff --git a/main.go b/main.go\n+// uncommitted change\n"
+	branchRangeDiff = "diff --git a/api.go b/api.go\n+// committed earlier on the branch\n"
+)
+
+// diffGitOps serves a working-tree diff and a separate branch-range diff.
+type diffGitOps struct {
+	*fakeGitOps
+
+	branchDiffCalls int
+}
+
+func (f *diffGitOps) GetDiff(ctx context.Context, repoPath string, staged bool) (string, error) {
+	if staged {
+		return "", nil
+	}
+	return workingTreeDiff, nil
+}
+
+func (f *diffGitOps) GetBranchDiff(ctx context.Context, repoPath, base, head string) (string, error) {
+	f.branchDiffCalls++
+	return branchRangeDiff, nil
+}
+
+func (f *diffGitOps) GetSubmoduleUpdates(ctx context.Context, repoPath string) ([]domain.SubmoduleUpdate, error) {
+	return nil, nil
+}
+
+// capturingProvider records the analysis request it receives.
+type capturingProvider struct {
+	ai.Provider
+
+	request ai.AnalysisRequest
+	message string // Suggested message; empty suggests none
+}
+
+func (p *capturingProvider) Analyze(ctx context.Context, request ai.AnalysisRequest) (*ai.AnalysisResponse, error) {
+	p.request = request
+	decision, err := domain.NewDecision(domain.ActionCommitDirect, 0.9, "small change")
+	if err != nil {
+		return nil, err
+	}
+	if p.message != "" {
+		msg, err := domain.NewCommitMessage(p.message)
+		if err != nil {
+			return nil, err
+		}
+		decision.SetSuggestedMessage(msg)
+	}
+	return &ai.AnalysisResponse{Decision: decision}, nil
+}
+
+func TestAnalyzeCommit_ScopeLimitsDiffToWorkingTree(t *testing.T) {
+	tests := []struct {
+		name           string
+		scope          string
+		wantBranchDiff string
+	}{
+		{"default scope", "", ""},
+		{"changes scope", domain.AnalysisScopeChanges, ""},
+		{"branch scope", domain.AnalysisScopeBranch, branchRangeDiff},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &diffGitOps{fakeGitOps: newNoAIGitOps(t)}
+			ops.branchInfo.SetParent("main")
+			provider := &capturingProvider{}
+
+			resp, err := NewAnalyzeCommitUseCase(ops, provider).Execute(context.Background(), AnalyzeCommitRequest{
+				RepoPath: "/tmp/repo",
+				APIKey:   mustAPIKey(t),
+				Scope:    tt.scope,
+			})
+			if err != nil {
+				t.Fatalf("Execute() unexpected error = %v", err)
+			}
+
+			// The analyzed diff is always the uncommitted delta
+			if provider.request.Diff != workingTreeDiff {
+				t.Errorf("Diff = %q, want working-tree delta %q", provider.request.Diff, workingTreeDiff)
+			}
+			if resp.Diff != workingTreeDiff {
+				t.Errorf("resp.Diff = %q, want working-tree delta %q", resp.Diff, workingTreeDiff)
+			}
+
+			// The branch range is only gathered for the branch scope
+			if provider.request.BranchDiff != tt.wantBranchDiff {
+				t.Errorf("BranchDiff = %q, want %q", provider.request.BranchDiff, tt.wantBranchDiff)
+			}
+			if tt.wantBranchDiff == "" && ops.branchDiffCalls != 0 {
+				t.Errorf("GetBranchDiff called %d times, want 0", ops.branchDiffCalls)
+			}
+		})
+	}
+}
+
+func mustAPIKey(t *testing.T) *domain.APIKey {
+	t.Helper()
+
+	apiKey, err := domain.NewAPIKey("csk-test-key", "cerebras")
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+	return apiKey
+}
+
+func TestAnalyzeCommit_ReportsCommitHooks(t *testing.T) {
+	ops := newNoAIGitOps(t)
+	ops.hooks = []string{"commit-msg", "pre-commit", "pre-push"}
+
+	resp, err := NewAnalyzeCommitUseCase(ops, &countingProvider{}).Execute(context.Background(), AnalyzeCommitRequest{
+		RepoPath: "/tmp/repo",
+		SkipAI:   true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+
+	// Only commit hooks, in the order git runs them
+	want := []string{"pre-commit", "commit-msg"}
+	if len(resp.Hooks) != len(want) || resp.Hooks[0] != want[0] || resp.Hooks[1] != want[1] {
+		t.Errorf("Hooks = %v, want %v", resp.Hooks, want)
+	}
+}
+
+func TestAnalyzeCommit_ReportsLastCommitForAmend(t *testing.T) {
+	tests := []struct {
+		name       string
+		hasRemote  bool
+		ahead      int
+		noCommits  bool
+		wantPushed bool
+	}{
+		{"no remote", false, 0, false, false},
+		{"ahead of remote", true, 1, false, false},
+		{"already pushed", true, 0, false, true},
+		{"no commits yet", false, 0, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := newNoAIGitOps(t)
+			ops.hasRemote = tt.hasRemote
+			ops.ahead = tt.ahead
+			if tt.noCommits {
+				ops.log = nil
+			}
+
+			resp, err := NewAnalyzeCommitUseCase(ops, &countingProvider{}).Execute(context.Background(), AnalyzeCommitRequest{
+				RepoPath: "/tmp/repo",
+				SkipAI:   true,
+			})
+			if err != nil {
+				t.Fatalf("Execute() unexpected error = %v", err)
+			}
+
+			if tt.noCommits {
+				if resp.LastCommit != nil {
+					t.Errorf("LastCommit = %+v, want nil without commits", resp.LastCommit)
+				}
+				return
+			}
+			if resp.LastCommit == nil {
+				t.Fatal("Expected the last commit to be reported")
+			}
+			if resp.LastCommit.Hash != "abc123" || resp.LastCommit.Message != "Add login page" {
+				t.Errorf("LastCommit = %+v, want HEAD", resp.LastCommit)
+			}
+			if resp.LastCommit.Pushed != tt.wantPushed {
+				t.Errorf("Pushed = %v, want %v", resp.LastCommit.Pushed, tt.wantPushed)
+			}
+		})
+	}
+}
+
+func TestAnalyzeCommit_WhitespaceOnlySkipsAI(t *testing.T) {
+	ops := newNoAIGitOps(t)
+	ops.whitespace = true
+	provider := &countingProvider{}
+
+	resp, err := NewAnalyzeCommitUseCase(ops, provider).Execute(context.Background(), AnalyzeCommitRequest{
+		RepoPath:               "/tmp/repo",
+		APIKey:                 mustAPIKey(t),
+		UseConventionalCommits: true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+
+	if provider.calls != 0 {
+		t.Errorf("provider called %d times, want 0 for whitespace-only changes", provider.calls)
+	}
+	if !resp.WhitespaceOnly {
+		t.Error("Expected WhitespaceOnly to be set")
+	}
+	if got := resp.Decision.SuggestedMessage().Title(); got != "chore: normalize whitespace" {
+		t.Errorf("suggested message = %q, want %q", got, "chore: normalize whitespace")
+	}
+}
+
+func TestAnalyzeCommit_CommitTemplateReachesAnalysis(t *testing.T) {
+	const template = "[TICKET-###] Summary\n\n# Why is this change needed?\nWhy:"
+
+	ops := &diffGitOps{fakeGitOps: newNoAIGitOps(t)}
+	ops.template = template
+	provider := &capturingProvider{}
+
+	resp, err := NewAnalyzeCommitUseCase(ops, provider).Execute(context.Background(), AnalyzeCommitRequest{
+		RepoPath: "/tmp/repo",
+		APIKey:   mustAPIKey(t),
+	})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+
+	if provider.request.CommitTemplate != template {
+		t.Errorf("CommitTemplate = %q, want the template content %q", provider.request.CommitTemplate, template)
+	}
+	if resp.Template != template {
+		t.Errorf("resp.Template = %q, want %q", resp.Template, template)
+	}
+}
+
+func TestAnalyzeCommit_ScopeFromChangedPaths(t *testing.T) {
+	tests := []struct {
+		name         string
+		requireScope bool
+		wantTitle    string
+	}{
+		{"hint only", false, "feat: add login form"},
+		{"required scope is added", true, "feat(ui): add login form"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &diffGitOps{fakeGitOps: newNoAIGitOps(t)}
+			repo, err := domain.NewRepository("/tmp/repo")
+			if err != nil {
+				t.Fatalf("NewRepository() error = %v", err)
+			}
+			repo.AddChange(domain.FileChange{Path: "internal/ui/login.go", Status: domain.StatusModified})
+			repo.AddChange(domain.FileChange{Path: "internal/ui/styles.go", Status: domain.StatusModified})
+			ops.repo = repo
+			provider := &capturingProvider{message: "feat: add login form"}
+
+			resp, err := NewAnalyzeCommitUseCase(ops, provider).Execute(context.Background(), AnalyzeCommitRequest{
+				RepoPath:               "/tmp/repo",
+				APIKey:                 mustAPIKey(t),
+				UseConventionalCommits: true,
+				RequireScope:           tt.requireScope,
+			})
+			if err != nil {
+				t.Fatalf("Execute() unexpected error = %v", err)
+			}
+
+			if provider.request.ScopeHint != "ui" || resp.SuggestedScope != "ui" {
+				t.Errorf("ScopeHint = %q, SuggestedScope = %q, want \"ui\"", provider.request.ScopeHint, resp.SuggestedScope)
+			}
+			if title := resp.Decision.SuggestedMessage().Title(); title != tt.wantTitle {
+				t.Errorf("title = %q, want %q", title, tt.wantTitle)
+			}
+		})
+	}
+}
+
+func TestAnalyzeCommit_GitmojiPrefix(t *testing.T) {
+	tests := []struct {
+		name      string
+		gitmoji   bool
+		wantTitle string
+	}{
+		{"conventional", false, "feat: add login form"},
+		{"gitmoji", true, "✨ feat: add login form"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &diffGitOps{fakeGitOps: newNoAIGitOps(t)}
+			provider := &capturingProvider{message: "feat: add login form"}
+
+			resp, err := NewAnalyzeCommitUseCase(ops, provider).Execute(context.Background(), AnalyzeCommitRequest{
+				RepoPath:               "/tmp/repo",
+				APIKey:                 mustAPIKey(t),
+				UseConventionalCommits: true,
+				Gitmoji:                tt.gitmoji,
+			})
+			if err != nil {
+				t.Fatalf("Execute() unexpected error = %v", err)
+			}
+
+			if provider.request.Gitmoji != tt.gitmoji {
+				t.Errorf("request Gitmoji = %v, want %v", provider.request.Gitmoji, tt.gitmoji)
+			}
+			if title := resp.Decision.SuggestedMessage().Title(); title != tt.wantTitle {
+				t.Errorf("title = %q, want %q", title, tt.wantTitle)
+			}
+		})
+	}
+}
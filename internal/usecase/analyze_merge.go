@@ -27,9 +27,11 @@ func NewAnalyzeMergeUseCase(gitOps git.Operations, aiProvider ai.Provider) *Anal
 type AnalyzeMergeRequest struct {
 	RepoPath          string
 	SourceBranch      string   // Optional, defaults to current branch
-	TargetBranch      string   // Optional, defaults to parent branch
+	TargetBranch      string   // Optional, defaults to the remembered merge target, then the parent branch
 	ProtectedBranches []string
 	APIKey            *domain.APIKey
+	SkipAI            bool   // Skip the AI provider and use DefaultStrategy with a standard message
+	DefaultStrategy   string // Strategy suggested when SkipAI is set (defaults to "regular")
 }
 
 // AnalyzeMergeResponse contains the result of merge analysis.
@@ -38,6 +40,7 @@ type AnalyzeMergeResponse struct {
 	TargetBranch      string
 	CommitCount       int
 	Commits           []git.CommitInfo
+	Files             []git.FileStat // Files the merge would change, with line stats
 	CanMerge          bool
 	Conflicts         []string
 	SuggestedStrategy string
@@ -90,12 +93,15 @@ func (uc *AnalyzeMergeUseCase) Execute(ctx context.Context, req AnalyzeMergeRequ
 		return false
 	}
 
-	// Determine target branch (specified, parent, or fallback to common branches)
+	// Determine target branch (specified, remembered, parent, or fallback to common branches)
 	targetBranch := req.TargetBranch
 	if targetBranch == "" {
-		// Try to get configured parent branch
+		// A target the user chose to remember wins over the parent
+		remembered, _ := uc.gitOps.GetMergeTarget(ctx, req.RepoPath, sourceBranch)
 		parentBranch := sourceBranchInfo.Parent()
-		if parentBranch != "" && branchExists(parentBranch) {
+		if remembered != "" && branchExists(remembered) {
+			targetBranch = remembered
+		} else if parentBranch != "" && branchExists(parentBranch) {
 			targetBranch = parentBranch
 		} else {
 			// Parent doesn't exist or not configured, try common branch names
@@ -152,6 +158,10 @@ func (uc *AnalyzeMergeUseCase) Execute(ctx context.Context, req AnalyzeMergeRequ
 		return nil, fmt.Errorf("failed to check merge possibility: %w", err)
 	}
 
+	// File-level preview so a squash isn't a black box (non-fatal: the merge
+	// itself doesn't depend on it)
+	files, _ := uc.gitOps.GetMergePreviewStats(ctx, req.RepoPath, targetBranch, sourceBranch)
+
 	// Get AI recommendation for merge message and strategy
 	commitMessages := make([]string, len(commits))
 	for i, commit := range commits {
@@ -166,7 +176,12 @@ func (uc *AnalyzeMergeUseCase) Execute(ctx context.Context, req AnalyzeMergeRequ
 		APIKey:       req.APIKey,
 	}
 
-	mergeMessageResp, err := uc.aiProvider.GenerateMergeMessage(ctx, mergeMessageReq)
+	var mergeMessageResp *ai.MergeMessageResponse
+	if req.SkipAI {
+		mergeMessageResp, err = defaultMergeMessage(sourceBranch, targetBranch, req.DefaultStrategy)
+	} else {
+		mergeMessageResp, err = uc.aiProvider.GenerateMergeMessage(ctx, mergeMessageReq)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate merge message: %w", err)
 	}
@@ -223,6 +238,7 @@ func (uc *AnalyzeMergeUseCase) Execute(ctx context.Context, req AnalyzeMergeRequ
 		TargetBranch:      targetBranch,
 		CommitCount:       len(commits),
 		Commits:           commits,
+		Files:             files,
 		CanMerge:          canMerge,
 		Conflicts:         conflicts,
 		SuggestedStrategy: mergeMessageResp.SuggestedStrategy,
@@ -234,6 +250,26 @@ func (uc *AnalyzeMergeUseCase) Execute(ctx context.Context, req AnalyzeMergeRequ
 	}, nil
 }
 
+// defaultMergeMessage builds the standard git merge message and configured
+// strategy used when AI is disabled.
+func defaultMergeMessage(sourceBranch, targetBranch, strategy string) (*ai.MergeMessageResponse, error) {
+	if strategy == "" {
+		strategy = "regular"
+	}
+
+	msg, err := domain.NewCommitMessage(fmt.Sprintf("Merge branch '%s' into %s", sourceBranch, targetBranch))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ai.MergeMessageResponse{
+		MergeMessage:      msg,
+		SuggestedStrategy: strategy,
+		Reasoning:         "AI analysis disabled - using the configured default merge strategy",
+		Model:             "manual",
+	}, nil
+}
+
 // isProtectedBranch checks if a branch is in the protected branches list.
 func isProtectedBranch(branch string, protectedBranches []string) bool {
 	for _, protected := range protectedBranches {
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAnalyzeMerge_RememberedTarget(t *testing.T) {
+	tests := []struct {
+		name       string
+		remembered string
+		want       string
+	}{
+		{"nothing remembered uses the parent", "", "develop"},
+		{"remembered target wins over the parent", "release", "release"},
+		{"deleted remembered target falls back to the parent", "staging", "develop"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := newNoAIGitOps(t)
+			ops.branches = []string{"main", "develop", "release", "feature/login"}
+			ops.branchInfo.SetParent("develop")
+
+			// The merge flow stores a target the user chose to remember
+			if tt.remembered != "" {
+				if err := ops.SetMergeTarget(context.Background(), "/tmp/repo", "feature/login", tt.remembered); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			uc := NewAnalyzeMergeUseCase(ops, &countingProvider{})
+			resp, err := uc.Execute(context.Background(), AnalyzeMergeRequest{
+				RepoPath: "/tmp/repo",
+				SkipAI:   true,
+			})
+			if err != nil {
+				t.Fatalf("Execute() unexpected error = %v", err)
+			}
+			if resp.TargetBranch != tt.want {
+				t.Errorf("TargetBranch = %q, want %q", resp.TargetBranch, tt.want)
+			}
+		})
+	}
+}
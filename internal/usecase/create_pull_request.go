@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/yourusername/gitman/internal/adapter/ai"
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// maxDefaultPRCommits caps the commits listed in a description written without the AI
+const maxDefaultPRCommits = 10
+
+// CreatePullRequestUseCase opens a pull request for the current branch, with
+// a title and description the AI drafts from the branch's commits.
+type CreatePullRequestUseCase struct {
+	gitOps     git.Operations
+	aiProvider ai.Provider
+	ghOps      GitHubOperations
+}
+
+// NewCreatePullRequestUseCase creates a new CreatePullRequestUseCase.
+func NewCreatePullRequestUseCase(gitOps git.Operations, aiProvider ai.Provider) *CreatePullRequestUseCase {
+	return &CreatePullRequestUseCase{
+		gitOps:     gitOps,
+		aiProvider: aiProvider,
+		ghOps:      &gitHubOpsWrapper{},
+	}
+}
+
+// SetGitHubOps sets the GitHub operations (for dependency injection).
+func (uc *CreatePullRequestUseCase) SetGitHubOps(ghOps GitHubOperations) {
+	uc.ghOps = ghOps
+}
+
+// DraftPullRequestRequest contains the parameters for drafting a pull request.
+type DraftPullRequestRequest struct {
+	RepoPath          string
+	BaseBranch        string // Optional, defaults to the remembered merge target, then the parent branch or one suggested by the branch type
+	ProtectedBranches []string
+	SkipAI            bool // Describe the pull request from its commits without calling the AI
+	APIKey            *domain.APIKey
+}
+
+// PullRequestDraft is a pull request ready to be reviewed and opened.
+type PullRequestDraft struct {
+	HeadBranch string
+	BaseBranch string
+	Title      string
+	Body       string
+	Commits    []git.CommitInfo // Commits on HeadBranch that aren't on BaseBranch
+	Blocker    string           // Why the pull request can't be opened yet, or "" if it can
+	TokensUsed int
+	Model      string
+}
+
+// Draft checks the current branch can be proposed, then describes its
+// commits. Protected branches and branches without an upstream are refused,
+// since gh can only open a pull request for a pushed feature branch.
+func (uc *CreatePullRequestUseCase) Draft(ctx context.Context, req DraftPullRequestRequest) (*PullRequestDraft, error) {
+	return uc.draft(ctx, req, true)
+}
+
+// Summarize describes the current branch as a pull request from all its
+// commits and its net diff against the base, whether or not it can be
+// proposed yet. If it can't, the draft's Blocker says why and Create
+// refuses it; the title and description can still be copied.
+func (uc *CreatePullRequestUseCase) Summarize(ctx context.Context, req DraftPullRequestRequest) (*PullRequestDraft, error) {
+	return uc.draft(ctx, req, false)
+}
+
+// draft describes the current branch; with strict, a branch that can't be
+// proposed is an error rather than a Blocker
+func (uc *CreatePullRequestUseCase) draft(ctx context.Context, req DraftPullRequestRequest, strict bool) (*PullRequestDraft, error) {
+	branchInfo, err := uc.gitOps.GetBranchInfo(ctx, req.RepoPath, req.ProtectedBranches)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch info: %w", err)
+	}
+
+	head := branchInfo.Name()
+	var blocker string
+	if branchInfo.IsProtected() || isProtectedBranch(head, req.ProtectedBranches) {
+		blocker = fmt.Sprintf("%s is a protected branch; open pull requests from a feature branch", head)
+	} else if branchInfo.Upstream() == "" {
+		blocker = fmt.Sprintf("%s has no upstream; push it before opening a pull request", head)
+	}
+	if strict && blocker != "" {
+		return nil, errors.New(blocker)
+	}
+
+	// Base branch (specified, remembered, or the suggested target, which
+	// prefers the parent)
+	base := req.BaseBranch
+	if base == "" {
+		base, _ = uc.gitOps.GetMergeTarget(ctx, req.RepoPath, head)
+	}
+	if base == "" {
+		base = branchInfo.SuggestedMergeTarget()
+	}
+	if base == "" || base == head {
+		return nil, fmt.Errorf("no branch to open a pull request for %s against", head)
+	}
+
+	commits, err := uc.gitOps.GetBranchCommits(ctx, req.RepoPath, head, base)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch commits: %w", err)
+	}
+	if len(commits) == 0 {
+		return nil, fmt.Errorf("%s has no commits that aren't on %s", head, base)
+	}
+
+	draft := &PullRequestDraft{
+		HeadBranch: head,
+		BaseBranch: base,
+		Commits:    commits,
+		Blocker:    blocker,
+	}
+
+	if req.SkipAI {
+		draft.Title, draft.Body = defaultPRDescription(head, commits)
+		draft.Model = "manual"
+		return draft, nil
+	}
+
+	subjects := make([]string, len(commits))
+	for i, commit := range commits {
+		subjects[i] = commitSubject(commit.Message)
+	}
+
+	// Summaries look past the commits at what the branch changes overall
+	var diff string
+	if !strict {
+		diff, err = uc.gitOps.GetBranchDiff(ctx, req.RepoPath, base, head)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get branch diff: %w", err)
+		}
+	}
+
+	aiResp, err := uc.aiProvider.GeneratePRDescription(ctx, ai.PRDescriptionRequest{
+		SourceBranch: head,
+		TargetBranch: base,
+		Commits:      subjects,
+		Diff:         diff,
+		APIKey:       req.APIKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate pull request description: %w", err)
+	}
+
+	draft.Title = aiResp.Title
+	draft.Body = prDescriptionBody(aiResp.Summary, "Changes", aiResp.Changes)
+	draft.TokensUsed = aiResp.TokensUsed
+	draft.Model = aiResp.Model
+	return draft, nil
+}
+
+// Create opens draft as a pull request with title and body, which may have
+// been edited since it was drafted.
+func (uc *CreatePullRequestUseCase) Create(ctx context.Context, repoPath string, draft *PullRequestDraft, title, body string) (*domain.PRInfo, error) {
+	if draft == nil {
+		return nil, errors.New("no pull request drafted")
+	}
+	if draft.Blocker != "" {
+		return nil, errors.New(draft.Blocker)
+	}
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, errors.New("pull request title cannot be empty")
+	}
+
+	prInfo, err := uc.ghOps.CreatePullRequest(ctx, repoPath, draft.BaseBranch, draft.HeadBranch, title, strings.TrimSpace(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+	return prInfo, nil
+}
+
+// defaultPRDescription describes a pull request without the AI: a single
+// commit lends its subject, otherwise the branch names it, and the body lists
+// the commits.
+func defaultPRDescription(head string, commits []git.CommitInfo) (string, string) {
+	title := head
+	if len(commits) == 1 {
+		title = commitSubject(commits[0].Message)
+	}
+
+	var subjects []string
+	for i, commit := range commits {
+		if i == maxDefaultPRCommits {
+			subjects = append(subjects, fmt.Sprintf("...and %d more commits", len(commits)-maxDefaultPRCommits))
+			break
+		}
+		subjects = append(subjects, commitSubject(commit.Message))
+	}
+	return title, prDescriptionBody("", "Commits", subjects)
+}
+
+// prDescriptionBody writes a pull request description: the summary, then
+// the bullets under a "## heading" section
+func prDescriptionBody(summary, heading string, bullets []string) string {
+	var sb strings.Builder
+	if summary = strings.TrimSpace(summary); summary != "" {
+		sb.WriteString(summary + "\n\n")
+	}
+	if len(bullets) > 0 {
+		sb.WriteString("## " + heading + "\n")
+		for _, bullet := range bullets {
+			sb.WriteString("- " + bullet + "\n")
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// commitSubject returns the first line of a commit message
+func commitSubject(message string) string {
+	return strings.SplitN(message, "\n", 2)[0]
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/adapter/ai"
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// prDescriptionProvider returns a canned pull request and records the request it was given.
+type prDescriptionProvider struct {
+	ai.Provider
+
+	request ai.PRDescriptionRequest
+}
+
+func (p *prDescriptionProvider) GeneratePRDescription(ctx context.Context, request ai.PRDescriptionRequest) (*ai.PRDescriptionResponse, error) {
+	p.request = request
+	return &ai.PRDescriptionResponse{
+		Title:      "Add OAuth login",
+		Summary:    "Adds an OAuth login flow.",
+		Changes:    []string{"Login page", "OAuth callback"},
+		TokensUsed: 90,
+		Model:      "stub",
+	}, nil
+}
+
+// prGitHubOps records the pull request it was asked to open.
+type prGitHubOps struct {
+	GitHubOperations
+
+	base, head, title, body string
+}
+
+func (f *prGitHubOps) CreatePullRequest(ctx context.Context, repoPath, base, head, title, body string) (*domain.PRInfo, error) {
+	f.base, f.head, f.title, f.body = base, head, title, body
+	prInfo, err := domain.NewPRInfo(42, title, "octocat", base, head)
+	if err != nil {
+		return nil, err
+	}
+	prInfo.SetHTMLURL("https://github.com/octo/repo/pull/42")
+	return prInfo, nil
+}
+
+func TestCreatePullRequest_DraftsFromBranchCommits(t *testing.T) {
+	ops := newNoAIGitOps(t)
+	ops.branchInfo.SetParent("develop")
+	ops.branchInfo.SetUpstream("origin/feature/login")
+	ops.log = []git.CommitInfo{{Hash: "b2", Message: "Add OAuth callback\n\nUses PKCE."}, {Hash: "a1", Message: "Add login page"}}
+
+	provider := &prDescriptionProvider{}
+	uc := NewCreatePullRequestUseCase(ops, provider)
+	draft, err := uc.Draft(context.Background(), DraftPullRequestRequest{RepoPath: "/tmp/repo"})
+	if err != nil {
+		t.Fatalf("Draft() unexpected error = %v", err)
+	}
+
+	if draft.HeadBranch != "feature/login" || draft.BaseBranch != "develop" {
+		t.Errorf("Draft() = %s → %s, want feature/login → develop", draft.HeadBranch, draft.BaseBranch)
+	}
+	if draft.Title != "Add OAuth login" || draft.Body != "Adds an OAuth login flow.\n\n## Changes\n- Login page\n- OAuth callback" {
+		t.Errorf("Draft() = %q / %q, want the AI's title, summary and changes", draft.Title, draft.Body)
+	}
+	if len(provider.request.Commits) != 2 || provider.request.Commits[0] != "Add OAuth callback" {
+		t.Errorf("AI was given commits %q, want the subjects of the branch's commits", provider.request.Commits)
+	}
+
+	ghOps := &prGitHubOps{}
+	uc.SetGitHubOps(ghOps)
+	prInfo, err := uc.Create(context.Background(), "/tmp/repo", draft, "  Add OAuth login flow ", draft.Body)
+	if err != nil {
+		t.Fatalf("Create() unexpected error = %v", err)
+	}
+	if ghOps.base != "develop" || ghOps.head != "feature/login" || ghOps.title != "Add OAuth login flow" {
+		t.Errorf("opened %s → %s titled %q, want the edited title on the drafted branches", ghOps.head, ghOps.base, ghOps.title)
+	}
+	if prInfo.HTMLURL() != "https://github.com/octo/repo/pull/42" {
+		t.Errorf("HTMLURL() = %q, want the created pull request's URL", prInfo.HTMLURL())
+	}
+}
+
+func TestCreatePullRequest_SkipAI(t *testing.T) {
+	ops := newNoAIGitOps(t)
+	ops.branchInfo.SetUpstream("origin/feature/login")
+	ops.mergeTargets = map[string]string{"feature/login": "release"}
+
+	provider := &countingProvider{}
+	draft, err := NewCreatePullRequestUseCase(ops, provider).Draft(context.Background(), DraftPullRequestRequest{
+		RepoPath: "/tmp/repo",
+		SkipAI:   true,
+	})
+	if err != nil {
+		t.Fatalf("Draft() unexpected error = %v", err)
+	}
+	if provider.calls != 0 {
+		t.Errorf("provider invoked %d times, want 0", provider.calls)
+	}
+	if draft.BaseBranch != "release" {
+		t.Errorf("BaseBranch = %q, want the remembered merge target", draft.BaseBranch)
+	}
+	if draft.Title != "Add login page" || draft.Body != "## Commits\n- Add login page" {
+		t.Errorf("Draft() = %q / %q, want the only commit's subject and a commit list", draft.Title, draft.Body)
+	}
+}
+
+func TestCreatePullRequest_RefusesBranch(t *testing.T) {
+	tests := []struct {
+		name      string
+		branch    string
+		upstream  string
+		commits   []git.CommitInfo
+		wantError string
+	}{
+		{"protected branch", "main", "origin/main", []git.CommitInfo{{Message: "Fix typo"}}, "protected branch"},
+		{"not pushed", "feature/login", "", []git.CommitInfo{{Message: "Add login page"}}, "push it before"},
+		{"nothing to propose", "feature/login", "origin/feature/login", nil, "no commits"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := newNoAIGitOps(t)
+			branchInfo, err := domain.NewBranchInfo(tt.branch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			branchInfo.SetParent("develop")
+			branchInfo.SetUpstream(tt.upstream)
+			ops.branchInfo = branchInfo
+			ops.log = tt.commits
+
+			_, err = NewCreatePullRequestUseCase(ops, &countingProvider{}).Draft(context.Background(), DraftPullRequestRequest{
+				RepoPath:          "/tmp/repo",
+				ProtectedBranches: []string{"main"},
+				SkipAI:            true,
+			})
+			if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+				t.Errorf("Draft() error = %v, want one mentioning %q", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestCreatePullRequest_SummarizeBranch(t *testing.T) {
+	ops := newNoAIGitOps(t)
+	ops.branchInfo.SetParent("develop") // Not pushed yet
+
+	provider := &prDescriptionProvider{}
+	uc := NewCreatePullRequestUseCase(&diffGitOps{fakeGitOps: ops}, provider)
+	draft, err := uc.Summarize(context.Background(), DraftPullRequestRequest{RepoPath: "/tmp/repo"})
+	if err != nil {
+		t.Fatalf("Summarize() unexpected error = %v", err)
+	}
+
+	if provider.request.Diff != branchRangeDiff {
+		t.Errorf("AI was given diff %q, want the branch's net diff", provider.request.Diff)
+	}
+	if draft.Title != "Add OAuth login" || !strings.Contains(draft.Blocker, "push it before") {
+		t.Errorf("Summarize() = %q with blocker %q, want the AI's title and the missing upstream", draft.Title, draft.Blocker)
+	}
+
+	ghOps := &prGitHubOps{}
+	uc.SetGitHubOps(ghOps)
+	if _, err := uc.Create(context.Background(), "/tmp/repo", draft, draft.Title, draft.Body); err == nil || ghOps.title != "" {
+		t.Errorf("Create() error = %v, want the blocked draft refused without calling gh", err)
+	}
+}
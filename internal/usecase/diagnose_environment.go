@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/yourusername/gitman/internal/adapter/ai"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// MinGitVersion is the oldest git GitMind supports; older versions lack
+// git branch --show-current.
+const MinGitVersion = "2.22"
+
+// EnvironmentProbe detects the tools and state gm doctor reports on.
+type EnvironmentProbe interface {
+	// GitVersion returns the installed git version, or an error if git can't be run.
+	GitVersion(ctx context.Context) (string, error)
+
+	// IsGitRepo reports whether path is inside a git repository.
+	IsGitRepo(ctx context.Context, path string) (bool, error)
+
+	// GHInstalled reports whether the gh CLI is on the PATH.
+	GHInstalled() bool
+
+	// GHAuthenticated reports whether gh is logged in to GitHub.
+	GHAuthenticated(ctx context.Context) (bool, error)
+
+	// ClipboardAvailable reports whether a clipboard tool is available.
+	ClipboardAvailable() bool
+}
+
+// DiagnoseEnvironmentUseCase runs the gm doctor checks.
+type DiagnoseEnvironmentUseCase struct {
+	probe EnvironmentProbe
+}
+
+// NewDiagnoseEnvironmentUseCase creates a new DiagnoseEnvironmentUseCase.
+func NewDiagnoseEnvironmentUseCase(probe EnvironmentProbe) *DiagnoseEnvironmentUseCase {
+	return &DiagnoseEnvironmentUseCase{
+		probe: probe,
+	}
+}
+
+// DiagnoseEnvironmentRequest contains the state to diagnose.
+type DiagnoseEnvironmentRequest struct {
+	WorkDir      string
+	ConfigPath   string
+	ConfigExists bool
+	Config       *domain.Config // Loaded config, or defaults when the file is missing
+	ConfigErr    error          // Error loading the config file, if any
+	AIProvider   ai.Provider    // If set, the API key is validated against the provider
+}
+
+// DiagnoseEnvironmentResponse contains the checklist, in display order.
+type DiagnoseEnvironmentResponse struct {
+	Checks []domain.DoctorCheck
+}
+
+// Failed reports whether any check failed.
+func (r *DiagnoseEnvironmentResponse) Failed() bool {
+	for _, check := range r.Checks {
+		if check.Status == domain.CheckFail {
+			return true
+		}
+	}
+	return false
+}
+
+// Execute runs every check; a failing check never stops the ones after it.
+func (uc *DiagnoseEnvironmentUseCase) Execute(ctx context.Context, req DiagnoseEnvironmentRequest) (*DiagnoseEnvironmentResponse, error) {
+	return &DiagnoseEnvironmentResponse{
+		Checks: []domain.DoctorCheck{
+			uc.checkGit(ctx),
+			uc.checkGH(ctx),
+			checkConfig(req),
+			checkAPIKey(ctx, req),
+			uc.checkClipboard(),
+			uc.checkRepo(ctx, req.WorkDir),
+		},
+	}, nil
+}
+
+func (uc *DiagnoseEnvironmentUseCase) checkGit(ctx context.Context) domain.DoctorCheck {
+	check := domain.DoctorCheck{Name: "git"}
+
+	version, err := uc.probe.GitVersion(ctx)
+	if err != nil {
+		check.Status = domain.CheckFail
+		check.Detail = "not found"
+		check.Hint = "Install git from https://git-scm.com/downloads"
+		return check
+	}
+
+	check.Detail = version
+	if compareVersions(version, MinGitVersion) < 0 {
+		check.Status = domain.CheckWarn
+		check.Hint = fmt.Sprintf("GitMind needs git %s or newer; some commands may fail", MinGitVersion)
+		return check
+	}
+	check.Status = domain.CheckPass
+	return check
+}
+
+func (uc *DiagnoseEnvironmentUseCase) checkGH(ctx context.Context) domain.DoctorCheck {
+	check := domain.DoctorCheck{Name: "GitHub CLI"}
+
+	if !uc.probe.GHInstalled() {
+		check.Status = domain.CheckWarn
+		check.Detail = "not installed"
+		check.Hint = "Install gh from https://cli.github.com to create pull requests"
+		return check
+	}
+
+	authenticated, err := uc.probe.GHAuthenticated(ctx)
+	switch {
+	case err != nil:
+		check.Status = domain.CheckWarn
+		check.Detail = fmt.Sprintf("couldn't check login: %v", err)
+	case !authenticated:
+		check.Status = domain.CheckWarn
+		check.Detail = "not logged in"
+		check.Hint = "Run 'gh auth login'"
+	default:
+		check.Status = domain.CheckPass
+		check.Detail = "logged in"
+	}
+	return check
+}
+
+func checkConfig(req DiagnoseEnvironmentRequest) domain.DoctorCheck {
+	check := domain.DoctorCheck{Name: "config"}
+
+	switch {
+	case req.ConfigErr != nil:
+		check.Status = domain.CheckFail
+		check.Detail = req.ConfigErr.Error()
+		check.Hint = fmt.Sprintf("Fix or remove %s, then run 'gm config'", req.ConfigPath)
+		return check
+	case !req.ConfigExists:
+		check.Status = domain.CheckWarn
+		check.Detail = fmt.Sprintf("%s not found, using defaults", req.ConfigPath)
+		check.Hint = "Run 'gm onboard' or 'gm config' to create it"
+		return check
+	}
+
+	// A missing API key is reported by its own check
+	cfg := *req.Config
+	if cfg.AI.APIKey == "" {
+		cfg.AI.APIKey = "unset"
+	}
+	if err := cfg.Validate(); err != nil {
+		check.Status = domain.CheckFail
+		check.Detail = fmt.Sprintf("invalid: %v", err)
+		check.Hint = fmt.Sprintf("Fix %s or run 'gm config'", req.ConfigPath)
+		return check
+	}
+
+	check.Status = domain.CheckPass
+	check.Detail = req.ConfigPath
+	return check
+}
+
+func checkAPIKey(ctx context.Context, req DiagnoseEnvironmentRequest) domain.DoctorCheck {
+	check := domain.DoctorCheck{Name: "API key"}
+	if req.Config == nil {
+		check.Status = domain.CheckWarn
+		check.Detail = "skipped, config couldn't be loaded"
+		return check
+	}
+
+	provider := req.Config.AI.Provider
+	if !domain.ProviderRequiresAPIKey(provider) {
+		check.Status = domain.CheckPass
+		check.Detail = fmt.Sprintf("not needed for %s", provider)
+	} else if req.Config.AI.APIKey == "" {
+		check.Status = domain.CheckFail
+		check.Detail = fmt.Sprintf("no %s key configured", provider)
+		check.Hint = "Run 'gm config' to set one, or use --no-ai"
+		return check
+	} else {
+		check.Status = domain.CheckPass
+		check.Detail = fmt.Sprintf("%s key configured", provider)
+	}
+
+	if req.AIProvider == nil {
+		return check
+	}
+	if err := req.AIProvider.ValidateKey(ctx); err != nil {
+		check.Status = domain.CheckFail
+		check.Detail = fmt.Sprintf("%s rejected: %v", provider, err)
+		check.Hint = "Check the key and network, then run 'gm config'"
+		return check
+	}
+	check.Detail += ", validated"
+	return check
+}
+
+func (uc *DiagnoseEnvironmentUseCase) checkClipboard() domain.DoctorCheck {
+	check := domain.DoctorCheck{Name: "clipboard"}
+
+	if !uc.probe.ClipboardAvailable() {
+		check.Status = domain.CheckWarn
+		check.Detail = "no clipboard tool found"
+		check.Hint = "Install xclip, xsel, or wl-clipboard to copy commit messages"
+		return check
+	}
+	check.Status = domain.CheckPass
+	check.Detail = "available"
+	return check
+}
+
+func (uc *DiagnoseEnvironmentUseCase) checkRepo(ctx context.Context, workDir string) domain.DoctorCheck {
+	check := domain.DoctorCheck{Name: "repository"}
+
+	isRepo, err := uc.probe.IsGitRepo(ctx, workDir)
+	if err != nil || !isRepo {
+		check.Status = domain.CheckWarn
+		check.Detail = fmt.Sprintf("%s is not a git repository", workDir)
+		check.Hint = "cd into a repository, or run 'gm onboard' to create one"
+		return check
+	}
+	check.Status = domain.CheckPass
+	check.Detail = workDir
+	return check
+}
+
+// compareVersions compares dotted version strings numerically, returning
+// -1, 0, or 1. Missing or non-numeric parts count as 0.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/adapter/ai"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// fakeProbe reports a fixed environment
+type fakeProbe struct {
+	gitVersion  string
+	gitErr      error
+	isRepo      bool
+	ghInstalled bool
+	ghAuthed    bool
+	ghAuthErr   error
+	clipboard   bool
+}
+
+func (p *fakeProbe) GitVersion(ctx context.Context) (string, error) { return p.gitVersion, p.gitErr }
+func (p *fakeProbe) IsGitRepo(ctx context.Context, path string) (bool, error) {
+	return p.isRepo, nil
+}
+func (p *fakeProbe) GHInstalled() bool { return p.ghInstalled }
+func (p *fakeProbe) GHAuthenticated(ctx context.Context) (bool, error) {
+	return p.ghAuthed, p.ghAuthErr
+}
+func (p *fakeProbe) ClipboardAvailable() bool { return p.clipboard }
+
+// keyCheckProvider accepts or rejects the API key
+type keyCheckProvider struct {
+	ai.Provider
+	err error
+}
+
+func (p *keyCheckProvider) ValidateKey(ctx context.Context) error { return p.err }
+
+func healthyProbe() *fakeProbe {
+	return &fakeProbe{gitVersion: "2.43.0", isRepo: true, ghInstalled: true, ghAuthed: true, clipboard: true}
+}
+
+func healthyRequest() DiagnoseEnvironmentRequest {
+	cfg := domain.NewDefaultConfig()
+	cfg.AI.APIKey = "csk-test"
+	return DiagnoseEnvironmentRequest{
+		WorkDir:      "/tmp/repo",
+		ConfigPath:   "/home/me/.gitman.json",
+		ConfigExists: true,
+		Config:       cfg,
+	}
+}
+
+func TestDiagnoseEnvironment_Checks(t *testing.T) {
+	tests := []struct {
+		name       string
+		probe      func(p *fakeProbe)
+		request    func(r *DiagnoseEnvironmentRequest)
+		check      string
+		wantStatus domain.CheckStatus
+	}{
+		{name: "git installed", check: "git", wantStatus: domain.CheckPass},
+		{name: "git missing", probe: func(p *fakeProbe) { p.gitErr = errors.New("not found") }, check: "git", wantStatus: domain.CheckFail},
+		{name: "git too old", probe: func(p *fakeProbe) { p.gitVersion = "2.17.1" }, check: "git", wantStatus: domain.CheckWarn},
+		{name: "gh logged in", check: "GitHub CLI", wantStatus: domain.CheckPass},
+		{name: "gh missing", probe: func(p *fakeProbe) { p.ghInstalled = false }, check: "GitHub CLI", wantStatus: domain.CheckWarn},
+		{name: "gh logged out", probe: func(p *fakeProbe) { p.ghAuthed = false }, check: "GitHub CLI", wantStatus: domain.CheckWarn},
+		{name: "gh status unknown", probe: func(p *fakeProbe) { p.ghAuthErr = errors.New("timeout") }, check: "GitHub CLI", wantStatus: domain.CheckWarn},
+		{name: "config valid", check: "config", wantStatus: domain.CheckPass},
+		{name: "config missing", request: func(r *DiagnoseEnvironmentRequest) { r.ConfigExists = false }, check: "config", wantStatus: domain.CheckWarn},
+		{
+			name: "config unreadable",
+			request: func(r *DiagnoseEnvironmentRequest) {
+				r.Config, r.ConfigErr = nil, errors.New("failed to parse config file")
+			},
+			check: "config", wantStatus: domain.CheckFail,
+		},
+		{name: "config invalid", request: func(r *DiagnoseEnvironmentRequest) { r.Config.Git.MainBranch = "" }, check: "config", wantStatus: domain.CheckFail},
+		{name: "config valid without key", request: func(r *DiagnoseEnvironmentRequest) { r.Config.AI.APIKey = "" }, check: "config", wantStatus: domain.CheckPass},
+		{name: "key configured", check: "API key", wantStatus: domain.CheckPass},
+		{name: "key missing", request: func(r *DiagnoseEnvironmentRequest) { r.Config.AI.APIKey = "" }, check: "API key", wantStatus: domain.CheckFail},
+		{
+			name: "key not needed",
+			request: func(r *DiagnoseEnvironmentRequest) {
+				r.Config.AI.Provider, r.Config.AI.APIKey = "ollama", ""
+			},
+			check: "API key", wantStatus: domain.CheckPass,
+		},
+		{name: "key validated", request: func(r *DiagnoseEnvironmentRequest) { r.AIProvider = &keyCheckProvider{} }, check: "API key", wantStatus: domain.CheckPass},
+		{
+			name: "key rejected",
+			request: func(r *DiagnoseEnvironmentRequest) {
+				r.AIProvider = &keyCheckProvider{err: errors.New("401 unauthorized")}
+			},
+			check: "API key", wantStatus: domain.CheckFail,
+		},
+		{name: "key skipped without config", request: func(r *DiagnoseEnvironmentRequest) { r.Config = nil; r.ConfigErr = errors.New("bad") }, check: "API key", wantStatus: domain.CheckWarn},
+		{name: "clipboard available", check: "clipboard", wantStatus: domain.CheckPass},
+		{name: "clipboard missing", probe: func(p *fakeProbe) { p.clipboard = false }, check: "clipboard", wantStatus: domain.CheckWarn},
+		{name: "in a repository", check: "repository", wantStatus: domain.CheckPass},
+		{name: "outside a repository", probe: func(p *fakeProbe) { p.isRepo = false }, check: "repository", wantStatus: domain.CheckWarn},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probe := healthyProbe()
+			if tt.probe != nil {
+				tt.probe(probe)
+			}
+			req := healthyRequest()
+			if tt.request != nil {
+				tt.request(&req)
+			}
+
+			resp, err := NewDiagnoseEnvironmentUseCase(probe).Execute(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if len(resp.Checks) != 6 {
+				t.Fatalf("Expected 6 checks, got %d", len(resp.Checks))
+			}
+
+			var found *domain.DoctorCheck
+			for i := range resp.Checks {
+				if resp.Checks[i].Name == tt.check {
+					found = &resp.Checks[i]
+				}
+			}
+			if found == nil {
+				t.Fatalf("No %q check in %v", tt.check, resp.Checks)
+			}
+			if found.Status != tt.wantStatus {
+				t.Errorf("%s check = %s (%s), want %s", tt.check, found.Status, found.Detail, tt.wantStatus)
+			}
+			if found.Status != domain.CheckPass && found.Detail == "" {
+				t.Errorf("Expected a detail explaining the %s", found.Status)
+			}
+			if found.Status == domain.CheckFail && !resp.Failed() {
+				t.Errorf("Expected Failed() with a failing %s check", tt.check)
+			}
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"2.43.0", "2.22", 1},
+		{"2.22", "2.22.0", 0},
+		{"2.9.5", "2.22", -1},
+		{"3.0", "2.99.1", 1},
+	}
+
+	for _, tt := range tests {
+		if got := compareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
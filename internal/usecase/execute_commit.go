@@ -28,16 +28,35 @@ type ExecuteCommitRequest struct {
 	CommitMessage *domain.CommitMessage
 	BranchName    string
 	StageAll      bool
+	Push          bool   // Push the committed branch when a remote is configured
+	PushMode      string // What else Push sends (domain.PushMode*); empty pushes only the branch
+
+	// Files limits the commit to these paths. They are staged and committed on
+	// their own, so other changes stay unstaged; StageAll is ignored when set.
+	Files []string
+
+	// KeepStagedOnFailure leaves files staged by StageAll in the index when the
+	// commit fails. By default the index is restored to its state before staging.
+	KeepStagedOnFailure bool
+
+	// DryRun lists the git commands the commit would run in PlannedCommands
+	// instead of running them. Read-only queries still run.
+	DryRun bool
 }
 
 // ExecuteCommitResponse contains the result of the commit execution.
 type ExecuteCommitResponse struct {
 	Success       bool
 	BranchCreated string
-	CommitHash    string
+	Branch        string // Branch the commit landed on
+	CommitHash    string // Short hash of the new commit
 	Message       string
 	Pushed        bool   // Whether changes were pushed to remote
 	PushError     error  // Error from push operation (if any)
+	LocalOnly     bool   // Push was requested but skipped because no remote is configured
+	Amended       bool   // The changes were folded into the previous commit
+
+	PlannedCommands []string // Commands a dry run would have executed, in order
 }
 
 // Execute performs the commit operation.
@@ -50,6 +69,15 @@ func (uc *ExecuteCommitUseCase) Execute(ctx context.Context, req ExecuteCommitRe
 		Success: true,
 	}
 
+	// Selected files are staged by the commit itself
+	if len(req.Files) > 0 {
+		req.StageAll = false
+	}
+
+	if req.DryRun {
+		return uc.plan(ctx, req)
+	}
+
 	switch req.Action {
 	case domain.ActionReview:
 		// User chose manual review - just exit gracefully
@@ -59,18 +87,34 @@ func (uc *ExecuteCommitUseCase) Execute(ctx context.Context, req ExecuteCommitRe
 
 	case domain.ActionCommitDirect:
 		// Stage files first
+		snapshot := uc.snapshotIndex(ctx, req)
 		if req.StageAll {
 			if err := uc.gitOps.Add(ctx, req.RepoPath, nil); err != nil {
-				return nil, fmt.Errorf("failed to stage files: %w", err)
+				return nil, uc.restoreIndex(ctx, req.RepoPath, snapshot, fmt.Errorf("failed to stage files: %w", err))
 			}
 		}
 
 		// Commit directly to current branch
-		if err := uc.gitOps.Commit(ctx, req.RepoPath, req.CommitMessage.FullMessage(), nil); err != nil {
-			return nil, fmt.Errorf("failed to commit: %w", err)
+		if err := uc.gitOps.Commit(ctx, req.RepoPath, req.CommitMessage.FullMessage(), req.Files); err != nil {
+			return nil, uc.restoreIndex(ctx, req.RepoPath, snapshot, fmt.Errorf("failed to commit: %w", err))
 		}
 		resp.Message = "Changes committed successfully"
 
+	case domain.ActionAmend:
+		// Fold the changes into the previous commit on the current branch
+		snapshot := uc.snapshotIndex(ctx, req)
+		if req.StageAll {
+			if err := uc.gitOps.Add(ctx, req.RepoPath, nil); err != nil {
+				return nil, uc.restoreIndex(ctx, req.RepoPath, snapshot, fmt.Errorf("failed to stage files: %w", err))
+			}
+		}
+
+		if err := uc.gitOps.AmendCommit(ctx, req.RepoPath, req.CommitMessage.FullMessage(), req.Files); err != nil {
+			return nil, uc.restoreIndex(ctx, req.RepoPath, snapshot, fmt.Errorf("failed to amend commit: %w", err))
+		}
+		resp.Amended = true
+		resp.Message = "Changes amended into the previous commit"
+
 	case domain.ActionCreateBranch:
 		// Create new branch and commit there
 		if req.BranchName == "" {
@@ -82,13 +126,14 @@ func (uc *ExecuteCommitUseCase) Execute(ctx context.Context, req ExecuteCommitRe
 		commits, err := uc.gitOps.GetLog(ctx, req.RepoPath, 1)
 		if err != nil || len(commits) == 0 {
 			// Empty repo - make initial commit on current branch first
+			snapshot := uc.snapshotIndex(ctx, req)
 			if req.StageAll {
 				if err := uc.gitOps.Add(ctx, req.RepoPath, nil); err != nil {
-					return nil, fmt.Errorf("failed to stage files: %w", err)
+					return nil, uc.restoreIndex(ctx, req.RepoPath, snapshot, fmt.Errorf("failed to stage files: %w", err))
 				}
 			}
-			if err := uc.gitOps.Commit(ctx, req.RepoPath, req.CommitMessage.FullMessage(), nil); err != nil {
-				return nil, fmt.Errorf("failed to make initial commit: %w", err)
+			if err := uc.gitOps.Commit(ctx, req.RepoPath, req.CommitMessage.FullMessage(), req.Files); err != nil {
+				return nil, uc.restoreIndex(ctx, req.RepoPath, snapshot, fmt.Errorf("failed to make initial commit: %w", err))
 			}
 			resp.Message = "Made initial commit on master (cannot create branch in empty repo)"
 		} else {
@@ -113,15 +158,16 @@ func (uc *ExecuteCommitUseCase) Execute(ctx context.Context, req ExecuteCommitRe
 			_ = uc.gitOps.SetParentBranch(ctx, req.RepoPath, req.BranchName, currentBranch)
 
 			// NOW stage files on the new branch
+			snapshot := uc.snapshotIndex(ctx, req)
 			if req.StageAll {
 				if err := uc.gitOps.Add(ctx, req.RepoPath, nil); err != nil {
-					return nil, fmt.Errorf("failed to stage files on new branch: %w", err)
+					return nil, uc.restoreIndex(ctx, req.RepoPath, snapshot, fmt.Errorf("failed to stage files on new branch: %w", err))
 				}
 			}
 
 			// Commit on new branch
-			if err := uc.gitOps.Commit(ctx, req.RepoPath, req.CommitMessage.FullMessage(), nil); err != nil {
-				return nil, fmt.Errorf("failed to commit on new branch: %w", err)
+			if err := uc.gitOps.Commit(ctx, req.RepoPath, req.CommitMessage.FullMessage(), req.Files); err != nil {
+				return nil, uc.restoreIndex(ctx, req.RepoPath, snapshot, fmt.Errorf("failed to commit on new branch: %w", err))
 			}
 
 			resp.BranchCreated = req.BranchName
@@ -132,5 +178,301 @@ func (uc *ExecuteCommitUseCase) Execute(ctx context.Context, req ExecuteCommitRe
 		return nil, fmt.Errorf("unsupported action: %s", req.Action)
 	}
 
+	// Record where the commit landed (non-fatal, used for reporting)
+	resp.Branch = resp.BranchCreated
+	if resp.Branch == "" {
+		if branch, err := uc.gitOps.GetCurrentBranch(ctx, req.RepoPath); err == nil {
+			resp.Branch = branch
+		}
+	}
+	if log, err := uc.gitOps.GetLog(ctx, req.RepoPath, 1); err == nil && len(log) > 0 {
+		resp.CommitHash = log[0].Hash
+		if len(resp.CommitHash) > 7 {
+			resp.CommitHash = resp.CommitHash[:7] // Short hash
+		}
+	}
+
+	if req.Push {
+		uc.pushAfterCommit(ctx, req, resp)
+	}
+
+	return resp, nil
+}
+
+// plan lists the git commands Execute would run for req, in order, without
+// changing the repository.
+func (uc *ExecuteCommitUseCase) plan(ctx context.Context, req ExecuteCommitRequest) (*ExecuteCommitResponse, error) {
+	resp := &ExecuteCommitResponse{Success: true}
+	message := req.CommitMessage.FullMessage()
+
+	var commands [][]string
+	stage := func() {
+		if req.StageAll {
+			commands = append(commands, git.AddArgs(nil))
+		} else if len(req.Files) > 0 {
+			commands = append(commands, git.AddArgs(req.Files))
+		}
+	}
+
+	switch req.Action {
+	case domain.ActionReview:
+		resp.Message = "Manual review selected - no changes would be made"
+		return resp, nil
+
+	case domain.ActionCommitDirect:
+		stage()
+		commands = append(commands, uc.gitOps.CommitArgs(message, req.Files, false))
+
+	case domain.ActionAmend:
+		stage()
+		commands = append(commands, uc.gitOps.CommitArgs(message, req.Files, true))
+
+	case domain.ActionCreateBranch:
+		if req.BranchName == "" {
+			return nil, fmt.Errorf("branch name is required for create-branch action")
+		}
+
+		// An empty repository gets its initial commit on the current branch
+		if commits, err := uc.gitOps.GetLog(ctx, req.RepoPath, 1); err != nil || len(commits) == 0 {
+			stage()
+			commands = append(commands, uc.gitOps.CommitArgs(message, req.Files, false))
+			break
+		}
+
+		currentBranch, err := uc.gitOps.GetCurrentBranch(ctx, req.RepoPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current branch: %w", err)
+		}
+		commands = append(commands,
+			[]string{"branch", req.BranchName},
+			[]string{"checkout", req.BranchName},
+			[]string{"config", fmt.Sprintf("branch.%s.parent", req.BranchName), currentBranch},
+		)
+		stage()
+		commands = append(commands, uc.gitOps.CommitArgs(message, req.Files, false))
+		resp.BranchCreated = req.BranchName
+
+	default:
+		return nil, fmt.Errorf("unsupported action: %s", req.Action)
+	}
+
+	if req.Push {
+		pushCommands, err := uc.planPush(ctx, req, resp)
+		if err != nil {
+			return nil, err
+		}
+		commands = append(commands, pushCommands...)
+	}
+
+	for _, args := range commands {
+		resp.PlannedCommands = append(resp.PlannedCommands, git.FormatCommand(args...))
+	}
+	resp.Message = "Dry run - no changes were made"
 	return resp, nil
 }
+
+// planPush lists the push commands pushAfterCommit would run. A repository
+// without remotes pushes nothing and is reported as local only.
+func (uc *ExecuteCommitUseCase) planPush(ctx context.Context, req ExecuteCommitRequest, resp *ExecuteCommitResponse) ([][]string, error) {
+	hasRemote, err := uc.gitOps.HasRemote(ctx, req.RepoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check remotes: %w", err)
+	}
+	if !hasRemote {
+		resp.LocalOnly = true
+		return nil, nil
+	}
+
+	branch := resp.BranchCreated
+	if branch == "" {
+		if branch, err = uc.gitOps.GetCurrentBranch(ctx, req.RepoPath); err != nil {
+			return nil, fmt.Errorf("failed to get current branch: %w", err)
+		}
+	}
+
+	// Same choice of remote and -u as Push; a branch created by the commit
+	// tracks nothing yet, so it goes to the primary remote
+	remote, tracking, err := uc.gitOps.PushRemote(ctx, req.RepoPath, branch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get remote: %w", err)
+	}
+
+	commands := [][]string{git.PushArgs(remote, branch, !tracking, false)}
+	switch req.PushMode {
+	case domain.PushModeCurrentTags:
+		commands = append(commands, []string{"push", remote, "--tags"})
+	case domain.PushModeAll:
+		commands = append(commands, []string{"push", remote, "--all"})
+	}
+	return commands, nil
+}
+
+// PushesAfterCommit reports whether a commit with action should be pushed
+// when auto-push is on. An amended commit that was already pushed needs a
+// force push, which is left to the user rather than done automatically.
+func PushesAfterCommit(autoPush bool, action domain.ActionType, last *LastCommit) bool {
+	if !autoPush || action == domain.ActionReview {
+		return false
+	}
+	return action != domain.ActionAmend || last == nil || !last.Pushed
+}
+
+// CommitTarget describes the repository a commit lands in, for CommitChecklist.
+type CommitTarget struct {
+	Branch      string // Current branch
+	FileCount   int    // Changed files the commit includes
+	NoCommits   bool   // The repository has no commits yet
+	Remote      string // Remote a push goes to; empty when none is configured
+	HasUpstream bool   // The current branch already tracks a remote branch
+}
+
+// CommitChecklist lists, in order and in plain words, what Execute will do
+// for req, so the side effects can be confirmed before anything runs. It
+// follows the same steps as a dry run, e.g. "Create branch feature/x from
+// main", "Commit 3 files to feature/x", "Push feature/x to origin with -u".
+func CommitChecklist(req ExecuteCommitRequest, target CommitTarget) []string {
+	files := fmt.Sprintf("%d files", target.FileCount)
+	if target.FileCount == 1 {
+		files = "1 file"
+	}
+
+	var steps []string
+	stage := func() {
+		if len(req.Files) > 0 {
+			steps = append(steps, "Stage the "+files+" selected")
+		} else if req.StageAll {
+			steps = append(steps, "Stage all changes")
+		}
+	}
+
+	branch := target.Branch
+	switch req.Action {
+	case domain.ActionReview:
+		return nil
+
+	case domain.ActionCommitDirect:
+		stage()
+		steps = append(steps, fmt.Sprintf("Commit %s to %s", files, branch))
+
+	case domain.ActionAmend:
+		stage()
+		steps = append(steps, fmt.Sprintf("Amend the previous commit on %s with %s", branch, files))
+
+	case domain.ActionCreateBranch:
+		if target.NoCommits {
+			stage()
+			steps = append(steps, fmt.Sprintf("Make the initial commit on %s (%s); %s is not created in an empty repository", branch, files, req.BranchName))
+			break
+		}
+		if branch != "" {
+			steps = append(steps, fmt.Sprintf("Create branch %s from %s and switch to it", req.BranchName, branch))
+		} else {
+			steps = append(steps, fmt.Sprintf("Create branch %s and switch to it", req.BranchName))
+		}
+		branch = req.BranchName
+		stage()
+		steps = append(steps, fmt.Sprintf("Commit %s to %s", files, branch))
+	}
+
+	if !req.Push {
+		return steps
+	}
+	if target.Remote == "" {
+		return append(steps, "Keep the commit local: no remote is configured to push to")
+	}
+
+	// A branch created by the commit has no upstream yet
+	if branch != target.Branch || !target.HasUpstream {
+		steps = append(steps, fmt.Sprintf("Push %s to %s with -u, setting its upstream", branch, target.Remote))
+	} else {
+		steps = append(steps, fmt.Sprintf("Push %s to %s", branch, target.Remote))
+	}
+	switch req.PushMode {
+	case domain.PushModeCurrentTags:
+		steps = append(steps, "Push all tags to "+target.Remote)
+	case domain.PushModeAll:
+		steps = append(steps, "Push all branches to "+target.Remote)
+	}
+	return steps
+}
+
+// snapshotIndex records the index before StageAll or Files stage changes, so a
+// failed commit can put the user's staging back. It returns "" when there is
+// nothing to restore: staging is left alone, restoring is disabled, or the
+// snapshot failed (e.g. the index has unresolved conflicts).
+func (uc *ExecuteCommitUseCase) snapshotIndex(ctx context.Context, req ExecuteCommitRequest) string {
+	if (!req.StageAll && len(req.Files) == 0) || req.KeepStagedOnFailure {
+		return ""
+	}
+
+	tree, err := uc.gitOps.SnapshotIndex(ctx, req.RepoPath)
+	if err != nil {
+		return ""
+	}
+	return tree
+}
+
+// restoreIndex puts back the index snapshot after commitErr and returns
+// commitErr, noting if the staging could not be restored.
+func (uc *ExecuteCommitUseCase) restoreIndex(ctx context.Context, repoPath, snapshot string, commitErr error) error {
+	if snapshot == "" {
+		return commitErr
+	}
+
+	if err := uc.gitOps.RestoreIndex(ctx, repoPath, snapshot); err != nil {
+		return fmt.Errorf("%w (files staged for the commit are still staged: %v)", commitErr, err)
+	}
+	return commitErr
+}
+
+// pushAfterCommit pushes the committed branch. Push problems never fail the
+// commit itself; they are reported on the response instead.
+func (uc *ExecuteCommitUseCase) pushAfterCommit(ctx context.Context, req ExecuteCommitRequest, resp *ExecuteCommitResponse) {
+	// Local-only repositories have nothing to push to
+	hasRemote, err := uc.gitOps.HasRemote(ctx, req.RepoPath)
+	if err != nil {
+		resp.PushError = fmt.Errorf("failed to check remotes: %w", err)
+		return
+	}
+	if !hasRemote {
+		resp.LocalOnly = true
+		return
+	}
+
+	branch := resp.Branch
+	if branch == "" {
+		branch, err = uc.gitOps.GetCurrentBranch(ctx, req.RepoPath)
+		if err != nil {
+			resp.PushError = fmt.Errorf("failed to get current branch: %w", err)
+			return
+		}
+	}
+
+	// Tags and other branches follow the branch to the remote it tracks
+	remote, _, err := uc.gitOps.PushRemote(ctx, req.RepoPath, branch)
+	if err != nil {
+		resp.PushError = fmt.Errorf("failed to get remote: %w", err)
+		return
+	}
+
+	// The Push implementation automatically handles -u if upstream is missing
+	if err := uc.gitOps.Push(ctx, req.RepoPath, remote, branch, false); err != nil {
+		resp.PushError = err
+		return
+	}
+
+	switch req.PushMode {
+	case domain.PushModeCurrentTags:
+		if err := uc.gitOps.PushTags(ctx, req.RepoPath, remote); err != nil {
+			resp.PushError = err
+			return
+		}
+	case domain.PushModeAll:
+		if err := uc.gitOps.PushAllBranches(ctx, req.RepoPath, remote); err != nil {
+			resp.PushError = err
+			return
+		}
+	}
+
+	resp.Pushed = true
+}
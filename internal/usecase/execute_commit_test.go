@@ -0,0 +1,632 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// fakeGitOps implements only the git operations a test exercises.
+// Calling any other method panics via the nil embedded interface.
+type fakeGitOps struct {
+	git.Operations
+
+	hasRemote     bool
+	currentBranch string
+	pushCalls     int
+	pushedBranch  string
+	pushes        []string // Each push invocation: the branch, "--tags" or "--all"
+	pushRemotes   []string // Remote passed to each push invocation
+	remote        string   // GetRemoteName result; "origin" if empty
+	tracking      string   // Remote the branch tracks, if any
+	signCommits   bool     // CommitArgs adds -S, as ExecOperations does with signing on
+	commitCalls   int
+	log           []git.CommitInfo
+	repo          *domain.Repository
+	branchInfo    *domain.BranchInfo
+	branches      []string
+	hooks         []string
+	index         string // Stand-in for the staging area: Add sets it to "all"
+	commitErr     error
+	whitespace    bool           // IsWhitespaceOnlyChange result
+	template      string         // GetCommitTemplate result
+	added         [][]string     // Files passed to each Add call
+	messages      []string       // Messages passed to each Commit call
+	committed     [][]string     // Files passed to each Commit or AmendCommit call
+	mergeFiles    []git.FileStat // GetMergePreviewStats result
+	ahead         int            // GetRemoteSyncStatus ahead count
+	amendCalls    int
+	failOnCommit  int               // Only this Commit call fails with commitErr (0 fails every call)
+	gitDir        string            // GitPath root; empty makes GitPath fail
+	mergeTargets  map[string]string // Remembered merge target per branch
+}
+
+func (f *fakeGitOps) GitPath(ctx context.Context, repoPath, name string) (string, error) {
+	if f.gitDir == "" {
+		return "", errors.New("no git directory")
+	}
+	return filepath.Join(f.gitDir, name), nil
+}
+
+func (f *fakeGitOps) GetRemoteSyncStatus(ctx context.Context, repoPath, branch string) (int, int, error) {
+	return f.ahead, 0, nil
+}
+
+func (f *fakeGitOps) AmendCommit(ctx context.Context, repoPath string, message string, files []string) error {
+	f.amendCalls++
+	f.messages = append(f.messages, message)
+	f.committed = append(f.committed, files)
+	return f.commitErr
+}
+
+func (f *fakeGitOps) ListHooks(ctx context.Context, repoPath string) ([]string, error) {
+	return f.hooks, nil
+}
+
+func (f *fakeGitOps) IsWhitespaceOnlyChange(ctx context.Context, repoPath string) (bool, error) {
+	return f.whitespace, nil
+}
+
+func (f *fakeGitOps) GetCommitTemplate(ctx context.Context, repoPath string) (string, error) {
+	return f.template, nil
+}
+
+func (f *fakeGitOps) IsGitRepo(ctx context.Context, path string) (bool, error) {
+	return true, nil
+}
+
+func (f *fakeGitOps) GetStatus(ctx context.Context, repoPath string) (*domain.Repository, error) {
+	return f.repo, nil
+}
+
+func (f *fakeGitOps) GetBranchInfo(ctx context.Context, repoPath string, protectedBranches []string) (*domain.BranchInfo, error) {
+	return f.branchInfo, nil
+}
+
+func (f *fakeGitOps) ListBranches(ctx context.Context, repoPath string, includeRemote bool) ([]string, error) {
+	return f.branches, nil
+}
+
+func (f *fakeGitOps) GetBranchCommits(ctx context.Context, repoPath, branch, excludeBranch string) ([]git.CommitInfo, error) {
+	return f.log, nil
+}
+
+func (f *fakeGitOps) CanMerge(ctx context.Context, repoPath, sourceBranch, targetBranch string) (bool, []string, error) {
+	return true, nil, nil
+}
+
+func (f *fakeGitOps) GetMergeTarget(ctx context.Context, repoPath, branch string) (string, error) {
+	return f.mergeTargets[branch], nil
+}
+
+func (f *fakeGitOps) SetMergeTarget(ctx context.Context, repoPath, branch, target string) error {
+	if f.mergeTargets == nil {
+		f.mergeTargets = map[string]string{}
+	}
+	f.mergeTargets[branch] = target
+	return nil
+}
+
+func (f *fakeGitOps) GetMergePreviewStats(ctx context.Context, repoPath, target, source string) ([]git.FileStat, error) {
+	return f.mergeFiles, nil
+}
+
+func (f *fakeGitOps) GetLog(ctx context.Context, repoPath string, count int) ([]git.CommitInfo, error) {
+	return f.log, nil
+}
+
+func (f *fakeGitOps) Add(ctx context.Context, repoPath string, files []string) error {
+	f.index = "all"
+	f.added = append(f.added, files)
+	return nil
+}
+
+func (f *fakeGitOps) Commit(ctx context.Context, repoPath string, message string, files []string) error {
+	f.commitCalls++
+	f.messages = append(f.messages, message)
+	f.committed = append(f.committed, files)
+	if f.failOnCommit > 0 && f.commitCalls != f.failOnCommit {
+		return nil
+	}
+	return f.commitErr
+}
+
+func (f *fakeGitOps) SnapshotIndex(ctx context.Context, repoPath string) (string, error) {
+	return f.index, nil
+}
+
+func (f *fakeGitOps) RestoreIndex(ctx context.Context, repoPath, tree string) error {
+	f.index = tree
+	return nil
+}
+
+func (f *fakeGitOps) HasRemote(ctx context.Context, repoPath string) (bool, error) {
+	return f.hasRemote, nil
+}
+
+func (f *fakeGitOps) GetCurrentBranch(ctx context.Context, repoPath string) (string, error) {
+	return f.currentBranch, nil
+}
+
+func (f *fakeGitOps) GetRemoteName(ctx context.Context, repoPath string) (string, error) {
+	if f.remote != "" {
+		return f.remote, nil
+	}
+	return "origin", nil
+}
+
+func (f *fakeGitOps) CommitArgs(message string, files []string, amend bool) []string {
+	args := git.CommitArgs(message, files, amend)
+	if f.signCommits {
+		return append([]string{args[0], "-S"}, args[1:]...)
+	}
+	return args
+}
+
+func (f *fakeGitOps) PushRemote(ctx context.Context, repoPath, branch string) (string, bool, error) {
+	if f.tracking != "" {
+		return f.tracking, true, nil
+	}
+	remote, err := f.GetRemoteName(ctx, repoPath)
+	return remote, false, err
+}
+
+func (f *fakeGitOps) Push(ctx context.Context, repoPath, remote, branch string, force bool) error {
+	f.pushCalls++
+	f.pushedBranch = branch
+	f.pushes = append(f.pushes, branch)
+	f.pushRemotes = append(f.pushRemotes, remote)
+	return nil
+}
+
+func (f *fakeGitOps) PushTags(ctx context.Context, repoPath, remote string) error {
+	f.pushes = append(f.pushes, "--tags")
+	f.pushRemotes = append(f.pushRemotes, remote)
+	return nil
+}
+
+func (f *fakeGitOps) PushAllBranches(ctx context.Context, repoPath, remote string) error {
+	f.pushes = append(f.pushes, "--all")
+	f.pushRemotes = append(f.pushRemotes, remote)
+	return nil
+}
+
+func TestExecuteCommit_PushWithoutRemote(t *testing.T) {
+	tests := []struct {
+		name          string
+		hasRemote     bool
+		wantPushCalls int
+		wantPushed    bool
+		wantLocalOnly bool
+	}{
+		{
+			name:          "no remote skips push without error",
+			hasRemote:     false,
+			wantPushCalls: 0,
+			wantPushed:    false,
+			wantLocalOnly: true,
+		},
+		{
+			name:          "remote configured pushes current branch",
+			hasRemote:     true,
+			wantPushCalls: 1,
+			wantPushed:    true,
+			wantLocalOnly: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &fakeGitOps{hasRemote: tt.hasRemote, currentBranch: "main"}
+			uc := NewExecuteCommitUseCase(ops)
+
+			msg, err := domain.NewCommitMessage("Add feature")
+			if err != nil {
+				t.Fatalf("NewCommitMessage() unexpected error = %v", err)
+			}
+
+			resp, err := uc.Execute(context.Background(), ExecuteCommitRequest{
+				RepoPath:      "/tmp/repo",
+				Action:        domain.ActionCommitDirect,
+				CommitMessage: msg,
+				StageAll:      true,
+				Push:          true,
+			})
+			if err != nil {
+				t.Fatalf("Execute() unexpected error = %v", err)
+			}
+
+			if ops.commitCalls != 1 {
+				t.Errorf("Commit called %d times, want 1", ops.commitCalls)
+			}
+			if ops.pushCalls != tt.wantPushCalls {
+				t.Errorf("Push called %d times, want %d", ops.pushCalls, tt.wantPushCalls)
+			}
+			if resp.PushError != nil {
+				t.Errorf("PushError = %v, want nil", resp.PushError)
+			}
+			if resp.Pushed != tt.wantPushed {
+				t.Errorf("Pushed = %v, want %v", resp.Pushed, tt.wantPushed)
+			}
+			if resp.LocalOnly != tt.wantLocalOnly {
+				t.Errorf("LocalOnly = %v, want %v", resp.LocalOnly, tt.wantLocalOnly)
+			}
+			if tt.wantPushCalls > 0 && ops.pushedBranch != "main" {
+				t.Errorf("Pushed branch = %q, want %q", ops.pushedBranch, "main")
+			}
+		})
+	}
+}
+
+func TestExecuteCommit_PushMode(t *testing.T) {
+	tests := []struct {
+		mode       string
+		wantPushes []string
+	}{
+		{"", []string{"main"}},
+		{domain.PushModeCurrent, []string{"main"}},
+		{domain.PushModeCurrentTags, []string{"main", "--tags"}},
+		{domain.PushModeAll, []string{"main", "--all"}},
+	}
+
+	for _, tt := range tests {
+		t.Run("mode "+tt.mode, func(t *testing.T) {
+			// A fork setup, where the primary remote isn't origin
+			ops := &fakeGitOps{hasRemote: true, currentBranch: "main", remote: "upstream"}
+
+			msg, err := domain.NewCommitMessage("Add feature")
+			if err != nil {
+				t.Fatalf("NewCommitMessage() unexpected error = %v", err)
+			}
+
+			resp, err := NewExecuteCommitUseCase(ops).Execute(context.Background(), ExecuteCommitRequest{
+				RepoPath:      "/tmp/repo",
+				Action:        domain.ActionCommitDirect,
+				CommitMessage: msg,
+				StageAll:      true,
+				Push:          true,
+				PushMode:      tt.mode,
+			})
+			if err != nil {
+				t.Fatalf("Execute() unexpected error = %v", err)
+			}
+
+			if !resp.Pushed || strings.Join(ops.pushes, " ") != strings.Join(tt.wantPushes, " ") {
+				t.Errorf("pushes = %v (pushed=%v), want %v", ops.pushes, resp.Pushed, tt.wantPushes)
+			}
+			for _, remote := range ops.pushRemotes {
+				if remote != "upstream" {
+					t.Errorf("pushed to %v, want every push to go to the primary remote", ops.pushRemotes)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestExecuteCommit_PushToTrackedRemote(t *testing.T) {
+	msg, err := domain.NewCommitMessage("Add feature")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() unexpected error = %v", err)
+	}
+	req := ExecuteCommitRequest{
+		RepoPath:      "/tmp/repo",
+		Action:        domain.ActionCommitDirect,
+		CommitMessage: msg,
+		StageAll:      true,
+		Push:          true,
+		PushMode:      domain.PushModeCurrentTags,
+	}
+
+	// main tracks the fork, not the primary remote origin
+	ops := &fakeGitOps{hasRemote: true, currentBranch: "main", tracking: "fork"}
+	resp, err := NewExecuteCommitUseCase(ops).Execute(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+	if !resp.Pushed || strings.Join(ops.pushRemotes, " ") != "fork fork" {
+		t.Errorf("pushed to %v (pushed=%v), want the branch and tags to go to the tracked fork", ops.pushRemotes, resp.Pushed)
+	}
+
+	// The dry run shows the same commands, without moving the upstream
+	req.DryRun = true
+	resp, err = NewExecuteCommitUseCase(&fakeGitOps{hasRemote: true, currentBranch: "main", tracking: "fork"}).Execute(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Execute() dry run unexpected error = %v", err)
+	}
+	want := []string{"git push fork main", "git push fork --tags"}
+	if got := resp.PlannedCommands[len(resp.PlannedCommands)-2:]; strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("planned pushes = %v, want %v", got, want)
+	}
+}
+
+func TestExecuteCommit_FailedCommitRestoresIndex(t *testing.T) {
+	tests := []struct {
+		name                string
+		keepStagedOnFailure bool
+		wantIndex           string
+	}{
+		{"default restores prior staging", false, "partial"},
+		{"keep staged when configured", true, "all"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &fakeGitOps{
+				currentBranch: "main",
+				index:         "partial", // The user had staged some files themselves
+				commitErr:     errors.New("pre-commit hook rejected the commit"),
+			}
+
+			msg, err := domain.NewCommitMessage("Add feature")
+			if err != nil {
+				t.Fatalf("NewCommitMessage() unexpected error = %v", err)
+			}
+
+			_, err = NewExecuteCommitUseCase(ops).Execute(context.Background(), ExecuteCommitRequest{
+				RepoPath:            "/tmp/repo",
+				Action:              domain.ActionCommitDirect,
+				CommitMessage:       msg,
+				StageAll:            true,
+				KeepStagedOnFailure: tt.keepStagedOnFailure,
+			})
+			if !errors.Is(err, ops.commitErr) {
+				t.Fatalf("Execute() error = %v, want the commit error", err)
+			}
+
+			if ops.index != tt.wantIndex {
+				t.Errorf("index = %q after failed commit, want %q", ops.index, tt.wantIndex)
+			}
+		})
+	}
+}
+
+func TestExecuteCommit_AmendFoldsChangesIntoLastCommit(t *testing.T) {
+	ops := &fakeGitOps{currentBranch: "main", log: []git.CommitInfo{{Hash: "def4567890", Message: "Add feature"}}}
+
+	msg, err := domain.NewCommitMessage("Add feature with tests")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() unexpected error = %v", err)
+	}
+
+	resp, err := NewExecuteCommitUseCase(ops).Execute(context.Background(), ExecuteCommitRequest{
+		RepoPath:      "/tmp/repo",
+		Action:        domain.ActionAmend,
+		CommitMessage: msg,
+		StageAll:      true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+
+	if ops.amendCalls != 1 || ops.commitCalls != 0 {
+		t.Errorf("AmendCommit called %d times and Commit %d times, want 1 and 0", ops.amendCalls, ops.commitCalls)
+	}
+	if ops.index != "all" {
+		t.Errorf("index = %q, want the changes staged before amending", ops.index)
+	}
+	if len(ops.messages) != 1 || ops.messages[0] != "Add feature with tests" {
+		t.Errorf("messages = %v, want the new message", ops.messages)
+	}
+	if !resp.Amended || resp.CommitHash != "def4567" {
+		t.Errorf("Amended = %v, CommitHash = %q, want the amended commit's short hash", resp.Amended, resp.CommitHash)
+	}
+}
+
+func TestExecuteCommit_SelectedFilesOnly(t *testing.T) {
+	ops := &fakeGitOps{currentBranch: "main"}
+
+	msg, err := domain.NewCommitMessage("Fix login redirect")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() unexpected error = %v", err)
+	}
+
+	_, err = NewExecuteCommitUseCase(ops).Execute(context.Background(), ExecuteCommitRequest{
+		RepoPath:      "/tmp/repo",
+		Action:        domain.ActionCommitDirect,
+		CommitMessage: msg,
+		StageAll:      true,
+		Files:         []string{"auth/login.go"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+
+	if len(ops.added) != 0 {
+		t.Errorf("Add called with %v, want nothing else staged", ops.added)
+	}
+	if len(ops.committed) != 1 || len(ops.committed[0]) != 1 || ops.committed[0][0] != "auth/login.go" {
+		t.Errorf("committed files = %v, want only the selected file", ops.committed)
+	}
+}
+
+func TestExecuteCommit_DryRunPlansCommands(t *testing.T) {
+	ops := &fakeGitOps{
+		hasRemote:     true,
+		currentBranch: "main",
+		log:           []git.CommitInfo{{Hash: "abc1234"}},
+	}
+
+	msg, err := domain.NewCommitMessage("Add login page")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() unexpected error = %v", err)
+	}
+
+	resp, err := NewExecuteCommitUseCase(ops).Execute(context.Background(), ExecuteCommitRequest{
+		RepoPath:      "/tmp/repo",
+		Action:        domain.ActionCreateBranch,
+		BranchName:    "feature/login",
+		CommitMessage: msg,
+		StageAll:      true,
+		Push:          true,
+		DryRun:        true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+
+	want := []string{
+		"git branch feature/login",
+		"git checkout feature/login",
+		"git config branch.feature/login.parent main",
+		"git add -A",
+		"git commit -m 'Add login page'",
+		"git push --set-upstream origin feature/login",
+	}
+	if strings.Join(resp.PlannedCommands, "\n") != strings.Join(want, "\n") {
+		t.Errorf("PlannedCommands =\n%s\nwant\n%s", strings.Join(resp.PlannedCommands, "\n"), strings.Join(want, "\n"))
+	}
+	if len(ops.added) != 0 || ops.commitCalls != 0 || ops.pushCalls != 0 {
+		t.Errorf("dry run touched the repository: added=%v commits=%d pushes=%d", ops.added, ops.commitCalls, ops.pushCalls)
+	}
+}
+
+func TestExecuteCommit_DryRunPlansSignedCommit(t *testing.T) {
+	ops := &fakeGitOps{currentBranch: "main", log: []git.CommitInfo{{Hash: "abc1234"}}, signCommits: true}
+
+	msg, err := domain.NewCommitMessage("Add login page")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() unexpected error = %v", err)
+	}
+
+	for _, action := range []domain.ActionType{domain.ActionCommitDirect, domain.ActionAmend} {
+		resp, err := NewExecuteCommitUseCase(ops).Execute(context.Background(), ExecuteCommitRequest{
+			RepoPath:      "/tmp/repo",
+			Action:        action,
+			CommitMessage: msg,
+			DryRun:        true,
+		})
+		if err != nil {
+			t.Fatalf("Execute(%s) unexpected error = %v", action, err)
+		}
+
+		want := "git commit -S -m 'Add login page'"
+		if action == domain.ActionAmend {
+			want = "git commit -S --amend -m 'Add login page'"
+		}
+		if strings.Join(resp.PlannedCommands, "\n") != want {
+			t.Errorf("PlannedCommands(%s) = %q, want the signed commit %q", action, resp.PlannedCommands, want)
+		}
+	}
+}
+
+func TestCommitChecklist(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    ExecuteCommitRequest
+		target CommitTarget
+		want   []string
+	}{
+		{
+			name:   "create branch and push",
+			req:    ExecuteCommitRequest{Action: domain.ActionCreateBranch, BranchName: "feature/login", StageAll: true, Push: true},
+			target: CommitTarget{Branch: "main", FileCount: 3, Remote: "origin", HasUpstream: true},
+			want: []string{
+				"Create branch feature/login from main and switch to it",
+				"Stage all changes",
+				"Commit 3 files to feature/login",
+				"Push feature/login to origin with -u, setting its upstream",
+			},
+		},
+		{
+			name:   "direct commit pushing tags to a tracked branch",
+			req:    ExecuteCommitRequest{Action: domain.ActionCommitDirect, StageAll: true, Push: true, PushMode: domain.PushModeCurrentTags},
+			target: CommitTarget{Branch: "feature/login", FileCount: 1, Remote: "upstream", HasUpstream: true},
+			want:   []string{"Stage all changes", "Commit 1 file to feature/login", "Push feature/login to upstream", "Push all tags to upstream"},
+		},
+		{
+			name:   "selected files without auto-push",
+			req:    ExecuteCommitRequest{Action: domain.ActionCommitDirect, Files: []string{"a.go", "b.go"}},
+			target: CommitTarget{Branch: "main", FileCount: 2, Remote: "origin"},
+			want:   []string{"Stage the 2 files selected", "Commit 2 files to main"},
+		},
+		{
+			name:   "staged only amend",
+			req:    ExecuteCommitRequest{Action: domain.ActionAmend},
+			target: CommitTarget{Branch: "main", FileCount: 1},
+			want:   []string{"Amend the previous commit on main with 1 file"},
+		},
+		{
+			name:   "push without a remote",
+			req:    ExecuteCommitRequest{Action: domain.ActionCommitDirect, StageAll: true, Push: true},
+			target: CommitTarget{Branch: "main", FileCount: 2},
+			want:   []string{"Stage all changes", "Commit 2 files to main", "Keep the commit local: no remote is configured to push to"},
+		},
+		{
+			name:   "review",
+			req:    ExecuteCommitRequest{Action: domain.ActionReview, Push: true},
+			target: CommitTarget{Branch: "main", FileCount: 2, Remote: "origin"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CommitChecklist(tt.req, tt.target); strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("CommitChecklist() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+// TestCommitChecklist_MatchesDryRun tests that each checklist step is a command the dry run plans, in the same order
+func TestCommitChecklist_MatchesDryRun(t *testing.T) {
+	ops := &fakeGitOps{hasRemote: true, currentBranch: "main", log: []git.CommitInfo{{Hash: "abc1234"}}}
+	msg, err := domain.NewCommitMessage("Add login page")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() unexpected error = %v", err)
+	}
+	req := ExecuteCommitRequest{
+		RepoPath:      "/tmp/repo",
+		Action:        domain.ActionCreateBranch,
+		BranchName:    "feature/login",
+		CommitMessage: msg,
+		StageAll:      true,
+		Push:          true,
+		DryRun:        true,
+	}
+	resp, err := NewExecuteCommitUseCase(ops).Execute(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+	steps := CommitChecklist(req, CommitTarget{Branch: "main", FileCount: 3, Remote: "origin"})
+
+	pairs := []struct{ command, step string }{
+		{"git branch feature/login", "Create branch feature/login from main"},
+		{"git add -A", "Stage all changes"},
+		{"git commit", "Commit 3 files to feature/login"},
+		{"git push --set-upstream origin feature/login", "Push feature/login to origin with -u"},
+	}
+	if len(steps) != len(pairs) {
+		t.Fatalf("CommitChecklist() = %q, want %d steps", steps, len(pairs))
+	}
+	next := 0
+	for i, pair := range pairs {
+		for next < len(resp.PlannedCommands) && !strings.HasPrefix(resp.PlannedCommands[next], pair.command) {
+			next++
+		}
+		if next == len(resp.PlannedCommands) {
+			t.Fatalf("Expected %q planned after the previous step, got %q", pair.command, resp.PlannedCommands)
+		}
+		if !strings.HasPrefix(steps[i], pair.step) {
+			t.Errorf("Step %d = %q, want it to start with %q", i+1, steps[i], pair.step)
+		}
+	}
+}
+
+func TestPushesAfterCommit(t *testing.T) {
+	pushed := &LastCommit{Hash: "abc1234", Pushed: true}
+	if !PushesAfterCommit(true, domain.ActionCreateBranch, nil) {
+		t.Error("Expected auto-push to push a new branch")
+	}
+	if PushesAfterCommit(false, domain.ActionCommitDirect, nil) || PushesAfterCommit(true, domain.ActionReview, nil) {
+		t.Error("Expected no push with auto-push off or for a review")
+	}
+	if PushesAfterCommit(true, domain.ActionAmend, pushed) {
+		t.Error("Expected an amend of a pushed commit not to be pushed")
+	}
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/yourusername/gitman/internal/adapter/git"
@@ -27,6 +28,7 @@ type ExecuteMergeRequest struct {
 	TargetBranch  string
 	Strategy      string // "squash", "regular", "fast-forward", "rebase"
 	MergeMessage  *domain.CommitMessage
+	DryRun        bool // List the git commands in PlannedCommands instead of running them
 }
 
 // ExecuteMergeResponse contains the result of the merge execution.
@@ -35,9 +37,12 @@ type ExecuteMergeResponse struct {
 	MergeCommit  string
 	Strategy     string
 	Message      string
+
+	PlannedCommands []string // Commands a dry run would have executed, in order
 }
 
-// Execute performs the merge operation.
+// Execute performs the merge operation. A merge that conflicts returns an
+// error wrapping *git.MergeConflictError and is left in progress.
 func (uc *ExecuteMergeUseCase) Execute(ctx context.Context, req ExecuteMergeRequest) (*ExecuteMergeResponse, error) {
 	if req.SourceBranch == "" || req.TargetBranch == "" {
 		return nil, fmt.Errorf("source and target branches are required")
@@ -53,13 +58,6 @@ func (uc *ExecuteMergeUseCase) Execute(ctx context.Context, req ExecuteMergeRequ
 		return nil, fmt.Errorf("failed to get current branch: %w", err)
 	}
 
-	// Checkout target branch if not already on it
-	if currentBranch != req.TargetBranch {
-		if err := uc.gitOps.CheckoutBranch(ctx, req.RepoPath, req.TargetBranch); err != nil {
-			return nil, fmt.Errorf("failed to checkout target branch '%s': %w", req.TargetBranch, err)
-		}
-	}
-
 	// Prepare merge message
 	mergeMsg := ""
 	if req.MergeMessage != nil {
@@ -75,7 +73,23 @@ func (uc *ExecuteMergeUseCase) Execute(ctx context.Context, req ExecuteMergeRequ
 		strategy = "regular" // Default strategy
 	}
 
+	if req.DryRun {
+		return uc.planMerge(req, currentBranch, strategy, mergeMsg), nil
+	}
+
+	// Checkout target branch if not already on it
+	if currentBranch != req.TargetBranch {
+		if err := uc.gitOps.CheckoutBranch(ctx, req.RepoPath, req.TargetBranch); err != nil {
+			return nil, fmt.Errorf("failed to checkout target branch '%s': %w", req.TargetBranch, err)
+		}
+	}
+
 	if err := uc.gitOps.Merge(ctx, req.RepoPath, req.SourceBranch, strategy, mergeMsg); err != nil {
+		// Conflicts stay in progress for the caller to resolve or abort
+		var conflictErr *git.MergeConflictError
+		if errors.As(err, &conflictErr) {
+			return nil, fmt.Errorf("merge failed: %w", err)
+		}
 		// Attempt to abort merge on failure
 		_ = uc.gitOps.AbortMerge(ctx, req.RepoPath)
 		return nil, fmt.Errorf("merge failed: %w", err)
@@ -97,3 +111,25 @@ func (uc *ExecuteMergeUseCase) Execute(ctx context.Context, req ExecuteMergeRequ
 
 	return resp, nil
 }
+
+// planMerge lists the git commands Execute would run for req, in order.
+func (uc *ExecuteMergeUseCase) planMerge(req ExecuteMergeRequest, currentBranch, strategy, message string) *ExecuteMergeResponse {
+	var commands [][]string
+	if currentBranch != req.TargetBranch {
+		commands = append(commands, []string{"checkout", req.TargetBranch})
+	}
+	commands = append(commands, git.MergeArgs(req.SourceBranch, strategy, message))
+	if strategy == "squash" {
+		commands = append(commands, uc.gitOps.CommitArgs(git.SquashMessage(req.SourceBranch, message), nil, false))
+	}
+
+	resp := &ExecuteMergeResponse{
+		Success:  true,
+		Strategy: strategy,
+		Message:  "Dry run - no changes were made",
+	}
+	for _, args := range commands {
+		resp.PlannedCommands = append(resp.PlannedCommands, git.FormatCommand(args...))
+	}
+	return resp
+}
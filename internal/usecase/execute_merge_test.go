@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+func TestExecuteMerge_DryRunPlansCommands(t *testing.T) {
+	msg, err := domain.NewCommitMessage("Merge feature/login")
+	if err != nil {
+		t.Fatalf("NewCommitMessage() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		strategy string
+		want     []string
+	}{
+		{"regular", []string{"git checkout main", "git merge --no-ff -m 'Merge feature/login' feature/login"}},
+		{"squash", []string{"git checkout main", "git merge --squash feature/login", "git commit -m 'Merge feature/login'"}},
+		{"rebase", []string{"git checkout main", "git rebase feature/login"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.strategy, func(t *testing.T) {
+			// Checkout and Merge are not faked: a dry run must not call them
+			ops := &fakeGitOps{currentBranch: "feature/login"}
+
+			resp, err := NewExecuteMergeUseCase(ops).Execute(context.Background(), ExecuteMergeRequest{
+				RepoPath:     "/tmp/repo",
+				SourceBranch: "feature/login",
+				TargetBranch: "main",
+				Strategy:     tt.strategy,
+				MergeMessage: msg,
+				DryRun:       true,
+			})
+			if err != nil {
+				t.Fatalf("Execute() unexpected error = %v", err)
+			}
+
+			if strings.Join(resp.PlannedCommands, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("PlannedCommands =\n%s\nwant\n%s", strings.Join(resp.PlannedCommands, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+// failingMergeGitOps fails the merge with mergeErr and records aborts
+type failingMergeGitOps struct {
+	git.Operations
+
+	mergeErr error
+	aborted  bool
+}
+
+func (f *failingMergeGitOps) GetCurrentBranch(ctx context.Context, repoPath string) (string, error) {
+	return "main", nil
+}
+
+func (f *failingMergeGitOps) Merge(ctx context.Context, repoPath, sourceBranch, strategy, message string) error {
+	return f.mergeErr
+}
+
+func (f *failingMergeGitOps) AbortMerge(ctx context.Context, repoPath string) error {
+	f.aborted = true
+	return nil
+}
+
+func TestExecuteMerge_ConflictLeftInProgress(t *testing.T) {
+	tests := []struct {
+		name         string
+		mergeErr     error
+		wantConflict bool
+		wantAborted  bool
+	}{
+		{"conflict", &git.MergeConflictError{Source: "feature/login", Files: []string{"auth.go"}}, true, false},
+		{"other failure", errors.New("merge failed: not something we can merge"), false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &failingMergeGitOps{mergeErr: tt.mergeErr}
+
+			_, err := NewExecuteMergeUseCase(ops).Execute(context.Background(), ExecuteMergeRequest{
+				RepoPath:     "/tmp/repo",
+				SourceBranch: "feature/login",
+				TargetBranch: "main",
+				Strategy:     "regular",
+			})
+			if err == nil {
+				t.Fatal("Execute() expected an error")
+			}
+
+			var conflictErr *git.MergeConflictError
+			if errors.As(err, &conflictErr) != tt.wantConflict {
+				t.Errorf("Execute() error = %v, want conflict %v", err, tt.wantConflict)
+			}
+			if ops.aborted != tt.wantAborted {
+				t.Errorf("AbortMerge called = %v, want %v", ops.aborted, tt.wantAborted)
+			}
+		})
+	}
+}
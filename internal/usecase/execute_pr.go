@@ -22,6 +22,7 @@ type ExecutePRUseCase struct {
 // GitHubOperations defines GitHub-specific operations needed for PR management.
 type GitHubOperations interface {
 	CreatePR(ctx context.Context, repoPath string, opts *domain.PROptions) (*domain.PRInfo, error)
+	CreatePullRequest(ctx context.Context, repoPath, base, head, title, body string) (*domain.PRInfo, error)
 	ListPRs(ctx context.Context, repoPath string, state string) ([]*domain.PRInfo, error)
 	GetPR(ctx context.Context, repoPath string, number int) (*domain.PRInfo, error)
 }
@@ -132,7 +133,7 @@ func (uc *ExecutePRUseCase) smartPush(ctx context.Context, repoPath, branch stri
 
 	// If no upstream, definitely need to push
 	if !hasUpstream {
-		if err := uc.gitOps.Push(ctx, repoPath, branch, false); err != nil {
+		if err := uc.gitOps.Push(ctx, repoPath, "", branch, false); err != nil {
 			return false, fmt.Errorf("failed to push branch: %w", err)
 		}
 		return true, nil
@@ -145,7 +146,7 @@ func (uc *ExecutePRUseCase) smartPush(ctx context.Context, repoPath, branch stri
 	}
 
 	if unpushed > 0 {
-		if err := uc.gitOps.Push(ctx, repoPath, branch, false); err != nil {
+		if err := uc.gitOps.Push(ctx, repoPath, "", branch, false); err != nil {
 			return false, fmt.Errorf("failed to push commits: %w", err)
 		}
 		return true, nil
@@ -182,6 +183,10 @@ func (w *gitHubOpsWrapper) CreatePR(ctx context.Context, repoPath string, opts *
 	return github.CreatePR(ctx, repoPath, opts)
 }
 
+func (w *gitHubOpsWrapper) CreatePullRequest(ctx context.Context, repoPath, base, head, title, body string) (*domain.PRInfo, error) {
+	return github.CreatePullRequest(ctx, repoPath, base, head, title, body)
+}
+
 func (w *gitHubOpsWrapper) ListPRs(ctx context.Context, repoPath string, state string) ([]*domain.PRInfo, error) {
 	return github.ListPRs(ctx, repoPath, state)
 }
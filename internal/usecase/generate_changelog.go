@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yourusername/gitman/internal/adapter/ai"
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// DefaultChangelogPath is where changelogs are written when no path is given.
+const DefaultChangelogPath = "CHANGELOG.md"
+
+// ChangelogStdout as the output path leaves the changelog file alone; the
+// caller prints the changelog instead.
+const ChangelogStdout = "-"
+
+// changelogHeading is the top-level heading of a new changelog file.
+const changelogHeading = "# Changelog"
+
+// GenerateChangelogUseCase writes a changelog for the commits between two
+// refs, grouped by conventional-commit type and worded by the AI.
+type GenerateChangelogUseCase struct {
+	gitOps     git.Operations
+	aiProvider ai.Provider
+}
+
+// NewGenerateChangelogUseCase creates a new GenerateChangelogUseCase.
+func NewGenerateChangelogUseCase(gitOps git.Operations, aiProvider ai.Provider) *GenerateChangelogUseCase {
+	return &GenerateChangelogUseCase{
+		gitOps:     gitOps,
+		aiProvider: aiProvider,
+	}
+}
+
+// GenerateChangelogRequest contains the parameters for changelog generation.
+type GenerateChangelogRequest struct {
+	RepoPath   string
+	FromRef    string // Older ref, e.g. the previous release tag
+	ToRef      string // Newer ref, e.g. the new tag or HEAD
+	Title      string // Section heading (defaults to ToRef)
+	OutputPath string // File to write, relative to RepoPath (defaults to CHANGELOG.md, ChangelogStdout writes none)
+	SkipAI     bool   // List the grouped commit subjects as they are, without calling the AI
+	APIKey     *domain.APIKey
+}
+
+// GenerateChangelogResponse contains the generated changelog.
+type GenerateChangelogResponse struct {
+	Changelog   *domain.Changelog
+	OutputPath  string // Absolute path of the written file, or "" if none was written
+	CommitCount int
+	TokensUsed  int
+	Model       string
+}
+
+// Execute gathers the commits in FromRef..ToRef, groups them by
+// conventional-commit type, asks the AI to word the entries, and prepends the
+// result to the changelog file.
+func (uc *GenerateChangelogUseCase) Execute(ctx context.Context, req GenerateChangelogRequest) (*GenerateChangelogResponse, error) {
+	if req.FromRef == "" || req.ToRef == "" {
+		return nil, errors.New("both refs are required")
+	}
+	if !req.SkipAI && uc.aiProvider == nil {
+		return nil, errors.New("changelog generation requires an AI provider")
+	}
+
+	commits, err := uc.gitOps.GetCommitsBetween(ctx, req.RepoPath, req.FromRef, req.ToRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commits: %w", err)
+	}
+	if len(commits) == 0 {
+		return nil, fmt.Errorf("no commits between %s and %s", req.FromRef, req.ToRef)
+	}
+
+	subjects := make([]string, len(commits))
+	for i, commit := range commits {
+		subjects[i] = commit.Message
+	}
+
+	grouped := domain.GroupCommitsByType(subjects)
+
+	resp := &GenerateChangelogResponse{CommitCount: len(commits)}
+	changelog := grouped
+	if !req.SkipAI {
+		aiResp, err := uc.aiProvider.GenerateChangelog(ctx, ai.ChangelogRequest{
+			FromRef: req.FromRef,
+			ToRef:   req.ToRef,
+			Commits: subjects,
+			Grouped: grouped,
+			APIKey:  req.APIKey,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("AI changelog generation failed: %w", err)
+		}
+		changelog = aiResp.Changelog
+		resp.TokensUsed = aiResp.TokensUsed
+		resp.Model = aiResp.Model
+	}
+	resp.Changelog = changelog
+
+	changelog.Title = req.Title
+	if changelog.Title == "" {
+		changelog.Title = req.ToRef
+	}
+
+	if req.OutputPath == ChangelogStdout {
+		return resp, nil
+	}
+
+	outputPath := req.OutputPath
+	if outputPath == "" {
+		outputPath = DefaultChangelogPath
+	}
+	if !filepath.IsAbs(outputPath) {
+		outputPath = filepath.Join(req.RepoPath, outputPath)
+	}
+
+	if err := writeChangelog(outputPath, changelog.Markdown()); err != nil {
+		return nil, err
+	}
+
+	resp.OutputPath = outputPath
+	return resp, nil
+}
+
+// writeChangelog inserts section at the top of the changelog at path, below the
+// "# Changelog" heading, so the newest release comes first. The file is created if missing.
+func writeChangelog(path, section string) error {
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	content := prependChangelogSection(string(existing), section)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// prependChangelogSection returns existing with section inserted as the newest entry.
+func prependChangelogSection(existing, section string) string {
+	section = strings.TrimRight(section, "\n") + "\n"
+
+	existing = strings.TrimLeft(existing, "\n")
+	if existing == "" {
+		return changelogHeading + "\n\n" + section
+	}
+
+	// Keep the title (and any intro text) above the newest release
+	if idx := strings.Index(existing, "\n## "); idx >= 0 {
+		return existing[:idx+1] + section + "\n" + existing[idx+1:]
+	}
+	if strings.HasPrefix(existing, "## ") {
+		return changelogHeading + "\n\n" + section + "\n" + existing
+	}
+	return strings.TrimRight(existing, "\n") + "\n\n" + section
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/adapter/git"
+)
+
+// rangeGitOps serves the commits of a release range.
+type rangeGitOps struct {
+	*fakeGitOps
+
+	from, to string
+}
+
+func (f *rangeGitOps) GetCommitsBetween(ctx context.Context, repoPath, a, b string) ([]git.CommitInfo, error) {
+	f.from, f.to = a, b
+	return f.log, nil
+}
+
+func TestPrependChangelogSection(t *testing.T) {
+	section := "## v1.1.0\n\n### Features\n\n- Add tags view\n"
+
+	tests := []struct {
+		name     string
+		existing string
+		want     string
+	}{
+		{
+			name:     "new file",
+			existing: "",
+			want:     "# Changelog\n\n## v1.1.0\n\n### Features\n\n- Add tags view\n",
+		},
+		{
+			name:     "newest release goes above older ones",
+			existing: "# Changelog\n\n## v1.0.0\n\n- Initial release\n",
+			want:     "# Changelog\n\n## v1.1.0\n\n### Features\n\n- Add tags view\n\n## v1.0.0\n\n- Initial release\n",
+		},
+		{
+			name:     "file without title",
+			existing: "## v1.0.0\n\n- Initial release\n",
+			want:     "# Changelog\n\n## v1.1.0\n\n### Features\n\n- Add tags view\n\n## v1.0.0\n\n- Initial release\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := prependChangelogSection(tt.existing, section); got != tt.want {
+				t.Errorf("prependChangelogSection() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateChangelog_SkipAIGroupsByType(t *testing.T) {
+	ops := &rangeGitOps{fakeGitOps: newNoAIGitOps(t)}
+	ops.log = []git.CommitInfo{
+		{Hash: "c3", Message: "feat(tags): add tags view"},
+		{Hash: "b2", Message: "Update README"},
+		{Hash: "a1", Message: "fix: handle detached HEAD"},
+	}
+	repoPath := t.TempDir()
+
+	provider := &countingProvider{}
+	resp, err := NewGenerateChangelogUseCase(ops, provider).Execute(context.Background(), GenerateChangelogRequest{
+		RepoPath:   repoPath,
+		FromRef:    "v1.0.0",
+		ToRef:      "HEAD",
+		OutputPath: ChangelogStdout,
+		SkipAI:     true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+	if provider.calls != 0 {
+		t.Errorf("provider invoked %d times, want 0", provider.calls)
+	}
+	if ops.from != "v1.0.0" || ops.to != "HEAD" {
+		t.Errorf("commits read from %s..%s, want v1.0.0..HEAD", ops.from, ops.to)
+	}
+
+	want := "## HEAD\n\n### Features\n\n- **tags:** add tags view\n\n### Fixes\n\n- handle detached HEAD\n\n### Other changes\n\n- Update README\n"
+	if got := resp.Changelog.Markdown(); got != want {
+		t.Errorf("Markdown() =\n%q\nwant\n%q", got, want)
+	}
+	if resp.OutputPath != "" {
+		t.Errorf("OutputPath = %q, want no file written", resp.OutputPath)
+	}
+	if _, err := os.Stat(filepath.Join(repoPath, DefaultChangelogPath)); !os.IsNotExist(err) {
+		t.Errorf("expected %s not to be written, stat error = %v", DefaultChangelogPath, err)
+	}
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/yourusername/gitman/internal/adapter/git"
 	"github.com/yourusername/gitman/internal/domain"
@@ -11,6 +12,7 @@ import (
 // ManageBranchesUseCase handles branch management operations with validation.
 type ManageBranchesUseCase struct {
 	gitOps git.Operations
+	cache  *RefCache // Reuses GetAllBranches results; nil disables caching
 }
 
 // NewManageBranchesUseCase creates a new ManageBranchesUseCase.
@@ -20,6 +22,13 @@ func NewManageBranchesUseCase(gitOps git.Operations) *ManageBranchesUseCase {
 	}
 }
 
+// WithCache makes GetAllBranches reuse results from cache, which the
+// branch operations invalidate. It returns uc for chaining.
+func (uc *ManageBranchesUseCase) WithCache(cache *RefCache) *ManageBranchesUseCase {
+	uc.cache = cache
+	return uc
+}
+
 // DeleteBranchRequest contains parameters for deleting a branch.
 type DeleteBranchRequest struct {
 	RepoPath          string
@@ -65,6 +74,24 @@ type SetUpstreamResponse struct {
 	Message string
 }
 
+// UpstreamCandidates lists the remotes and remote branches an upstream can point to.
+type UpstreamCandidates struct {
+	Remotes        []string
+	RemoteBranches []string // "<remote>/<branch>"
+}
+
+// CherryPickRequest contains parameters for cherry-picking commits onto the current branch.
+type CherryPickRequest struct {
+	RepoPath string
+	Hashes   []string // Applied in this order, so oldest first
+}
+
+// CherryPickResponse contains the result of a cherry-pick.
+type CherryPickResponse struct {
+	NewCommits []string // Hashes of the commits created on the current branch, in pick order
+	Message    string
+}
+
 // DeleteBranch deletes a branch with validation and optional remote deletion.
 func (uc *ManageBranchesUseCase) DeleteBranch(ctx context.Context, req DeleteBranchRequest) (*DeleteBranchResponse, error) {
 	if req.BranchName == "" {
@@ -97,6 +124,7 @@ func (uc *ManageBranchesUseCase) DeleteBranch(ctx context.Context, req DeleteBra
 	if err := uc.gitOps.DeleteBranch(ctx, req.RepoPath, req.BranchName, req.Force); err != nil {
 		return nil, fmt.Errorf("failed to delete local branch: %w", err)
 	}
+	uc.cache.Invalidate()
 
 	resp.LocalDeleted = true
 	resp.Message = fmt.Sprintf("Local branch '%s' deleted successfully", req.BranchName)
@@ -142,6 +170,7 @@ func (uc *ManageBranchesUseCase) RenameBranch(ctx context.Context, req RenameBra
 	if err := uc.gitOps.RenameBranch(ctx, req.RepoPath, req.OldName, req.NewName); err != nil {
 		return nil, fmt.Errorf("failed to rename branch: %w", err)
 	}
+	uc.cache.Invalidate()
 
 	return &RenameBranchResponse{
 		Success: true,
@@ -159,10 +188,20 @@ func (uc *ManageBranchesUseCase) SetUpstream(ctx context.Context, req SetUpstrea
 		return nil, fmt.Errorf("upstream branch is required")
 	}
 
+	// Catch malformed values or unknown remotes before git does
+	remotes, err := uc.gitOps.ListRemotes(ctx, req.RepoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remotes: %w", err)
+	}
+	if err := domain.ValidateUpstream(req.Upstream, remotes); err != nil {
+		return nil, fmt.Errorf("invalid upstream: %w", err)
+	}
+
 	// Perform set upstream
 	if err := uc.gitOps.SetUpstreamBranch(ctx, req.RepoPath, req.BranchName, req.Upstream); err != nil {
 		return nil, fmt.Errorf("failed to set upstream: %w", err)
 	}
+	uc.cache.Invalidate()
 
 	return &SetUpstreamResponse{
 		Success: true,
@@ -170,8 +209,82 @@ func (uc *ManageBranchesUseCase) SetUpstream(ctx context.Context, req SetUpstrea
 	}, nil
 }
 
-// GetAllBranches retrieves all branches with detailed information.
+// GetUpstreamCandidates returns the remotes and remote branches available as upstreams.
+func (uc *ManageBranchesUseCase) GetUpstreamCandidates(ctx context.Context, repoPath string) (*UpstreamCandidates, error) {
+	remotes, err := uc.gitOps.ListRemotes(ctx, repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remotes: %w", err)
+	}
+
+	branches, err := uc.gitOps.ListRemoteBranches(ctx, repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remote branches: %w", err)
+	}
+
+	return &UpstreamCandidates{
+		Remotes:        remotes,
+		RemoteBranches: branches,
+	}, nil
+}
+
+// GetCherryPickCandidates returns the commits on sourceBranch that the current
+// branch doesn't have, newest first.
+func (uc *ManageBranchesUseCase) GetCherryPickCandidates(ctx context.Context, repoPath, sourceBranch string) ([]git.CommitInfo, error) {
+	currentBranch, err := uc.gitOps.GetCurrentBranch(ctx, repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current branch: %w", err)
+	}
+	if sourceBranch == currentBranch {
+		return nil, fmt.Errorf("cannot cherry-pick from the current branch '%s'", sourceBranch)
+	}
+
+	commits, err := uc.gitOps.GetBranchCommits(ctx, repoPath, sourceBranch, currentBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commits on '%s': %w", sourceBranch, err)
+	}
+	return commits, nil
+}
+
+// CherryPick applies commits onto the current branch. A conflict aborts the
+// whole cherry-pick, leaving the branch as it was.
+func (uc *ManageBranchesUseCase) CherryPick(ctx context.Context, req CherryPickRequest) (*CherryPickResponse, error) {
+	if len(req.Hashes) == 0 {
+		return nil, fmt.Errorf("select at least one commit to cherry-pick")
+	}
+
+	err := uc.gitOps.CherryPick(ctx, req.RepoPath, req.Hashes)
+	uc.cache.Invalidate() // An aborted cherry-pick may have moved HEAD and back
+	if err != nil {
+		return nil, err
+	}
+
+	// Each picked commit adds one commit on top of HEAD
+	log, err := uc.gitOps.GetLog(ctx, req.RepoPath, len(req.Hashes))
+	if err != nil {
+		return nil, fmt.Errorf("cherry-picked, but failed to read the new commits: %w", err)
+	}
+	newCommits := make([]string, 0, len(log))
+	for i := len(log) - 1; i >= 0; i-- {
+		newCommits = append(newCommits, log[i].Hash)
+	}
+
+	return &CherryPickResponse{
+		NewCommits: newCommits,
+		Message:    fmt.Sprintf("Cherry-picked %d commit(s)", len(newCommits)),
+	}, nil
+}
+
+// GetAllBranches retrieves all branches with detailed information. With a
+// cache, results from the last few seconds are reused while no ref has moved.
 func (uc *ManageBranchesUseCase) GetAllBranches(ctx context.Context, repoPath string, protectedBranches []string) ([]*domain.BranchInfo, error) {
+	key := "branches:" + strings.Join(protectedBranches, ",")
+	return CachedLoad(ctx, uc.cache, uc.gitOps, repoPath, key, func() ([]*domain.BranchInfo, error) {
+		return uc.loadAllBranches(ctx, repoPath, protectedBranches)
+	})
+}
+
+// loadAllBranches reads every local branch with its parent, divergence and commit count
+func (uc *ManageBranchesUseCase) loadAllBranches(ctx context.Context, repoPath string, protectedBranches []string) ([]*domain.BranchInfo, error) {
 	// Get current branch first
 	currentBranch, err := uc.gitOps.GetCurrentBranch(ctx, repoPath)
 	if err != nil {
@@ -184,6 +297,11 @@ func (uc *ManageBranchesUseCase) GetAllBranches(ctx context.Context, repoPath st
 		return nil, fmt.Errorf("failed to list branches: %w", err)
 	}
 
+	// Divergence from the integration branch says more about a feature
+	// branch than divergence from its upstream
+	configuredBase, _ := uc.gitOps.GetBaseBranch(ctx, repoPath)
+	base := detectBaseBranch(configuredBase, branches, protectedBranches)
+
 	// Build detailed info for each branch
 	branchInfos := make([]*domain.BranchInfo, 0, len(branches))
 	for _, branchName := range branches {
@@ -214,6 +332,13 @@ func (uc *ManageBranchesUseCase) GetAllBranches(ctx context.Context, repoPath st
 			}
 		}
 
+		if base != "" && branchName != base {
+			ahead, behind, err := uc.gitOps.GetDivergence(ctx, repoPath, branchName, base)
+			if err == nil {
+				branchInfo.SetBaseDivergence(base, ahead, behind)
+			}
+		}
+
 		// Get commit count relative to parent (if parent exists)
 		if parent != "" && parent != branchName {
 			commits, err := uc.gitOps.GetBranchCommits(ctx, repoPath, branchName, parent)
@@ -231,6 +356,36 @@ func (uc *ManageBranchesUseCase) GetAllBranches(ctx context.Context, repoPath st
 	return sortedBranches, nil
 }
 
+// detectBaseBranch returns the branch divergence is measured against: the
+// base set for the repository, or else the first protected branch that
+// exists, e.g. main. It returns "" when there is neither.
+func detectBaseBranch(configured string, branches, protectedBranches []string) string {
+	if configured != "" {
+		return configured
+	}
+	for _, protected := range protectedBranches {
+		for _, branch := range branches {
+			if branch == protected {
+				return branch
+			}
+		}
+	}
+	return ""
+}
+
+// SetBaseBranch makes base the branch every branch's divergence is measured
+// against in this repository.
+func (uc *ManageBranchesUseCase) SetBaseBranch(ctx context.Context, repoPath, base string) error {
+	if base == "" {
+		return fmt.Errorf("base branch is required")
+	}
+	if err := uc.gitOps.SetBaseBranch(ctx, repoPath, base); err != nil {
+		return fmt.Errorf("failed to set base branch: %w", err)
+	}
+	uc.cache.Invalidate()
+	return nil
+}
+
 // sortBranches sorts branches with current first, then protected, then alphabetically.
 func sortBranches(branches []*domain.BranchInfo, currentBranch string) []*domain.BranchInfo {
 	var current, protected, other []*domain.BranchInfo
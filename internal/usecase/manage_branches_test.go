@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/adapter/git"
+)
+
+// cherryPickGitOps adds cherry-pick recording to fakeGitOps.
+type cherryPickGitOps struct {
+	*fakeGitOps
+
+	picked   []string
+	pickErr  error
+	excluded string
+}
+
+func (f *cherryPickGitOps) CherryPick(ctx context.Context, repoPath string, hashes []string) error {
+	f.picked = hashes
+	return f.pickErr
+}
+
+func (f *cherryPickGitOps) GetBranchCommits(ctx context.Context, repoPath, branch, excludeBranch string) ([]git.CommitInfo, error) {
+	f.excluded = excludeBranch
+	return []git.CommitInfo{{Hash: "bbb", Message: "Fix login"}}, nil
+}
+
+func TestManageBranches_CherryPick(t *testing.T) {
+	ops := &cherryPickGitOps{fakeGitOps: &fakeGitOps{
+		// GetLog is newest first
+		log: []git.CommitInfo{{Hash: "new2"}, {Hash: "new1"}},
+	}}
+	uc := NewManageBranchesUseCase(ops)
+
+	resp, err := uc.CherryPick(context.Background(), CherryPickRequest{RepoPath: "/repo", Hashes: []string{"aaa", "bbb"}})
+	if err != nil {
+		t.Fatalf("CherryPick() error = %v", err)
+	}
+	if !reflect.DeepEqual(ops.picked, []string{"aaa", "bbb"}) {
+		t.Errorf("Picked %v, want [aaa bbb]", ops.picked)
+	}
+	if !reflect.DeepEqual(resp.NewCommits, []string{"new1", "new2"}) {
+		t.Errorf("NewCommits = %v, want [new1 new2] in pick order", resp.NewCommits)
+	}
+
+	// A conflict comes back as the typed error
+	ops.pickErr = &git.CherryPickConflictError{Commit: "bbb", Files: []string{"login.go"}}
+	_, err = uc.CherryPick(context.Background(), CherryPickRequest{RepoPath: "/repo", Hashes: []string{"bbb"}})
+	var conflict *git.CherryPickConflictError
+	if !errors.As(err, &conflict) {
+		t.Errorf("Expected CherryPickConflictError, got %v", err)
+	}
+
+	if _, err := uc.CherryPick(context.Background(), CherryPickRequest{RepoPath: "/repo"}); err == nil {
+		t.Error("Expected an error with no commits selected")
+	}
+}
+
+func TestManageBranches_GetCherryPickCandidates(t *testing.T) {
+	ops := &cherryPickGitOps{fakeGitOps: &fakeGitOps{currentBranch: "main"}}
+	uc := NewManageBranchesUseCase(ops)
+
+	commits, err := uc.GetCherryPickCandidates(context.Background(), "/repo", "feature/login")
+	if err != nil {
+		t.Fatalf("GetCherryPickCandidates() error = %v", err)
+	}
+	if len(commits) != 1 || ops.excluded != "main" {
+		t.Errorf("Expected feature/login commits not on main, got %v excluding %q", commits, ops.excluded)
+	}
+
+	if _, err := uc.GetCherryPickCandidates(context.Background(), "/repo", "main"); err == nil {
+		t.Error("Expected an error cherry-picking from the current branch")
+	}
+}
+
+// baseGitOps adds a configured base branch and per-pair divergence to fakeGitOps.
+type baseGitOps struct {
+	*fakeGitOps
+
+	base       string            // Configured base branch
+	divergence map[string][2]int // "branch..base" → ahead, behind
+}
+
+func (f *baseGitOps) GetBaseBranch(ctx context.Context, repoPath string) (string, error) {
+	return f.base, nil
+}
+
+func (f *baseGitOps) SetBaseBranch(ctx context.Context, repoPath, base string) error {
+	f.base = base
+	return nil
+}
+
+func (f *baseGitOps) GetParentBranch(ctx context.Context, repoPath, branch string) (string, error) {
+	return "", nil
+}
+
+func (f *baseGitOps) HasUpstream(ctx context.Context, repoPath, branch string) (bool, error) {
+	return false, nil
+}
+
+func (f *baseGitOps) GetDivergence(ctx context.Context, repoPath, branch1, branch2 string) (int, int, error) {
+	counts := f.divergence[branch1+".."+branch2]
+	return counts[0], counts[1], nil
+}
+
+func TestManageBranches_DivergenceFromBase(t *testing.T) {
+	ops := &baseGitOps{
+		fakeGitOps: &fakeGitOps{currentBranch: "feature/login", branches: []string{"develop", "feature/login", "main"}},
+		divergence: map[string][2]int{
+			"feature/login..develop": {2, 1},
+			"feature/login..main":    {5, 0},
+			"main..develop":          {0, 3},
+		},
+	}
+	uc := NewManageBranchesUseCase(ops)
+	protected := []string{"main", "develop"}
+
+	baseOf := func(t *testing.T, name string) (string, int, int) {
+		t.Helper()
+		branches, err := uc.GetAllBranches(context.Background(), "/repo", protected)
+		if err != nil {
+			t.Fatalf("GetAllBranches() error = %v", err)
+		}
+		for _, branch := range branches {
+			if branch.Name() == name {
+				ahead, behind := branch.BaseDivergence()
+				return branch.Base(), ahead, behind
+			}
+		}
+		t.Fatalf("GetAllBranches() has no %q", name)
+		return "", 0, 0
+	}
+
+	// Without a configured base the first protected branch is the base
+	if base, ahead, behind := baseOf(t, "feature/login"); base != "main" || ahead != 5 || behind != 0 {
+		t.Errorf("feature/login vs %q = ↑%d ↓%d, want main ↑5 ↓0", base, ahead, behind)
+	}
+	if base, _, _ := baseOf(t, "main"); base != "" {
+		t.Errorf("main is measured against %q, want the base itself left out", base)
+	}
+
+	if err := uc.SetBaseBranch(context.Background(), "/repo", "develop"); err != nil {
+		t.Fatalf("SetBaseBranch() error = %v", err)
+	}
+	if base, ahead, behind := baseOf(t, "feature/login"); base != "develop" || ahead != 2 || behind != 1 {
+		t.Errorf("feature/login vs %q = ↑%d ↓%d, want develop ↑2 ↓1", base, ahead, behind)
+	}
+	if base, ahead, behind := baseOf(t, "main"); base != "develop" || ahead != 0 || behind != 3 {
+		t.Errorf("main vs %q = ↑%d ↓%d, want develop ↑0 ↓3", base, ahead, behind)
+	}
+}
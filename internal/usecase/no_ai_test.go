@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/adapter/ai"
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// countingProvider records every call so tests can assert the provider was bypassed.
+type countingProvider struct {
+	ai.Provider
+
+	calls int
+}
+
+func (p *countingProvider) Analyze(ctx context.Context, request ai.AnalysisRequest) (*ai.AnalysisResponse, error) {
+	p.calls++
+	return nil, nil
+}
+
+func (p *countingProvider) GenerateMergeMessage(ctx context.Context, request ai.MergeMessageRequest) (*ai.MergeMessageResponse, error) {
+	p.calls++
+	return nil, nil
+}
+
+func newNoAIGitOps(t *testing.T) *fakeGitOps {
+	t.Helper()
+
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+	repo.AddChange(domain.FileChange{Path: "main.go", Status: domain.StatusModified, Additions: 3})
+
+	branchInfo, err := domain.NewBranchInfo("feature/login")
+	if err != nil {
+		t.Fatalf("NewBranchInfo() error = %v", err)
+	}
+
+	return &fakeGitOps{
+		currentBranch: "feature/login",
+		repo:          repo,
+		branchInfo:    branchInfo,
+		branches:      []string{"main", "feature/login"},
+		log:           []git.CommitInfo{{Hash: "abc123", Message: "Add login page"}},
+		mergeFiles:    []git.FileStat{{Path: "login.go", Additions: 40}},
+	}
+}
+
+func TestAnalyzeCommit_SkipAINeverInvokesProvider(t *testing.T) {
+	provider := &countingProvider{}
+	uc := NewAnalyzeCommitUseCase(newNoAIGitOps(t), provider)
+
+	resp, err := uc.Execute(context.Background(), AnalyzeCommitRequest{
+		RepoPath: "/tmp/repo",
+		SkipAI:   true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+
+	if provider.calls != 0 {
+		t.Errorf("provider invoked %d times, want 0", provider.calls)
+	}
+	if resp.Decision.SuggestedMessage() != nil {
+		t.Errorf("SuggestedMessage() = %q, want nil so the user writes it", resp.Decision.SuggestedMessage().Title())
+	}
+	if resp.Decision.Action() != domain.ActionCommitDirect {
+		t.Errorf("Action() = %v, want %v", resp.Decision.Action(), domain.ActionCommitDirect)
+	}
+}
+
+func TestAnalyzeMerge_SkipAIUsesDefaultStrategy(t *testing.T) {
+	provider := &countingProvider{}
+	uc := NewAnalyzeMergeUseCase(newNoAIGitOps(t), provider)
+
+	resp, err := uc.Execute(context.Background(), AnalyzeMergeRequest{
+		RepoPath:        "/tmp/repo",
+		SourceBranch:    "feature/login",
+		TargetBranch:    "main",
+		SkipAI:          true,
+		DefaultStrategy: "squash",
+	})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+
+	if provider.calls != 0 {
+		t.Errorf("provider invoked %d times, want 0", provider.calls)
+	}
+	if resp.SuggestedStrategy != "squash" {
+		t.Errorf("SuggestedStrategy = %q, want %q", resp.SuggestedStrategy, "squash")
+	}
+	if len(resp.Files) != 1 || resp.Files[0].Path != "login.go" {
+		t.Errorf("Files = %+v, want the merge preview stats", resp.Files)
+	}
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yourusername/gitman/internal/adapter/git"
+)
+
+// CommandRunner runs a shell command in dir with extra KEY=value environment variables.
+type CommandRunner func(ctx context.Context, dir, command string, env []string) (string, error)
+
+// PostCommitHookUseCase runs the user's configured post-commit command.
+type PostCommitHookUseCase struct {
+	gitOps git.Operations
+	run    CommandRunner
+}
+
+// NewPostCommitHookUseCase creates a new PostCommitHookUseCase.
+func NewPostCommitHookUseCase(gitOps git.Operations, run CommandRunner) *PostCommitHookUseCase {
+	return &PostCommitHookUseCase{
+		gitOps: gitOps,
+		run:    run,
+	}
+}
+
+// PostCommitHookRequest contains the parameters for running the hook.
+type PostCommitHookRequest struct {
+	RepoPath string
+	Command  string
+}
+
+// PostCommitHookResponse contains the result of running the hook.
+type PostCommitHookResponse struct {
+	Output string   // Combined stdout/stderr of the command
+	Env    []string // GITMIND_* variables passed to the command
+}
+
+// Execute runs the hook for the commit at HEAD. It only reads repository
+// state, so a failing hook can never affect the commit that triggered it.
+func (uc *PostCommitHookUseCase) Execute(ctx context.Context, req PostCommitHookRequest) (*PostCommitHookResponse, error) {
+	if req.Command == "" {
+		return nil, fmt.Errorf("post-commit command is empty")
+	}
+
+	commits, err := uc.gitOps.GetLog(ctx, req.RepoPath, 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read last commit: %w", err)
+	}
+	if len(commits) == 0 {
+		return nil, fmt.Errorf("no commit found for post-commit command")
+	}
+
+	branch, err := uc.gitOps.GetCurrentBranch(ctx, req.RepoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current branch: %w", err)
+	}
+
+	env := []string{
+		"GITMIND_COMMIT_SHA=" + commits[0].Hash,
+		"GITMIND_COMMIT_MESSAGE=" + commits[0].Message,
+		"GITMIND_BRANCH=" + branch,
+		"GITMIND_REPO_PATH=" + req.RepoPath,
+	}
+
+	output, err := uc.run(ctx, req.RepoPath, req.Command, env)
+	resp := &PostCommitHookResponse{Output: output, Env: env}
+	if err != nil {
+		return resp, fmt.Errorf("post-commit command failed: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/adapter/git"
+)
+
+func TestPostCommitHook_InvokedWithEnv(t *testing.T) {
+	ops := &fakeGitOps{
+		currentBranch: "feature/login",
+		log:           []git.CommitInfo{{Hash: "abc123def", Message: "Add login page"}},
+	}
+
+	var gotDir, gotCommand string
+	var gotEnv []string
+	runner := func(ctx context.Context, dir, command string, env []string) (string, error) {
+		gotDir, gotCommand, gotEnv = dir, command, env
+		return "notified\n", nil
+	}
+
+	uc := NewPostCommitHookUseCase(ops, runner)
+	resp, err := uc.Execute(context.Background(), PostCommitHookRequest{
+		RepoPath: "/tmp/repo",
+		Command:  "./notify.sh",
+	})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+
+	if gotDir != "/tmp/repo" {
+		t.Errorf("dir = %q, want %q", gotDir, "/tmp/repo")
+	}
+	if gotCommand != "./notify.sh" {
+		t.Errorf("command = %q, want %q", gotCommand, "./notify.sh")
+	}
+
+	wantEnv := []string{
+		"GITMIND_COMMIT_SHA=abc123def",
+		"GITMIND_BRANCH=feature/login",
+		"GITMIND_COMMIT_MESSAGE=Add login page",
+	}
+	joined := strings.Join(gotEnv, "\n")
+	for _, want := range wantEnv {
+		if !strings.Contains(joined, want) {
+			t.Errorf("env missing %q, got %v", want, gotEnv)
+		}
+	}
+
+	if resp.Output != "notified\n" {
+		t.Errorf("Output = %q, want %q", resp.Output, "notified\n")
+	}
+}
+
+func TestPostCommitHook_EmptyCommand(t *testing.T) {
+	uc := NewPostCommitHookUseCase(&fakeGitOps{}, nil)
+
+	if _, err := uc.Execute(context.Background(), PostCommitHookRequest{RepoPath: "/tmp/repo"}); err == nil {
+		t.Error("Execute() expected error for empty command, got nil")
+	}
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/yourusername/gitman/internal/adapter/git"
+)
+
+// RebaseBranchUseCase keeps a feature branch current by rebasing it onto
+// its parent branch once the parent has moved ahead.
+type RebaseBranchUseCase struct {
+	gitOps git.Operations
+}
+
+// NewRebaseBranchUseCase creates a new RebaseBranchUseCase.
+func NewRebaseBranchUseCase(gitOps git.Operations) *RebaseBranchUseCase {
+	return &RebaseBranchUseCase{
+		gitOps: gitOps,
+	}
+}
+
+// PlanRebaseRequest contains the parameters for deciding whether to rebase.
+type PlanRebaseRequest struct {
+	RepoPath          string
+	ProtectedBranches []string
+}
+
+// RebasePlan describes whether the current branch should be rebased onto its parent.
+type RebasePlan struct {
+	Branch         string
+	Parent         string
+	Behind         int    // Commits on the parent that the branch doesn't have
+	HasUpstream    bool   // The branch has been pushed
+	RewritesPushed bool   // Some of the branch's own commits are on the remote, so the rebase needs a force push
+	NeedsRebase    bool   // The branch is behind its parent and may be rebased
+	Reason         string // Why no rebase is needed or allowed, when NeedsRebase is false
+}
+
+// Plan checks whether the current branch is behind its parent and can be rebased.
+func (uc *RebaseBranchUseCase) Plan(ctx context.Context, req PlanRebaseRequest) (*RebasePlan, error) {
+	branch, err := uc.gitOps.GetCurrentBranch(ctx, req.RepoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current branch: %w", err)
+	}
+
+	plan := &RebasePlan{Branch: branch}
+	if branch == "HEAD" {
+		plan.Reason = "HEAD is detached; check out a branch to rebase it"
+		return plan, nil
+	}
+	if isProtectedBranch(branch, req.ProtectedBranches) {
+		plan.Reason = fmt.Sprintf("'%s' is protected; rebasing it would rewrite shared history", branch)
+		return plan, nil
+	}
+
+	parent, err := uc.gitOps.GetParentBranch(ctx, req.RepoPath, branch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parent branch: %w", err)
+	}
+	if parent == "" {
+		plan.Reason = fmt.Sprintf("'%s' has no parent branch to rebase onto", branch)
+		return plan, nil
+	}
+	plan.Parent = parent
+
+	ahead, behind, err := uc.gitOps.GetDivergence(ctx, req.RepoPath, branch, parent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare with '%s': %w", parent, err)
+	}
+	plan.Behind = behind
+	if behind == 0 {
+		plan.Reason = fmt.Sprintf("'%s' is already up to date with '%s'", branch, parent)
+		return plan, nil
+	}
+
+	hasUpstream, err := uc.gitOps.HasUpstream(ctx, req.RepoPath, branch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check upstream: %w", err)
+	}
+	plan.HasUpstream = hasUpstream
+	plan.RewritesPushed = hasUpstream && RewritesPushedHistory(ctx, uc.gitOps, req.RepoPath, branch, ahead)
+	plan.NeedsRebase = true
+
+	return plan, nil
+}
+
+// ExecuteRebaseRequest contains the parameters for rebasing a branch.
+type ExecuteRebaseRequest struct {
+	RepoPath string
+	Parent   string // Branch to rebase the current branch onto
+}
+
+// ExecuteRebaseResponse contains the result of the rebase.
+type ExecuteRebaseResponse struct {
+	Parent string
+	Head   string // Short hash of the rebased branch tip
+}
+
+// Execute rebases the current branch onto req.Parent. On conflicts the rebase
+// is aborted, leaving the branch exactly as it was.
+func (uc *RebaseBranchUseCase) Execute(ctx context.Context, req ExecuteRebaseRequest) (*ExecuteRebaseResponse, error) {
+	if req.Parent == "" {
+		return nil, fmt.Errorf("parent branch is required")
+	}
+
+	if err := uc.gitOps.Merge(ctx, req.RepoPath, req.Parent, "rebase", ""); err != nil {
+		if strings.Contains(err.Error(), "rebase conflict") {
+			_ = uc.gitOps.AbortRebase(ctx, req.RepoPath)
+			return nil, fmt.Errorf("rebase onto '%s' hit conflicts and was aborted; merge '%s' instead or resolve them manually with git rebase: %w", req.Parent, req.Parent, err)
+		}
+		return nil, fmt.Errorf("failed to rebase onto '%s': %w", req.Parent, err)
+	}
+
+	resp := &ExecuteRebaseResponse{Parent: req.Parent}
+	if log, err := uc.gitOps.GetLog(ctx, req.RepoPath, 1); err == nil && len(log) > 0 && len(log[0].Hash) >= 7 {
+		resp.Head = log[0].Hash[:7]
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/adapter/git"
+)
+
+// rebaseGitOps adds parent tracking and rebase recording to fakeGitOps.
+type rebaseGitOps struct {
+	*fakeGitOps
+
+	parent      string
+	behind      int
+	upstream    bool
+	mergeErr    error
+	rebasedOnto string
+	aborts      int
+}
+
+func (f *rebaseGitOps) GetParentBranch(ctx context.Context, repoPath, branch string) (string, error) {
+	return f.parent, nil
+}
+
+func (f *rebaseGitOps) GetDivergence(ctx context.Context, repoPath, branch1, branch2 string) (int, int, error) {
+	return 2, f.behind, nil
+}
+
+func (f *rebaseGitOps) HasUpstream(ctx context.Context, repoPath, branch string) (bool, error) {
+	return f.upstream, nil
+}
+
+func (f *rebaseGitOps) Merge(ctx context.Context, repoPath, sourceBranch, strategy, message string) error {
+	if strategy == "rebase" {
+		f.rebasedOnto = sourceBranch
+	}
+	return f.mergeErr
+}
+
+func (f *rebaseGitOps) AbortRebase(ctx context.Context, repoPath string) error {
+	f.aborts++
+	return nil
+}
+
+func TestRebaseBranch_PlanBehindParent(t *testing.T) {
+	tests := []struct {
+		name        string
+		branch      string
+		parent      string
+		behind      int
+		upstream    bool
+		wantRebase  bool
+		wantReason  string
+		wantPushing bool
+	}{
+		{name: "behind parent", branch: "feature/login", parent: "develop", behind: 3, wantRebase: true},
+		{name: "behind parent and pushed", branch: "feature/login", parent: "develop", behind: 1, upstream: true, wantRebase: true, wantPushing: true},
+		{name: "up to date", branch: "feature/login", parent: "develop", behind: 0, wantReason: "already up to date"},
+		{name: "no parent", branch: "feature/login", behind: 3, wantReason: "no parent branch"},
+		{name: "protected branch", branch: "main", parent: "develop", behind: 3, wantReason: "protected"},
+		{name: "detached HEAD", branch: "HEAD", parent: "develop", behind: 3, wantReason: "detached"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &rebaseGitOps{
+				fakeGitOps: &fakeGitOps{currentBranch: tt.branch, hasRemote: tt.upstream},
+				parent:     tt.parent,
+				behind:     tt.behind,
+				upstream:   tt.upstream,
+			}
+
+			plan, err := NewRebaseBranchUseCase(ops).Plan(context.Background(), PlanRebaseRequest{
+				RepoPath:          "/tmp/repo",
+				ProtectedBranches: []string{"main"},
+			})
+			if err != nil {
+				t.Fatalf("Plan() unexpected error = %v", err)
+			}
+
+			if plan.NeedsRebase != tt.wantRebase {
+				t.Errorf("NeedsRebase = %v, want %v (reason %q)", plan.NeedsRebase, tt.wantRebase, plan.Reason)
+			}
+			if plan.HasUpstream != tt.wantPushing || plan.RewritesPushed != tt.wantPushing {
+				t.Errorf("HasUpstream = %v, RewritesPushed = %v, want %v", plan.HasUpstream, plan.RewritesPushed, tt.wantPushing)
+			}
+			if tt.wantRebase && (plan.Parent != tt.parent || plan.Behind != tt.behind) {
+				t.Errorf("plan = %+v, want parent %q behind %d", plan, tt.parent, tt.behind)
+			}
+			if !strings.Contains(plan.Reason, tt.wantReason) {
+				t.Errorf("Reason = %q, want it to mention %q", plan.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestRebaseBranch_ExecuteAbortsOnConflict(t *testing.T) {
+	ops := &rebaseGitOps{
+		fakeGitOps: &fakeGitOps{log: []git.CommitInfo{{Hash: "abc1234def", Message: "Add login"}}},
+	}
+	uc := NewRebaseBranchUseCase(ops)
+
+	resp, err := uc.Execute(context.Background(), ExecuteRebaseRequest{RepoPath: "/tmp/repo", Parent: "develop"})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+	if ops.rebasedOnto != "develop" || resp.Head != "abc1234" {
+		t.Errorf("rebased onto %q with head %q, want develop and abc1234", ops.rebasedOnto, resp.Head)
+	}
+
+	ops.mergeErr = errors.New("rebase conflict: CONFLICT (content): Merge conflict in login.go")
+	if _, err := uc.Execute(context.Background(), ExecuteRebaseRequest{RepoPath: "/tmp/repo", Parent: "develop"}); err == nil {
+		t.Fatal("Execute() expected error on conflict")
+	}
+	if ops.aborts != 1 {
+		t.Errorf("AbortRebase called %d times, want 1", ops.aborts)
+	}
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/yourusername/gitman/internal/adapter/git"
+)
+
+// RefCacheTTL is how long results derived from refs are reused, so repeated
+// refreshes of the branch list or commit graph don't re-run git for each one.
+const RefCacheTTL = 5 * time.Second
+
+// RefCache holds results derived from the repository's refs, such as the
+// branch list and the commit graph, for a short time. An entry is reused only
+// while git.Operations.RefsFingerprint is unchanged, so commits, merges,
+// checkouts and branch deletions miss it even within the TTL. Operations that
+// change what the fingerprint doesn't cover, like a branch's upstream or
+// parent, call Invalidate.
+type RefCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]refCacheEntry
+}
+
+type refCacheEntry struct {
+	fingerprint string
+	stored      time.Time
+	value       any
+}
+
+// NewRefCache creates a cache whose entries expire after ttl.
+func NewRefCache(ttl time.Duration) *RefCache {
+	return &RefCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]refCacheEntry),
+	}
+}
+
+// Invalidate drops every entry, so the next load runs git again.
+func (c *RefCache) Invalidate() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]refCacheEntry)
+}
+
+// get returns the value stored under key for fingerprint, if it hasn't expired
+func (c *RefCache) get(key, fingerprint string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || entry.fingerprint != fingerprint || c.now().Sub(entry.stored) > c.ttl {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// put stores value under key for fingerprint
+func (c *RefCache) put(key, fingerprint string, value any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = refCacheEntry{fingerprint: fingerprint, stored: c.now(), value: value}
+}
+
+// CachedLoad returns the result of load cached in c under key, running load
+// only when there is no entry for the repository's current refs. A nil cache,
+// or refs that can't be read, always run load; errors are never cached.
+func CachedLoad[T any](ctx context.Context, c *RefCache, gitOps git.Operations, repoPath, key string, load func() (T, error)) (T, error) {
+	if c == nil {
+		return load()
+	}
+	fingerprint, err := gitOps.RefsFingerprint(ctx, repoPath)
+	if err != nil {
+		return load()
+	}
+
+	key = repoPath + "\x00" + key
+	if value, ok := c.get(key, fingerprint); ok {
+		return value.(T), nil
+	}
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+	c.put(key, fingerprint, value)
+	return value, nil
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yourusername/gitman/internal/adapter/git"
+)
+
+// fingerprintGitOps reports a settable refs fingerprint
+type fingerprintGitOps struct {
+	git.Operations
+
+	fingerprint string
+}
+
+func (f *fingerprintGitOps) RefsFingerprint(ctx context.Context, repoPath string) (string, error) {
+	return f.fingerprint, nil
+}
+
+func TestCachedLoad(t *testing.T) {
+	ops := &fingerprintGitOps{fingerprint: "main abc123"}
+	cache := NewRefCache(5 * time.Second)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	loads := 0
+	load := func() (int, error) {
+		loads++
+		return loads, nil
+	}
+	get := func() int {
+		t.Helper()
+		value, err := CachedLoad(context.Background(), cache, ops, "/tmp/repo", "graph", load)
+		if err != nil {
+			t.Fatalf("CachedLoad() unexpected error = %v", err)
+		}
+		return value
+	}
+
+	if get() != 1 || get() != 1 {
+		t.Fatalf("Expected the second load to reuse the first, loaded %d times", loads)
+	}
+
+	// A moved ref misses the cache
+	ops.fingerprint = "main def456"
+	if got := get(); got != 2 {
+		t.Errorf("Expected a reload after a commit, got %d", got)
+	}
+
+	// So does an expired entry
+	now = now.Add(6 * time.Second)
+	if got := get(); got != 3 {
+		t.Errorf("Expected a reload after the TTL, got %d", got)
+	}
+
+	cache.Invalidate()
+	if got := get(); got != 4 {
+		t.Errorf("Expected a reload after Invalidate, got %d", got)
+	}
+
+	// Errors are not cached
+	failing := func() (int, error) { return 0, errors.New("git log failed") }
+	if _, err := CachedLoad(context.Background(), cache, ops, "/tmp/repo", "branches", failing); err == nil {
+		t.Fatal("Expected the load error")
+	}
+	if got, _ := CachedLoad(context.Background(), cache, ops, "/tmp/repo", "branches", load); got != 5 {
+		t.Errorf("Expected a failed load to be retried, got %d", got)
+	}
+
+	// Without a cache every call loads
+	if got, _ := CachedLoad(context.Background(), nil, ops, "/tmp/repo", "graph", load); got != 6 {
+		t.Errorf("Expected a nil cache to load, got %d", got)
+	}
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/yourusername/gitman/internal/adapter/ai"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// ReviewPatchUseCase asks the AI to summarize and assess a patch received
+// from elsewhere. It is read-only: no repository is read or modified.
+type ReviewPatchUseCase struct {
+	aiProvider ai.Provider
+}
+
+// NewReviewPatchUseCase creates a new ReviewPatchUseCase.
+func NewReviewPatchUseCase(aiProvider ai.Provider) *ReviewPatchUseCase {
+	return &ReviewPatchUseCase{
+		aiProvider: aiProvider,
+	}
+}
+
+// ReviewPatchRequest contains the patch to review.
+type ReviewPatchRequest struct {
+	Patch  string // Unified diff or git format-patch output
+	APIKey *domain.APIKey
+}
+
+// ReviewPatchResponse contains the review.
+type ReviewPatchResponse struct {
+	Review     *domain.PatchReview
+	TokensUsed int
+	Model      string
+}
+
+// Execute reviews req.Patch.
+func (uc *ReviewPatchUseCase) Execute(ctx context.Context, req ReviewPatchRequest) (*ReviewPatchResponse, error) {
+	if uc.aiProvider == nil {
+		return nil, errors.New("patch review requires an AI provider")
+	}
+	if strings.TrimSpace(req.Patch) == "" {
+		return nil, errors.New("patch is empty")
+	}
+
+	files := domain.ParsePatchFiles(req.Patch)
+	if len(files) == 0 {
+		return nil, errors.New("input does not look like a diff (no ---/+++ file headers found)")
+	}
+
+	aiResp, err := uc.aiProvider.ReviewPatch(ctx, ai.PatchReviewRequest{
+		Patch:  req.Patch,
+		Files:  files,
+		APIKey: req.APIKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to review patch: %w", err)
+	}
+
+	review := aiResp.Review
+	review.Files = files
+
+	return &ReviewPatchResponse{
+		Review:     review,
+		TokensUsed: aiResp.TokensUsed,
+		Model:      aiResp.Model,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/adapter/ai"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// reviewProvider returns a canned review and records the request it was given.
+type reviewProvider struct {
+	ai.Provider
+
+	request ai.PatchReviewRequest
+}
+
+func (p *reviewProvider) ReviewPatch(ctx context.Context, request ai.PatchReviewRequest) (*ai.PatchReviewResponse, error) {
+	p.request = request
+	return &ai.PatchReviewResponse{
+		Review: &domain.PatchReview{
+			Summary:  "Adds a retry to the login client.",
+			Verdict:  domain.PatchNeedsChanges,
+			Concerns: []string{"The retry loop has no upper bound"},
+		},
+		TokensUsed: 120,
+		Model:      "stub",
+	}, nil
+}
+
+const samplePatch = `From 1a2b3c4d Mon Sep 17 00:00:00 2001
+From: Ada <ada@example.com>
+Subject: [PATCH] Retry login on timeout
+
+diff --git a/internal/auth/client.go b/internal/auth/client.go
+--- a/internal/auth/client.go
++++ b/internal/auth/client.go
+@@ -10,6 +10,10 @@ func (c *Client) Login(ctx context.Context) error {
+-	return c.do(ctx)
++	for {
++		if err := c.do(ctx); err == nil {
++			return nil
++		}
++	}
+ }
+`
+
+func TestReviewPatch_ProducesReviewFromProvider(t *testing.T) {
+	provider := &reviewProvider{}
+
+	resp, err := NewReviewPatchUseCase(provider).Execute(context.Background(), ReviewPatchRequest{Patch: samplePatch})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+
+	if provider.request.Patch != samplePatch {
+		t.Error("provider did not receive the patch")
+	}
+	if len(provider.request.Files) != 1 || provider.request.Files[0] != "internal/auth/client.go" {
+		t.Errorf("provider Files = %q, want [internal/auth/client.go]", provider.request.Files)
+	}
+	if resp.Review.Verdict != domain.PatchNeedsChanges || len(resp.Review.Concerns) != 1 {
+		t.Errorf("Review = %+v, want the provider's review", resp.Review)
+	}
+	if len(resp.Review.Files) != 1 {
+		t.Errorf("Review.Files = %q, want the parsed patch files", resp.Review.Files)
+	}
+}
+
+func TestReviewPatch_RejectsNonDiffInput(t *testing.T) {
+	provider := &reviewProvider{}
+
+	for _, patch := range []string{"", "  \n", "not a diff at all"} {
+		if _, err := NewReviewPatchUseCase(provider).Execute(context.Background(), ReviewPatchRequest{Patch: patch}); err == nil {
+			t.Errorf("Execute(%q) expected error", patch)
+		}
+	}
+	if provider.request.Patch != "" {
+		t.Error("provider should not be called for invalid input")
+	}
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/yourusername/gitman/internal/adapter/git"
+)
+
+// RewritesPushedHistory reports whether rewriting the newest count commits of
+// branch ("" for the current one) changes commits its remote already has, as
+// amending (count 1) or rebasing the branch's own commits does. Only the
+// commits the branch is ahead of its remote are unpushed, so rewriting more
+// than those needs a force push afterwards. A repository without a remote has
+// no pushed history; if the remote state can't be determined the history is
+// treated as pushed, so the rewrite comes with a warning.
+func RewritesPushedHistory(ctx context.Context, gitOps git.Operations, repoPath, branch string, count int) bool {
+	if count <= 0 {
+		return false
+	}
+
+	hasRemote, err := gitOps.HasRemote(ctx, repoPath)
+	if err != nil {
+		return true
+	}
+	if !hasRemote {
+		return false
+	}
+
+	ahead, _, err := gitOps.GetRemoteSyncStatus(ctx, repoPath, branch)
+	return err != nil || count > ahead
+}
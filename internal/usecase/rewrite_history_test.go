@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRewritesPushedHistory(t *testing.T) {
+	tests := []struct {
+		name      string
+		hasRemote bool
+		ahead     int // Newest commits not on the remote yet
+		count     int // Newest commits being rewritten
+		want      bool
+	}{
+		{"amend a commit present upstream", true, 0, 1, true},
+		{"amend an unpushed commit", true, 1, 1, false},
+		{"rebase reaching into pushed commits", true, 2, 3, true},
+		{"rebase only unpushed commits", true, 3, 3, false},
+		{"no remote", false, 0, 1, false},
+		{"nothing to rewrite", true, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &fakeGitOps{hasRemote: tt.hasRemote, ahead: tt.ahead}
+			if got := RewritesPushedHistory(context.Background(), ops, "/tmp/repo", "feature/login", tt.count); got != tt.want {
+				t.Errorf("RewritesPushedHistory(%d commits, %d ahead) = %v, want %v", tt.count, tt.ahead, got, tt.want)
+			}
+		})
+	}
+}
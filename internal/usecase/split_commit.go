@@ -0,0 +1,274 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/yourusername/gitman/internal/adapter/ai"
+	"github.com/yourusername/gitman/internal/adapter/git"
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+// SplitCommitUseCase turns a working tree with unrelated changes into a
+// sequence of atomic commits: the AI groups the files, then each group is
+// staged and committed on its own.
+type SplitCommitUseCase struct {
+	gitOps     git.Operations
+	aiProvider ai.Provider
+}
+
+// NewSplitCommitUseCase creates a new SplitCommitUseCase.
+func NewSplitCommitUseCase(gitOps git.Operations, aiProvider ai.Provider) *SplitCommitUseCase {
+	return &SplitCommitUseCase{
+		gitOps:     gitOps,
+		aiProvider: aiProvider,
+	}
+}
+
+// SuggestSplitRequest contains the parameters for proposing a commit split.
+type SuggestSplitRequest struct {
+	RepoPath               string
+	UseConventionalCommits bool
+	APIKey                 *domain.APIKey
+}
+
+// SuggestSplitResponse contains the proposed commits, in order.
+type SuggestSplitResponse struct {
+	Repository *domain.Repository
+	Groups     []domain.CommitGroup
+	TokensUsed int
+	Model      string
+}
+
+// Suggest asks the AI to group all uncommitted changes into logical commits.
+func (uc *SplitCommitUseCase) Suggest(ctx context.Context, req SuggestSplitRequest) (*SuggestSplitResponse, error) {
+	if uc.aiProvider == nil {
+		return nil, errors.New("splitting commits requires an AI provider")
+	}
+
+	repo, err := uc.gitOps.GetStatus(ctx, req.RepoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository status: %w", err)
+	}
+	if !repo.HasChanges() {
+		return nil, fmt.Errorf("no changes to commit")
+	}
+
+	// Staged and unstaged changes are split together, so send both
+	var diffs []string
+	for _, staged := range []bool{true, false} {
+		diff, err := uc.gitOps.GetDiff(ctx, req.RepoPath, staged)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get diff: %w", err)
+		}
+		if diff != "" {
+			diffs = append(diffs, diff)
+		}
+	}
+	if len(repo.GetChangesByStatus(domain.StatusUntracked)) > 0 {
+		if untracked, err := buildUntrackedFilesDiff(req.RepoPath, repo); err == nil {
+			diffs = append(diffs, untracked)
+		}
+	}
+
+	aiResp, err := uc.aiProvider.SuggestCommitSplit(ctx, ai.CommitSplitRequest{
+		Repository:             repo,
+		Diff:                   strings.Join(diffs, "\n"),
+		UseConventionalCommits: req.UseConventionalCommits,
+		APIKey:                 req.APIKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest commit split: %w", err)
+	}
+
+	return &SuggestSplitResponse{
+		Repository: repo,
+		Groups:     aiResp.Groups,
+		TokensUsed: aiResp.TokensUsed,
+		Model:      aiResp.Model,
+	}, nil
+}
+
+// ExecuteSplitRequest contains the commits to make.
+type ExecuteSplitRequest struct {
+	RepoPath string
+	Groups   []domain.CommitGroup
+}
+
+// ExecuteSplitResponse contains the commits that were made.
+type ExecuteSplitResponse struct {
+	CommitHashes []string // Short hashes, one per committed group
+}
+
+// Execute commits each group in order, staging only that group's files.
+// Anything already staged is unstaged first so it lands in its own group's
+// commit. If a commit fails, earlier commits are kept and the error reports
+// how far the split got; the remaining commits are saved so PendingSplit can
+// offer to resume them.
+func (uc *SplitCommitUseCase) Execute(ctx context.Context, req ExecuteSplitRequest) (*ExecuteSplitResponse, error) {
+	if len(req.Groups) == 0 {
+		return nil, errors.New("no commit groups to execute")
+	}
+	for i, group := range req.Groups {
+		if len(group.Files) == 0 || group.Message == nil {
+			return nil, fmt.Errorf("commit %d has no files or message", i+1)
+		}
+	}
+
+	// Start from an empty staging area (the index matching HEAD)
+	if err := uc.gitOps.RestoreIndex(ctx, req.RepoPath, "HEAD"); err != nil {
+		return nil, fmt.Errorf("failed to unstage changes: %w", err)
+	}
+
+	resp := &ExecuteSplitResponse{}
+	for i, group := range req.Groups {
+		// Record what is left before each commit; the plan is best effort
+		// and must not stop the split
+		_ = uc.savePlan(ctx, req.RepoPath, req.Groups[i:])
+
+		if err := uc.gitOps.Add(ctx, req.RepoPath, group.Files); err != nil {
+			return resp, splitError(i, len(req.Groups), fmt.Errorf("failed to stage files: %w", err))
+		}
+		if err := uc.gitOps.Commit(ctx, req.RepoPath, group.Message.FullMessage(), nil); err != nil {
+			return resp, splitError(i, len(req.Groups), fmt.Errorf("failed to commit: %w", err))
+		}
+
+		hash := ""
+		if log, err := uc.gitOps.GetLog(ctx, req.RepoPath, 1); err == nil && len(log) > 0 {
+			hash = log[0].Hash
+			if len(hash) > 7 {
+				hash = hash[:7] // Short hash
+			}
+		}
+		resp.CommitHashes = append(resp.CommitHashes, hash)
+	}
+
+	// A plan left behind is harmless: PendingSplit drops groups with no changes
+	_ = uc.DiscardPendingSplit(ctx, req.RepoPath)
+
+	return resp, nil
+}
+
+// splitPlanFile holds the remaining commits of an interrupted split, inside the git directory.
+const splitPlanFile = "gitmind-split.json"
+
+// splitPlanGroup is the on-disk form of a domain.CommitGroup.
+type splitPlanGroup struct {
+	Files     []string `json:"files"`
+	Message   string   `json:"message"`
+	Reasoning string   `json:"reasoning,omitempty"`
+}
+
+// PendingSplit returns the remaining commits of an interrupted split, or nil
+// when there is none. Groups whose files no longer have changes (committed by
+// hand since) are dropped, and a plan with nothing left is discarded.
+func (uc *SplitCommitUseCase) PendingSplit(ctx context.Context, repoPath string) ([]domain.CommitGroup, error) {
+	path, err := uc.gitOps.GitPath(ctx, repoPath, splitPlanFile)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read split plan: %w", err)
+	}
+
+	var saved []splitPlanGroup
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("failed to parse split plan: %w", err)
+	}
+
+	repo, err := uc.gitOps.GetStatus(ctx, repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository status: %w", err)
+	}
+	changed := make(map[string]bool)
+	for _, change := range repo.Changes() {
+		changed[change.Path] = true
+	}
+
+	var groups []domain.CommitGroup
+	for _, g := range saved {
+		var files []string
+		for _, file := range g.Files {
+			if changed[file] {
+				files = append(files, file)
+			}
+		}
+		if len(files) == 0 {
+			continue
+		}
+
+		msg, err := parseSplitMessage(g.Message)
+		if err != nil {
+			continue
+		}
+		groups = append(groups, domain.CommitGroup{Files: files, Message: msg, Reasoning: g.Reasoning})
+	}
+
+	if len(groups) == 0 {
+		return nil, uc.DiscardPendingSplit(ctx, repoPath)
+	}
+	return groups, nil
+}
+
+// DiscardPendingSplit forgets an interrupted split. It is not an error if there is none.
+func (uc *SplitCommitUseCase) DiscardPendingSplit(ctx context.Context, repoPath string) error {
+	path, err := uc.gitOps.GitPath(ctx, repoPath, splitPlanFile)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove split plan: %w", err)
+	}
+	return nil
+}
+
+// savePlan records the commits that still have to be made.
+func (uc *SplitCommitUseCase) savePlan(ctx context.Context, repoPath string, groups []domain.CommitGroup) error {
+	path, err := uc.gitOps.GitPath(ctx, repoPath, splitPlanFile)
+	if err != nil {
+		return err
+	}
+
+	saved := make([]splitPlanGroup, 0, len(groups))
+	for _, group := range groups {
+		saved = append(saved, splitPlanGroup{
+			Files:     group.Files,
+			Message:   group.Message.FullMessage(),
+			Reasoning: group.Reasoning,
+		})
+	}
+
+	data, err := json.MarshalIndent(saved, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode split plan: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write split plan: %w", err)
+	}
+	return nil
+}
+
+// parseSplitMessage rebuilds a commit message from its title and body.
+func parseSplitMessage(full string) (*domain.CommitMessage, error) {
+	title, body, _ := strings.Cut(full, "\n")
+	msg, err := domain.NewCommitMessage(title)
+	if err != nil {
+		return nil, err
+	}
+	msg.SetBody(body)
+	return msg, nil
+}
+
+// splitError reports which commit of the split failed.
+func splitError(index, total int, err error) error {
+	return fmt.Errorf("commit %d of %d: %w (%d earlier commits were kept)", index+1, total, err, index)
+}
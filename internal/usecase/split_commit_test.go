@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/gitman/internal/domain"
+)
+
+func TestSplitCommit_ExecuteCommitsEachGroup(t *testing.T) {
+	ops := &fakeGitOps{index: "partial"}
+
+	groups := []domain.CommitGroup{
+		{Files: []string{"api/user.go", "api/user_test.go"}, Message: mustCommitMessage(t, "Add user lookup endpoint")},
+		{Files: []string{"docs/README.md"}, Message: mustCommitMessage(t, "Document setup")},
+	}
+
+	resp, err := NewSplitCommitUseCase(ops, nil).Execute(context.Background(), ExecuteSplitRequest{
+		RepoPath: "/tmp/repo",
+		Groups:   groups,
+	})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error = %v", err)
+	}
+
+	// Each commit stages only its own group's files
+	if len(ops.added) != len(groups) || len(ops.messages) != len(groups) {
+		t.Fatalf("Add/Commit called %d/%d times, want once per group", len(ops.added), len(ops.messages))
+	}
+	for i, group := range groups {
+		if strings.Join(ops.added[i], ",") != strings.Join(group.Files, ",") {
+			t.Errorf("commit %d staged %v, want %v", i+1, ops.added[i], group.Files)
+		}
+		if ops.messages[i] != group.Message.FullMessage() {
+			t.Errorf("commit %d message = %q, want %q", i+1, ops.messages[i], group.Message.FullMessage())
+		}
+	}
+	if len(resp.CommitHashes) != len(groups) {
+		t.Errorf("CommitHashes = %v, want one per group", resp.CommitHashes)
+	}
+}
+
+func mustCommitMessage(t *testing.T, title string) *domain.CommitMessage {
+	t.Helper()
+
+	msg, err := domain.NewCommitMessage(title)
+	if err != nil {
+		t.Fatalf("NewCommitMessage() error = %v", err)
+	}
+	return msg
+}
+
+func TestSplitCommit_InterruptedSplitIsResumable(t *testing.T) {
+	repo, err := domain.NewRepository("/tmp/repo")
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v", err)
+	}
+	repo.AddChange(domain.FileChange{Path: "docs/README.md", Status: domain.StatusModified})
+	repo.AddChange(domain.FileChange{Path: "Makefile", Status: domain.StatusModified})
+
+	ops := &fakeGitOps{
+		repo:         repo,
+		gitDir:       t.TempDir(),
+		commitErr:    errors.New("commit-msg hook rejected the commit"),
+		failOnCommit: 2,
+	}
+	uc := NewSplitCommitUseCase(ops, nil)
+
+	groups := []domain.CommitGroup{
+		{Files: []string{"api/user.go"}, Message: mustCommitMessage(t, "Add user lookup endpoint")},
+		{Files: []string{"docs/README.md"}, Message: mustCommitMessage(t, "Document setup")},
+		{Files: []string{"Makefile", "build.sh"}, Message: mustCommitMessage(t, "Add release target")},
+	}
+	groups[2].Message.SetBody("Builds signed archives.")
+
+	if _, err := uc.Execute(context.Background(), ExecuteSplitRequest{RepoPath: "/tmp/repo", Groups: groups}); err == nil {
+		t.Fatal("Execute() error = nil, want the second commit to fail")
+	}
+
+	// The first commit was made; the rest are offered again, minus files without changes
+	pending, err := uc.PendingSplit(context.Background(), "/tmp/repo")
+	if err != nil {
+		t.Fatalf("PendingSplit() error = %v", err)
+	}
+	if len(pending) != 2 {
+		t.Fatalf("PendingSplit() = %d groups, want the 2 unfinished commits", len(pending))
+	}
+	if strings.Join(pending[1].Files, ",") != "Makefile" {
+		t.Errorf("pending files = %v, want only the changed Makefile", pending[1].Files)
+	}
+	if pending[1].Message.FullMessage() != groups[2].Message.FullMessage() {
+		t.Errorf("pending message = %q, want %q", pending[1].Message.FullMessage(), groups[2].Message.FullMessage())
+	}
+
+	ops.failOnCommit = 0
+	ops.commitErr = nil
+	if _, err := uc.Execute(context.Background(), ExecuteSplitRequest{RepoPath: "/tmp/repo", Groups: pending}); err != nil {
+		t.Fatalf("Execute() resume error = %v", err)
+	}
+	if pending, err := uc.PendingSplit(context.Background(), "/tmp/repo"); err != nil || pending != nil {
+		t.Errorf("PendingSplit() after resume = %v, %v, want no plan", pending, err)
+	}
+}